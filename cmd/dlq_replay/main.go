@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/client/kafka/consumer"
+	"github.com/ipv02/auth/internal/client/kafka/producer"
+	"github.com/ipv02/auth/internal/config"
+	"github.com/ipv02/auth/internal/config/env"
+)
+
+// replayGroupSuffix отдельная группа хранит свои смещения в dead letter топике,
+// поэтому повторный запуск не отправляет сообщения заново
+const replayGroupSuffix = "-dlq-replay"
+
+var (
+	configPath  string
+	topic       string
+	idleTimeout time.Duration
+)
+
+func init() {
+	flag.StringVar(&configPath, "config-path", "local.env", "path to config file")
+	flag.StringVar(&topic, "topic", "", "source topic whose dead letter topic is replayed")
+	flag.DurationVar(&idleTimeout, "idle-timeout", 30*time.Second, "stop after no messages were received for this long")
+}
+
+// Команда возвращает сообщения из <topic>.dlq в исходный топик и завершается,
+// когда новых сообщений нет дольше idle-timeout
+func main() {
+	flag.Parse()
+
+	if len(topic) == 0 {
+		log.Fatalf("topic is required")
+	}
+
+	err := config.Load(configPath)
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	consumerConfig, err := env.NewKafkaConsumerConfig()
+	if err != nil {
+		log.Fatalf("failed to get kafka consumer config: %v", err)
+	}
+
+	producerConfig, err := env.NewKafkaProducerConfig()
+	if err != nil {
+		log.Fatalf("failed to get kafka producer config: %v", err)
+	}
+
+	syncProducer, err := sarama.NewSyncProducer(producerConfig.Brokers(), producerConfig.Config())
+	if err != nil {
+		log.Fatalf("failed to create sync producer: %v", err)
+	}
+
+	p := producer.NewSyncProducer(syncProducer, nil)
+	defer func() {
+		if err := p.Close(); err != nil {
+			log.Printf("failed to close producer: %v", err)
+		}
+	}()
+
+	// у новой группы еще нет смещений, и она должна вернуть все накопившиеся сообщения,
+	// а не только пришедшие после запуска, независимо от KAFKA_CONSUMER_INITIAL_OFFSET
+	groupConfig := consumerConfig.Config()
+	groupConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	consumerGroup, err := sarama.NewConsumerGroup(
+		consumerConfig.Brokers(),
+		consumerConfig.GroupID()+replayGroupSuffix,
+		groupConfig,
+	)
+	if err != nil {
+		log.Fatalf("failed to create consumer group: %v", err)
+	}
+
+	// без dead letter producer сообщение, которое не удалось вернуть, повторяется до остановки команды.
+	// Сообщения возвращаются одним воркером, чтобы сохранить их порядок в dead letter топике
+	c := consumer.NewConsumer(consumerGroup, consumer.NewGroupHandler(consumer.RetryPolicy{
+		Attempts:       consumerConfig.RetryAttempts(),
+		InitialBackoff: consumerConfig.RetryInitialBackoff(),
+		MaxBackoff:     consumerConfig.RetryMaxBackoff(),
+	}, nil, 1))
+	defer func() {
+		if err := c.Close(); err != nil {
+			log.Printf("failed to close consumer: %v", err)
+		}
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	activity := make(chan struct{}, 1)
+	go cancelWhenIdle(ctx, cancel, activity)
+
+	var replayed atomic.Int64
+	err = c.Consume(ctx, []string{topic + consumer.DeadLetterTopicSuffix}, func(ctx context.Context, msg *sarama.ConsumerMessage) error {
+		select {
+		case activity <- struct{}{}:
+		default:
+		}
+
+		err := p.SendMessage(ctx, consumer.ToReplayMessage(msg))
+		if err != nil {
+			return err
+		}
+
+		replayed.Add(1)
+		return nil
+	})
+	if err != nil && !errors.Is(err, context.Canceled) {
+		log.Printf("failed to replay dead letter messages: %v", err)
+	}
+
+	log.Printf("replayed %d messages to %s", replayed.Load(), topic)
+}
+
+// cancelWhenIdle отменяет контекст, если за idleTimeout не было получено ни одного сообщения
+func cancelWhenIdle(ctx context.Context, cancel context.CancelFunc, activity <-chan struct{}) {
+	timer := time.NewTimer(idleTimeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-activity:
+			if !timer.Stop() {
+				<-timer.C
+			}
+			timer.Reset(idleTimeout)
+		case <-timer.C:
+			cancel()
+			return
+		}
+	}
+}
@@ -1,72 +1,88 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
+	"flag"
 	"log"
-	"strings"
 
 	"github.com/IBM/sarama"
 	"github.com/brianvoe/gofakeit"
+	"google.golang.org/protobuf/proto"
 
-	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/client/kafka/producer"
+	"github.com/ipv02/auth/internal/config"
+	"github.com/ipv02/auth/internal/config/env"
+	"github.com/ipv02/auth/internal/service/consumer/user_saver"
+	"github.com/ipv02/auth/pkg/user_events_v1"
 )
 
-const (
-	brokerAddress           = "localhost:9092, localhost:9093, localhost:9094"
-	topicName               = "test-topic"
-	producerRetryMax        = 5
-	isProducerReturnSuccess = true
-)
+const topicName = "test-topic"
+
+var configPath string
+
+func init() {
+	flag.StringVar(&configPath, "config-path", "local.env", "path to config file")
+}
 
 func main() {
-	producer, err := newSyncProducer(strings.Split(brokerAddress, ","))
+	flag.Parse()
+	ctx := context.Background()
+
+	err := config.Load(configPath)
+	if err != nil {
+		log.Fatalf("failed to load config: %v\n", err.Error())
+	}
+
+	producerConfig, err := env.NewKafkaProducerConfig()
+	if err != nil {
+		log.Fatalf("failed to get kafka producer config: %v\n", err.Error())
+	}
+
+	asyncProducer, err := sarama.NewAsyncProducer(producerConfig.Brokers(), producerConfig.Config())
 	if err != nil {
 		log.Fatalf("failed to start producer: %v\n", err.Error())
 	}
 
+	// Close дожидается отчета о доставке, поэтому сообщение можно отправить асинхронно
+	p := producer.NewAsyncProducer(asyncProducer, func(report producer.DeliveryReport) {
+		if report.Err != nil {
+			producer.LogDeliveryErrors(report)
+			return
+		}
+
+		log.Printf("message sent to partition %d with offset %d\n", report.Partition, report.Offset)
+	})
+
 	defer func() {
-		if err = producer.Close(); err != nil {
+		if err = p.Close(); err != nil {
 			log.Fatalf("failed to close producer: %v\n", err.Error())
 		}
 	}()
 
-	userCreate := model.UserCreate{
-		Name:            gofakeit.Name(),
+	password := gofakeit.Password(true, true, true, true, false, 10)
+	event := &user_events_v1.UserCreateEvent{
+		Name:            gofakeit.Lexify("????????"),
 		Email:           gofakeit.Email(),
-		Password:        gofakeit.Password(true, true, true, true, true, 10),
-		PasswordConfirm: gofakeit.Password(true, true, true, true, true, 10),
-		Role:            gofakeit.Int32(),
+		Password:        password,
+		PasswordConfirm: password,
+		Role:            user_events_v1.UserRole_USER,
 	}
 
-	data, err := json.Marshal(userCreate)
+	data, err := proto.Marshal(event)
 	if err != nil {
 		log.Fatalf("failed to marshal data: %v\n", err.Error())
 	}
 
-	msg := &sarama.ProducerMessage{
+	err = p.SendMessage(ctx, &producer.Message{
 		Topic: topicName,
-		Value: sarama.StringEncoder(data),
-	}
-
-	partition, offset, err := producer.SendMessage(msg)
+		Value: data,
+		Headers: []producer.Header{
+			{Key: user_saver.ContentTypeHeader, Value: []byte(user_saver.ContentTypeProtobuf)},
+			{Key: user_saver.IdempotencyKeyHeader, Value: []byte(gofakeit.UUID())},
+		},
+	})
 	if err != nil {
 		log.Printf("failed to send message in Kafka: %v\n", err.Error())
 		return
 	}
-
-	log.Printf("message sent to partition %d with offset %d\n", partition, offset)
-}
-
-func newSyncProducer(brokerList []string) (sarama.SyncProducer, error) {
-	config := sarama.NewConfig()
-	config.Producer.RequiredAcks = sarama.WaitForAll
-	config.Producer.Retry.Max = producerRetryMax
-	config.Producer.Return.Successes = isProducerReturnSuccess
-
-	producer, err := sarama.NewSyncProducer(brokerList, config)
-	if err != nil {
-		return nil, err
-	}
-
-	return producer, nil
 }
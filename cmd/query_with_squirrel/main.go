@@ -31,8 +31,8 @@ func main() {
 	// Делаем запрос на вставку записи в таблицу auth
 	builderInsert := sq.Insert("auth").
 		PlaceholderFormat(sq.Dollar).
-		Columns("name", "email", "role", "password", "password_confirm").
-		Values(gofakeit.Name(), gofakeit.Email(), 1, "password", "password").
+		Columns("name", "email", "role", "password").
+		Values(gofakeit.Name(), gofakeit.Email(), 1, "password").
 		Suffix("RETURNING id")
 
 	query, args, err := builderInsert.ToSql()
@@ -0,0 +1,36 @@
+package access
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/utils"
+	"github.com/ipv02/auth/pkg/access_v1"
+)
+
+// Check запрос на проверку доступа владельца bearer токена к эндпоинту.
+func (i *Implementation) Check(ctx context.Context, req *access_v1.CheckRequest) (*emptypb.Empty, error) {
+	accessToken, err := utils.BearerTokenFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	err = i.accessService.Check(ctx, accessToken, req.GetEndpointAddress())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrorInvalidToken):
+			return nil, status.Error(codes.Unauthenticated, model.ErrorInvalidToken.Error())
+		case errors.Is(err, model.ErrorAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, model.ErrorAccessDenied.Error())
+		default:
+			return nil, err
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
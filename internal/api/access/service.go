@@ -0,0 +1,19 @@
+package access
+
+import (
+	"github.com/ipv02/auth/internal/service"
+	"github.com/ipv02/auth/pkg/access_v1"
+)
+
+// Implementation структура описывающая сервер проверки доступа
+type Implementation struct {
+	access_v1.UnimplementedAccessV1Server
+	accessService service.AccessService
+}
+
+// NewImplementation конструктор создает реализацию сервера проверки доступа
+func NewImplementation(accessService service.AccessService) *Implementation {
+	return &Implementation{
+		accessService: accessService,
+	}
+}
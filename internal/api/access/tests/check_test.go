@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ipv02/auth/internal/api/access"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/service"
+	serviceMocks "github.com/ipv02/auth/internal/service/mocks"
+	"github.com/ipv02/auth/pkg/access_v1"
+)
+
+func TestCheck(t *testing.T) {
+	type accessServiceMockFunc func(mc *minimock.Controller) service.AccessService
+
+	type args struct {
+		ctx context.Context
+		req *access_v1.CheckRequest
+	}
+
+	var (
+		mc = minimock.NewController(t)
+
+		token    = gofakeit.UUID()
+		endpoint = "/user_v1.UserV1/DeleteUser"
+
+		ctx        = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+		serviceErr = fmt.Errorf("service error")
+
+		req = &access_v1.CheckRequest{
+			EndpointAddress: endpoint,
+		}
+	)
+
+	tests := []struct {
+		name              string
+		args              args
+		want              *emptypb.Empty
+		err               error
+		accessServiceMock accessServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: &emptypb.Empty{},
+			err:  nil,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckMock.Expect(ctx, token, endpoint).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "missing token case",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: nil,
+			err:  status.Error(codes.Unauthenticated, model.ErrorMissingToken.Error()),
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				return serviceMocks.NewAccessServiceMock(mc)
+			},
+		},
+		{
+			name: "access denied case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  status.Error(codes.PermissionDenied, model.ErrorAccessDenied.Error()),
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckMock.Expect(ctx, token, endpoint).Return(model.ErrorAccessDenied)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckMock.Expect(ctx, token, endpoint).Return(serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessServiceMock := tt.accessServiceMock(mc)
+			api := access.NewImplementation(accessServiceMock)
+
+			res, err := api.Check(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
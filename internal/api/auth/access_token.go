@@ -0,0 +1,19 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/ipv02/auth/pkg/auth_v1"
+)
+
+// GetAccessToken запрос на получение access токена по refresh токену.
+func (i *Implementation) GetAccessToken(ctx context.Context, req *auth_v1.GetAccessTokenRequest) (*auth_v1.GetAccessTokenResponse, error) {
+	accessToken, err := i.authService.GetAccessToken(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &auth_v1.GetAccessTokenResponse{
+		AccessToken: accessToken,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package auth
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ipv02/auth/internal/model"
+)
+
+var unauthenticatedErrors = []error{
+	model.ErrorInvalidCredentials,
+	model.ErrorInvalidToken,
+	model.ErrorRefreshTokenReused,
+}
+
+// toStatusError переводит ошибки аутентификации в gRPC статус Unauthenticated
+func toStatusError(err error) error {
+	for _, target := range unauthenticatedErrors {
+		if errors.Is(err, target) {
+			return status.Error(codes.Unauthenticated, target.Error())
+		}
+	}
+
+	return err
+}
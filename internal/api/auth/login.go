@@ -0,0 +1,20 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/ipv02/auth/pkg/auth_v1"
+)
+
+// Login запрос на вход пользователя по email и паролю.
+func (i *Implementation) Login(ctx context.Context, req *auth_v1.LoginRequest) (*auth_v1.LoginResponse, error) {
+	tokens, err := i.authService.Login(ctx, req.GetEmail(), req.GetPassword())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &auth_v1.LoginResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
@@ -0,0 +1,20 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/ipv02/auth/pkg/auth_v1"
+)
+
+// GetRefreshToken запрос на обмен refresh токена на новую пару токенов.
+func (i *Implementation) GetRefreshToken(ctx context.Context, req *auth_v1.GetRefreshTokenRequest) (*auth_v1.GetRefreshTokenResponse, error) {
+	tokens, err := i.authService.GetRefreshToken(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &auth_v1.GetRefreshTokenResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
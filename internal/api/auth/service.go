@@ -0,0 +1,19 @@
+package auth
+
+import (
+	"github.com/ipv02/auth/internal/service"
+	"github.com/ipv02/auth/pkg/auth_v1"
+)
+
+// Implementation структура описывающая сервер аутентификации
+type Implementation struct {
+	auth_v1.UnimplementedAuthV1Server
+	authService service.AuthService
+}
+
+// NewImplementation конструктор создает реализацию сервера аутентификации
+func NewImplementation(authService service.AuthService) *Implementation {
+	return &Implementation{
+		authService: authService,
+	}
+}
@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ipv02/auth/internal/api/auth"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/service"
+	serviceMocks "github.com/ipv02/auth/internal/service/mocks"
+	"github.com/ipv02/auth/pkg/auth_v1"
+)
+
+func TestLogin(t *testing.T) {
+	type authServiceMockFunc func(mc *minimock.Controller) service.AuthService
+
+	type args struct {
+		ctx context.Context
+		req *auth_v1.LoginRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		email        = gofakeit.Email()
+		password     = gofakeit.Password(true, true, true, true, false, 10)
+		accessToken  = gofakeit.UUID()
+		refreshToken = gofakeit.UUID()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &auth_v1.LoginRequest{
+			Email:    email,
+			Password: password,
+		}
+
+		serviceRes = &model.TokenPair{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+		}
+
+		res = &auth_v1.LoginResponse{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *auth_v1.LoginResponse
+		err             error
+		authServiceMock authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.LoginMock.Expect(ctx, email, password).Return(serviceRes, nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid credentials case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  status.Error(codes.Unauthenticated, model.ErrorInvalidCredentials.Error()),
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.LoginMock.Expect(ctx, email, password).Return(nil, model.ErrorInvalidCredentials)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.LoginMock.Expect(ctx, email, password).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authServiceMock := tt.authServiceMock(mc)
+			api := auth.NewImplementation(authServiceMock)
+
+			res, err := api.Login(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ipv02/auth/internal/api/auth"
+	"github.com/ipv02/auth/internal/model"
+	serviceMocks "github.com/ipv02/auth/internal/service/mocks"
+	"github.com/ipv02/auth/pkg/auth_v1"
+)
+
+func TestGetRefreshToken(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		refreshToken    = gofakeit.UUID()
+		newRefreshToken = gofakeit.UUID()
+		newAccessToken  = gofakeit.UUID()
+	)
+
+	t.Run("success case", func(t *testing.T) {
+		mock := serviceMocks.NewAuthServiceMock(mc)
+		mock.GetRefreshTokenMock.Expect(ctx, refreshToken).Return(&model.TokenPair{
+			AccessToken:  newAccessToken,
+			RefreshToken: newRefreshToken,
+		}, nil)
+
+		res, err := auth.NewImplementation(mock).GetRefreshToken(ctx, &auth_v1.GetRefreshTokenRequest{RefreshToken: refreshToken})
+		require.NoError(t, err)
+		require.Equal(t, &auth_v1.GetRefreshTokenResponse{
+			AccessToken:  newAccessToken,
+			RefreshToken: newRefreshToken,
+		}, res)
+	})
+
+	t.Run("reused token case", func(t *testing.T) {
+		mock := serviceMocks.NewAuthServiceMock(mc)
+		mock.GetRefreshTokenMock.Expect(ctx, refreshToken).Return(nil, errors.Wrap(model.ErrorRefreshTokenReused, "tx"))
+
+		res, err := auth.NewImplementation(mock).GetRefreshToken(ctx, &auth_v1.GetRefreshTokenRequest{RefreshToken: refreshToken})
+		require.Nil(t, res)
+		require.Equal(t, status.Error(codes.Unauthenticated, model.ErrorRefreshTokenReused.Error()), err)
+	})
+}
+
+func TestGetAccessToken(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		refreshToken = gofakeit.UUID()
+		accessToken  = gofakeit.UUID()
+	)
+
+	t.Run("success case", func(t *testing.T) {
+		mock := serviceMocks.NewAuthServiceMock(mc)
+		mock.GetAccessTokenMock.Expect(ctx, refreshToken).Return(accessToken, nil)
+
+		res, err := auth.NewImplementation(mock).GetAccessToken(ctx, &auth_v1.GetAccessTokenRequest{RefreshToken: refreshToken})
+		require.NoError(t, err)
+		require.Equal(t, &auth_v1.GetAccessTokenResponse{AccessToken: accessToken}, res)
+	})
+
+	t.Run("invalid token case", func(t *testing.T) {
+		mock := serviceMocks.NewAuthServiceMock(mc)
+		mock.GetAccessTokenMock.Expect(ctx, refreshToken).Return("", model.ErrorInvalidToken)
+
+		res, err := auth.NewImplementation(mock).GetAccessToken(ctx, &auth_v1.GetAccessTokenRequest{RefreshToken: refreshToken})
+		require.Nil(t, res)
+		require.Equal(t, status.Error(codes.Unauthenticated, model.ErrorInvalidToken.Error()), err)
+	})
+}
@@ -6,13 +6,14 @@ import (
 
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/ipv02/auth/internal/model"
 	"github.com/ipv02/auth/pkg/user_v1"
 )
 
 // DeleteUser запрос на удаление пользователя.
 func (i *Implementation) DeleteUser(ctx context.Context, req *user_v1.DeleteUserRequest) (*emptypb.Empty, error) {
 	if err := req.ValidateRequest(); err != nil {
-		return nil, err
+		return nil, model.NewInvalidArgumentError(err.Error())
 	}
 
 	err := i.userService.DeleteUser(ctx, req.Id)
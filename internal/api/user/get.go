@@ -4,14 +4,19 @@ import (
 	"context"
 	"log"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
 	"github.com/ipv02/auth/internal/converter"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/utils"
 	"github.com/ipv02/auth/pkg/user_v1"
 )
 
 // GetUser запроолс получения информации о пользователе.
 func (i *Implementation) GetUser(ctx context.Context, req *user_v1.GetUserRequest) (*user_v1.GetUserResponse, error) {
 	if err := req.ValidateRequest(); err != nil {
-		return nil, err
+		return nil, model.NewInvalidArgumentError(err.Error())
 	}
 
 	userObj, err := i.userService.GetUser(ctx, req.GetId())
@@ -21,5 +26,8 @@ func (i *Implementation) GetUser(ctx context.Context, req *user_v1.GetUserReques
 
 	log.Printf("get user: %v", userObj)
 
+	// Через gateway версия записи отдается заголовком ETag
+	_ = grpc.SetHeader(ctx, metadata.Pairs(utils.ETagHeader, utils.ETagFromVersion(userObj.Version)))
+
 	return converter.ToUserFromService(userObj), nil
 }
@@ -0,0 +1,23 @@
+package user
+
+import (
+	"context"
+
+	"github.com/ipv02/auth/internal/converter"
+	"github.com/ipv02/auth/pkg/user_v1"
+)
+
+// ListUsers запрос на получение страницы пользователей.
+func (i *Implementation) ListUsers(ctx context.Context, req *user_v1.ListUsersRequest) (*user_v1.ListUsersResponse, error) {
+	query, err := converter.ToUserListQueryFromReq(req)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := i.userService.ListUsers(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToUserListFromService(list)
+}
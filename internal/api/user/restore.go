@@ -0,0 +1,22 @@
+package user
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ipv02/auth/pkg/user_v1"
+)
+
+// RestoreUser запрос на восстановление удаленного пользователя.
+func (i *Implementation) RestoreUser(ctx context.Context, req *user_v1.RestoreUserRequest) (*emptypb.Empty, error) {
+	err := i.userService.RestoreUser(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("restored user: %v", req)
+
+	return &emptypb.Empty{}, nil
+}
@@ -93,6 +93,20 @@ func TestCreate(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "user already exists case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  model.ErrUserAlreadyExists,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateUserMock.Expect(ctx, serviceReq).Return(0, model.ErrUserAlreadyExists)
+				return mock
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ipv02/auth/internal/api/user"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/service"
+	serviceMocks "github.com/ipv02/auth/internal/service/mocks"
+	"github.com/ipv02/auth/internal/utils"
+	"github.com/ipv02/auth/pkg/user_v1"
+)
+
+func TestList(t *testing.T) {
+	type userServiceMockFunc func(mc *minimock.Controller) service.UserService
+
+	type args struct {
+		ctx context.Context
+		req *user_v1.ListUsersRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id          = gofakeit.Int64()
+		name        = gofakeit.Name()
+		email       = gofakeit.Email()
+		createdAt   = gofakeit.Date().UTC()
+		createdFrom = gofakeit.Date().UTC()
+
+		serviceErr = fmt.Errorf("service error")
+
+		cursor = &model.UserCursor{
+			CreatedAt: time.Date(2026, 10, 18, 12, 0, 0, 123456000, time.UTC),
+			ID:        gofakeit.Int64(),
+		}
+		pageToken, _ = utils.EncodeUserCursor(cursor)
+
+		req = &user_v1.ListUsersRequest{
+			PageSize:  10,
+			PageToken: pageToken,
+			Filter: &user_v1.ListUsersFilter{
+				Role:        user_v1.UserRole_ADMIN,
+				Name:        name,
+				CreatedFrom: timestamppb.New(createdFrom),
+			},
+			Sort: user_v1.SortOrder_CREATED_AT_ASC,
+		}
+
+		query = &model.UserListQuery{
+			Limit:  10,
+			Cursor: cursor,
+			Filter: model.UserListFilter{
+				Role:        int32(user_v1.UserRole_ADMIN),
+				Name:        name,
+				CreatedFrom: &createdFrom,
+			},
+			SortAsc: true,
+		}
+
+		serviceRes = &model.UserList{
+			Users: []*model.UserGet{
+				{
+					ID:        id,
+					Name:      name,
+					Email:     email,
+					UserRole:  int32(user_v1.UserRole_ADMIN),
+					CreatedAt: createdAt,
+				},
+			},
+			NextCursor: cursor,
+		}
+
+		res = &user_v1.ListUsersResponse{
+			Users: []*user_v1.GetUserResponse{
+				{
+					Id:        id,
+					Name:      name,
+					Email:     email,
+					Role:      user_v1.UserRole_ADMIN,
+					CreatedAt: timestamppb.New(createdAt),
+				},
+			},
+			NextPageToken: pageToken,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *user_v1.ListUsersResponse
+		err             error
+		userServiceMock userServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.ListUsersMock.Expect(ctx, query).Return(serviceRes, nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid page token case",
+			args: args{
+				ctx: ctx,
+				req: &user_v1.ListUsersRequest{PageToken: "not a token"},
+			},
+			want: nil,
+			err:  model.ErrorInvalidPageToken,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				return serviceMocks.NewUserServiceMock(mc)
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.ListUsersMock.Expect(ctx, query).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userServiceMock := tt.userServiceMock(mc)
+			api := user.NewImplementation(userServiceMock)
+
+			res, err := api.ListUsers(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
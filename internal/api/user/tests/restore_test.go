@@ -0,0 +1,104 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ipv02/auth/internal/api/user"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/service"
+	serviceMocks "github.com/ipv02/auth/internal/service/mocks"
+	"github.com/ipv02/auth/pkg/user_v1"
+)
+
+func TestRestore(t *testing.T) {
+	type userServiceMockFunc func(mc *minimock.Controller) service.UserService
+
+	type args struct {
+		ctx context.Context
+		req *user_v1.RestoreUserRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id = gofakeit.Int64()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &user_v1.RestoreUserRequest{
+			Id: id,
+		}
+
+		res = &emptypb.Empty{}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *emptypb.Empty
+		err             error
+		userServiceMock userServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.RestoreUserMock.Expect(ctx, id).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.RestoreUserMock.Expect(ctx, id).Return(serviceErr)
+				return mock
+			},
+		},
+		{
+			name: "email already taken case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  model.ErrUserAlreadyExists,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.RestoreUserMock.Expect(ctx, id).Return(model.ErrUserAlreadyExists)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userServiceMock := tt.userServiceMock(mc)
+			api := user.NewImplementation(userServiceMock)
+
+			res, err := api.RestoreUser(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
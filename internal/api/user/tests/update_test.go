@@ -8,7 +8,9 @@ import (
 	"github.com/brianvoe/gofakeit"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/ipv02/auth/internal/api/user"
@@ -38,17 +40,42 @@ func TestUpdate(t *testing.T) {
 		serviceErr = fmt.Errorf("service error")
 
 		req = &user_v1.UpdateUserRequest{
-			Id:    id,
-			Name:  wrapperspb.String(name),
-			Email: wrapperspb.String(email),
-			Role:  user_v1.UserRole(role),
+			Id:         id,
+			Name:       wrapperspb.String(name),
+			Email:      wrapperspb.String(email),
+			Role:       user_v1.UserRole(role),
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "email", "role"}},
 		}
 
 		serviceReq = &model.UserUpdate{
 			ID:    id,
 			Name:  &name,
 			Email: &email,
-			Role:  role,
+			Role:  &role,
+		}
+
+		nameOnlyReq = &user_v1.UpdateUserRequest{
+			Id:         id,
+			Name:       wrapperspb.String(name),
+			Role:       user_v1.UserRole_UNKNOWN,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+		}
+
+		nameOnlyServiceReq = &model.UserUpdate{
+			ID:   id,
+			Name: &name,
+		}
+
+		version = int64(gofakeit.Uint32()) + 1
+
+		ifMatchCtx = metadata.NewIncomingContext(ctx, metadata.Pairs("if-match", fmt.Sprintf(`W/"%d"`, version)))
+
+		serviceReqWithVersion = &model.UserUpdate{
+			ID:      id,
+			Name:    &name,
+			Email:   &email,
+			Role:    &role,
+			Version: version,
 		}
 
 		res = &emptypb.Empty{}
@@ -89,6 +116,78 @@ func TestUpdate(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "only masked fields case",
+			args: args{
+				ctx: ctx,
+				req: nameOnlyReq,
+			},
+			want: res,
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.UpdateUserMock.Expect(ctx, nameOnlyServiceReq).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "unknown mask path case",
+			args: args{
+				ctx: ctx,
+				req: &user_v1.UpdateUserRequest{
+					Id:         id,
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"password"}},
+				},
+			},
+			want: nil,
+			err: model.NewInvalidArgumentError("unknown update_mask path", model.FieldViolation{
+				Field:       "update_mask",
+				Description: `unknown path "password"`,
+			}),
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				return serviceMocks.NewUserServiceMock(mc)
+			},
+		},
+		{
+			name: "version from If-Match case",
+			args: args{
+				ctx: ifMatchCtx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.UpdateUserMock.Expect(ifMatchCtx, serviceReqWithVersion).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid If-Match case",
+			args: args{
+				ctx: metadata.NewIncomingContext(ctx, metadata.Pairs("if-match", "*")),
+				req: req,
+			},
+			want: nil,
+			err:  model.ErrorInvalidIfMatch,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				return serviceMocks.NewUserServiceMock(mc)
+			},
+		},
+		{
+			name: "version mismatch case",
+			args: args{
+				ctx: ifMatchCtx,
+				req: req,
+			},
+			want: nil,
+			err:  model.ErrorUserVersionMismatch,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.UpdateUserMock.Expect(ifMatchCtx, serviceReqWithVersion).Return(model.ErrorUserVersionMismatch)
+				return mock
+			},
+		},
 	}
 
 	for _, tt := range tests {
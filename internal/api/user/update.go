@@ -7,16 +7,32 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/ipv02/auth/internal/converter"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/utils"
 	"github.com/ipv02/auth/pkg/user_v1"
 )
 
 // UpdateUser запрос на обновление данных о пользователе.
 func (i *Implementation) UpdateUser(ctx context.Context, req *user_v1.UpdateUserRequest) (*emptypb.Empty, error) {
 	if err := req.ValidateRequest(); err != nil {
+		return nil, model.NewInvalidArgumentError(err.Error())
+	}
+
+	userUpdate, err := converter.ToUserUpdateFromReq(req)
+	if err != nil {
 		return nil, err
 	}
 
-	err := i.userService.UpdateUser(ctx, converter.ToUserUpdateFromReq(req))
+	if userUpdate.Version == 0 {
+		version, err := utils.VersionFromIfMatch(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		userUpdate.Version = version
+	}
+
+	err = i.userService.UpdateUser(ctx, userUpdate)
 	if err != nil {
 		return nil, err
 	}
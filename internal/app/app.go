@@ -2,26 +2,38 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"log"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rakyll/statik/fs"
 	"github.com/rs/cors"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 
 	"github.com/ipv02/auth/internal/closer"
 	"github.com/ipv02/auth/internal/config"
 	"github.com/ipv02/auth/internal/interceptor"
+	"github.com/ipv02/auth/internal/tracing"
+	"github.com/ipv02/auth/internal/utils"
+	"github.com/ipv02/auth/pkg/access_v1"
+	"github.com/ipv02/auth/pkg/auth_v1"
 	desc "github.com/ipv02/auth/pkg/user_v1"
 	// statik используется для инициализации статических ресурсов
 	// _ "github.com/ipv02/auth/statik"
@@ -34,6 +46,7 @@ type App struct {
 	grpcServer      *grpc.Server
 	httpServer      *http.Server
 	swaggerServer   *http.Server
+	metricsServer   *http.Server
 }
 
 // NewApp создает новый экземпляр App, инициализируя зависимости
@@ -57,12 +70,9 @@ func (a *App) Run(ctx context.Context) error {
 
 	ctx, cancel := context.WithCancel(ctx)
 
-	wg := &sync.WaitGroup{}
-	wg.Add(2)
-
+	// Серверы работают до завершения процесса, в WaitGroup учитываются
+	// только фоновые обработчики, которые завершаются по отмене контекста
 	go func() {
-		defer wg.Done()
-
 		err := a.runGRPCServer()
 		if err != nil {
 			log.Fatalf("failed to run GRPC server: %v", err)
@@ -70,8 +80,6 @@ func (a *App) Run(ctx context.Context) error {
 	}()
 
 	go func() {
-		defer wg.Done()
-
 		err := a.runHTTPServer()
 		if err != nil {
 			log.Fatalf("failed to run HTTP server: %v", err)
@@ -79,14 +87,22 @@ func (a *App) Run(ctx context.Context) error {
 	}()
 
 	go func() {
-		defer wg.Done()
-
 		err := a.runSwaggerServer()
 		if err != nil {
 			log.Fatalf("failed to run Swagger server: %v", err)
 		}
 	}()
 
+	go func() {
+		err := a.runMetricsServer()
+		if err != nil {
+			log.Fatalf("failed to run metrics server: %v", err)
+		}
+	}()
+
+	wg := &sync.WaitGroup{}
+	wg.Add(4)
+
 	go func() {
 		defer wg.Done()
 		err := a.serviceProvider.UserSaverConsumer(ctx).RunConsumer(ctx)
@@ -95,6 +111,34 @@ func (a *App) Run(ctx context.Context) error {
 		}
 	}()
 
+	go func() {
+		defer wg.Done()
+		err := a.serviceProvider.AccessService(ctx).RunPolicyReload(ctx)
+		if err != nil {
+			log.Printf("failed to run access policy reload: %s", err.Error())
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		err := a.serviceProvider.PurgerService(ctx).RunPurger(ctx)
+		if err != nil {
+			log.Printf("failed to run user purger: %s", err.Error())
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		if !a.serviceProvider.OutboxConfig().Enabled() {
+			return
+		}
+
+		err := a.serviceProvider.OutboxService(ctx).RunRelay(ctx)
+		if err != nil {
+			log.Printf("failed to run outbox relay: %s", err.Error())
+		}
+	}()
+
 	gracefulShutdown(ctx, cancel, wg)
 	return nil
 }
@@ -103,9 +147,11 @@ func (a *App) initDeps(ctx context.Context) error {
 	inits := []func(context.Context) error{
 		a.initConfig,
 		a.initServiceProvider,
+		a.initTracing,
 		a.initGRPCServer,
 		a.initHTTPServer,
 		a.initSwaggerServer,
+		a.initMetricsServer,
 	}
 
 	for _, f := range inits {
@@ -132,24 +178,47 @@ func (a *App) initServiceProvider(_ context.Context) error {
 	return nil
 }
 
+// initTracing устанавливает глобальный провайдер трейсов и формат передачи контекста трейса,
+// которые используют gRPC, gateway, клиенты баз данных и kafka
+func (a *App) initTracing(ctx context.Context) error {
+	otel.SetTracerProvider(a.serviceProvider.TracerProvider(ctx))
+	otel.SetTextMapPropagator(tracing.NewPropagator())
+
+	return nil
+}
+
 func (a *App) initGRPCServer(ctx context.Context) error {
 	a.grpcServer = grpc.NewServer(
 		grpc.Creds(insecure.NewCredentials()),
-		grpc.UnaryInterceptor(interceptor.ValidateInterceptor),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			interceptor.MetricsInterceptor,
+			interceptor.ErrorInterceptor,
+			a.serviceProvider.AuthInterceptor().Unary,
+			interceptor.ValidateInterceptor,
+		),
 	)
 
 	reflection.Register(a.grpcServer)
 
 	desc.RegisterUserV1Server(a.grpcServer, a.serviceProvider.UserImpl(ctx))
+	auth_v1.RegisterAuthV1Server(a.grpcServer, a.serviceProvider.AuthImpl(ctx))
+	access_v1.RegisterAccessV1Server(a.grpcServer, a.serviceProvider.AccessImpl(ctx))
 
 	return nil
 }
 
 func (a *App) initHTTPServer(ctx context.Context) error {
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithErrorHandler(httpErrorHandler),
+		runtime.WithMiddlewares(interceptor.TracingMiddleware, interceptor.MetricsMiddleware),
+	)
 
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 	}
 
 	err := desc.RegisterUserV1HandlerFromEndpoint(ctx, mux, a.serviceProvider.GRPCConfig().Address(), opts)
@@ -157,16 +226,27 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 		return err
 	}
 
+	err = auth_v1.RegisterAuthV1HandlerFromEndpoint(ctx, mux, a.serviceProvider.GRPCConfig().Address(), opts)
+	if err != nil {
+		return err
+	}
+
+	err = access_v1.RegisterAccessV1HandlerFromEndpoint(ctx, mux, a.serviceProvider.GRPCConfig().Address(), opts)
+	if err != nil {
+		return err
+	}
+
 	corsMiddleware := cors.New(cors.Options{
 		AllowedOrigins:   []string{"*"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Content-Type", "Content-Length", "Authorization"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Content-Type", "Content-Length", "Authorization", "If-Match"},
+		ExposedHeaders:   []string{"ETag"},
 		AllowCredentials: true,
 	})
 
 	a.httpServer = &http.Server{
 		Addr:              a.serviceProvider.HTTPConfig().Address(),
-		Handler:           corsMiddleware.Handler(mux),
+		Handler:           otelhttp.NewHandler(corsMiddleware.Handler(mux), "gateway"),
 		ReadHeaderTimeout: 5 * time.Second,
 	}
 
@@ -192,6 +272,19 @@ func (a *App) initSwaggerServer(_ context.Context) error {
 	return nil
 }
 
+func (a *App) initMetricsServer(_ context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	a.metricsServer = &http.Server{
+		Addr:              a.serviceProvider.MetricsConfig().Address(),
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	return nil
+}
+
 func (a *App) runGRPCServer() error {
 	log.Printf("GRPC server is running on %s", a.serviceProvider.GRPCConfig().Address())
 
@@ -230,6 +323,17 @@ func (a *App) runSwaggerServer() error {
 	return nil
 }
 
+func (a *App) runMetricsServer() error {
+	log.Printf("Metrics server is running on %s", a.serviceProvider.MetricsConfig().Address())
+
+	err := a.metricsServer.ListenAndServe()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func serveSwaggerFile(path string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log.Printf("Serving swagger file: %s", path)
@@ -275,6 +379,44 @@ func serveSwaggerFile(path string) http.HandlerFunc {
 	}
 }
 
+// incomingHeaderMatcher передает If-Match в gRPC метаданные без префикса gateway
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, utils.IfMatchHeader) {
+		return utils.IfMatchHeader, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher отдает версию записи из gRPC метаданных заголовком ETag
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == utils.ETagHeader {
+		return "ETag", true
+	}
+
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
+
+// httpErrorHandler отвечает 412 на несовпадение версии записи. Остальные Aborted остаются 409 по умолчанию
+func httpErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if isPreconditionFailure(err) {
+		err = &runtime.HTTPStatusError{HTTPStatus: http.StatusPreconditionFailed, Err: err}
+	}
+
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+// isPreconditionFailure проверяет, что gRPC статус содержит деталь PreconditionFailure
+func isPreconditionFailure(err error) bool {
+	for _, detail := range status.Convert(err).Details() {
+		if _, ok := detail.(*errdetails.PreconditionFailure); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 func gracefulShutdown(ctx context.Context, cancel context.CancelFunc, wg *sync.WaitGroup) {
 	select {
 	case <-ctx.Done():
@@ -3,10 +3,16 @@ package app
 import (
 	"context"
 	"log"
+	"log/slog"
+	"os"
 
 	"github.com/IBM/sarama"
 	redigo "github.com/gomodule/redigo/redis"
+	"github.com/prometheus/client_golang/prometheus"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
+	"github.com/ipv02/auth/internal/api/access"
+	"github.com/ipv02/auth/internal/api/auth"
 	"github.com/ipv02/auth/internal/api/user"
 	"github.com/ipv02/auth/internal/client/cache"
 	"github.com/ipv02/auth/internal/client/cache/redis"
@@ -15,15 +21,28 @@ import (
 	"github.com/ipv02/auth/internal/client/db/transaction"
 	"github.com/ipv02/auth/internal/client/kafka"
 	kafkaConsumer "github.com/ipv02/auth/internal/client/kafka/consumer"
+	kafkaProducer "github.com/ipv02/auth/internal/client/kafka/producer"
 	"github.com/ipv02/auth/internal/closer"
 	"github.com/ipv02/auth/internal/config"
 	"github.com/ipv02/auth/internal/config/env"
+	"github.com/ipv02/auth/internal/interceptor"
 	"github.com/ipv02/auth/internal/repository"
+	accessRepository "github.com/ipv02/auth/internal/repository/access/pg"
+	outboxRepository "github.com/ipv02/auth/internal/repository/outbox/pg"
+	processedMessageRepository "github.com/ipv02/auth/internal/repository/processed_message/pg"
+	refreshTokenRepository "github.com/ipv02/auth/internal/repository/refresh_token/pg"
+	userRepositoryCached "github.com/ipv02/auth/internal/repository/user/cached"
 	userRepository "github.com/ipv02/auth/internal/repository/user/pg"
 	userRepositoryRedis "github.com/ipv02/auth/internal/repository/user/redis"
 	"github.com/ipv02/auth/internal/service"
+	accessService "github.com/ipv02/auth/internal/service/access"
+	authService "github.com/ipv02/auth/internal/service/auth"
 	userSaverConsumer "github.com/ipv02/auth/internal/service/consumer/user_saver"
+	"github.com/ipv02/auth/internal/service/hasher"
+	outboxService "github.com/ipv02/auth/internal/service/outbox"
+	purgerService "github.com/ipv02/auth/internal/service/purger"
 	userService "github.com/ipv02/auth/internal/service/user"
+	"github.com/ipv02/auth/internal/tracing"
 )
 
 type serviceProvider struct {
@@ -31,9 +50,20 @@ type serviceProvider struct {
 	grpcConfig          config.GRPCConfig
 	httpConfig          config.HTTPConfig
 	swaggerConfig       config.SwaggerConfig
+	metricsConfig       config.MetricsConfig
+	tracingConfig       config.TracingConfig
 	redisConfig         config.RedisConfig
 	storageConfig       config.StorageConfig
+	userCacheConfig     config.UserCacheConfig
 	kafkaConsumerConfig config.KafkaConsumerConfig
+	kafkaProducerConfig config.KafkaProducerConfig
+	hasherConfig        config.PasswordHasherConfig
+	jwtConfig           config.JWTConfig
+	accessConfig        config.AccessConfig
+	userPurgerConfig    config.UserPurgerConfig
+	outboxConfig        config.OutboxConfig
+
+	tracerProvider *sdktrace.TracerProvider
 
 	dbClient  db.Client
 	txManager db.TxManager
@@ -41,17 +71,36 @@ type serviceProvider struct {
 	redisPool   *redigo.Pool
 	redisClient cache.RedisClient
 
-	userRepository repository.UserRepository
+	userRepository             repository.UserRepository
+	refreshTokenRepository     repository.RefreshTokenRepository
+	accessRepository           repository.AccessRepository
+	outboxRepository           repository.OutboxRepository
+	processedMessageRepository repository.ProcessedMessageRepository
+
+	passwordHasher service.PasswordHasher
+
+	userService   service.UserService
+	authService   service.AuthService
+	accessService service.AccessService
+	purgerService service.PurgerService
+	outboxService service.OutboxRelayService
 
-	userService service.UserService
+	userImpl   *user.Implementation
+	authImpl   *auth.Implementation
+	accessImpl *access.Implementation
 
-	userImpl *user.Implementation
+	authInterceptor *interceptor.AuthInterceptor
 
 	userSaverConsumer service.ConsumerService
 
-	consumer             kafka.Consumer
-	consumerGroup        sarama.ConsumerGroup
-	consumerGroupHandler *kafkaConsumer.GroupHandler
+	consumer                  kafka.Consumer
+	batchConsumer             kafka.BatchConsumer
+	consumerGroup             sarama.ConsumerGroup
+	consumerGroupHandler      *kafkaConsumer.GroupHandler
+	batchConsumerGroupHandler *kafkaConsumer.BatchGroupHandler
+
+	producer     kafka.Producer
+	syncProducer sarama.SyncProducer
 }
 
 func newServiceProvider() *serviceProvider {
@@ -114,6 +163,52 @@ func (s *serviceProvider) SwaggerConfig() config.SwaggerConfig {
 	return s.swaggerConfig
 }
 
+// MetricsConfig представляет конфигурацию для подключения к серверу метрик
+func (s *serviceProvider) MetricsConfig() config.MetricsConfig {
+	if s.metricsConfig == nil {
+		cfg, err := env.NewMetricsConfig()
+		if err != nil {
+			log.Fatalf("failed to get metrics config: %s", err.Error())
+		}
+
+		s.metricsConfig = cfg
+	}
+
+	return s.metricsConfig
+}
+
+// TracingConfig представляет конфигурацию трейсинга
+func (s *serviceProvider) TracingConfig() config.TracingConfig {
+	if s.tracingConfig == nil {
+		cfg, err := env.NewTracingConfig()
+		if err != nil {
+			log.Fatalf("failed to get tracing config: %s", err.Error())
+		}
+
+		s.tracingConfig = cfg
+	}
+
+	return s.tracingConfig
+}
+
+// TracerProvider провайдер трейсов, при завершении отправляет накопленные span в экспортер
+func (s *serviceProvider) TracerProvider(ctx context.Context) *sdktrace.TracerProvider {
+	if s.tracerProvider == nil {
+		tp, err := tracing.NewTracerProvider(ctx, s.TracingConfig())
+		if err != nil {
+			log.Fatalf("failed to create tracer provider: %s", err.Error())
+		}
+
+		closer.Add(func() error {
+			return tp.Shutdown(context.Background())
+		})
+
+		s.tracerProvider = tp
+	}
+
+	return s.tracerProvider
+}
+
 // RedisConfig представляет конфигурацию для подключения к redis
 func (s *serviceProvider) RedisConfig() config.RedisConfig {
 	if s.redisConfig == nil {
@@ -142,6 +237,20 @@ func (s *serviceProvider) StorageConfig() config.StorageConfig {
 	return s.storageConfig
 }
 
+// UserCacheConfig представляет конфигурацию кеша пользователей
+func (s *serviceProvider) UserCacheConfig() config.UserCacheConfig {
+	if s.userCacheConfig == nil {
+		cfg, err := env.NewUserCacheConfig()
+		if err != nil {
+			log.Fatalf("failed to get user cache config: %s", err.Error())
+		}
+
+		s.userCacheConfig = cfg
+	}
+
+	return s.userCacheConfig
+}
+
 // KafkaConsumerConfig представляет конфигурацию для подключения к kafka
 func (s *serviceProvider) KafkaConsumerConfig() config.KafkaConsumerConfig {
 	if s.kafkaConsumerConfig == nil {
@@ -156,10 +265,105 @@ func (s *serviceProvider) KafkaConsumerConfig() config.KafkaConsumerConfig {
 	return s.kafkaConsumerConfig
 }
 
+// KafkaProducerConfig представляет конфигурацию отправки сообщений в kafka
+func (s *serviceProvider) KafkaProducerConfig() config.KafkaProducerConfig {
+	if s.kafkaProducerConfig == nil {
+		cfg, err := env.NewKafkaProducerConfig()
+		if err != nil {
+			log.Fatalf("failed to get kafka producer config: %s", err.Error())
+		}
+
+		s.kafkaProducerConfig = cfg
+	}
+
+	return s.kafkaProducerConfig
+}
+
+// PasswordHasherConfig представляет конфигурацию хеширования паролей
+func (s *serviceProvider) PasswordHasherConfig() config.PasswordHasherConfig {
+	if s.hasherConfig == nil {
+		cfg, err := env.NewPasswordHasherConfig()
+		if err != nil {
+			log.Fatalf("failed to get password hasher config: %s", err.Error())
+		}
+
+		s.hasherConfig = cfg
+	}
+
+	return s.hasherConfig
+}
+
+// JWTConfig представляет конфигурацию выпуска JWT токенов
+func (s *serviceProvider) JWTConfig() config.JWTConfig {
+	if s.jwtConfig == nil {
+		cfg, err := env.NewJWTConfig()
+		if err != nil {
+			log.Fatalf("failed to get jwt config: %s", err.Error())
+		}
+
+		s.jwtConfig = cfg
+	}
+
+	return s.jwtConfig
+}
+
+// AccessConfig представляет конфигурацию проверки доступа
+func (s *serviceProvider) AccessConfig() config.AccessConfig {
+	if s.accessConfig == nil {
+		cfg, err := env.NewAccessConfig()
+		if err != nil {
+			log.Fatalf("failed to get access config: %s", err.Error())
+		}
+
+		s.accessConfig = cfg
+	}
+
+	return s.accessConfig
+}
+
+// UserPurgerConfig представляет конфигурацию очистки удаленных пользователей
+func (s *serviceProvider) UserPurgerConfig() config.UserPurgerConfig {
+	if s.userPurgerConfig == nil {
+		cfg, err := env.NewUserPurgerConfig()
+		if err != nil {
+			log.Fatalf("failed to get user purger config: %s", err.Error())
+		}
+
+		s.userPurgerConfig = cfg
+	}
+
+	return s.userPurgerConfig
+}
+
+// OutboxConfig представляет конфигурацию публикации событий из outbox
+func (s *serviceProvider) OutboxConfig() config.OutboxConfig {
+	if s.outboxConfig == nil {
+		cfg, err := env.NewOutboxConfig()
+		if err != nil {
+			log.Fatalf("failed to get outbox config: %s", err.Error())
+		}
+
+		s.outboxConfig = cfg
+	}
+
+	return s.outboxConfig
+}
+
 // DBClient клиент для работы с базой данных
 func (s *serviceProvider) DBClient(ctx context.Context) db.Client {
 	if s.dbClient == nil {
-		cl, err := pg.New(ctx, s.PgConfig().DSN())
+		cl, err := pg.New(ctx, s.PgConfig().DSN(), pg.Options{
+			ReplicaDSNs: s.PgConfig().ReplicaDSNs(),
+			Replica: pg.ReplicaOptions{
+				MaxLag:        s.PgConfig().ReplicaMaxLag(),
+				CheckInterval: s.PgConfig().ReplicaCheckInterval(),
+			},
+			SlowQueryThreshold: s.PgConfig().SlowQueryThreshold(),
+			Logger: slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+				Level: s.PgConfig().LogLevel(),
+			})),
+			Registerer: prometheus.DefaultRegisterer,
+		})
 		if err != nil {
 			log.Fatalf("failed to connect to database: %s", err.Error())
 		}
@@ -180,7 +384,11 @@ func (s *serviceProvider) DBClient(ctx context.Context) db.Client {
 // TxManager возвращает экземпляр менеджера транзакций
 func (s *serviceProvider) TxManager(ctx context.Context) db.TxManager {
 	if s.txManager == nil {
-		s.txManager = transaction.NewTransactionManager(s.DBClient(ctx).DB())
+		s.txManager = transaction.NewTransactionManager(s.DBClient(ctx).DB(), transaction.RetryPolicy{
+			Attempts:       s.PgConfig().TxRetryAttempts(),
+			InitialBackoff: s.PgConfig().TxRetryInitialBackoff(),
+			MaxBackoff:     s.PgConfig().TxRetryMaxBackoff(),
+		})
 	}
 
 	return s.txManager
@@ -196,6 +404,11 @@ func (s *serviceProvider) RedisPool() *redigo.Pool {
 				return redigo.DialContext(ctx, "tcp", s.RedisConfig().Address())
 			},
 		}
+
+		err := prometheus.Register(redis.NewPoolCollector(s.redisPool))
+		if err != nil {
+			log.Fatalf("failed to register redis pool metrics: %s", err.Error())
+		}
 	}
 
 	return s.redisPool
@@ -213,27 +426,158 @@ func (s *serviceProvider) RedisClient() cache.RedisClient {
 // UserRepository возвращает экземпляр репозитория
 func (s *serviceProvider) UserRepository(ctx context.Context) repository.UserRepository {
 	if s.userRepository == nil {
-		if s.StorageConfig().Mode() == "redis" {
+		switch s.StorageConfig().Mode() {
+		case "redis":
 			s.userRepository = userRepositoryRedis.NewRepository(s.RedisClient())
-		}
-
-		if s.StorageConfig().Mode() == "pg" {
+		case "pg":
 			s.userRepository = userRepository.NewRepository(s.DBClient(ctx))
+		case "cached":
+			s.userRepository = userRepositoryCached.NewRepository(
+				userRepository.NewRepository(s.DBClient(ctx)),
+				s.RedisClient(),
+				s.UserCacheConfig().TTL(),
+				s.UserCacheConfig().NegativeTTL(),
+				s.UserCacheConfig().MasterReadTTL(),
+			)
+		default:
+			log.Fatalf("unknown storage mode: %s", s.StorageConfig().Mode())
 		}
 	}
 
 	return s.userRepository
 }
 
+// PasswordHasher возвращает экземпляр хешера паролей
+func (s *serviceProvider) PasswordHasher() service.PasswordHasher {
+	if s.passwordHasher == nil {
+		h, err := hasher.NewHasher(s.PasswordHasherConfig().Algorithm())
+		if err != nil {
+			log.Fatalf("failed to create password hasher: %s", err.Error())
+		}
+
+		s.passwordHasher = h
+	}
+
+	return s.passwordHasher
+}
+
+// RefreshTokenRepository возвращает экземпляр репозитория refresh токенов
+func (s *serviceProvider) RefreshTokenRepository(ctx context.Context) repository.RefreshTokenRepository {
+	if s.refreshTokenRepository == nil {
+		s.refreshTokenRepository = refreshTokenRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.refreshTokenRepository
+}
+
+// AccessRepository возвращает экземпляр репозитория правил доступа
+func (s *serviceProvider) AccessRepository(ctx context.Context) repository.AccessRepository {
+	if s.accessRepository == nil {
+		s.accessRepository = accessRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.accessRepository
+}
+
+// OutboxRepository возвращает экземпляр репозитория outbox событий.
+// События хранятся в postgres и записываются в одной транзакции с пользователем,
+// поэтому outbox нельзя использовать с хранилищем redis
+func (s *serviceProvider) OutboxRepository(ctx context.Context) repository.OutboxRepository {
+	if s.outboxRepository == nil {
+		if s.StorageConfig().Mode() == "redis" {
+			log.Fatalf("outbox is not supported with storage mode redis, set OUTBOX_ENABLED=false")
+		}
+
+		s.outboxRepository = outboxRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.outboxRepository
+}
+
+// ProcessedMessageRepository возвращает экземпляр репозитория обработанных сообщений kafka
+func (s *serviceProvider) ProcessedMessageRepository(ctx context.Context) repository.ProcessedMessageRepository {
+	if s.processedMessageRepository == nil {
+		s.processedMessageRepository = processedMessageRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.processedMessageRepository
+}
+
 // UserService возвращает экземпляр сервиса
 func (s *serviceProvider) UserService(ctx context.Context) service.UserService {
 	if s.userService == nil {
-		s.userService = userService.NewService(s.UserRepository(ctx), s.TxManager(ctx))
+		var outboxRepo repository.OutboxRepository
+		if s.OutboxConfig().Enabled() {
+			outboxRepo = s.OutboxRepository(ctx)
+		}
+
+		s.userService = userService.NewService(
+			s.UserRepository(ctx),
+			outboxRepo,
+			s.TxManager(ctx),
+			s.PasswordHasher(),
+		)
 	}
 
 	return s.userService
 }
 
+// AuthService возвращает экземпляр сервиса аутентификации
+func (s *serviceProvider) AuthService(ctx context.Context) service.AuthService {
+	if s.authService == nil {
+		s.authService = authService.NewService(
+			s.UserRepository(ctx),
+			s.RefreshTokenRepository(ctx),
+			s.TxManager(ctx),
+			s.PasswordHasher(),
+			s.JWTConfig(),
+		)
+	}
+
+	return s.authService
+}
+
+// AccessService возвращает экземпляр сервиса проверки доступа
+func (s *serviceProvider) AccessService(ctx context.Context) service.AccessService {
+	if s.accessService == nil {
+		s.accessService = accessService.NewService(
+			s.AccessRepository(ctx),
+			s.JWTConfig(),
+			s.AccessConfig(),
+		)
+	}
+
+	return s.accessService
+}
+
+// PurgerService возвращает экземпляр сервиса очистки удаленных пользователей и обработанных сообщений
+func (s *serviceProvider) PurgerService(ctx context.Context) service.PurgerService {
+	if s.purgerService == nil {
+		s.purgerService = purgerService.NewService(
+			s.UserRepository(ctx),
+			s.ProcessedMessageRepository(ctx),
+			s.TxManager(ctx),
+			s.UserPurgerConfig(),
+		)
+	}
+
+	return s.purgerService
+}
+
+// OutboxService возвращает экземпляр сервиса публикации событий из outbox
+func (s *serviceProvider) OutboxService(ctx context.Context) service.OutboxRelayService {
+	if s.outboxService == nil {
+		s.outboxService = outboxService.NewService(
+			s.OutboxRepository(ctx),
+			s.TxManager(ctx),
+			s.Producer(),
+			s.OutboxConfig(),
+		)
+	}
+
+	return s.outboxService
+}
+
 // UserImpl возвращает экземпляр имплементации
 func (s *serviceProvider) UserImpl(ctx context.Context) *user.Implementation {
 	if s.userImpl == nil {
@@ -243,13 +587,56 @@ func (s *serviceProvider) UserImpl(ctx context.Context) *user.Implementation {
 	return s.userImpl
 }
 
-// UserSaverConsumer возвращает экземпляр consumerService
+// AuthImpl возвращает экземпляр имплементации сервера аутентификации
+func (s *serviceProvider) AuthImpl(ctx context.Context) *auth.Implementation {
+	if s.authImpl == nil {
+		s.authImpl = auth.NewImplementation(s.AuthService(ctx))
+	}
+
+	return s.authImpl
+}
+
+// AccessImpl возвращает экземпляр имплементации сервера проверки доступа
+func (s *serviceProvider) AccessImpl(ctx context.Context) *access.Implementation {
+	if s.accessImpl == nil {
+		s.accessImpl = access.NewImplementation(s.AccessService(ctx))
+	}
+
+	return s.accessImpl
+}
+
+// AuthInterceptor возвращает экземпляр интерсептора аутентификации
+func (s *serviceProvider) AuthInterceptor() *interceptor.AuthInterceptor {
+	if s.authInterceptor == nil {
+		s.authInterceptor = interceptor.NewAuthInterceptor(s.JWTConfig())
+	}
+
+	return s.authInterceptor
+}
+
+// UserSaverConsumer возвращает экземпляр consumerService, режим обработки сообщений задается конфигурацией
 func (s *serviceProvider) UserSaverConsumer(ctx context.Context) service.ConsumerService {
 	if s.userSaverConsumer == nil {
-		s.userSaverConsumer = userSaverConsumer.NewService(
-			s.UserRepository(ctx),
-			s.Consumer(),
-		)
+		switch s.KafkaConsumerConfig().Mode() {
+		case "message":
+			s.userSaverConsumer = userSaverConsumer.NewService(
+				s.UserService(ctx),
+				s.Consumer(),
+				s.KafkaConsumerConfig().Topics(),
+				s.TxManager(ctx),
+				s.ProcessedMessageRepository(ctx),
+			)
+		case "batch":
+			s.userSaverConsumer = userSaverConsumer.NewBatchService(
+				s.UserService(ctx),
+				s.BatchConsumer(),
+				s.KafkaConsumerConfig().Topics(),
+				s.TxManager(ctx),
+				s.ProcessedMessageRepository(ctx),
+			)
+		default:
+			log.Fatalf("unknown kafka consumer mode: %s", s.KafkaConsumerConfig().Mode())
+		}
 	}
 
 	return s.userSaverConsumer
@@ -268,6 +655,19 @@ func (s *serviceProvider) Consumer() kafka.Consumer {
 	return s.consumer
 }
 
+// BatchConsumer создает consumer, который обрабатывает сообщения пакетами
+func (s *serviceProvider) BatchConsumer() kafka.BatchConsumer {
+	if s.batchConsumer == nil {
+		s.batchConsumer = kafkaConsumer.NewBatchConsumer(
+			s.ConsumerGroup(),
+			s.BatchConsumerGroupHandler(),
+		)
+		closer.Add(s.batchConsumer.Close)
+	}
+
+	return s.batchConsumer
+}
+
 // ConsumerGroup создает consumerGroup
 func (s *serviceProvider) ConsumerGroup() sarama.ConsumerGroup {
 	if s.consumerGroup == nil {
@@ -286,11 +686,65 @@ func (s *serviceProvider) ConsumerGroup() sarama.ConsumerGroup {
 	return s.consumerGroup
 }
 
+// ConsumerRetryPolicy возвращает политику повторной обработки сообщений consumer
+func (s *serviceProvider) ConsumerRetryPolicy() kafkaConsumer.RetryPolicy {
+	return kafkaConsumer.RetryPolicy{
+		Attempts:       s.KafkaConsumerConfig().RetryAttempts(),
+		InitialBackoff: s.KafkaConsumerConfig().RetryInitialBackoff(),
+		MaxBackoff:     s.KafkaConsumerConfig().RetryMaxBackoff(),
+	}
+}
+
 // ConsumerGroupHandler создает consumerGroupHandler
 func (s *serviceProvider) ConsumerGroupHandler() *kafkaConsumer.GroupHandler {
 	if s.consumerGroupHandler == nil {
-		s.consumerGroupHandler = kafkaConsumer.NewGroupHandler()
+		s.consumerGroupHandler = kafkaConsumer.NewGroupHandler(
+			s.ConsumerRetryPolicy(),
+			s.Producer(),
+			s.KafkaConsumerConfig().Workers(),
+		)
 	}
 
 	return s.consumerGroupHandler
 }
+
+// BatchConsumerGroupHandler создает обработчик группы, который собирает сообщения в пакеты
+func (s *serviceProvider) BatchConsumerGroupHandler() *kafkaConsumer.BatchGroupHandler {
+	if s.batchConsumerGroupHandler == nil {
+		s.batchConsumerGroupHandler = kafkaConsumer.NewBatchGroupHandler(
+			s.ConsumerRetryPolicy(),
+			s.Producer(),
+			s.KafkaConsumerConfig().BatchSize(),
+			s.KafkaConsumerConfig().BatchTimeout(),
+		)
+	}
+
+	return s.batchConsumerGroupHandler
+}
+
+// Producer возвращает синхронный producer, который дожидается подтверждения записи каждого сообщения
+func (s *serviceProvider) Producer() kafka.Producer {
+	if s.producer == nil {
+		s.producer = kafkaProducer.NewSyncProducer(s.SyncProducer(), nil)
+		closer.Add(s.producer.Close)
+	}
+
+	return s.producer
+}
+
+// SyncProducer создает синхронный sarama producer
+func (s *serviceProvider) SyncProducer() sarama.SyncProducer {
+	if s.syncProducer == nil {
+		producer, err := sarama.NewSyncProducer(
+			s.KafkaProducerConfig().Brokers(),
+			s.KafkaProducerConfig().Config(),
+		)
+		if err != nil {
+			log.Fatalf("failed to create sync producer: %v", err)
+		}
+
+		s.syncProducer = producer
+	}
+
+	return s.syncProducer
+}
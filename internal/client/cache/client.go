@@ -9,9 +9,19 @@ import (
 type RedisClient interface {
 	HashSet(ctx context.Context, key string, values interface{}) error
 	Set(ctx context.Context, key string, value interface{}) error
+	SetEx(ctx context.Context, key string, value interface{}, expiration time.Duration) error
 	HGetAll(ctx context.Context, key string) ([]interface{}, error)
 	Get(ctx context.Context, key string) (interface{}, error)
 	Expire(ctx context.Context, key string, expiration time.Duration) error
 	Ping(ctx context.Context) error
 	Delete(ctx context.Context, key string) error
+	SAdd(ctx context.Context, key string, member interface{}) error
+	SRem(ctx context.Context, key string, member interface{}) error
+	SMembers(ctx context.Context, key string) ([]string, error)
+	ZAdd(ctx context.Context, key string, score int64, member interface{}) error
+	ZRem(ctx context.Context, key string, member interface{}) error
+	ZRangeByScore(ctx context.Context, key, min, max string, count int64) ([]string, error)
+	ZRangeByLex(ctx context.Context, key, min, max string, count int64) ([]string, error)
+	ZRevRangeByLex(ctx context.Context, key, max, min string, count int64) ([]string, error)
+	Eval(ctx context.Context, script string, keyCount int, keysAndArgs ...interface{}) (interface{}, error)
 }
@@ -0,0 +1,6724 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/ipv02/auth/internal/client/cache.RedisClient -o redis_client_minimock.go -n RedisClientMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// RedisClientMock implements mm_cache.RedisClient
+type RedisClientMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDelete          func(ctx context.Context, key string) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, key string)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mRedisClientMockDelete
+
+	funcEval          func(ctx context.Context, script string, keyCount int, keysAndArgs ...interface{}) (p1 interface{}, err error)
+	funcEvalOrigin    string
+	inspectFuncEval   func(ctx context.Context, script string, keyCount int, keysAndArgs ...interface{})
+	afterEvalCounter  uint64
+	beforeEvalCounter uint64
+	EvalMock          mRedisClientMockEval
+
+	funcExpire          func(ctx context.Context, key string, expiration time.Duration) (err error)
+	funcExpireOrigin    string
+	inspectFuncExpire   func(ctx context.Context, key string, expiration time.Duration)
+	afterExpireCounter  uint64
+	beforeExpireCounter uint64
+	ExpireMock          mRedisClientMockExpire
+
+	funcGet          func(ctx context.Context, key string) (p1 interface{}, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, key string)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mRedisClientMockGet
+
+	funcHGetAll          func(ctx context.Context, key string) (pa1 []interface{}, err error)
+	funcHGetAllOrigin    string
+	inspectFuncHGetAll   func(ctx context.Context, key string)
+	afterHGetAllCounter  uint64
+	beforeHGetAllCounter uint64
+	HGetAllMock          mRedisClientMockHGetAll
+
+	funcHashSet          func(ctx context.Context, key string, values interface{}) (err error)
+	funcHashSetOrigin    string
+	inspectFuncHashSet   func(ctx context.Context, key string, values interface{})
+	afterHashSetCounter  uint64
+	beforeHashSetCounter uint64
+	HashSetMock          mRedisClientMockHashSet
+
+	funcPing          func(ctx context.Context) (err error)
+	funcPingOrigin    string
+	inspectFuncPing   func(ctx context.Context)
+	afterPingCounter  uint64
+	beforePingCounter uint64
+	PingMock          mRedisClientMockPing
+
+	funcSAdd          func(ctx context.Context, key string, member interface{}) (err error)
+	funcSAddOrigin    string
+	inspectFuncSAdd   func(ctx context.Context, key string, member interface{})
+	afterSAddCounter  uint64
+	beforeSAddCounter uint64
+	SAddMock          mRedisClientMockSAdd
+
+	funcSMembers          func(ctx context.Context, key string) (sa1 []string, err error)
+	funcSMembersOrigin    string
+	inspectFuncSMembers   func(ctx context.Context, key string)
+	afterSMembersCounter  uint64
+	beforeSMembersCounter uint64
+	SMembersMock          mRedisClientMockSMembers
+
+	funcSRem          func(ctx context.Context, key string, member interface{}) (err error)
+	funcSRemOrigin    string
+	inspectFuncSRem   func(ctx context.Context, key string, member interface{})
+	afterSRemCounter  uint64
+	beforeSRemCounter uint64
+	SRemMock          mRedisClientMockSRem
+
+	funcSet          func(ctx context.Context, key string, value interface{}) (err error)
+	funcSetOrigin    string
+	inspectFuncSet   func(ctx context.Context, key string, value interface{})
+	afterSetCounter  uint64
+	beforeSetCounter uint64
+	SetMock          mRedisClientMockSet
+
+	funcSetEx          func(ctx context.Context, key string, value interface{}, expiration time.Duration) (err error)
+	funcSetExOrigin    string
+	inspectFuncSetEx   func(ctx context.Context, key string, value interface{}, expiration time.Duration)
+	afterSetExCounter  uint64
+	beforeSetExCounter uint64
+	SetExMock          mRedisClientMockSetEx
+
+	funcZAdd          func(ctx context.Context, key string, score int64, member interface{}) (err error)
+	funcZAddOrigin    string
+	inspectFuncZAdd   func(ctx context.Context, key string, score int64, member interface{})
+	afterZAddCounter  uint64
+	beforeZAddCounter uint64
+	ZAddMock          mRedisClientMockZAdd
+
+	funcZRangeByLex          func(ctx context.Context, key string, min string, max string, count int64) (sa1 []string, err error)
+	funcZRangeByLexOrigin    string
+	inspectFuncZRangeByLex   func(ctx context.Context, key string, min string, max string, count int64)
+	afterZRangeByLexCounter  uint64
+	beforeZRangeByLexCounter uint64
+	ZRangeByLexMock          mRedisClientMockZRangeByLex
+
+	funcZRangeByScore          func(ctx context.Context, key string, min string, max string, count int64) (sa1 []string, err error)
+	funcZRangeByScoreOrigin    string
+	inspectFuncZRangeByScore   func(ctx context.Context, key string, min string, max string, count int64)
+	afterZRangeByScoreCounter  uint64
+	beforeZRangeByScoreCounter uint64
+	ZRangeByScoreMock          mRedisClientMockZRangeByScore
+
+	funcZRem          func(ctx context.Context, key string, member interface{}) (err error)
+	funcZRemOrigin    string
+	inspectFuncZRem   func(ctx context.Context, key string, member interface{})
+	afterZRemCounter  uint64
+	beforeZRemCounter uint64
+	ZRemMock          mRedisClientMockZRem
+
+	funcZRevRangeByLex          func(ctx context.Context, key string, max string, min string, count int64) (sa1 []string, err error)
+	funcZRevRangeByLexOrigin    string
+	inspectFuncZRevRangeByLex   func(ctx context.Context, key string, max string, min string, count int64)
+	afterZRevRangeByLexCounter  uint64
+	beforeZRevRangeByLexCounter uint64
+	ZRevRangeByLexMock          mRedisClientMockZRevRangeByLex
+}
+
+// NewRedisClientMock returns a mock for mm_cache.RedisClient
+func NewRedisClientMock(t minimock.Tester) *RedisClientMock {
+	m := &RedisClientMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteMock = mRedisClientMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*RedisClientMockDeleteParams{}
+
+	m.EvalMock = mRedisClientMockEval{mock: m}
+	m.EvalMock.callArgs = []*RedisClientMockEvalParams{}
+
+	m.ExpireMock = mRedisClientMockExpire{mock: m}
+	m.ExpireMock.callArgs = []*RedisClientMockExpireParams{}
+
+	m.GetMock = mRedisClientMockGet{mock: m}
+	m.GetMock.callArgs = []*RedisClientMockGetParams{}
+
+	m.HGetAllMock = mRedisClientMockHGetAll{mock: m}
+	m.HGetAllMock.callArgs = []*RedisClientMockHGetAllParams{}
+
+	m.HashSetMock = mRedisClientMockHashSet{mock: m}
+	m.HashSetMock.callArgs = []*RedisClientMockHashSetParams{}
+
+	m.PingMock = mRedisClientMockPing{mock: m}
+	m.PingMock.callArgs = []*RedisClientMockPingParams{}
+
+	m.SAddMock = mRedisClientMockSAdd{mock: m}
+	m.SAddMock.callArgs = []*RedisClientMockSAddParams{}
+
+	m.SMembersMock = mRedisClientMockSMembers{mock: m}
+	m.SMembersMock.callArgs = []*RedisClientMockSMembersParams{}
+
+	m.SRemMock = mRedisClientMockSRem{mock: m}
+	m.SRemMock.callArgs = []*RedisClientMockSRemParams{}
+
+	m.SetMock = mRedisClientMockSet{mock: m}
+	m.SetMock.callArgs = []*RedisClientMockSetParams{}
+
+	m.SetExMock = mRedisClientMockSetEx{mock: m}
+	m.SetExMock.callArgs = []*RedisClientMockSetExParams{}
+
+	m.ZAddMock = mRedisClientMockZAdd{mock: m}
+	m.ZAddMock.callArgs = []*RedisClientMockZAddParams{}
+
+	m.ZRangeByLexMock = mRedisClientMockZRangeByLex{mock: m}
+	m.ZRangeByLexMock.callArgs = []*RedisClientMockZRangeByLexParams{}
+
+	m.ZRangeByScoreMock = mRedisClientMockZRangeByScore{mock: m}
+	m.ZRangeByScoreMock.callArgs = []*RedisClientMockZRangeByScoreParams{}
+
+	m.ZRemMock = mRedisClientMockZRem{mock: m}
+	m.ZRemMock.callArgs = []*RedisClientMockZRemParams{}
+
+	m.ZRevRangeByLexMock = mRedisClientMockZRevRangeByLex{mock: m}
+	m.ZRevRangeByLexMock.callArgs = []*RedisClientMockZRevRangeByLexParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRedisClientMockDelete struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockDeleteExpectation
+	expectations       []*RedisClientMockDeleteExpectation
+
+	callArgs []*RedisClientMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockDeleteExpectation specifies expectation struct of the RedisClient.Delete
+type RedisClientMockDeleteExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockDeleteParams
+	paramPtrs          *RedisClientMockDeleteParamPtrs
+	expectationOrigins RedisClientMockDeleteExpectationOrigins
+	results            *RedisClientMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockDeleteParams contains parameters of the RedisClient.Delete
+type RedisClientMockDeleteParams struct {
+	ctx context.Context
+	key string
+}
+
+// RedisClientMockDeleteParamPtrs contains pointers to parameters of the RedisClient.Delete
+type RedisClientMockDeleteParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// RedisClientMockDeleteResults contains results of the RedisClient.Delete
+type RedisClientMockDeleteResults struct {
+	err error
+}
+
+// RedisClientMockDeleteOrigins contains origins of expectations of the RedisClient.Delete
+type RedisClientMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mRedisClientMockDelete) Optional() *mRedisClientMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for RedisClient.Delete
+func (mmDelete *mRedisClientMockDelete) Expect(ctx context.Context, key string) *mRedisClientMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RedisClientMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RedisClientMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("RedisClientMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &RedisClientMockDeleteParams{ctx, key}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.Delete
+func (mmDelete *mRedisClientMockDelete) ExpectCtxParam1(ctx context.Context) *mRedisClientMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RedisClientMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RedisClientMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("RedisClientMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &RedisClientMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.Delete
+func (mmDelete *mRedisClientMockDelete) ExpectKeyParam2(key string) *mRedisClientMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RedisClientMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RedisClientMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("RedisClientMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &RedisClientMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.key = &key
+	mmDelete.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.Delete
+func (mmDelete *mRedisClientMockDelete) Inspect(f func(ctx context.Context, key string)) *mRedisClientMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for RedisClientMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by RedisClient.Delete
+func (mmDelete *mRedisClientMockDelete) Return(err error) *RedisClientMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RedisClientMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RedisClientMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &RedisClientMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the RedisClient.Delete method
+func (mmDelete *mRedisClientMockDelete) Set(f func(ctx context.Context, key string) (err error)) *RedisClientMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the RedisClient.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the RedisClient.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the RedisClient.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mRedisClientMockDelete) When(ctx context.Context, key string) *RedisClientMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RedisClientMock.Delete mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &RedisClientMockDeleteParams{ctx, key},
+		expectationOrigins: RedisClientMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.Delete return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockDeleteExpectation) Then(err error) *RedisClientMock {
+	e.results = &RedisClientMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.Delete should be invoked
+func (mmDelete *mRedisClientMockDelete) Times(n uint64) *mRedisClientMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of RedisClientMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mRedisClientMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_cache.RedisClient
+func (mmDelete *RedisClientMock) Delete(ctx context.Context, key string) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, key)
+	}
+
+	mm_params := RedisClientMockDeleteParams{ctx, key}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockDeleteParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("RedisClientMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmDelete.t.Errorf("RedisClientMock.Delete got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("RedisClientMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the RedisClientMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, key)
+	}
+	mmDelete.t.Fatalf("Unexpected call to RedisClientMock.Delete. %v %v", ctx, key)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished RedisClientMock.Delete invocations
+func (mmDelete *RedisClientMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of RedisClientMock.Delete invocations
+func (mmDelete *RedisClientMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mRedisClientMockDelete) Calls() []*RedisClientMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mRedisClientMockEval struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockEvalExpectation
+	expectations       []*RedisClientMockEvalExpectation
+
+	callArgs []*RedisClientMockEvalParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockEvalExpectation specifies expectation struct of the RedisClient.Eval
+type RedisClientMockEvalExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockEvalParams
+	paramPtrs          *RedisClientMockEvalParamPtrs
+	expectationOrigins RedisClientMockEvalExpectationOrigins
+	results            *RedisClientMockEvalResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockEvalParams contains parameters of the RedisClient.Eval
+type RedisClientMockEvalParams struct {
+	ctx         context.Context
+	script      string
+	keyCount    int
+	keysAndArgs []interface{}
+}
+
+// RedisClientMockEvalParamPtrs contains pointers to parameters of the RedisClient.Eval
+type RedisClientMockEvalParamPtrs struct {
+	ctx         *context.Context
+	script      *string
+	keyCount    *int
+	keysAndArgs *[]interface{}
+}
+
+// RedisClientMockEvalResults contains results of the RedisClient.Eval
+type RedisClientMockEvalResults struct {
+	p1  interface{}
+	err error
+}
+
+// RedisClientMockEvalOrigins contains origins of expectations of the RedisClient.Eval
+type RedisClientMockEvalExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originScript      string
+	originKeyCount    string
+	originKeysAndArgs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEval *mRedisClientMockEval) Optional() *mRedisClientMockEval {
+	mmEval.optional = true
+	return mmEval
+}
+
+// Expect sets up expected params for RedisClient.Eval
+func (mmEval *mRedisClientMockEval) Expect(ctx context.Context, script string, keyCount int, keysAndArgs ...interface{}) *mRedisClientMockEval {
+	if mmEval.mock.funcEval != nil {
+		mmEval.mock.t.Fatalf("RedisClientMock.Eval mock is already set by Set")
+	}
+
+	if mmEval.defaultExpectation == nil {
+		mmEval.defaultExpectation = &RedisClientMockEvalExpectation{}
+	}
+
+	if mmEval.defaultExpectation.paramPtrs != nil {
+		mmEval.mock.t.Fatalf("RedisClientMock.Eval mock is already set by ExpectParams functions")
+	}
+
+	mmEval.defaultExpectation.params = &RedisClientMockEvalParams{ctx, script, keyCount, keysAndArgs}
+	mmEval.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEval.expectations {
+		if minimock.Equal(e.params, mmEval.defaultExpectation.params) {
+			mmEval.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEval.defaultExpectation.params)
+		}
+	}
+
+	return mmEval
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.Eval
+func (mmEval *mRedisClientMockEval) ExpectCtxParam1(ctx context.Context) *mRedisClientMockEval {
+	if mmEval.mock.funcEval != nil {
+		mmEval.mock.t.Fatalf("RedisClientMock.Eval mock is already set by Set")
+	}
+
+	if mmEval.defaultExpectation == nil {
+		mmEval.defaultExpectation = &RedisClientMockEvalExpectation{}
+	}
+
+	if mmEval.defaultExpectation.params != nil {
+		mmEval.mock.t.Fatalf("RedisClientMock.Eval mock is already set by Expect")
+	}
+
+	if mmEval.defaultExpectation.paramPtrs == nil {
+		mmEval.defaultExpectation.paramPtrs = &RedisClientMockEvalParamPtrs{}
+	}
+	mmEval.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEval.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEval
+}
+
+// ExpectScriptParam2 sets up expected param script for RedisClient.Eval
+func (mmEval *mRedisClientMockEval) ExpectScriptParam2(script string) *mRedisClientMockEval {
+	if mmEval.mock.funcEval != nil {
+		mmEval.mock.t.Fatalf("RedisClientMock.Eval mock is already set by Set")
+	}
+
+	if mmEval.defaultExpectation == nil {
+		mmEval.defaultExpectation = &RedisClientMockEvalExpectation{}
+	}
+
+	if mmEval.defaultExpectation.params != nil {
+		mmEval.mock.t.Fatalf("RedisClientMock.Eval mock is already set by Expect")
+	}
+
+	if mmEval.defaultExpectation.paramPtrs == nil {
+		mmEval.defaultExpectation.paramPtrs = &RedisClientMockEvalParamPtrs{}
+	}
+	mmEval.defaultExpectation.paramPtrs.script = &script
+	mmEval.defaultExpectation.expectationOrigins.originScript = minimock.CallerInfo(1)
+
+	return mmEval
+}
+
+// ExpectKeyCountParam3 sets up expected param keyCount for RedisClient.Eval
+func (mmEval *mRedisClientMockEval) ExpectKeyCountParam3(keyCount int) *mRedisClientMockEval {
+	if mmEval.mock.funcEval != nil {
+		mmEval.mock.t.Fatalf("RedisClientMock.Eval mock is already set by Set")
+	}
+
+	if mmEval.defaultExpectation == nil {
+		mmEval.defaultExpectation = &RedisClientMockEvalExpectation{}
+	}
+
+	if mmEval.defaultExpectation.params != nil {
+		mmEval.mock.t.Fatalf("RedisClientMock.Eval mock is already set by Expect")
+	}
+
+	if mmEval.defaultExpectation.paramPtrs == nil {
+		mmEval.defaultExpectation.paramPtrs = &RedisClientMockEvalParamPtrs{}
+	}
+	mmEval.defaultExpectation.paramPtrs.keyCount = &keyCount
+	mmEval.defaultExpectation.expectationOrigins.originKeyCount = minimock.CallerInfo(1)
+
+	return mmEval
+}
+
+// ExpectKeysAndArgsParam4 sets up expected param keysAndArgs for RedisClient.Eval
+func (mmEval *mRedisClientMockEval) ExpectKeysAndArgsParam4(keysAndArgs ...interface{}) *mRedisClientMockEval {
+	if mmEval.mock.funcEval != nil {
+		mmEval.mock.t.Fatalf("RedisClientMock.Eval mock is already set by Set")
+	}
+
+	if mmEval.defaultExpectation == nil {
+		mmEval.defaultExpectation = &RedisClientMockEvalExpectation{}
+	}
+
+	if mmEval.defaultExpectation.params != nil {
+		mmEval.mock.t.Fatalf("RedisClientMock.Eval mock is already set by Expect")
+	}
+
+	if mmEval.defaultExpectation.paramPtrs == nil {
+		mmEval.defaultExpectation.paramPtrs = &RedisClientMockEvalParamPtrs{}
+	}
+	mmEval.defaultExpectation.paramPtrs.keysAndArgs = &keysAndArgs
+	mmEval.defaultExpectation.expectationOrigins.originKeysAndArgs = minimock.CallerInfo(1)
+
+	return mmEval
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.Eval
+func (mmEval *mRedisClientMockEval) Inspect(f func(ctx context.Context, script string, keyCount int, keysAndArgs ...interface{})) *mRedisClientMockEval {
+	if mmEval.mock.inspectFuncEval != nil {
+		mmEval.mock.t.Fatalf("Inspect function is already set for RedisClientMock.Eval")
+	}
+
+	mmEval.mock.inspectFuncEval = f
+
+	return mmEval
+}
+
+// Return sets up results that will be returned by RedisClient.Eval
+func (mmEval *mRedisClientMockEval) Return(p1 interface{}, err error) *RedisClientMock {
+	if mmEval.mock.funcEval != nil {
+		mmEval.mock.t.Fatalf("RedisClientMock.Eval mock is already set by Set")
+	}
+
+	if mmEval.defaultExpectation == nil {
+		mmEval.defaultExpectation = &RedisClientMockEvalExpectation{mock: mmEval.mock}
+	}
+	mmEval.defaultExpectation.results = &RedisClientMockEvalResults{p1, err}
+	mmEval.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEval.mock
+}
+
+// Set uses given function f to mock the RedisClient.Eval method
+func (mmEval *mRedisClientMockEval) Set(f func(ctx context.Context, script string, keyCount int, keysAndArgs ...interface{}) (p1 interface{}, err error)) *RedisClientMock {
+	if mmEval.defaultExpectation != nil {
+		mmEval.mock.t.Fatalf("Default expectation is already set for the RedisClient.Eval method")
+	}
+
+	if len(mmEval.expectations) > 0 {
+		mmEval.mock.t.Fatalf("Some expectations are already set for the RedisClient.Eval method")
+	}
+
+	mmEval.mock.funcEval = f
+	mmEval.mock.funcEvalOrigin = minimock.CallerInfo(1)
+	return mmEval.mock
+}
+
+// When sets expectation for the RedisClient.Eval which will trigger the result defined by the following
+// Then helper
+func (mmEval *mRedisClientMockEval) When(ctx context.Context, script string, keyCount int, keysAndArgs ...interface{}) *RedisClientMockEvalExpectation {
+	if mmEval.mock.funcEval != nil {
+		mmEval.mock.t.Fatalf("RedisClientMock.Eval mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockEvalExpectation{
+		mock:               mmEval.mock,
+		params:             &RedisClientMockEvalParams{ctx, script, keyCount, keysAndArgs},
+		expectationOrigins: RedisClientMockEvalExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEval.expectations = append(mmEval.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.Eval return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockEvalExpectation) Then(p1 interface{}, err error) *RedisClientMock {
+	e.results = &RedisClientMockEvalResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.Eval should be invoked
+func (mmEval *mRedisClientMockEval) Times(n uint64) *mRedisClientMockEval {
+	if n == 0 {
+		mmEval.mock.t.Fatalf("Times of RedisClientMock.Eval mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEval.expectedInvocations, n)
+	mmEval.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEval
+}
+
+func (mmEval *mRedisClientMockEval) invocationsDone() bool {
+	if len(mmEval.expectations) == 0 && mmEval.defaultExpectation == nil && mmEval.mock.funcEval == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEval.mock.afterEvalCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEval.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Eval implements mm_cache.RedisClient
+func (mmEval *RedisClientMock) Eval(ctx context.Context, script string, keyCount int, keysAndArgs ...interface{}) (p1 interface{}, err error) {
+	mm_atomic.AddUint64(&mmEval.beforeEvalCounter, 1)
+	defer mm_atomic.AddUint64(&mmEval.afterEvalCounter, 1)
+
+	mmEval.t.Helper()
+
+	if mmEval.inspectFuncEval != nil {
+		mmEval.inspectFuncEval(ctx, script, keyCount, keysAndArgs...)
+	}
+
+	mm_params := RedisClientMockEvalParams{ctx, script, keyCount, keysAndArgs}
+
+	// Record call args
+	mmEval.EvalMock.mutex.Lock()
+	mmEval.EvalMock.callArgs = append(mmEval.EvalMock.callArgs, &mm_params)
+	mmEval.EvalMock.mutex.Unlock()
+
+	for _, e := range mmEval.EvalMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmEval.EvalMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEval.EvalMock.defaultExpectation.Counter, 1)
+		mm_want := mmEval.EvalMock.defaultExpectation.params
+		mm_want_ptrs := mmEval.EvalMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockEvalParams{ctx, script, keyCount, keysAndArgs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEval.t.Errorf("RedisClientMock.Eval got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEval.EvalMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.script != nil && !minimock.Equal(*mm_want_ptrs.script, mm_got.script) {
+				mmEval.t.Errorf("RedisClientMock.Eval got unexpected parameter script, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEval.EvalMock.defaultExpectation.expectationOrigins.originScript, *mm_want_ptrs.script, mm_got.script, minimock.Diff(*mm_want_ptrs.script, mm_got.script))
+			}
+
+			if mm_want_ptrs.keyCount != nil && !minimock.Equal(*mm_want_ptrs.keyCount, mm_got.keyCount) {
+				mmEval.t.Errorf("RedisClientMock.Eval got unexpected parameter keyCount, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEval.EvalMock.defaultExpectation.expectationOrigins.originKeyCount, *mm_want_ptrs.keyCount, mm_got.keyCount, minimock.Diff(*mm_want_ptrs.keyCount, mm_got.keyCount))
+			}
+
+			if mm_want_ptrs.keysAndArgs != nil && !minimock.Equal(*mm_want_ptrs.keysAndArgs, mm_got.keysAndArgs) {
+				mmEval.t.Errorf("RedisClientMock.Eval got unexpected parameter keysAndArgs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEval.EvalMock.defaultExpectation.expectationOrigins.originKeysAndArgs, *mm_want_ptrs.keysAndArgs, mm_got.keysAndArgs, minimock.Diff(*mm_want_ptrs.keysAndArgs, mm_got.keysAndArgs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEval.t.Errorf("RedisClientMock.Eval got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEval.EvalMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEval.EvalMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEval.t.Fatal("No results are set for the RedisClientMock.Eval")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmEval.funcEval != nil {
+		return mmEval.funcEval(ctx, script, keyCount, keysAndArgs...)
+	}
+	mmEval.t.Fatalf("Unexpected call to RedisClientMock.Eval. %v %v %v %v", ctx, script, keyCount, keysAndArgs)
+	return
+}
+
+// EvalAfterCounter returns a count of finished RedisClientMock.Eval invocations
+func (mmEval *RedisClientMock) EvalAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEval.afterEvalCounter)
+}
+
+// EvalBeforeCounter returns a count of RedisClientMock.Eval invocations
+func (mmEval *RedisClientMock) EvalBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEval.beforeEvalCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.Eval.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEval *mRedisClientMockEval) Calls() []*RedisClientMockEvalParams {
+	mmEval.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockEvalParams, len(mmEval.callArgs))
+	copy(argCopy, mmEval.callArgs)
+
+	mmEval.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEvalDone returns true if the count of the Eval invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockEvalDone() bool {
+	if m.EvalMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EvalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EvalMock.invocationsDone()
+}
+
+// MinimockEvalInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockEvalInspect() {
+	for _, e := range m.EvalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.Eval at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEvalCounter := mm_atomic.LoadUint64(&m.afterEvalCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EvalMock.defaultExpectation != nil && afterEvalCounter < 1 {
+		if m.EvalMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.Eval at\n%s", m.EvalMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.Eval at\n%s with params: %#v", m.EvalMock.defaultExpectation.expectationOrigins.origin, *m.EvalMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEval != nil && afterEvalCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.Eval at\n%s", m.funcEvalOrigin)
+	}
+
+	if !m.EvalMock.invocationsDone() && afterEvalCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.Eval at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EvalMock.expectedInvocations), m.EvalMock.expectedInvocationsOrigin, afterEvalCounter)
+	}
+}
+
+type mRedisClientMockExpire struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockExpireExpectation
+	expectations       []*RedisClientMockExpireExpectation
+
+	callArgs []*RedisClientMockExpireParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockExpireExpectation specifies expectation struct of the RedisClient.Expire
+type RedisClientMockExpireExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockExpireParams
+	paramPtrs          *RedisClientMockExpireParamPtrs
+	expectationOrigins RedisClientMockExpireExpectationOrigins
+	results            *RedisClientMockExpireResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockExpireParams contains parameters of the RedisClient.Expire
+type RedisClientMockExpireParams struct {
+	ctx        context.Context
+	key        string
+	expiration time.Duration
+}
+
+// RedisClientMockExpireParamPtrs contains pointers to parameters of the RedisClient.Expire
+type RedisClientMockExpireParamPtrs struct {
+	ctx        *context.Context
+	key        *string
+	expiration *time.Duration
+}
+
+// RedisClientMockExpireResults contains results of the RedisClient.Expire
+type RedisClientMockExpireResults struct {
+	err error
+}
+
+// RedisClientMockExpireOrigins contains origins of expectations of the RedisClient.Expire
+type RedisClientMockExpireExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originKey        string
+	originExpiration string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExpire *mRedisClientMockExpire) Optional() *mRedisClientMockExpire {
+	mmExpire.optional = true
+	return mmExpire
+}
+
+// Expect sets up expected params for RedisClient.Expire
+func (mmExpire *mRedisClientMockExpire) Expect(ctx context.Context, key string, expiration time.Duration) *mRedisClientMockExpire {
+	if mmExpire.mock.funcExpire != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Set")
+	}
+
+	if mmExpire.defaultExpectation == nil {
+		mmExpire.defaultExpectation = &RedisClientMockExpireExpectation{}
+	}
+
+	if mmExpire.defaultExpectation.paramPtrs != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by ExpectParams functions")
+	}
+
+	mmExpire.defaultExpectation.params = &RedisClientMockExpireParams{ctx, key, expiration}
+	mmExpire.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExpire.expectations {
+		if minimock.Equal(e.params, mmExpire.defaultExpectation.params) {
+			mmExpire.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExpire.defaultExpectation.params)
+		}
+	}
+
+	return mmExpire
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.Expire
+func (mmExpire *mRedisClientMockExpire) ExpectCtxParam1(ctx context.Context) *mRedisClientMockExpire {
+	if mmExpire.mock.funcExpire != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Set")
+	}
+
+	if mmExpire.defaultExpectation == nil {
+		mmExpire.defaultExpectation = &RedisClientMockExpireExpectation{}
+	}
+
+	if mmExpire.defaultExpectation.params != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Expect")
+	}
+
+	if mmExpire.defaultExpectation.paramPtrs == nil {
+		mmExpire.defaultExpectation.paramPtrs = &RedisClientMockExpireParamPtrs{}
+	}
+	mmExpire.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExpire.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExpire
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.Expire
+func (mmExpire *mRedisClientMockExpire) ExpectKeyParam2(key string) *mRedisClientMockExpire {
+	if mmExpire.mock.funcExpire != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Set")
+	}
+
+	if mmExpire.defaultExpectation == nil {
+		mmExpire.defaultExpectation = &RedisClientMockExpireExpectation{}
+	}
+
+	if mmExpire.defaultExpectation.params != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Expect")
+	}
+
+	if mmExpire.defaultExpectation.paramPtrs == nil {
+		mmExpire.defaultExpectation.paramPtrs = &RedisClientMockExpireParamPtrs{}
+	}
+	mmExpire.defaultExpectation.paramPtrs.key = &key
+	mmExpire.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmExpire
+}
+
+// ExpectExpirationParam3 sets up expected param expiration for RedisClient.Expire
+func (mmExpire *mRedisClientMockExpire) ExpectExpirationParam3(expiration time.Duration) *mRedisClientMockExpire {
+	if mmExpire.mock.funcExpire != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Set")
+	}
+
+	if mmExpire.defaultExpectation == nil {
+		mmExpire.defaultExpectation = &RedisClientMockExpireExpectation{}
+	}
+
+	if mmExpire.defaultExpectation.params != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Expect")
+	}
+
+	if mmExpire.defaultExpectation.paramPtrs == nil {
+		mmExpire.defaultExpectation.paramPtrs = &RedisClientMockExpireParamPtrs{}
+	}
+	mmExpire.defaultExpectation.paramPtrs.expiration = &expiration
+	mmExpire.defaultExpectation.expectationOrigins.originExpiration = minimock.CallerInfo(1)
+
+	return mmExpire
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.Expire
+func (mmExpire *mRedisClientMockExpire) Inspect(f func(ctx context.Context, key string, expiration time.Duration)) *mRedisClientMockExpire {
+	if mmExpire.mock.inspectFuncExpire != nil {
+		mmExpire.mock.t.Fatalf("Inspect function is already set for RedisClientMock.Expire")
+	}
+
+	mmExpire.mock.inspectFuncExpire = f
+
+	return mmExpire
+}
+
+// Return sets up results that will be returned by RedisClient.Expire
+func (mmExpire *mRedisClientMockExpire) Return(err error) *RedisClientMock {
+	if mmExpire.mock.funcExpire != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Set")
+	}
+
+	if mmExpire.defaultExpectation == nil {
+		mmExpire.defaultExpectation = &RedisClientMockExpireExpectation{mock: mmExpire.mock}
+	}
+	mmExpire.defaultExpectation.results = &RedisClientMockExpireResults{err}
+	mmExpire.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExpire.mock
+}
+
+// Set uses given function f to mock the RedisClient.Expire method
+func (mmExpire *mRedisClientMockExpire) Set(f func(ctx context.Context, key string, expiration time.Duration) (err error)) *RedisClientMock {
+	if mmExpire.defaultExpectation != nil {
+		mmExpire.mock.t.Fatalf("Default expectation is already set for the RedisClient.Expire method")
+	}
+
+	if len(mmExpire.expectations) > 0 {
+		mmExpire.mock.t.Fatalf("Some expectations are already set for the RedisClient.Expire method")
+	}
+
+	mmExpire.mock.funcExpire = f
+	mmExpire.mock.funcExpireOrigin = minimock.CallerInfo(1)
+	return mmExpire.mock
+}
+
+// When sets expectation for the RedisClient.Expire which will trigger the result defined by the following
+// Then helper
+func (mmExpire *mRedisClientMockExpire) When(ctx context.Context, key string, expiration time.Duration) *RedisClientMockExpireExpectation {
+	if mmExpire.mock.funcExpire != nil {
+		mmExpire.mock.t.Fatalf("RedisClientMock.Expire mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockExpireExpectation{
+		mock:               mmExpire.mock,
+		params:             &RedisClientMockExpireParams{ctx, key, expiration},
+		expectationOrigins: RedisClientMockExpireExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExpire.expectations = append(mmExpire.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.Expire return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockExpireExpectation) Then(err error) *RedisClientMock {
+	e.results = &RedisClientMockExpireResults{err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.Expire should be invoked
+func (mmExpire *mRedisClientMockExpire) Times(n uint64) *mRedisClientMockExpire {
+	if n == 0 {
+		mmExpire.mock.t.Fatalf("Times of RedisClientMock.Expire mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExpire.expectedInvocations, n)
+	mmExpire.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExpire
+}
+
+func (mmExpire *mRedisClientMockExpire) invocationsDone() bool {
+	if len(mmExpire.expectations) == 0 && mmExpire.defaultExpectation == nil && mmExpire.mock.funcExpire == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExpire.mock.afterExpireCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExpire.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Expire implements mm_cache.RedisClient
+func (mmExpire *RedisClientMock) Expire(ctx context.Context, key string, expiration time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmExpire.beforeExpireCounter, 1)
+	defer mm_atomic.AddUint64(&mmExpire.afterExpireCounter, 1)
+
+	mmExpire.t.Helper()
+
+	if mmExpire.inspectFuncExpire != nil {
+		mmExpire.inspectFuncExpire(ctx, key, expiration)
+	}
+
+	mm_params := RedisClientMockExpireParams{ctx, key, expiration}
+
+	// Record call args
+	mmExpire.ExpireMock.mutex.Lock()
+	mmExpire.ExpireMock.callArgs = append(mmExpire.ExpireMock.callArgs, &mm_params)
+	mmExpire.ExpireMock.mutex.Unlock()
+
+	for _, e := range mmExpire.ExpireMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmExpire.ExpireMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExpire.ExpireMock.defaultExpectation.Counter, 1)
+		mm_want := mmExpire.ExpireMock.defaultExpectation.params
+		mm_want_ptrs := mmExpire.ExpireMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockExpireParams{ctx, key, expiration}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExpire.t.Errorf("RedisClientMock.Expire got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpire.ExpireMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmExpire.t.Errorf("RedisClientMock.Expire got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpire.ExpireMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.expiration != nil && !minimock.Equal(*mm_want_ptrs.expiration, mm_got.expiration) {
+				mmExpire.t.Errorf("RedisClientMock.Expire got unexpected parameter expiration, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpire.ExpireMock.defaultExpectation.expectationOrigins.originExpiration, *mm_want_ptrs.expiration, mm_got.expiration, minimock.Diff(*mm_want_ptrs.expiration, mm_got.expiration))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExpire.t.Errorf("RedisClientMock.Expire got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExpire.ExpireMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExpire.ExpireMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExpire.t.Fatal("No results are set for the RedisClientMock.Expire")
+		}
+		return (*mm_results).err
+	}
+	if mmExpire.funcExpire != nil {
+		return mmExpire.funcExpire(ctx, key, expiration)
+	}
+	mmExpire.t.Fatalf("Unexpected call to RedisClientMock.Expire. %v %v %v", ctx, key, expiration)
+	return
+}
+
+// ExpireAfterCounter returns a count of finished RedisClientMock.Expire invocations
+func (mmExpire *RedisClientMock) ExpireAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpire.afterExpireCounter)
+}
+
+// ExpireBeforeCounter returns a count of RedisClientMock.Expire invocations
+func (mmExpire *RedisClientMock) ExpireBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpire.beforeExpireCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.Expire.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExpire *mRedisClientMockExpire) Calls() []*RedisClientMockExpireParams {
+	mmExpire.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockExpireParams, len(mmExpire.callArgs))
+	copy(argCopy, mmExpire.callArgs)
+
+	mmExpire.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExpireDone returns true if the count of the Expire invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockExpireDone() bool {
+	if m.ExpireMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExpireMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExpireMock.invocationsDone()
+}
+
+// MinimockExpireInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockExpireInspect() {
+	for _, e := range m.ExpireMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.Expire at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExpireCounter := mm_atomic.LoadUint64(&m.afterExpireCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExpireMock.defaultExpectation != nil && afterExpireCounter < 1 {
+		if m.ExpireMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.Expire at\n%s", m.ExpireMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.Expire at\n%s with params: %#v", m.ExpireMock.defaultExpectation.expectationOrigins.origin, *m.ExpireMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExpire != nil && afterExpireCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.Expire at\n%s", m.funcExpireOrigin)
+	}
+
+	if !m.ExpireMock.invocationsDone() && afterExpireCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.Expire at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExpireMock.expectedInvocations), m.ExpireMock.expectedInvocationsOrigin, afterExpireCounter)
+	}
+}
+
+type mRedisClientMockGet struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockGetExpectation
+	expectations       []*RedisClientMockGetExpectation
+
+	callArgs []*RedisClientMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockGetExpectation specifies expectation struct of the RedisClient.Get
+type RedisClientMockGetExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockGetParams
+	paramPtrs          *RedisClientMockGetParamPtrs
+	expectationOrigins RedisClientMockGetExpectationOrigins
+	results            *RedisClientMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockGetParams contains parameters of the RedisClient.Get
+type RedisClientMockGetParams struct {
+	ctx context.Context
+	key string
+}
+
+// RedisClientMockGetParamPtrs contains pointers to parameters of the RedisClient.Get
+type RedisClientMockGetParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// RedisClientMockGetResults contains results of the RedisClient.Get
+type RedisClientMockGetResults struct {
+	p1  interface{}
+	err error
+}
+
+// RedisClientMockGetOrigins contains origins of expectations of the RedisClient.Get
+type RedisClientMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mRedisClientMockGet) Optional() *mRedisClientMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for RedisClient.Get
+func (mmGet *mRedisClientMockGet) Expect(ctx context.Context, key string) *mRedisClientMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RedisClientMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &RedisClientMockGetParams{ctx, key}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.Get
+func (mmGet *mRedisClientMockGet) ExpectCtxParam1(ctx context.Context) *mRedisClientMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RedisClientMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RedisClientMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.Get
+func (mmGet *mRedisClientMockGet) ExpectKeyParam2(key string) *mRedisClientMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RedisClientMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RedisClientMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.key = &key
+	mmGet.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.Get
+func (mmGet *mRedisClientMockGet) Inspect(f func(ctx context.Context, key string)) *mRedisClientMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for RedisClientMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by RedisClient.Get
+func (mmGet *mRedisClientMockGet) Return(p1 interface{}, err error) *RedisClientMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RedisClientMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &RedisClientMockGetResults{p1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the RedisClient.Get method
+func (mmGet *mRedisClientMockGet) Set(f func(ctx context.Context, key string) (p1 interface{}, err error)) *RedisClientMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the RedisClient.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the RedisClient.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the RedisClient.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mRedisClientMockGet) When(ctx context.Context, key string) *RedisClientMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RedisClientMock.Get mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &RedisClientMockGetParams{ctx, key},
+		expectationOrigins: RedisClientMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.Get return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockGetExpectation) Then(p1 interface{}, err error) *RedisClientMock {
+	e.results = &RedisClientMockGetResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.Get should be invoked
+func (mmGet *mRedisClientMockGet) Times(n uint64) *mRedisClientMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of RedisClientMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mRedisClientMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_cache.RedisClient
+func (mmGet *RedisClientMock) Get(ctx context.Context, key string) (p1 interface{}, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, key)
+	}
+
+	mm_params := RedisClientMockGetParams{ctx, key}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockGetParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("RedisClientMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmGet.t.Errorf("RedisClientMock.Get got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("RedisClientMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the RedisClientMock.Get")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, key)
+	}
+	mmGet.t.Fatalf("Unexpected call to RedisClientMock.Get. %v %v", ctx, key)
+	return
+}
+
+// GetAfterCounter returns a count of finished RedisClientMock.Get invocations
+func (mmGet *RedisClientMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of RedisClientMock.Get invocations
+func (mmGet *RedisClientMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mRedisClientMockGet) Calls() []*RedisClientMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mRedisClientMockHGetAll struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockHGetAllExpectation
+	expectations       []*RedisClientMockHGetAllExpectation
+
+	callArgs []*RedisClientMockHGetAllParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockHGetAllExpectation specifies expectation struct of the RedisClient.HGetAll
+type RedisClientMockHGetAllExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockHGetAllParams
+	paramPtrs          *RedisClientMockHGetAllParamPtrs
+	expectationOrigins RedisClientMockHGetAllExpectationOrigins
+	results            *RedisClientMockHGetAllResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockHGetAllParams contains parameters of the RedisClient.HGetAll
+type RedisClientMockHGetAllParams struct {
+	ctx context.Context
+	key string
+}
+
+// RedisClientMockHGetAllParamPtrs contains pointers to parameters of the RedisClient.HGetAll
+type RedisClientMockHGetAllParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// RedisClientMockHGetAllResults contains results of the RedisClient.HGetAll
+type RedisClientMockHGetAllResults struct {
+	pa1 []interface{}
+	err error
+}
+
+// RedisClientMockHGetAllOrigins contains origins of expectations of the RedisClient.HGetAll
+type RedisClientMockHGetAllExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHGetAll *mRedisClientMockHGetAll) Optional() *mRedisClientMockHGetAll {
+	mmHGetAll.optional = true
+	return mmHGetAll
+}
+
+// Expect sets up expected params for RedisClient.HGetAll
+func (mmHGetAll *mRedisClientMockHGetAll) Expect(ctx context.Context, key string) *mRedisClientMockHGetAll {
+	if mmHGetAll.mock.funcHGetAll != nil {
+		mmHGetAll.mock.t.Fatalf("RedisClientMock.HGetAll mock is already set by Set")
+	}
+
+	if mmHGetAll.defaultExpectation == nil {
+		mmHGetAll.defaultExpectation = &RedisClientMockHGetAllExpectation{}
+	}
+
+	if mmHGetAll.defaultExpectation.paramPtrs != nil {
+		mmHGetAll.mock.t.Fatalf("RedisClientMock.HGetAll mock is already set by ExpectParams functions")
+	}
+
+	mmHGetAll.defaultExpectation.params = &RedisClientMockHGetAllParams{ctx, key}
+	mmHGetAll.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmHGetAll.expectations {
+		if minimock.Equal(e.params, mmHGetAll.defaultExpectation.params) {
+			mmHGetAll.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHGetAll.defaultExpectation.params)
+		}
+	}
+
+	return mmHGetAll
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.HGetAll
+func (mmHGetAll *mRedisClientMockHGetAll) ExpectCtxParam1(ctx context.Context) *mRedisClientMockHGetAll {
+	if mmHGetAll.mock.funcHGetAll != nil {
+		mmHGetAll.mock.t.Fatalf("RedisClientMock.HGetAll mock is already set by Set")
+	}
+
+	if mmHGetAll.defaultExpectation == nil {
+		mmHGetAll.defaultExpectation = &RedisClientMockHGetAllExpectation{}
+	}
+
+	if mmHGetAll.defaultExpectation.params != nil {
+		mmHGetAll.mock.t.Fatalf("RedisClientMock.HGetAll mock is already set by Expect")
+	}
+
+	if mmHGetAll.defaultExpectation.paramPtrs == nil {
+		mmHGetAll.defaultExpectation.paramPtrs = &RedisClientMockHGetAllParamPtrs{}
+	}
+	mmHGetAll.defaultExpectation.paramPtrs.ctx = &ctx
+	mmHGetAll.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmHGetAll
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.HGetAll
+func (mmHGetAll *mRedisClientMockHGetAll) ExpectKeyParam2(key string) *mRedisClientMockHGetAll {
+	if mmHGetAll.mock.funcHGetAll != nil {
+		mmHGetAll.mock.t.Fatalf("RedisClientMock.HGetAll mock is already set by Set")
+	}
+
+	if mmHGetAll.defaultExpectation == nil {
+		mmHGetAll.defaultExpectation = &RedisClientMockHGetAllExpectation{}
+	}
+
+	if mmHGetAll.defaultExpectation.params != nil {
+		mmHGetAll.mock.t.Fatalf("RedisClientMock.HGetAll mock is already set by Expect")
+	}
+
+	if mmHGetAll.defaultExpectation.paramPtrs == nil {
+		mmHGetAll.defaultExpectation.paramPtrs = &RedisClientMockHGetAllParamPtrs{}
+	}
+	mmHGetAll.defaultExpectation.paramPtrs.key = &key
+	mmHGetAll.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmHGetAll
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.HGetAll
+func (mmHGetAll *mRedisClientMockHGetAll) Inspect(f func(ctx context.Context, key string)) *mRedisClientMockHGetAll {
+	if mmHGetAll.mock.inspectFuncHGetAll != nil {
+		mmHGetAll.mock.t.Fatalf("Inspect function is already set for RedisClientMock.HGetAll")
+	}
+
+	mmHGetAll.mock.inspectFuncHGetAll = f
+
+	return mmHGetAll
+}
+
+// Return sets up results that will be returned by RedisClient.HGetAll
+func (mmHGetAll *mRedisClientMockHGetAll) Return(pa1 []interface{}, err error) *RedisClientMock {
+	if mmHGetAll.mock.funcHGetAll != nil {
+		mmHGetAll.mock.t.Fatalf("RedisClientMock.HGetAll mock is already set by Set")
+	}
+
+	if mmHGetAll.defaultExpectation == nil {
+		mmHGetAll.defaultExpectation = &RedisClientMockHGetAllExpectation{mock: mmHGetAll.mock}
+	}
+	mmHGetAll.defaultExpectation.results = &RedisClientMockHGetAllResults{pa1, err}
+	mmHGetAll.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmHGetAll.mock
+}
+
+// Set uses given function f to mock the RedisClient.HGetAll method
+func (mmHGetAll *mRedisClientMockHGetAll) Set(f func(ctx context.Context, key string) (pa1 []interface{}, err error)) *RedisClientMock {
+	if mmHGetAll.defaultExpectation != nil {
+		mmHGetAll.mock.t.Fatalf("Default expectation is already set for the RedisClient.HGetAll method")
+	}
+
+	if len(mmHGetAll.expectations) > 0 {
+		mmHGetAll.mock.t.Fatalf("Some expectations are already set for the RedisClient.HGetAll method")
+	}
+
+	mmHGetAll.mock.funcHGetAll = f
+	mmHGetAll.mock.funcHGetAllOrigin = minimock.CallerInfo(1)
+	return mmHGetAll.mock
+}
+
+// When sets expectation for the RedisClient.HGetAll which will trigger the result defined by the following
+// Then helper
+func (mmHGetAll *mRedisClientMockHGetAll) When(ctx context.Context, key string) *RedisClientMockHGetAllExpectation {
+	if mmHGetAll.mock.funcHGetAll != nil {
+		mmHGetAll.mock.t.Fatalf("RedisClientMock.HGetAll mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockHGetAllExpectation{
+		mock:               mmHGetAll.mock,
+		params:             &RedisClientMockHGetAllParams{ctx, key},
+		expectationOrigins: RedisClientMockHGetAllExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmHGetAll.expectations = append(mmHGetAll.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.HGetAll return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockHGetAllExpectation) Then(pa1 []interface{}, err error) *RedisClientMock {
+	e.results = &RedisClientMockHGetAllResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.HGetAll should be invoked
+func (mmHGetAll *mRedisClientMockHGetAll) Times(n uint64) *mRedisClientMockHGetAll {
+	if n == 0 {
+		mmHGetAll.mock.t.Fatalf("Times of RedisClientMock.HGetAll mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHGetAll.expectedInvocations, n)
+	mmHGetAll.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmHGetAll
+}
+
+func (mmHGetAll *mRedisClientMockHGetAll) invocationsDone() bool {
+	if len(mmHGetAll.expectations) == 0 && mmHGetAll.defaultExpectation == nil && mmHGetAll.mock.funcHGetAll == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHGetAll.mock.afterHGetAllCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHGetAll.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// HGetAll implements mm_cache.RedisClient
+func (mmHGetAll *RedisClientMock) HGetAll(ctx context.Context, key string) (pa1 []interface{}, err error) {
+	mm_atomic.AddUint64(&mmHGetAll.beforeHGetAllCounter, 1)
+	defer mm_atomic.AddUint64(&mmHGetAll.afterHGetAllCounter, 1)
+
+	mmHGetAll.t.Helper()
+
+	if mmHGetAll.inspectFuncHGetAll != nil {
+		mmHGetAll.inspectFuncHGetAll(ctx, key)
+	}
+
+	mm_params := RedisClientMockHGetAllParams{ctx, key}
+
+	// Record call args
+	mmHGetAll.HGetAllMock.mutex.Lock()
+	mmHGetAll.HGetAllMock.callArgs = append(mmHGetAll.HGetAllMock.callArgs, &mm_params)
+	mmHGetAll.HGetAllMock.mutex.Unlock()
+
+	for _, e := range mmHGetAll.HGetAllMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmHGetAll.HGetAllMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHGetAll.HGetAllMock.defaultExpectation.Counter, 1)
+		mm_want := mmHGetAll.HGetAllMock.defaultExpectation.params
+		mm_want_ptrs := mmHGetAll.HGetAllMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockHGetAllParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmHGetAll.t.Errorf("RedisClientMock.HGetAll got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHGetAll.HGetAllMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmHGetAll.t.Errorf("RedisClientMock.HGetAll got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHGetAll.HGetAllMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHGetAll.t.Errorf("RedisClientMock.HGetAll got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmHGetAll.HGetAllMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHGetAll.HGetAllMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHGetAll.t.Fatal("No results are set for the RedisClientMock.HGetAll")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmHGetAll.funcHGetAll != nil {
+		return mmHGetAll.funcHGetAll(ctx, key)
+	}
+	mmHGetAll.t.Fatalf("Unexpected call to RedisClientMock.HGetAll. %v %v", ctx, key)
+	return
+}
+
+// HGetAllAfterCounter returns a count of finished RedisClientMock.HGetAll invocations
+func (mmHGetAll *RedisClientMock) HGetAllAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHGetAll.afterHGetAllCounter)
+}
+
+// HGetAllBeforeCounter returns a count of RedisClientMock.HGetAll invocations
+func (mmHGetAll *RedisClientMock) HGetAllBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHGetAll.beforeHGetAllCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.HGetAll.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHGetAll *mRedisClientMockHGetAll) Calls() []*RedisClientMockHGetAllParams {
+	mmHGetAll.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockHGetAllParams, len(mmHGetAll.callArgs))
+	copy(argCopy, mmHGetAll.callArgs)
+
+	mmHGetAll.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHGetAllDone returns true if the count of the HGetAll invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockHGetAllDone() bool {
+	if m.HGetAllMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HGetAllMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HGetAllMock.invocationsDone()
+}
+
+// MinimockHGetAllInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockHGetAllInspect() {
+	for _, e := range m.HGetAllMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.HGetAll at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterHGetAllCounter := mm_atomic.LoadUint64(&m.afterHGetAllCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HGetAllMock.defaultExpectation != nil && afterHGetAllCounter < 1 {
+		if m.HGetAllMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.HGetAll at\n%s", m.HGetAllMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.HGetAll at\n%s with params: %#v", m.HGetAllMock.defaultExpectation.expectationOrigins.origin, *m.HGetAllMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHGetAll != nil && afterHGetAllCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.HGetAll at\n%s", m.funcHGetAllOrigin)
+	}
+
+	if !m.HGetAllMock.invocationsDone() && afterHGetAllCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.HGetAll at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.HGetAllMock.expectedInvocations), m.HGetAllMock.expectedInvocationsOrigin, afterHGetAllCounter)
+	}
+}
+
+type mRedisClientMockHashSet struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockHashSetExpectation
+	expectations       []*RedisClientMockHashSetExpectation
+
+	callArgs []*RedisClientMockHashSetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockHashSetExpectation specifies expectation struct of the RedisClient.HashSet
+type RedisClientMockHashSetExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockHashSetParams
+	paramPtrs          *RedisClientMockHashSetParamPtrs
+	expectationOrigins RedisClientMockHashSetExpectationOrigins
+	results            *RedisClientMockHashSetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockHashSetParams contains parameters of the RedisClient.HashSet
+type RedisClientMockHashSetParams struct {
+	ctx    context.Context
+	key    string
+	values interface{}
+}
+
+// RedisClientMockHashSetParamPtrs contains pointers to parameters of the RedisClient.HashSet
+type RedisClientMockHashSetParamPtrs struct {
+	ctx    *context.Context
+	key    *string
+	values *interface{}
+}
+
+// RedisClientMockHashSetResults contains results of the RedisClient.HashSet
+type RedisClientMockHashSetResults struct {
+	err error
+}
+
+// RedisClientMockHashSetOrigins contains origins of expectations of the RedisClient.HashSet
+type RedisClientMockHashSetExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originKey    string
+	originValues string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHashSet *mRedisClientMockHashSet) Optional() *mRedisClientMockHashSet {
+	mmHashSet.optional = true
+	return mmHashSet
+}
+
+// Expect sets up expected params for RedisClient.HashSet
+func (mmHashSet *mRedisClientMockHashSet) Expect(ctx context.Context, key string, values interface{}) *mRedisClientMockHashSet {
+	if mmHashSet.mock.funcHashSet != nil {
+		mmHashSet.mock.t.Fatalf("RedisClientMock.HashSet mock is already set by Set")
+	}
+
+	if mmHashSet.defaultExpectation == nil {
+		mmHashSet.defaultExpectation = &RedisClientMockHashSetExpectation{}
+	}
+
+	if mmHashSet.defaultExpectation.paramPtrs != nil {
+		mmHashSet.mock.t.Fatalf("RedisClientMock.HashSet mock is already set by ExpectParams functions")
+	}
+
+	mmHashSet.defaultExpectation.params = &RedisClientMockHashSetParams{ctx, key, values}
+	mmHashSet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmHashSet.expectations {
+		if minimock.Equal(e.params, mmHashSet.defaultExpectation.params) {
+			mmHashSet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHashSet.defaultExpectation.params)
+		}
+	}
+
+	return mmHashSet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.HashSet
+func (mmHashSet *mRedisClientMockHashSet) ExpectCtxParam1(ctx context.Context) *mRedisClientMockHashSet {
+	if mmHashSet.mock.funcHashSet != nil {
+		mmHashSet.mock.t.Fatalf("RedisClientMock.HashSet mock is already set by Set")
+	}
+
+	if mmHashSet.defaultExpectation == nil {
+		mmHashSet.defaultExpectation = &RedisClientMockHashSetExpectation{}
+	}
+
+	if mmHashSet.defaultExpectation.params != nil {
+		mmHashSet.mock.t.Fatalf("RedisClientMock.HashSet mock is already set by Expect")
+	}
+
+	if mmHashSet.defaultExpectation.paramPtrs == nil {
+		mmHashSet.defaultExpectation.paramPtrs = &RedisClientMockHashSetParamPtrs{}
+	}
+	mmHashSet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmHashSet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmHashSet
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.HashSet
+func (mmHashSet *mRedisClientMockHashSet) ExpectKeyParam2(key string) *mRedisClientMockHashSet {
+	if mmHashSet.mock.funcHashSet != nil {
+		mmHashSet.mock.t.Fatalf("RedisClientMock.HashSet mock is already set by Set")
+	}
+
+	if mmHashSet.defaultExpectation == nil {
+		mmHashSet.defaultExpectation = &RedisClientMockHashSetExpectation{}
+	}
+
+	if mmHashSet.defaultExpectation.params != nil {
+		mmHashSet.mock.t.Fatalf("RedisClientMock.HashSet mock is already set by Expect")
+	}
+
+	if mmHashSet.defaultExpectation.paramPtrs == nil {
+		mmHashSet.defaultExpectation.paramPtrs = &RedisClientMockHashSetParamPtrs{}
+	}
+	mmHashSet.defaultExpectation.paramPtrs.key = &key
+	mmHashSet.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmHashSet
+}
+
+// ExpectValuesParam3 sets up expected param values for RedisClient.HashSet
+func (mmHashSet *mRedisClientMockHashSet) ExpectValuesParam3(values interface{}) *mRedisClientMockHashSet {
+	if mmHashSet.mock.funcHashSet != nil {
+		mmHashSet.mock.t.Fatalf("RedisClientMock.HashSet mock is already set by Set")
+	}
+
+	if mmHashSet.defaultExpectation == nil {
+		mmHashSet.defaultExpectation = &RedisClientMockHashSetExpectation{}
+	}
+
+	if mmHashSet.defaultExpectation.params != nil {
+		mmHashSet.mock.t.Fatalf("RedisClientMock.HashSet mock is already set by Expect")
+	}
+
+	if mmHashSet.defaultExpectation.paramPtrs == nil {
+		mmHashSet.defaultExpectation.paramPtrs = &RedisClientMockHashSetParamPtrs{}
+	}
+	mmHashSet.defaultExpectation.paramPtrs.values = &values
+	mmHashSet.defaultExpectation.expectationOrigins.originValues = minimock.CallerInfo(1)
+
+	return mmHashSet
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.HashSet
+func (mmHashSet *mRedisClientMockHashSet) Inspect(f func(ctx context.Context, key string, values interface{})) *mRedisClientMockHashSet {
+	if mmHashSet.mock.inspectFuncHashSet != nil {
+		mmHashSet.mock.t.Fatalf("Inspect function is already set for RedisClientMock.HashSet")
+	}
+
+	mmHashSet.mock.inspectFuncHashSet = f
+
+	return mmHashSet
+}
+
+// Return sets up results that will be returned by RedisClient.HashSet
+func (mmHashSet *mRedisClientMockHashSet) Return(err error) *RedisClientMock {
+	if mmHashSet.mock.funcHashSet != nil {
+		mmHashSet.mock.t.Fatalf("RedisClientMock.HashSet mock is already set by Set")
+	}
+
+	if mmHashSet.defaultExpectation == nil {
+		mmHashSet.defaultExpectation = &RedisClientMockHashSetExpectation{mock: mmHashSet.mock}
+	}
+	mmHashSet.defaultExpectation.results = &RedisClientMockHashSetResults{err}
+	mmHashSet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmHashSet.mock
+}
+
+// Set uses given function f to mock the RedisClient.HashSet method
+func (mmHashSet *mRedisClientMockHashSet) Set(f func(ctx context.Context, key string, values interface{}) (err error)) *RedisClientMock {
+	if mmHashSet.defaultExpectation != nil {
+		mmHashSet.mock.t.Fatalf("Default expectation is already set for the RedisClient.HashSet method")
+	}
+
+	if len(mmHashSet.expectations) > 0 {
+		mmHashSet.mock.t.Fatalf("Some expectations are already set for the RedisClient.HashSet method")
+	}
+
+	mmHashSet.mock.funcHashSet = f
+	mmHashSet.mock.funcHashSetOrigin = minimock.CallerInfo(1)
+	return mmHashSet.mock
+}
+
+// When sets expectation for the RedisClient.HashSet which will trigger the result defined by the following
+// Then helper
+func (mmHashSet *mRedisClientMockHashSet) When(ctx context.Context, key string, values interface{}) *RedisClientMockHashSetExpectation {
+	if mmHashSet.mock.funcHashSet != nil {
+		mmHashSet.mock.t.Fatalf("RedisClientMock.HashSet mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockHashSetExpectation{
+		mock:               mmHashSet.mock,
+		params:             &RedisClientMockHashSetParams{ctx, key, values},
+		expectationOrigins: RedisClientMockHashSetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmHashSet.expectations = append(mmHashSet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.HashSet return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockHashSetExpectation) Then(err error) *RedisClientMock {
+	e.results = &RedisClientMockHashSetResults{err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.HashSet should be invoked
+func (mmHashSet *mRedisClientMockHashSet) Times(n uint64) *mRedisClientMockHashSet {
+	if n == 0 {
+		mmHashSet.mock.t.Fatalf("Times of RedisClientMock.HashSet mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHashSet.expectedInvocations, n)
+	mmHashSet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmHashSet
+}
+
+func (mmHashSet *mRedisClientMockHashSet) invocationsDone() bool {
+	if len(mmHashSet.expectations) == 0 && mmHashSet.defaultExpectation == nil && mmHashSet.mock.funcHashSet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHashSet.mock.afterHashSetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHashSet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// HashSet implements mm_cache.RedisClient
+func (mmHashSet *RedisClientMock) HashSet(ctx context.Context, key string, values interface{}) (err error) {
+	mm_atomic.AddUint64(&mmHashSet.beforeHashSetCounter, 1)
+	defer mm_atomic.AddUint64(&mmHashSet.afterHashSetCounter, 1)
+
+	mmHashSet.t.Helper()
+
+	if mmHashSet.inspectFuncHashSet != nil {
+		mmHashSet.inspectFuncHashSet(ctx, key, values)
+	}
+
+	mm_params := RedisClientMockHashSetParams{ctx, key, values}
+
+	// Record call args
+	mmHashSet.HashSetMock.mutex.Lock()
+	mmHashSet.HashSetMock.callArgs = append(mmHashSet.HashSetMock.callArgs, &mm_params)
+	mmHashSet.HashSetMock.mutex.Unlock()
+
+	for _, e := range mmHashSet.HashSetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmHashSet.HashSetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHashSet.HashSetMock.defaultExpectation.Counter, 1)
+		mm_want := mmHashSet.HashSetMock.defaultExpectation.params
+		mm_want_ptrs := mmHashSet.HashSetMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockHashSetParams{ctx, key, values}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmHashSet.t.Errorf("RedisClientMock.HashSet got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHashSet.HashSetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmHashSet.t.Errorf("RedisClientMock.HashSet got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHashSet.HashSetMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.values != nil && !minimock.Equal(*mm_want_ptrs.values, mm_got.values) {
+				mmHashSet.t.Errorf("RedisClientMock.HashSet got unexpected parameter values, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHashSet.HashSetMock.defaultExpectation.expectationOrigins.originValues, *mm_want_ptrs.values, mm_got.values, minimock.Diff(*mm_want_ptrs.values, mm_got.values))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHashSet.t.Errorf("RedisClientMock.HashSet got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmHashSet.HashSetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHashSet.HashSetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHashSet.t.Fatal("No results are set for the RedisClientMock.HashSet")
+		}
+		return (*mm_results).err
+	}
+	if mmHashSet.funcHashSet != nil {
+		return mmHashSet.funcHashSet(ctx, key, values)
+	}
+	mmHashSet.t.Fatalf("Unexpected call to RedisClientMock.HashSet. %v %v %v", ctx, key, values)
+	return
+}
+
+// HashSetAfterCounter returns a count of finished RedisClientMock.HashSet invocations
+func (mmHashSet *RedisClientMock) HashSetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHashSet.afterHashSetCounter)
+}
+
+// HashSetBeforeCounter returns a count of RedisClientMock.HashSet invocations
+func (mmHashSet *RedisClientMock) HashSetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHashSet.beforeHashSetCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.HashSet.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHashSet *mRedisClientMockHashSet) Calls() []*RedisClientMockHashSetParams {
+	mmHashSet.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockHashSetParams, len(mmHashSet.callArgs))
+	copy(argCopy, mmHashSet.callArgs)
+
+	mmHashSet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHashSetDone returns true if the count of the HashSet invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockHashSetDone() bool {
+	if m.HashSetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HashSetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HashSetMock.invocationsDone()
+}
+
+// MinimockHashSetInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockHashSetInspect() {
+	for _, e := range m.HashSetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.HashSet at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterHashSetCounter := mm_atomic.LoadUint64(&m.afterHashSetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HashSetMock.defaultExpectation != nil && afterHashSetCounter < 1 {
+		if m.HashSetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.HashSet at\n%s", m.HashSetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.HashSet at\n%s with params: %#v", m.HashSetMock.defaultExpectation.expectationOrigins.origin, *m.HashSetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHashSet != nil && afterHashSetCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.HashSet at\n%s", m.funcHashSetOrigin)
+	}
+
+	if !m.HashSetMock.invocationsDone() && afterHashSetCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.HashSet at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.HashSetMock.expectedInvocations), m.HashSetMock.expectedInvocationsOrigin, afterHashSetCounter)
+	}
+}
+
+type mRedisClientMockPing struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockPingExpectation
+	expectations       []*RedisClientMockPingExpectation
+
+	callArgs []*RedisClientMockPingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockPingExpectation specifies expectation struct of the RedisClient.Ping
+type RedisClientMockPingExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockPingParams
+	paramPtrs          *RedisClientMockPingParamPtrs
+	expectationOrigins RedisClientMockPingExpectationOrigins
+	results            *RedisClientMockPingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockPingParams contains parameters of the RedisClient.Ping
+type RedisClientMockPingParams struct {
+	ctx context.Context
+}
+
+// RedisClientMockPingParamPtrs contains pointers to parameters of the RedisClient.Ping
+type RedisClientMockPingParamPtrs struct {
+	ctx *context.Context
+}
+
+// RedisClientMockPingResults contains results of the RedisClient.Ping
+type RedisClientMockPingResults struct {
+	err error
+}
+
+// RedisClientMockPingOrigins contains origins of expectations of the RedisClient.Ping
+type RedisClientMockPingExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPing *mRedisClientMockPing) Optional() *mRedisClientMockPing {
+	mmPing.optional = true
+	return mmPing
+}
+
+// Expect sets up expected params for RedisClient.Ping
+func (mmPing *mRedisClientMockPing) Expect(ctx context.Context) *mRedisClientMockPing {
+	if mmPing.mock.funcPing != nil {
+		mmPing.mock.t.Fatalf("RedisClientMock.Ping mock is already set by Set")
+	}
+
+	if mmPing.defaultExpectation == nil {
+		mmPing.defaultExpectation = &RedisClientMockPingExpectation{}
+	}
+
+	if mmPing.defaultExpectation.paramPtrs != nil {
+		mmPing.mock.t.Fatalf("RedisClientMock.Ping mock is already set by ExpectParams functions")
+	}
+
+	mmPing.defaultExpectation.params = &RedisClientMockPingParams{ctx}
+	mmPing.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPing.expectations {
+		if minimock.Equal(e.params, mmPing.defaultExpectation.params) {
+			mmPing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPing.defaultExpectation.params)
+		}
+	}
+
+	return mmPing
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.Ping
+func (mmPing *mRedisClientMockPing) ExpectCtxParam1(ctx context.Context) *mRedisClientMockPing {
+	if mmPing.mock.funcPing != nil {
+		mmPing.mock.t.Fatalf("RedisClientMock.Ping mock is already set by Set")
+	}
+
+	if mmPing.defaultExpectation == nil {
+		mmPing.defaultExpectation = &RedisClientMockPingExpectation{}
+	}
+
+	if mmPing.defaultExpectation.params != nil {
+		mmPing.mock.t.Fatalf("RedisClientMock.Ping mock is already set by Expect")
+	}
+
+	if mmPing.defaultExpectation.paramPtrs == nil {
+		mmPing.defaultExpectation.paramPtrs = &RedisClientMockPingParamPtrs{}
+	}
+	mmPing.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPing.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPing
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.Ping
+func (mmPing *mRedisClientMockPing) Inspect(f func(ctx context.Context)) *mRedisClientMockPing {
+	if mmPing.mock.inspectFuncPing != nil {
+		mmPing.mock.t.Fatalf("Inspect function is already set for RedisClientMock.Ping")
+	}
+
+	mmPing.mock.inspectFuncPing = f
+
+	return mmPing
+}
+
+// Return sets up results that will be returned by RedisClient.Ping
+func (mmPing *mRedisClientMockPing) Return(err error) *RedisClientMock {
+	if mmPing.mock.funcPing != nil {
+		mmPing.mock.t.Fatalf("RedisClientMock.Ping mock is already set by Set")
+	}
+
+	if mmPing.defaultExpectation == nil {
+		mmPing.defaultExpectation = &RedisClientMockPingExpectation{mock: mmPing.mock}
+	}
+	mmPing.defaultExpectation.results = &RedisClientMockPingResults{err}
+	mmPing.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPing.mock
+}
+
+// Set uses given function f to mock the RedisClient.Ping method
+func (mmPing *mRedisClientMockPing) Set(f func(ctx context.Context) (err error)) *RedisClientMock {
+	if mmPing.defaultExpectation != nil {
+		mmPing.mock.t.Fatalf("Default expectation is already set for the RedisClient.Ping method")
+	}
+
+	if len(mmPing.expectations) > 0 {
+		mmPing.mock.t.Fatalf("Some expectations are already set for the RedisClient.Ping method")
+	}
+
+	mmPing.mock.funcPing = f
+	mmPing.mock.funcPingOrigin = minimock.CallerInfo(1)
+	return mmPing.mock
+}
+
+// When sets expectation for the RedisClient.Ping which will trigger the result defined by the following
+// Then helper
+func (mmPing *mRedisClientMockPing) When(ctx context.Context) *RedisClientMockPingExpectation {
+	if mmPing.mock.funcPing != nil {
+		mmPing.mock.t.Fatalf("RedisClientMock.Ping mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockPingExpectation{
+		mock:               mmPing.mock,
+		params:             &RedisClientMockPingParams{ctx},
+		expectationOrigins: RedisClientMockPingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPing.expectations = append(mmPing.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.Ping return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockPingExpectation) Then(err error) *RedisClientMock {
+	e.results = &RedisClientMockPingResults{err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.Ping should be invoked
+func (mmPing *mRedisClientMockPing) Times(n uint64) *mRedisClientMockPing {
+	if n == 0 {
+		mmPing.mock.t.Fatalf("Times of RedisClientMock.Ping mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPing.expectedInvocations, n)
+	mmPing.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPing
+}
+
+func (mmPing *mRedisClientMockPing) invocationsDone() bool {
+	if len(mmPing.expectations) == 0 && mmPing.defaultExpectation == nil && mmPing.mock.funcPing == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPing.mock.afterPingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPing.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Ping implements mm_cache.RedisClient
+func (mmPing *RedisClientMock) Ping(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmPing.beforePingCounter, 1)
+	defer mm_atomic.AddUint64(&mmPing.afterPingCounter, 1)
+
+	mmPing.t.Helper()
+
+	if mmPing.inspectFuncPing != nil {
+		mmPing.inspectFuncPing(ctx)
+	}
+
+	mm_params := RedisClientMockPingParams{ctx}
+
+	// Record call args
+	mmPing.PingMock.mutex.Lock()
+	mmPing.PingMock.callArgs = append(mmPing.PingMock.callArgs, &mm_params)
+	mmPing.PingMock.mutex.Unlock()
+
+	for _, e := range mmPing.PingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPing.PingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPing.PingMock.defaultExpectation.Counter, 1)
+		mm_want := mmPing.PingMock.defaultExpectation.params
+		mm_want_ptrs := mmPing.PingMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockPingParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPing.t.Errorf("RedisClientMock.Ping got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPing.PingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPing.t.Errorf("RedisClientMock.Ping got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPing.PingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPing.PingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPing.t.Fatal("No results are set for the RedisClientMock.Ping")
+		}
+		return (*mm_results).err
+	}
+	if mmPing.funcPing != nil {
+		return mmPing.funcPing(ctx)
+	}
+	mmPing.t.Fatalf("Unexpected call to RedisClientMock.Ping. %v", ctx)
+	return
+}
+
+// PingAfterCounter returns a count of finished RedisClientMock.Ping invocations
+func (mmPing *RedisClientMock) PingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPing.afterPingCounter)
+}
+
+// PingBeforeCounter returns a count of RedisClientMock.Ping invocations
+func (mmPing *RedisClientMock) PingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPing.beforePingCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.Ping.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPing *mRedisClientMockPing) Calls() []*RedisClientMockPingParams {
+	mmPing.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockPingParams, len(mmPing.callArgs))
+	copy(argCopy, mmPing.callArgs)
+
+	mmPing.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPingDone returns true if the count of the Ping invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockPingDone() bool {
+	if m.PingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PingMock.invocationsDone()
+}
+
+// MinimockPingInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockPingInspect() {
+	for _, e := range m.PingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.Ping at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPingCounter := mm_atomic.LoadUint64(&m.afterPingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PingMock.defaultExpectation != nil && afterPingCounter < 1 {
+		if m.PingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.Ping at\n%s", m.PingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.Ping at\n%s with params: %#v", m.PingMock.defaultExpectation.expectationOrigins.origin, *m.PingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPing != nil && afterPingCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.Ping at\n%s", m.funcPingOrigin)
+	}
+
+	if !m.PingMock.invocationsDone() && afterPingCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.Ping at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PingMock.expectedInvocations), m.PingMock.expectedInvocationsOrigin, afterPingCounter)
+	}
+}
+
+type mRedisClientMockSAdd struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockSAddExpectation
+	expectations       []*RedisClientMockSAddExpectation
+
+	callArgs []*RedisClientMockSAddParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockSAddExpectation specifies expectation struct of the RedisClient.SAdd
+type RedisClientMockSAddExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockSAddParams
+	paramPtrs          *RedisClientMockSAddParamPtrs
+	expectationOrigins RedisClientMockSAddExpectationOrigins
+	results            *RedisClientMockSAddResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockSAddParams contains parameters of the RedisClient.SAdd
+type RedisClientMockSAddParams struct {
+	ctx    context.Context
+	key    string
+	member interface{}
+}
+
+// RedisClientMockSAddParamPtrs contains pointers to parameters of the RedisClient.SAdd
+type RedisClientMockSAddParamPtrs struct {
+	ctx    *context.Context
+	key    *string
+	member *interface{}
+}
+
+// RedisClientMockSAddResults contains results of the RedisClient.SAdd
+type RedisClientMockSAddResults struct {
+	err error
+}
+
+// RedisClientMockSAddOrigins contains origins of expectations of the RedisClient.SAdd
+type RedisClientMockSAddExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originKey    string
+	originMember string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSAdd *mRedisClientMockSAdd) Optional() *mRedisClientMockSAdd {
+	mmSAdd.optional = true
+	return mmSAdd
+}
+
+// Expect sets up expected params for RedisClient.SAdd
+func (mmSAdd *mRedisClientMockSAdd) Expect(ctx context.Context, key string, member interface{}) *mRedisClientMockSAdd {
+	if mmSAdd.mock.funcSAdd != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Set")
+	}
+
+	if mmSAdd.defaultExpectation == nil {
+		mmSAdd.defaultExpectation = &RedisClientMockSAddExpectation{}
+	}
+
+	if mmSAdd.defaultExpectation.paramPtrs != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by ExpectParams functions")
+	}
+
+	mmSAdd.defaultExpectation.params = &RedisClientMockSAddParams{ctx, key, member}
+	mmSAdd.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSAdd.expectations {
+		if minimock.Equal(e.params, mmSAdd.defaultExpectation.params) {
+			mmSAdd.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSAdd.defaultExpectation.params)
+		}
+	}
+
+	return mmSAdd
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.SAdd
+func (mmSAdd *mRedisClientMockSAdd) ExpectCtxParam1(ctx context.Context) *mRedisClientMockSAdd {
+	if mmSAdd.mock.funcSAdd != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Set")
+	}
+
+	if mmSAdd.defaultExpectation == nil {
+		mmSAdd.defaultExpectation = &RedisClientMockSAddExpectation{}
+	}
+
+	if mmSAdd.defaultExpectation.params != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Expect")
+	}
+
+	if mmSAdd.defaultExpectation.paramPtrs == nil {
+		mmSAdd.defaultExpectation.paramPtrs = &RedisClientMockSAddParamPtrs{}
+	}
+	mmSAdd.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSAdd.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSAdd
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.SAdd
+func (mmSAdd *mRedisClientMockSAdd) ExpectKeyParam2(key string) *mRedisClientMockSAdd {
+	if mmSAdd.mock.funcSAdd != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Set")
+	}
+
+	if mmSAdd.defaultExpectation == nil {
+		mmSAdd.defaultExpectation = &RedisClientMockSAddExpectation{}
+	}
+
+	if mmSAdd.defaultExpectation.params != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Expect")
+	}
+
+	if mmSAdd.defaultExpectation.paramPtrs == nil {
+		mmSAdd.defaultExpectation.paramPtrs = &RedisClientMockSAddParamPtrs{}
+	}
+	mmSAdd.defaultExpectation.paramPtrs.key = &key
+	mmSAdd.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmSAdd
+}
+
+// ExpectMemberParam3 sets up expected param member for RedisClient.SAdd
+func (mmSAdd *mRedisClientMockSAdd) ExpectMemberParam3(member interface{}) *mRedisClientMockSAdd {
+	if mmSAdd.mock.funcSAdd != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Set")
+	}
+
+	if mmSAdd.defaultExpectation == nil {
+		mmSAdd.defaultExpectation = &RedisClientMockSAddExpectation{}
+	}
+
+	if mmSAdd.defaultExpectation.params != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Expect")
+	}
+
+	if mmSAdd.defaultExpectation.paramPtrs == nil {
+		mmSAdd.defaultExpectation.paramPtrs = &RedisClientMockSAddParamPtrs{}
+	}
+	mmSAdd.defaultExpectation.paramPtrs.member = &member
+	mmSAdd.defaultExpectation.expectationOrigins.originMember = minimock.CallerInfo(1)
+
+	return mmSAdd
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.SAdd
+func (mmSAdd *mRedisClientMockSAdd) Inspect(f func(ctx context.Context, key string, member interface{})) *mRedisClientMockSAdd {
+	if mmSAdd.mock.inspectFuncSAdd != nil {
+		mmSAdd.mock.t.Fatalf("Inspect function is already set for RedisClientMock.SAdd")
+	}
+
+	mmSAdd.mock.inspectFuncSAdd = f
+
+	return mmSAdd
+}
+
+// Return sets up results that will be returned by RedisClient.SAdd
+func (mmSAdd *mRedisClientMockSAdd) Return(err error) *RedisClientMock {
+	if mmSAdd.mock.funcSAdd != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Set")
+	}
+
+	if mmSAdd.defaultExpectation == nil {
+		mmSAdd.defaultExpectation = &RedisClientMockSAddExpectation{mock: mmSAdd.mock}
+	}
+	mmSAdd.defaultExpectation.results = &RedisClientMockSAddResults{err}
+	mmSAdd.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSAdd.mock
+}
+
+// Set uses given function f to mock the RedisClient.SAdd method
+func (mmSAdd *mRedisClientMockSAdd) Set(f func(ctx context.Context, key string, member interface{}) (err error)) *RedisClientMock {
+	if mmSAdd.defaultExpectation != nil {
+		mmSAdd.mock.t.Fatalf("Default expectation is already set for the RedisClient.SAdd method")
+	}
+
+	if len(mmSAdd.expectations) > 0 {
+		mmSAdd.mock.t.Fatalf("Some expectations are already set for the RedisClient.SAdd method")
+	}
+
+	mmSAdd.mock.funcSAdd = f
+	mmSAdd.mock.funcSAddOrigin = minimock.CallerInfo(1)
+	return mmSAdd.mock
+}
+
+// When sets expectation for the RedisClient.SAdd which will trigger the result defined by the following
+// Then helper
+func (mmSAdd *mRedisClientMockSAdd) When(ctx context.Context, key string, member interface{}) *RedisClientMockSAddExpectation {
+	if mmSAdd.mock.funcSAdd != nil {
+		mmSAdd.mock.t.Fatalf("RedisClientMock.SAdd mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockSAddExpectation{
+		mock:               mmSAdd.mock,
+		params:             &RedisClientMockSAddParams{ctx, key, member},
+		expectationOrigins: RedisClientMockSAddExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSAdd.expectations = append(mmSAdd.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.SAdd return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockSAddExpectation) Then(err error) *RedisClientMock {
+	e.results = &RedisClientMockSAddResults{err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.SAdd should be invoked
+func (mmSAdd *mRedisClientMockSAdd) Times(n uint64) *mRedisClientMockSAdd {
+	if n == 0 {
+		mmSAdd.mock.t.Fatalf("Times of RedisClientMock.SAdd mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSAdd.expectedInvocations, n)
+	mmSAdd.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSAdd
+}
+
+func (mmSAdd *mRedisClientMockSAdd) invocationsDone() bool {
+	if len(mmSAdd.expectations) == 0 && mmSAdd.defaultExpectation == nil && mmSAdd.mock.funcSAdd == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSAdd.mock.afterSAddCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSAdd.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SAdd implements mm_cache.RedisClient
+func (mmSAdd *RedisClientMock) SAdd(ctx context.Context, key string, member interface{}) (err error) {
+	mm_atomic.AddUint64(&mmSAdd.beforeSAddCounter, 1)
+	defer mm_atomic.AddUint64(&mmSAdd.afterSAddCounter, 1)
+
+	mmSAdd.t.Helper()
+
+	if mmSAdd.inspectFuncSAdd != nil {
+		mmSAdd.inspectFuncSAdd(ctx, key, member)
+	}
+
+	mm_params := RedisClientMockSAddParams{ctx, key, member}
+
+	// Record call args
+	mmSAdd.SAddMock.mutex.Lock()
+	mmSAdd.SAddMock.callArgs = append(mmSAdd.SAddMock.callArgs, &mm_params)
+	mmSAdd.SAddMock.mutex.Unlock()
+
+	for _, e := range mmSAdd.SAddMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSAdd.SAddMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSAdd.SAddMock.defaultExpectation.Counter, 1)
+		mm_want := mmSAdd.SAddMock.defaultExpectation.params
+		mm_want_ptrs := mmSAdd.SAddMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockSAddParams{ctx, key, member}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSAdd.t.Errorf("RedisClientMock.SAdd got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSAdd.SAddMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmSAdd.t.Errorf("RedisClientMock.SAdd got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSAdd.SAddMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.member != nil && !minimock.Equal(*mm_want_ptrs.member, mm_got.member) {
+				mmSAdd.t.Errorf("RedisClientMock.SAdd got unexpected parameter member, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSAdd.SAddMock.defaultExpectation.expectationOrigins.originMember, *mm_want_ptrs.member, mm_got.member, minimock.Diff(*mm_want_ptrs.member, mm_got.member))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSAdd.t.Errorf("RedisClientMock.SAdd got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSAdd.SAddMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSAdd.SAddMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSAdd.t.Fatal("No results are set for the RedisClientMock.SAdd")
+		}
+		return (*mm_results).err
+	}
+	if mmSAdd.funcSAdd != nil {
+		return mmSAdd.funcSAdd(ctx, key, member)
+	}
+	mmSAdd.t.Fatalf("Unexpected call to RedisClientMock.SAdd. %v %v %v", ctx, key, member)
+	return
+}
+
+// SAddAfterCounter returns a count of finished RedisClientMock.SAdd invocations
+func (mmSAdd *RedisClientMock) SAddAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSAdd.afterSAddCounter)
+}
+
+// SAddBeforeCounter returns a count of RedisClientMock.SAdd invocations
+func (mmSAdd *RedisClientMock) SAddBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSAdd.beforeSAddCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.SAdd.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSAdd *mRedisClientMockSAdd) Calls() []*RedisClientMockSAddParams {
+	mmSAdd.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockSAddParams, len(mmSAdd.callArgs))
+	copy(argCopy, mmSAdd.callArgs)
+
+	mmSAdd.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSAddDone returns true if the count of the SAdd invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockSAddDone() bool {
+	if m.SAddMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SAddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SAddMock.invocationsDone()
+}
+
+// MinimockSAddInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockSAddInspect() {
+	for _, e := range m.SAddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.SAdd at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSAddCounter := mm_atomic.LoadUint64(&m.afterSAddCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SAddMock.defaultExpectation != nil && afterSAddCounter < 1 {
+		if m.SAddMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.SAdd at\n%s", m.SAddMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.SAdd at\n%s with params: %#v", m.SAddMock.defaultExpectation.expectationOrigins.origin, *m.SAddMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSAdd != nil && afterSAddCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.SAdd at\n%s", m.funcSAddOrigin)
+	}
+
+	if !m.SAddMock.invocationsDone() && afterSAddCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.SAdd at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SAddMock.expectedInvocations), m.SAddMock.expectedInvocationsOrigin, afterSAddCounter)
+	}
+}
+
+type mRedisClientMockSMembers struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockSMembersExpectation
+	expectations       []*RedisClientMockSMembersExpectation
+
+	callArgs []*RedisClientMockSMembersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockSMembersExpectation specifies expectation struct of the RedisClient.SMembers
+type RedisClientMockSMembersExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockSMembersParams
+	paramPtrs          *RedisClientMockSMembersParamPtrs
+	expectationOrigins RedisClientMockSMembersExpectationOrigins
+	results            *RedisClientMockSMembersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockSMembersParams contains parameters of the RedisClient.SMembers
+type RedisClientMockSMembersParams struct {
+	ctx context.Context
+	key string
+}
+
+// RedisClientMockSMembersParamPtrs contains pointers to parameters of the RedisClient.SMembers
+type RedisClientMockSMembersParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// RedisClientMockSMembersResults contains results of the RedisClient.SMembers
+type RedisClientMockSMembersResults struct {
+	sa1 []string
+	err error
+}
+
+// RedisClientMockSMembersOrigins contains origins of expectations of the RedisClient.SMembers
+type RedisClientMockSMembersExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSMembers *mRedisClientMockSMembers) Optional() *mRedisClientMockSMembers {
+	mmSMembers.optional = true
+	return mmSMembers
+}
+
+// Expect sets up expected params for RedisClient.SMembers
+func (mmSMembers *mRedisClientMockSMembers) Expect(ctx context.Context, key string) *mRedisClientMockSMembers {
+	if mmSMembers.mock.funcSMembers != nil {
+		mmSMembers.mock.t.Fatalf("RedisClientMock.SMembers mock is already set by Set")
+	}
+
+	if mmSMembers.defaultExpectation == nil {
+		mmSMembers.defaultExpectation = &RedisClientMockSMembersExpectation{}
+	}
+
+	if mmSMembers.defaultExpectation.paramPtrs != nil {
+		mmSMembers.mock.t.Fatalf("RedisClientMock.SMembers mock is already set by ExpectParams functions")
+	}
+
+	mmSMembers.defaultExpectation.params = &RedisClientMockSMembersParams{ctx, key}
+	mmSMembers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSMembers.expectations {
+		if minimock.Equal(e.params, mmSMembers.defaultExpectation.params) {
+			mmSMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSMembers.defaultExpectation.params)
+		}
+	}
+
+	return mmSMembers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.SMembers
+func (mmSMembers *mRedisClientMockSMembers) ExpectCtxParam1(ctx context.Context) *mRedisClientMockSMembers {
+	if mmSMembers.mock.funcSMembers != nil {
+		mmSMembers.mock.t.Fatalf("RedisClientMock.SMembers mock is already set by Set")
+	}
+
+	if mmSMembers.defaultExpectation == nil {
+		mmSMembers.defaultExpectation = &RedisClientMockSMembersExpectation{}
+	}
+
+	if mmSMembers.defaultExpectation.params != nil {
+		mmSMembers.mock.t.Fatalf("RedisClientMock.SMembers mock is already set by Expect")
+	}
+
+	if mmSMembers.defaultExpectation.paramPtrs == nil {
+		mmSMembers.defaultExpectation.paramPtrs = &RedisClientMockSMembersParamPtrs{}
+	}
+	mmSMembers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSMembers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSMembers
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.SMembers
+func (mmSMembers *mRedisClientMockSMembers) ExpectKeyParam2(key string) *mRedisClientMockSMembers {
+	if mmSMembers.mock.funcSMembers != nil {
+		mmSMembers.mock.t.Fatalf("RedisClientMock.SMembers mock is already set by Set")
+	}
+
+	if mmSMembers.defaultExpectation == nil {
+		mmSMembers.defaultExpectation = &RedisClientMockSMembersExpectation{}
+	}
+
+	if mmSMembers.defaultExpectation.params != nil {
+		mmSMembers.mock.t.Fatalf("RedisClientMock.SMembers mock is already set by Expect")
+	}
+
+	if mmSMembers.defaultExpectation.paramPtrs == nil {
+		mmSMembers.defaultExpectation.paramPtrs = &RedisClientMockSMembersParamPtrs{}
+	}
+	mmSMembers.defaultExpectation.paramPtrs.key = &key
+	mmSMembers.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmSMembers
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.SMembers
+func (mmSMembers *mRedisClientMockSMembers) Inspect(f func(ctx context.Context, key string)) *mRedisClientMockSMembers {
+	if mmSMembers.mock.inspectFuncSMembers != nil {
+		mmSMembers.mock.t.Fatalf("Inspect function is already set for RedisClientMock.SMembers")
+	}
+
+	mmSMembers.mock.inspectFuncSMembers = f
+
+	return mmSMembers
+}
+
+// Return sets up results that will be returned by RedisClient.SMembers
+func (mmSMembers *mRedisClientMockSMembers) Return(sa1 []string, err error) *RedisClientMock {
+	if mmSMembers.mock.funcSMembers != nil {
+		mmSMembers.mock.t.Fatalf("RedisClientMock.SMembers mock is already set by Set")
+	}
+
+	if mmSMembers.defaultExpectation == nil {
+		mmSMembers.defaultExpectation = &RedisClientMockSMembersExpectation{mock: mmSMembers.mock}
+	}
+	mmSMembers.defaultExpectation.results = &RedisClientMockSMembersResults{sa1, err}
+	mmSMembers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSMembers.mock
+}
+
+// Set uses given function f to mock the RedisClient.SMembers method
+func (mmSMembers *mRedisClientMockSMembers) Set(f func(ctx context.Context, key string) (sa1 []string, err error)) *RedisClientMock {
+	if mmSMembers.defaultExpectation != nil {
+		mmSMembers.mock.t.Fatalf("Default expectation is already set for the RedisClient.SMembers method")
+	}
+
+	if len(mmSMembers.expectations) > 0 {
+		mmSMembers.mock.t.Fatalf("Some expectations are already set for the RedisClient.SMembers method")
+	}
+
+	mmSMembers.mock.funcSMembers = f
+	mmSMembers.mock.funcSMembersOrigin = minimock.CallerInfo(1)
+	return mmSMembers.mock
+}
+
+// When sets expectation for the RedisClient.SMembers which will trigger the result defined by the following
+// Then helper
+func (mmSMembers *mRedisClientMockSMembers) When(ctx context.Context, key string) *RedisClientMockSMembersExpectation {
+	if mmSMembers.mock.funcSMembers != nil {
+		mmSMembers.mock.t.Fatalf("RedisClientMock.SMembers mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockSMembersExpectation{
+		mock:               mmSMembers.mock,
+		params:             &RedisClientMockSMembersParams{ctx, key},
+		expectationOrigins: RedisClientMockSMembersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSMembers.expectations = append(mmSMembers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.SMembers return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockSMembersExpectation) Then(sa1 []string, err error) *RedisClientMock {
+	e.results = &RedisClientMockSMembersResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.SMembers should be invoked
+func (mmSMembers *mRedisClientMockSMembers) Times(n uint64) *mRedisClientMockSMembers {
+	if n == 0 {
+		mmSMembers.mock.t.Fatalf("Times of RedisClientMock.SMembers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSMembers.expectedInvocations, n)
+	mmSMembers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSMembers
+}
+
+func (mmSMembers *mRedisClientMockSMembers) invocationsDone() bool {
+	if len(mmSMembers.expectations) == 0 && mmSMembers.defaultExpectation == nil && mmSMembers.mock.funcSMembers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSMembers.mock.afterSMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSMembers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SMembers implements mm_cache.RedisClient
+func (mmSMembers *RedisClientMock) SMembers(ctx context.Context, key string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmSMembers.beforeSMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmSMembers.afterSMembersCounter, 1)
+
+	mmSMembers.t.Helper()
+
+	if mmSMembers.inspectFuncSMembers != nil {
+		mmSMembers.inspectFuncSMembers(ctx, key)
+	}
+
+	mm_params := RedisClientMockSMembersParams{ctx, key}
+
+	// Record call args
+	mmSMembers.SMembersMock.mutex.Lock()
+	mmSMembers.SMembersMock.callArgs = append(mmSMembers.SMembersMock.callArgs, &mm_params)
+	mmSMembers.SMembersMock.mutex.Unlock()
+
+	for _, e := range mmSMembers.SMembersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmSMembers.SMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSMembers.SMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmSMembers.SMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmSMembers.SMembersMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockSMembersParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSMembers.t.Errorf("RedisClientMock.SMembers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSMembers.SMembersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmSMembers.t.Errorf("RedisClientMock.SMembers got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSMembers.SMembersMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSMembers.t.Errorf("RedisClientMock.SMembers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSMembers.SMembersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSMembers.SMembersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSMembers.t.Fatal("No results are set for the RedisClientMock.SMembers")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmSMembers.funcSMembers != nil {
+		return mmSMembers.funcSMembers(ctx, key)
+	}
+	mmSMembers.t.Fatalf("Unexpected call to RedisClientMock.SMembers. %v %v", ctx, key)
+	return
+}
+
+// SMembersAfterCounter returns a count of finished RedisClientMock.SMembers invocations
+func (mmSMembers *RedisClientMock) SMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSMembers.afterSMembersCounter)
+}
+
+// SMembersBeforeCounter returns a count of RedisClientMock.SMembers invocations
+func (mmSMembers *RedisClientMock) SMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSMembers.beforeSMembersCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.SMembers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSMembers *mRedisClientMockSMembers) Calls() []*RedisClientMockSMembersParams {
+	mmSMembers.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockSMembersParams, len(mmSMembers.callArgs))
+	copy(argCopy, mmSMembers.callArgs)
+
+	mmSMembers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSMembersDone returns true if the count of the SMembers invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockSMembersDone() bool {
+	if m.SMembersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SMembersMock.invocationsDone()
+}
+
+// MinimockSMembersInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockSMembersInspect() {
+	for _, e := range m.SMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.SMembers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSMembersCounter := mm_atomic.LoadUint64(&m.afterSMembersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SMembersMock.defaultExpectation != nil && afterSMembersCounter < 1 {
+		if m.SMembersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.SMembers at\n%s", m.SMembersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.SMembers at\n%s with params: %#v", m.SMembersMock.defaultExpectation.expectationOrigins.origin, *m.SMembersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSMembers != nil && afterSMembersCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.SMembers at\n%s", m.funcSMembersOrigin)
+	}
+
+	if !m.SMembersMock.invocationsDone() && afterSMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.SMembers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SMembersMock.expectedInvocations), m.SMembersMock.expectedInvocationsOrigin, afterSMembersCounter)
+	}
+}
+
+type mRedisClientMockSRem struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockSRemExpectation
+	expectations       []*RedisClientMockSRemExpectation
+
+	callArgs []*RedisClientMockSRemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockSRemExpectation specifies expectation struct of the RedisClient.SRem
+type RedisClientMockSRemExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockSRemParams
+	paramPtrs          *RedisClientMockSRemParamPtrs
+	expectationOrigins RedisClientMockSRemExpectationOrigins
+	results            *RedisClientMockSRemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockSRemParams contains parameters of the RedisClient.SRem
+type RedisClientMockSRemParams struct {
+	ctx    context.Context
+	key    string
+	member interface{}
+}
+
+// RedisClientMockSRemParamPtrs contains pointers to parameters of the RedisClient.SRem
+type RedisClientMockSRemParamPtrs struct {
+	ctx    *context.Context
+	key    *string
+	member *interface{}
+}
+
+// RedisClientMockSRemResults contains results of the RedisClient.SRem
+type RedisClientMockSRemResults struct {
+	err error
+}
+
+// RedisClientMockSRemOrigins contains origins of expectations of the RedisClient.SRem
+type RedisClientMockSRemExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originKey    string
+	originMember string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSRem *mRedisClientMockSRem) Optional() *mRedisClientMockSRem {
+	mmSRem.optional = true
+	return mmSRem
+}
+
+// Expect sets up expected params for RedisClient.SRem
+func (mmSRem *mRedisClientMockSRem) Expect(ctx context.Context, key string, member interface{}) *mRedisClientMockSRem {
+	if mmSRem.mock.funcSRem != nil {
+		mmSRem.mock.t.Fatalf("RedisClientMock.SRem mock is already set by Set")
+	}
+
+	if mmSRem.defaultExpectation == nil {
+		mmSRem.defaultExpectation = &RedisClientMockSRemExpectation{}
+	}
+
+	if mmSRem.defaultExpectation.paramPtrs != nil {
+		mmSRem.mock.t.Fatalf("RedisClientMock.SRem mock is already set by ExpectParams functions")
+	}
+
+	mmSRem.defaultExpectation.params = &RedisClientMockSRemParams{ctx, key, member}
+	mmSRem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSRem.expectations {
+		if minimock.Equal(e.params, mmSRem.defaultExpectation.params) {
+			mmSRem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSRem.defaultExpectation.params)
+		}
+	}
+
+	return mmSRem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.SRem
+func (mmSRem *mRedisClientMockSRem) ExpectCtxParam1(ctx context.Context) *mRedisClientMockSRem {
+	if mmSRem.mock.funcSRem != nil {
+		mmSRem.mock.t.Fatalf("RedisClientMock.SRem mock is already set by Set")
+	}
+
+	if mmSRem.defaultExpectation == nil {
+		mmSRem.defaultExpectation = &RedisClientMockSRemExpectation{}
+	}
+
+	if mmSRem.defaultExpectation.params != nil {
+		mmSRem.mock.t.Fatalf("RedisClientMock.SRem mock is already set by Expect")
+	}
+
+	if mmSRem.defaultExpectation.paramPtrs == nil {
+		mmSRem.defaultExpectation.paramPtrs = &RedisClientMockSRemParamPtrs{}
+	}
+	mmSRem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSRem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSRem
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.SRem
+func (mmSRem *mRedisClientMockSRem) ExpectKeyParam2(key string) *mRedisClientMockSRem {
+	if mmSRem.mock.funcSRem != nil {
+		mmSRem.mock.t.Fatalf("RedisClientMock.SRem mock is already set by Set")
+	}
+
+	if mmSRem.defaultExpectation == nil {
+		mmSRem.defaultExpectation = &RedisClientMockSRemExpectation{}
+	}
+
+	if mmSRem.defaultExpectation.params != nil {
+		mmSRem.mock.t.Fatalf("RedisClientMock.SRem mock is already set by Expect")
+	}
+
+	if mmSRem.defaultExpectation.paramPtrs == nil {
+		mmSRem.defaultExpectation.paramPtrs = &RedisClientMockSRemParamPtrs{}
+	}
+	mmSRem.defaultExpectation.paramPtrs.key = &key
+	mmSRem.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmSRem
+}
+
+// ExpectMemberParam3 sets up expected param member for RedisClient.SRem
+func (mmSRem *mRedisClientMockSRem) ExpectMemberParam3(member interface{}) *mRedisClientMockSRem {
+	if mmSRem.mock.funcSRem != nil {
+		mmSRem.mock.t.Fatalf("RedisClientMock.SRem mock is already set by Set")
+	}
+
+	if mmSRem.defaultExpectation == nil {
+		mmSRem.defaultExpectation = &RedisClientMockSRemExpectation{}
+	}
+
+	if mmSRem.defaultExpectation.params != nil {
+		mmSRem.mock.t.Fatalf("RedisClientMock.SRem mock is already set by Expect")
+	}
+
+	if mmSRem.defaultExpectation.paramPtrs == nil {
+		mmSRem.defaultExpectation.paramPtrs = &RedisClientMockSRemParamPtrs{}
+	}
+	mmSRem.defaultExpectation.paramPtrs.member = &member
+	mmSRem.defaultExpectation.expectationOrigins.originMember = minimock.CallerInfo(1)
+
+	return mmSRem
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.SRem
+func (mmSRem *mRedisClientMockSRem) Inspect(f func(ctx context.Context, key string, member interface{})) *mRedisClientMockSRem {
+	if mmSRem.mock.inspectFuncSRem != nil {
+		mmSRem.mock.t.Fatalf("Inspect function is already set for RedisClientMock.SRem")
+	}
+
+	mmSRem.mock.inspectFuncSRem = f
+
+	return mmSRem
+}
+
+// Return sets up results that will be returned by RedisClient.SRem
+func (mmSRem *mRedisClientMockSRem) Return(err error) *RedisClientMock {
+	if mmSRem.mock.funcSRem != nil {
+		mmSRem.mock.t.Fatalf("RedisClientMock.SRem mock is already set by Set")
+	}
+
+	if mmSRem.defaultExpectation == nil {
+		mmSRem.defaultExpectation = &RedisClientMockSRemExpectation{mock: mmSRem.mock}
+	}
+	mmSRem.defaultExpectation.results = &RedisClientMockSRemResults{err}
+	mmSRem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSRem.mock
+}
+
+// Set uses given function f to mock the RedisClient.SRem method
+func (mmSRem *mRedisClientMockSRem) Set(f func(ctx context.Context, key string, member interface{}) (err error)) *RedisClientMock {
+	if mmSRem.defaultExpectation != nil {
+		mmSRem.mock.t.Fatalf("Default expectation is already set for the RedisClient.SRem method")
+	}
+
+	if len(mmSRem.expectations) > 0 {
+		mmSRem.mock.t.Fatalf("Some expectations are already set for the RedisClient.SRem method")
+	}
+
+	mmSRem.mock.funcSRem = f
+	mmSRem.mock.funcSRemOrigin = minimock.CallerInfo(1)
+	return mmSRem.mock
+}
+
+// When sets expectation for the RedisClient.SRem which will trigger the result defined by the following
+// Then helper
+func (mmSRem *mRedisClientMockSRem) When(ctx context.Context, key string, member interface{}) *RedisClientMockSRemExpectation {
+	if mmSRem.mock.funcSRem != nil {
+		mmSRem.mock.t.Fatalf("RedisClientMock.SRem mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockSRemExpectation{
+		mock:               mmSRem.mock,
+		params:             &RedisClientMockSRemParams{ctx, key, member},
+		expectationOrigins: RedisClientMockSRemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSRem.expectations = append(mmSRem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.SRem return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockSRemExpectation) Then(err error) *RedisClientMock {
+	e.results = &RedisClientMockSRemResults{err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.SRem should be invoked
+func (mmSRem *mRedisClientMockSRem) Times(n uint64) *mRedisClientMockSRem {
+	if n == 0 {
+		mmSRem.mock.t.Fatalf("Times of RedisClientMock.SRem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSRem.expectedInvocations, n)
+	mmSRem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSRem
+}
+
+func (mmSRem *mRedisClientMockSRem) invocationsDone() bool {
+	if len(mmSRem.expectations) == 0 && mmSRem.defaultExpectation == nil && mmSRem.mock.funcSRem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSRem.mock.afterSRemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSRem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SRem implements mm_cache.RedisClient
+func (mmSRem *RedisClientMock) SRem(ctx context.Context, key string, member interface{}) (err error) {
+	mm_atomic.AddUint64(&mmSRem.beforeSRemCounter, 1)
+	defer mm_atomic.AddUint64(&mmSRem.afterSRemCounter, 1)
+
+	mmSRem.t.Helper()
+
+	if mmSRem.inspectFuncSRem != nil {
+		mmSRem.inspectFuncSRem(ctx, key, member)
+	}
+
+	mm_params := RedisClientMockSRemParams{ctx, key, member}
+
+	// Record call args
+	mmSRem.SRemMock.mutex.Lock()
+	mmSRem.SRemMock.callArgs = append(mmSRem.SRemMock.callArgs, &mm_params)
+	mmSRem.SRemMock.mutex.Unlock()
+
+	for _, e := range mmSRem.SRemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSRem.SRemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSRem.SRemMock.defaultExpectation.Counter, 1)
+		mm_want := mmSRem.SRemMock.defaultExpectation.params
+		mm_want_ptrs := mmSRem.SRemMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockSRemParams{ctx, key, member}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSRem.t.Errorf("RedisClientMock.SRem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSRem.SRemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmSRem.t.Errorf("RedisClientMock.SRem got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSRem.SRemMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.member != nil && !minimock.Equal(*mm_want_ptrs.member, mm_got.member) {
+				mmSRem.t.Errorf("RedisClientMock.SRem got unexpected parameter member, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSRem.SRemMock.defaultExpectation.expectationOrigins.originMember, *mm_want_ptrs.member, mm_got.member, minimock.Diff(*mm_want_ptrs.member, mm_got.member))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSRem.t.Errorf("RedisClientMock.SRem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSRem.SRemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSRem.SRemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSRem.t.Fatal("No results are set for the RedisClientMock.SRem")
+		}
+		return (*mm_results).err
+	}
+	if mmSRem.funcSRem != nil {
+		return mmSRem.funcSRem(ctx, key, member)
+	}
+	mmSRem.t.Fatalf("Unexpected call to RedisClientMock.SRem. %v %v %v", ctx, key, member)
+	return
+}
+
+// SRemAfterCounter returns a count of finished RedisClientMock.SRem invocations
+func (mmSRem *RedisClientMock) SRemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSRem.afterSRemCounter)
+}
+
+// SRemBeforeCounter returns a count of RedisClientMock.SRem invocations
+func (mmSRem *RedisClientMock) SRemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSRem.beforeSRemCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.SRem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSRem *mRedisClientMockSRem) Calls() []*RedisClientMockSRemParams {
+	mmSRem.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockSRemParams, len(mmSRem.callArgs))
+	copy(argCopy, mmSRem.callArgs)
+
+	mmSRem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSRemDone returns true if the count of the SRem invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockSRemDone() bool {
+	if m.SRemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SRemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SRemMock.invocationsDone()
+}
+
+// MinimockSRemInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockSRemInspect() {
+	for _, e := range m.SRemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.SRem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSRemCounter := mm_atomic.LoadUint64(&m.afterSRemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SRemMock.defaultExpectation != nil && afterSRemCounter < 1 {
+		if m.SRemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.SRem at\n%s", m.SRemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.SRem at\n%s with params: %#v", m.SRemMock.defaultExpectation.expectationOrigins.origin, *m.SRemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSRem != nil && afterSRemCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.SRem at\n%s", m.funcSRemOrigin)
+	}
+
+	if !m.SRemMock.invocationsDone() && afterSRemCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.SRem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SRemMock.expectedInvocations), m.SRemMock.expectedInvocationsOrigin, afterSRemCounter)
+	}
+}
+
+type mRedisClientMockSet struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockSetExpectation
+	expectations       []*RedisClientMockSetExpectation
+
+	callArgs []*RedisClientMockSetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockSetExpectation specifies expectation struct of the RedisClient.Set
+type RedisClientMockSetExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockSetParams
+	paramPtrs          *RedisClientMockSetParamPtrs
+	expectationOrigins RedisClientMockSetExpectationOrigins
+	results            *RedisClientMockSetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockSetParams contains parameters of the RedisClient.Set
+type RedisClientMockSetParams struct {
+	ctx   context.Context
+	key   string
+	value interface{}
+}
+
+// RedisClientMockSetParamPtrs contains pointers to parameters of the RedisClient.Set
+type RedisClientMockSetParamPtrs struct {
+	ctx   *context.Context
+	key   *string
+	value *interface{}
+}
+
+// RedisClientMockSetResults contains results of the RedisClient.Set
+type RedisClientMockSetResults struct {
+	err error
+}
+
+// RedisClientMockSetOrigins contains origins of expectations of the RedisClient.Set
+type RedisClientMockSetExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originKey   string
+	originValue string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSet *mRedisClientMockSet) Optional() *mRedisClientMockSet {
+	mmSet.optional = true
+	return mmSet
+}
+
+// Expect sets up expected params for RedisClient.Set
+func (mmSet *mRedisClientMockSet) Expect(ctx context.Context, key string, value interface{}) *mRedisClientMockSet {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("RedisClientMock.Set mock is already set by Set")
+	}
+
+	if mmSet.defaultExpectation == nil {
+		mmSet.defaultExpectation = &RedisClientMockSetExpectation{}
+	}
+
+	if mmSet.defaultExpectation.paramPtrs != nil {
+		mmSet.mock.t.Fatalf("RedisClientMock.Set mock is already set by ExpectParams functions")
+	}
+
+	mmSet.defaultExpectation.params = &RedisClientMockSetParams{ctx, key, value}
+	mmSet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSet.expectations {
+		if minimock.Equal(e.params, mmSet.defaultExpectation.params) {
+			mmSet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSet.defaultExpectation.params)
+		}
+	}
+
+	return mmSet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.Set
+func (mmSet *mRedisClientMockSet) ExpectCtxParam1(ctx context.Context) *mRedisClientMockSet {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("RedisClientMock.Set mock is already set by Set")
+	}
+
+	if mmSet.defaultExpectation == nil {
+		mmSet.defaultExpectation = &RedisClientMockSetExpectation{}
+	}
+
+	if mmSet.defaultExpectation.params != nil {
+		mmSet.mock.t.Fatalf("RedisClientMock.Set mock is already set by Expect")
+	}
+
+	if mmSet.defaultExpectation.paramPtrs == nil {
+		mmSet.defaultExpectation.paramPtrs = &RedisClientMockSetParamPtrs{}
+	}
+	mmSet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSet
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.Set
+func (mmSet *mRedisClientMockSet) ExpectKeyParam2(key string) *mRedisClientMockSet {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("RedisClientMock.Set mock is already set by Set")
+	}
+
+	if mmSet.defaultExpectation == nil {
+		mmSet.defaultExpectation = &RedisClientMockSetExpectation{}
+	}
+
+	if mmSet.defaultExpectation.params != nil {
+		mmSet.mock.t.Fatalf("RedisClientMock.Set mock is already set by Expect")
+	}
+
+	if mmSet.defaultExpectation.paramPtrs == nil {
+		mmSet.defaultExpectation.paramPtrs = &RedisClientMockSetParamPtrs{}
+	}
+	mmSet.defaultExpectation.paramPtrs.key = &key
+	mmSet.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmSet
+}
+
+// ExpectValueParam3 sets up expected param value for RedisClient.Set
+func (mmSet *mRedisClientMockSet) ExpectValueParam3(value interface{}) *mRedisClientMockSet {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("RedisClientMock.Set mock is already set by Set")
+	}
+
+	if mmSet.defaultExpectation == nil {
+		mmSet.defaultExpectation = &RedisClientMockSetExpectation{}
+	}
+
+	if mmSet.defaultExpectation.params != nil {
+		mmSet.mock.t.Fatalf("RedisClientMock.Set mock is already set by Expect")
+	}
+
+	if mmSet.defaultExpectation.paramPtrs == nil {
+		mmSet.defaultExpectation.paramPtrs = &RedisClientMockSetParamPtrs{}
+	}
+	mmSet.defaultExpectation.paramPtrs.value = &value
+	mmSet.defaultExpectation.expectationOrigins.originValue = minimock.CallerInfo(1)
+
+	return mmSet
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.Set
+func (mmSet *mRedisClientMockSet) Inspect(f func(ctx context.Context, key string, value interface{})) *mRedisClientMockSet {
+	if mmSet.mock.inspectFuncSet != nil {
+		mmSet.mock.t.Fatalf("Inspect function is already set for RedisClientMock.Set")
+	}
+
+	mmSet.mock.inspectFuncSet = f
+
+	return mmSet
+}
+
+// Return sets up results that will be returned by RedisClient.Set
+func (mmSet *mRedisClientMockSet) Return(err error) *RedisClientMock {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("RedisClientMock.Set mock is already set by Set")
+	}
+
+	if mmSet.defaultExpectation == nil {
+		mmSet.defaultExpectation = &RedisClientMockSetExpectation{mock: mmSet.mock}
+	}
+	mmSet.defaultExpectation.results = &RedisClientMockSetResults{err}
+	mmSet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSet.mock
+}
+
+// Set uses given function f to mock the RedisClient.Set method
+func (mmSet *mRedisClientMockSet) Set(f func(ctx context.Context, key string, value interface{}) (err error)) *RedisClientMock {
+	if mmSet.defaultExpectation != nil {
+		mmSet.mock.t.Fatalf("Default expectation is already set for the RedisClient.Set method")
+	}
+
+	if len(mmSet.expectations) > 0 {
+		mmSet.mock.t.Fatalf("Some expectations are already set for the RedisClient.Set method")
+	}
+
+	mmSet.mock.funcSet = f
+	mmSet.mock.funcSetOrigin = minimock.CallerInfo(1)
+	return mmSet.mock
+}
+
+// When sets expectation for the RedisClient.Set which will trigger the result defined by the following
+// Then helper
+func (mmSet *mRedisClientMockSet) When(ctx context.Context, key string, value interface{}) *RedisClientMockSetExpectation {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("RedisClientMock.Set mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockSetExpectation{
+		mock:               mmSet.mock,
+		params:             &RedisClientMockSetParams{ctx, key, value},
+		expectationOrigins: RedisClientMockSetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSet.expectations = append(mmSet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.Set return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockSetExpectation) Then(err error) *RedisClientMock {
+	e.results = &RedisClientMockSetResults{err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.Set should be invoked
+func (mmSet *mRedisClientMockSet) Times(n uint64) *mRedisClientMockSet {
+	if n == 0 {
+		mmSet.mock.t.Fatalf("Times of RedisClientMock.Set mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSet.expectedInvocations, n)
+	mmSet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSet
+}
+
+func (mmSet *mRedisClientMockSet) invocationsDone() bool {
+	if len(mmSet.expectations) == 0 && mmSet.defaultExpectation == nil && mmSet.mock.funcSet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSet.mock.afterSetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Set implements mm_cache.RedisClient
+func (mmSet *RedisClientMock) Set(ctx context.Context, key string, value interface{}) (err error) {
+	mm_atomic.AddUint64(&mmSet.beforeSetCounter, 1)
+	defer mm_atomic.AddUint64(&mmSet.afterSetCounter, 1)
+
+	mmSet.t.Helper()
+
+	if mmSet.inspectFuncSet != nil {
+		mmSet.inspectFuncSet(ctx, key, value)
+	}
+
+	mm_params := RedisClientMockSetParams{ctx, key, value}
+
+	// Record call args
+	mmSet.SetMock.mutex.Lock()
+	mmSet.SetMock.callArgs = append(mmSet.SetMock.callArgs, &mm_params)
+	mmSet.SetMock.mutex.Unlock()
+
+	for _, e := range mmSet.SetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSet.SetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSet.SetMock.defaultExpectation.Counter, 1)
+		mm_want := mmSet.SetMock.defaultExpectation.params
+		mm_want_ptrs := mmSet.SetMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockSetParams{ctx, key, value}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSet.t.Errorf("RedisClientMock.Set got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSet.SetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmSet.t.Errorf("RedisClientMock.Set got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSet.SetMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.value != nil && !minimock.Equal(*mm_want_ptrs.value, mm_got.value) {
+				mmSet.t.Errorf("RedisClientMock.Set got unexpected parameter value, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSet.SetMock.defaultExpectation.expectationOrigins.originValue, *mm_want_ptrs.value, mm_got.value, minimock.Diff(*mm_want_ptrs.value, mm_got.value))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSet.t.Errorf("RedisClientMock.Set got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSet.SetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSet.SetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSet.t.Fatal("No results are set for the RedisClientMock.Set")
+		}
+		return (*mm_results).err
+	}
+	if mmSet.funcSet != nil {
+		return mmSet.funcSet(ctx, key, value)
+	}
+	mmSet.t.Fatalf("Unexpected call to RedisClientMock.Set. %v %v %v", ctx, key, value)
+	return
+}
+
+// SetAfterCounter returns a count of finished RedisClientMock.Set invocations
+func (mmSet *RedisClientMock) SetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSet.afterSetCounter)
+}
+
+// SetBeforeCounter returns a count of RedisClientMock.Set invocations
+func (mmSet *RedisClientMock) SetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSet.beforeSetCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.Set.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSet *mRedisClientMockSet) Calls() []*RedisClientMockSetParams {
+	mmSet.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockSetParams, len(mmSet.callArgs))
+	copy(argCopy, mmSet.callArgs)
+
+	mmSet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetDone returns true if the count of the Set invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockSetDone() bool {
+	if m.SetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetMock.invocationsDone()
+}
+
+// MinimockSetInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockSetInspect() {
+	for _, e := range m.SetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.Set at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetCounter := mm_atomic.LoadUint64(&m.afterSetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetMock.defaultExpectation != nil && afterSetCounter < 1 {
+		if m.SetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.Set at\n%s", m.SetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.Set at\n%s with params: %#v", m.SetMock.defaultExpectation.expectationOrigins.origin, *m.SetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSet != nil && afterSetCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.Set at\n%s", m.funcSetOrigin)
+	}
+
+	if !m.SetMock.invocationsDone() && afterSetCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.Set at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetMock.expectedInvocations), m.SetMock.expectedInvocationsOrigin, afterSetCounter)
+	}
+}
+
+type mRedisClientMockSetEx struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockSetExExpectation
+	expectations       []*RedisClientMockSetExExpectation
+
+	callArgs []*RedisClientMockSetExParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockSetExExpectation specifies expectation struct of the RedisClient.SetEx
+type RedisClientMockSetExExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockSetExParams
+	paramPtrs          *RedisClientMockSetExParamPtrs
+	expectationOrigins RedisClientMockSetExExpectationOrigins
+	results            *RedisClientMockSetExResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockSetExParams contains parameters of the RedisClient.SetEx
+type RedisClientMockSetExParams struct {
+	ctx        context.Context
+	key        string
+	value      interface{}
+	expiration time.Duration
+}
+
+// RedisClientMockSetExParamPtrs contains pointers to parameters of the RedisClient.SetEx
+type RedisClientMockSetExParamPtrs struct {
+	ctx        *context.Context
+	key        *string
+	value      *interface{}
+	expiration *time.Duration
+}
+
+// RedisClientMockSetExResults contains results of the RedisClient.SetEx
+type RedisClientMockSetExResults struct {
+	err error
+}
+
+// RedisClientMockSetExOrigins contains origins of expectations of the RedisClient.SetEx
+type RedisClientMockSetExExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originKey        string
+	originValue      string
+	originExpiration string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetEx *mRedisClientMockSetEx) Optional() *mRedisClientMockSetEx {
+	mmSetEx.optional = true
+	return mmSetEx
+}
+
+// Expect sets up expected params for RedisClient.SetEx
+func (mmSetEx *mRedisClientMockSetEx) Expect(ctx context.Context, key string, value interface{}, expiration time.Duration) *mRedisClientMockSetEx {
+	if mmSetEx.mock.funcSetEx != nil {
+		mmSetEx.mock.t.Fatalf("RedisClientMock.SetEx mock is already set by Set")
+	}
+
+	if mmSetEx.defaultExpectation == nil {
+		mmSetEx.defaultExpectation = &RedisClientMockSetExExpectation{}
+	}
+
+	if mmSetEx.defaultExpectation.paramPtrs != nil {
+		mmSetEx.mock.t.Fatalf("RedisClientMock.SetEx mock is already set by ExpectParams functions")
+	}
+
+	mmSetEx.defaultExpectation.params = &RedisClientMockSetExParams{ctx, key, value, expiration}
+	mmSetEx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetEx.expectations {
+		if minimock.Equal(e.params, mmSetEx.defaultExpectation.params) {
+			mmSetEx.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetEx.defaultExpectation.params)
+		}
+	}
+
+	return mmSetEx
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.SetEx
+func (mmSetEx *mRedisClientMockSetEx) ExpectCtxParam1(ctx context.Context) *mRedisClientMockSetEx {
+	if mmSetEx.mock.funcSetEx != nil {
+		mmSetEx.mock.t.Fatalf("RedisClientMock.SetEx mock is already set by Set")
+	}
+
+	if mmSetEx.defaultExpectation == nil {
+		mmSetEx.defaultExpectation = &RedisClientMockSetExExpectation{}
+	}
+
+	if mmSetEx.defaultExpectation.params != nil {
+		mmSetEx.mock.t.Fatalf("RedisClientMock.SetEx mock is already set by Expect")
+	}
+
+	if mmSetEx.defaultExpectation.paramPtrs == nil {
+		mmSetEx.defaultExpectation.paramPtrs = &RedisClientMockSetExParamPtrs{}
+	}
+	mmSetEx.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetEx.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetEx
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.SetEx
+func (mmSetEx *mRedisClientMockSetEx) ExpectKeyParam2(key string) *mRedisClientMockSetEx {
+	if mmSetEx.mock.funcSetEx != nil {
+		mmSetEx.mock.t.Fatalf("RedisClientMock.SetEx mock is already set by Set")
+	}
+
+	if mmSetEx.defaultExpectation == nil {
+		mmSetEx.defaultExpectation = &RedisClientMockSetExExpectation{}
+	}
+
+	if mmSetEx.defaultExpectation.params != nil {
+		mmSetEx.mock.t.Fatalf("RedisClientMock.SetEx mock is already set by Expect")
+	}
+
+	if mmSetEx.defaultExpectation.paramPtrs == nil {
+		mmSetEx.defaultExpectation.paramPtrs = &RedisClientMockSetExParamPtrs{}
+	}
+	mmSetEx.defaultExpectation.paramPtrs.key = &key
+	mmSetEx.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmSetEx
+}
+
+// ExpectValueParam3 sets up expected param value for RedisClient.SetEx
+func (mmSetEx *mRedisClientMockSetEx) ExpectValueParam3(value interface{}) *mRedisClientMockSetEx {
+	if mmSetEx.mock.funcSetEx != nil {
+		mmSetEx.mock.t.Fatalf("RedisClientMock.SetEx mock is already set by Set")
+	}
+
+	if mmSetEx.defaultExpectation == nil {
+		mmSetEx.defaultExpectation = &RedisClientMockSetExExpectation{}
+	}
+
+	if mmSetEx.defaultExpectation.params != nil {
+		mmSetEx.mock.t.Fatalf("RedisClientMock.SetEx mock is already set by Expect")
+	}
+
+	if mmSetEx.defaultExpectation.paramPtrs == nil {
+		mmSetEx.defaultExpectation.paramPtrs = &RedisClientMockSetExParamPtrs{}
+	}
+	mmSetEx.defaultExpectation.paramPtrs.value = &value
+	mmSetEx.defaultExpectation.expectationOrigins.originValue = minimock.CallerInfo(1)
+
+	return mmSetEx
+}
+
+// ExpectExpirationParam4 sets up expected param expiration for RedisClient.SetEx
+func (mmSetEx *mRedisClientMockSetEx) ExpectExpirationParam4(expiration time.Duration) *mRedisClientMockSetEx {
+	if mmSetEx.mock.funcSetEx != nil {
+		mmSetEx.mock.t.Fatalf("RedisClientMock.SetEx mock is already set by Set")
+	}
+
+	if mmSetEx.defaultExpectation == nil {
+		mmSetEx.defaultExpectation = &RedisClientMockSetExExpectation{}
+	}
+
+	if mmSetEx.defaultExpectation.params != nil {
+		mmSetEx.mock.t.Fatalf("RedisClientMock.SetEx mock is already set by Expect")
+	}
+
+	if mmSetEx.defaultExpectation.paramPtrs == nil {
+		mmSetEx.defaultExpectation.paramPtrs = &RedisClientMockSetExParamPtrs{}
+	}
+	mmSetEx.defaultExpectation.paramPtrs.expiration = &expiration
+	mmSetEx.defaultExpectation.expectationOrigins.originExpiration = minimock.CallerInfo(1)
+
+	return mmSetEx
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.SetEx
+func (mmSetEx *mRedisClientMockSetEx) Inspect(f func(ctx context.Context, key string, value interface{}, expiration time.Duration)) *mRedisClientMockSetEx {
+	if mmSetEx.mock.inspectFuncSetEx != nil {
+		mmSetEx.mock.t.Fatalf("Inspect function is already set for RedisClientMock.SetEx")
+	}
+
+	mmSetEx.mock.inspectFuncSetEx = f
+
+	return mmSetEx
+}
+
+// Return sets up results that will be returned by RedisClient.SetEx
+func (mmSetEx *mRedisClientMockSetEx) Return(err error) *RedisClientMock {
+	if mmSetEx.mock.funcSetEx != nil {
+		mmSetEx.mock.t.Fatalf("RedisClientMock.SetEx mock is already set by Set")
+	}
+
+	if mmSetEx.defaultExpectation == nil {
+		mmSetEx.defaultExpectation = &RedisClientMockSetExExpectation{mock: mmSetEx.mock}
+	}
+	mmSetEx.defaultExpectation.results = &RedisClientMockSetExResults{err}
+	mmSetEx.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetEx.mock
+}
+
+// Set uses given function f to mock the RedisClient.SetEx method
+func (mmSetEx *mRedisClientMockSetEx) Set(f func(ctx context.Context, key string, value interface{}, expiration time.Duration) (err error)) *RedisClientMock {
+	if mmSetEx.defaultExpectation != nil {
+		mmSetEx.mock.t.Fatalf("Default expectation is already set for the RedisClient.SetEx method")
+	}
+
+	if len(mmSetEx.expectations) > 0 {
+		mmSetEx.mock.t.Fatalf("Some expectations are already set for the RedisClient.SetEx method")
+	}
+
+	mmSetEx.mock.funcSetEx = f
+	mmSetEx.mock.funcSetExOrigin = minimock.CallerInfo(1)
+	return mmSetEx.mock
+}
+
+// When sets expectation for the RedisClient.SetEx which will trigger the result defined by the following
+// Then helper
+func (mmSetEx *mRedisClientMockSetEx) When(ctx context.Context, key string, value interface{}, expiration time.Duration) *RedisClientMockSetExExpectation {
+	if mmSetEx.mock.funcSetEx != nil {
+		mmSetEx.mock.t.Fatalf("RedisClientMock.SetEx mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockSetExExpectation{
+		mock:               mmSetEx.mock,
+		params:             &RedisClientMockSetExParams{ctx, key, value, expiration},
+		expectationOrigins: RedisClientMockSetExExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetEx.expectations = append(mmSetEx.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.SetEx return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockSetExExpectation) Then(err error) *RedisClientMock {
+	e.results = &RedisClientMockSetExResults{err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.SetEx should be invoked
+func (mmSetEx *mRedisClientMockSetEx) Times(n uint64) *mRedisClientMockSetEx {
+	if n == 0 {
+		mmSetEx.mock.t.Fatalf("Times of RedisClientMock.SetEx mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetEx.expectedInvocations, n)
+	mmSetEx.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetEx
+}
+
+func (mmSetEx *mRedisClientMockSetEx) invocationsDone() bool {
+	if len(mmSetEx.expectations) == 0 && mmSetEx.defaultExpectation == nil && mmSetEx.mock.funcSetEx == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetEx.mock.afterSetExCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetEx.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetEx implements mm_cache.RedisClient
+func (mmSetEx *RedisClientMock) SetEx(ctx context.Context, key string, value interface{}, expiration time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmSetEx.beforeSetExCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetEx.afterSetExCounter, 1)
+
+	mmSetEx.t.Helper()
+
+	if mmSetEx.inspectFuncSetEx != nil {
+		mmSetEx.inspectFuncSetEx(ctx, key, value, expiration)
+	}
+
+	mm_params := RedisClientMockSetExParams{ctx, key, value, expiration}
+
+	// Record call args
+	mmSetEx.SetExMock.mutex.Lock()
+	mmSetEx.SetExMock.callArgs = append(mmSetEx.SetExMock.callArgs, &mm_params)
+	mmSetEx.SetExMock.mutex.Unlock()
+
+	for _, e := range mmSetEx.SetExMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetEx.SetExMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetEx.SetExMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetEx.SetExMock.defaultExpectation.params
+		mm_want_ptrs := mmSetEx.SetExMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockSetExParams{ctx, key, value, expiration}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetEx.t.Errorf("RedisClientMock.SetEx got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetEx.SetExMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmSetEx.t.Errorf("RedisClientMock.SetEx got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetEx.SetExMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.value != nil && !minimock.Equal(*mm_want_ptrs.value, mm_got.value) {
+				mmSetEx.t.Errorf("RedisClientMock.SetEx got unexpected parameter value, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetEx.SetExMock.defaultExpectation.expectationOrigins.originValue, *mm_want_ptrs.value, mm_got.value, minimock.Diff(*mm_want_ptrs.value, mm_got.value))
+			}
+
+			if mm_want_ptrs.expiration != nil && !minimock.Equal(*mm_want_ptrs.expiration, mm_got.expiration) {
+				mmSetEx.t.Errorf("RedisClientMock.SetEx got unexpected parameter expiration, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetEx.SetExMock.defaultExpectation.expectationOrigins.originExpiration, *mm_want_ptrs.expiration, mm_got.expiration, minimock.Diff(*mm_want_ptrs.expiration, mm_got.expiration))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetEx.t.Errorf("RedisClientMock.SetEx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetEx.SetExMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetEx.SetExMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetEx.t.Fatal("No results are set for the RedisClientMock.SetEx")
+		}
+		return (*mm_results).err
+	}
+	if mmSetEx.funcSetEx != nil {
+		return mmSetEx.funcSetEx(ctx, key, value, expiration)
+	}
+	mmSetEx.t.Fatalf("Unexpected call to RedisClientMock.SetEx. %v %v %v %v", ctx, key, value, expiration)
+	return
+}
+
+// SetExAfterCounter returns a count of finished RedisClientMock.SetEx invocations
+func (mmSetEx *RedisClientMock) SetExAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetEx.afterSetExCounter)
+}
+
+// SetExBeforeCounter returns a count of RedisClientMock.SetEx invocations
+func (mmSetEx *RedisClientMock) SetExBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetEx.beforeSetExCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.SetEx.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetEx *mRedisClientMockSetEx) Calls() []*RedisClientMockSetExParams {
+	mmSetEx.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockSetExParams, len(mmSetEx.callArgs))
+	copy(argCopy, mmSetEx.callArgs)
+
+	mmSetEx.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetExDone returns true if the count of the SetEx invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockSetExDone() bool {
+	if m.SetExMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetExMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetExMock.invocationsDone()
+}
+
+// MinimockSetExInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockSetExInspect() {
+	for _, e := range m.SetExMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.SetEx at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetExCounter := mm_atomic.LoadUint64(&m.afterSetExCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetExMock.defaultExpectation != nil && afterSetExCounter < 1 {
+		if m.SetExMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.SetEx at\n%s", m.SetExMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.SetEx at\n%s with params: %#v", m.SetExMock.defaultExpectation.expectationOrigins.origin, *m.SetExMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetEx != nil && afterSetExCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.SetEx at\n%s", m.funcSetExOrigin)
+	}
+
+	if !m.SetExMock.invocationsDone() && afterSetExCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.SetEx at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetExMock.expectedInvocations), m.SetExMock.expectedInvocationsOrigin, afterSetExCounter)
+	}
+}
+
+type mRedisClientMockZAdd struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockZAddExpectation
+	expectations       []*RedisClientMockZAddExpectation
+
+	callArgs []*RedisClientMockZAddParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockZAddExpectation specifies expectation struct of the RedisClient.ZAdd
+type RedisClientMockZAddExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockZAddParams
+	paramPtrs          *RedisClientMockZAddParamPtrs
+	expectationOrigins RedisClientMockZAddExpectationOrigins
+	results            *RedisClientMockZAddResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockZAddParams contains parameters of the RedisClient.ZAdd
+type RedisClientMockZAddParams struct {
+	ctx    context.Context
+	key    string
+	score  int64
+	member interface{}
+}
+
+// RedisClientMockZAddParamPtrs contains pointers to parameters of the RedisClient.ZAdd
+type RedisClientMockZAddParamPtrs struct {
+	ctx    *context.Context
+	key    *string
+	score  *int64
+	member *interface{}
+}
+
+// RedisClientMockZAddResults contains results of the RedisClient.ZAdd
+type RedisClientMockZAddResults struct {
+	err error
+}
+
+// RedisClientMockZAddOrigins contains origins of expectations of the RedisClient.ZAdd
+type RedisClientMockZAddExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originKey    string
+	originScore  string
+	originMember string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmZAdd *mRedisClientMockZAdd) Optional() *mRedisClientMockZAdd {
+	mmZAdd.optional = true
+	return mmZAdd
+}
+
+// Expect sets up expected params for RedisClient.ZAdd
+func (mmZAdd *mRedisClientMockZAdd) Expect(ctx context.Context, key string, score int64, member interface{}) *mRedisClientMockZAdd {
+	if mmZAdd.mock.funcZAdd != nil {
+		mmZAdd.mock.t.Fatalf("RedisClientMock.ZAdd mock is already set by Set")
+	}
+
+	if mmZAdd.defaultExpectation == nil {
+		mmZAdd.defaultExpectation = &RedisClientMockZAddExpectation{}
+	}
+
+	if mmZAdd.defaultExpectation.paramPtrs != nil {
+		mmZAdd.mock.t.Fatalf("RedisClientMock.ZAdd mock is already set by ExpectParams functions")
+	}
+
+	mmZAdd.defaultExpectation.params = &RedisClientMockZAddParams{ctx, key, score, member}
+	mmZAdd.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmZAdd.expectations {
+		if minimock.Equal(e.params, mmZAdd.defaultExpectation.params) {
+			mmZAdd.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmZAdd.defaultExpectation.params)
+		}
+	}
+
+	return mmZAdd
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.ZAdd
+func (mmZAdd *mRedisClientMockZAdd) ExpectCtxParam1(ctx context.Context) *mRedisClientMockZAdd {
+	if mmZAdd.mock.funcZAdd != nil {
+		mmZAdd.mock.t.Fatalf("RedisClientMock.ZAdd mock is already set by Set")
+	}
+
+	if mmZAdd.defaultExpectation == nil {
+		mmZAdd.defaultExpectation = &RedisClientMockZAddExpectation{}
+	}
+
+	if mmZAdd.defaultExpectation.params != nil {
+		mmZAdd.mock.t.Fatalf("RedisClientMock.ZAdd mock is already set by Expect")
+	}
+
+	if mmZAdd.defaultExpectation.paramPtrs == nil {
+		mmZAdd.defaultExpectation.paramPtrs = &RedisClientMockZAddParamPtrs{}
+	}
+	mmZAdd.defaultExpectation.paramPtrs.ctx = &ctx
+	mmZAdd.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmZAdd
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.ZAdd
+func (mmZAdd *mRedisClientMockZAdd) ExpectKeyParam2(key string) *mRedisClientMockZAdd {
+	if mmZAdd.mock.funcZAdd != nil {
+		mmZAdd.mock.t.Fatalf("RedisClientMock.ZAdd mock is already set by Set")
+	}
+
+	if mmZAdd.defaultExpectation == nil {
+		mmZAdd.defaultExpectation = &RedisClientMockZAddExpectation{}
+	}
+
+	if mmZAdd.defaultExpectation.params != nil {
+		mmZAdd.mock.t.Fatalf("RedisClientMock.ZAdd mock is already set by Expect")
+	}
+
+	if mmZAdd.defaultExpectation.paramPtrs == nil {
+		mmZAdd.defaultExpectation.paramPtrs = &RedisClientMockZAddParamPtrs{}
+	}
+	mmZAdd.defaultExpectation.paramPtrs.key = &key
+	mmZAdd.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmZAdd
+}
+
+// ExpectScoreParam3 sets up expected param score for RedisClient.ZAdd
+func (mmZAdd *mRedisClientMockZAdd) ExpectScoreParam3(score int64) *mRedisClientMockZAdd {
+	if mmZAdd.mock.funcZAdd != nil {
+		mmZAdd.mock.t.Fatalf("RedisClientMock.ZAdd mock is already set by Set")
+	}
+
+	if mmZAdd.defaultExpectation == nil {
+		mmZAdd.defaultExpectation = &RedisClientMockZAddExpectation{}
+	}
+
+	if mmZAdd.defaultExpectation.params != nil {
+		mmZAdd.mock.t.Fatalf("RedisClientMock.ZAdd mock is already set by Expect")
+	}
+
+	if mmZAdd.defaultExpectation.paramPtrs == nil {
+		mmZAdd.defaultExpectation.paramPtrs = &RedisClientMockZAddParamPtrs{}
+	}
+	mmZAdd.defaultExpectation.paramPtrs.score = &score
+	mmZAdd.defaultExpectation.expectationOrigins.originScore = minimock.CallerInfo(1)
+
+	return mmZAdd
+}
+
+// ExpectMemberParam4 sets up expected param member for RedisClient.ZAdd
+func (mmZAdd *mRedisClientMockZAdd) ExpectMemberParam4(member interface{}) *mRedisClientMockZAdd {
+	if mmZAdd.mock.funcZAdd != nil {
+		mmZAdd.mock.t.Fatalf("RedisClientMock.ZAdd mock is already set by Set")
+	}
+
+	if mmZAdd.defaultExpectation == nil {
+		mmZAdd.defaultExpectation = &RedisClientMockZAddExpectation{}
+	}
+
+	if mmZAdd.defaultExpectation.params != nil {
+		mmZAdd.mock.t.Fatalf("RedisClientMock.ZAdd mock is already set by Expect")
+	}
+
+	if mmZAdd.defaultExpectation.paramPtrs == nil {
+		mmZAdd.defaultExpectation.paramPtrs = &RedisClientMockZAddParamPtrs{}
+	}
+	mmZAdd.defaultExpectation.paramPtrs.member = &member
+	mmZAdd.defaultExpectation.expectationOrigins.originMember = minimock.CallerInfo(1)
+
+	return mmZAdd
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.ZAdd
+func (mmZAdd *mRedisClientMockZAdd) Inspect(f func(ctx context.Context, key string, score int64, member interface{})) *mRedisClientMockZAdd {
+	if mmZAdd.mock.inspectFuncZAdd != nil {
+		mmZAdd.mock.t.Fatalf("Inspect function is already set for RedisClientMock.ZAdd")
+	}
+
+	mmZAdd.mock.inspectFuncZAdd = f
+
+	return mmZAdd
+}
+
+// Return sets up results that will be returned by RedisClient.ZAdd
+func (mmZAdd *mRedisClientMockZAdd) Return(err error) *RedisClientMock {
+	if mmZAdd.mock.funcZAdd != nil {
+		mmZAdd.mock.t.Fatalf("RedisClientMock.ZAdd mock is already set by Set")
+	}
+
+	if mmZAdd.defaultExpectation == nil {
+		mmZAdd.defaultExpectation = &RedisClientMockZAddExpectation{mock: mmZAdd.mock}
+	}
+	mmZAdd.defaultExpectation.results = &RedisClientMockZAddResults{err}
+	mmZAdd.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmZAdd.mock
+}
+
+// Set uses given function f to mock the RedisClient.ZAdd method
+func (mmZAdd *mRedisClientMockZAdd) Set(f func(ctx context.Context, key string, score int64, member interface{}) (err error)) *RedisClientMock {
+	if mmZAdd.defaultExpectation != nil {
+		mmZAdd.mock.t.Fatalf("Default expectation is already set for the RedisClient.ZAdd method")
+	}
+
+	if len(mmZAdd.expectations) > 0 {
+		mmZAdd.mock.t.Fatalf("Some expectations are already set for the RedisClient.ZAdd method")
+	}
+
+	mmZAdd.mock.funcZAdd = f
+	mmZAdd.mock.funcZAddOrigin = minimock.CallerInfo(1)
+	return mmZAdd.mock
+}
+
+// When sets expectation for the RedisClient.ZAdd which will trigger the result defined by the following
+// Then helper
+func (mmZAdd *mRedisClientMockZAdd) When(ctx context.Context, key string, score int64, member interface{}) *RedisClientMockZAddExpectation {
+	if mmZAdd.mock.funcZAdd != nil {
+		mmZAdd.mock.t.Fatalf("RedisClientMock.ZAdd mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockZAddExpectation{
+		mock:               mmZAdd.mock,
+		params:             &RedisClientMockZAddParams{ctx, key, score, member},
+		expectationOrigins: RedisClientMockZAddExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmZAdd.expectations = append(mmZAdd.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.ZAdd return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockZAddExpectation) Then(err error) *RedisClientMock {
+	e.results = &RedisClientMockZAddResults{err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.ZAdd should be invoked
+func (mmZAdd *mRedisClientMockZAdd) Times(n uint64) *mRedisClientMockZAdd {
+	if n == 0 {
+		mmZAdd.mock.t.Fatalf("Times of RedisClientMock.ZAdd mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmZAdd.expectedInvocations, n)
+	mmZAdd.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmZAdd
+}
+
+func (mmZAdd *mRedisClientMockZAdd) invocationsDone() bool {
+	if len(mmZAdd.expectations) == 0 && mmZAdd.defaultExpectation == nil && mmZAdd.mock.funcZAdd == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmZAdd.mock.afterZAddCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmZAdd.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ZAdd implements mm_cache.RedisClient
+func (mmZAdd *RedisClientMock) ZAdd(ctx context.Context, key string, score int64, member interface{}) (err error) {
+	mm_atomic.AddUint64(&mmZAdd.beforeZAddCounter, 1)
+	defer mm_atomic.AddUint64(&mmZAdd.afterZAddCounter, 1)
+
+	mmZAdd.t.Helper()
+
+	if mmZAdd.inspectFuncZAdd != nil {
+		mmZAdd.inspectFuncZAdd(ctx, key, score, member)
+	}
+
+	mm_params := RedisClientMockZAddParams{ctx, key, score, member}
+
+	// Record call args
+	mmZAdd.ZAddMock.mutex.Lock()
+	mmZAdd.ZAddMock.callArgs = append(mmZAdd.ZAddMock.callArgs, &mm_params)
+	mmZAdd.ZAddMock.mutex.Unlock()
+
+	for _, e := range mmZAdd.ZAddMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmZAdd.ZAddMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmZAdd.ZAddMock.defaultExpectation.Counter, 1)
+		mm_want := mmZAdd.ZAddMock.defaultExpectation.params
+		mm_want_ptrs := mmZAdd.ZAddMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockZAddParams{ctx, key, score, member}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmZAdd.t.Errorf("RedisClientMock.ZAdd got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZAdd.ZAddMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmZAdd.t.Errorf("RedisClientMock.ZAdd got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZAdd.ZAddMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.score != nil && !minimock.Equal(*mm_want_ptrs.score, mm_got.score) {
+				mmZAdd.t.Errorf("RedisClientMock.ZAdd got unexpected parameter score, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZAdd.ZAddMock.defaultExpectation.expectationOrigins.originScore, *mm_want_ptrs.score, mm_got.score, minimock.Diff(*mm_want_ptrs.score, mm_got.score))
+			}
+
+			if mm_want_ptrs.member != nil && !minimock.Equal(*mm_want_ptrs.member, mm_got.member) {
+				mmZAdd.t.Errorf("RedisClientMock.ZAdd got unexpected parameter member, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZAdd.ZAddMock.defaultExpectation.expectationOrigins.originMember, *mm_want_ptrs.member, mm_got.member, minimock.Diff(*mm_want_ptrs.member, mm_got.member))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmZAdd.t.Errorf("RedisClientMock.ZAdd got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmZAdd.ZAddMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmZAdd.ZAddMock.defaultExpectation.results
+		if mm_results == nil {
+			mmZAdd.t.Fatal("No results are set for the RedisClientMock.ZAdd")
+		}
+		return (*mm_results).err
+	}
+	if mmZAdd.funcZAdd != nil {
+		return mmZAdd.funcZAdd(ctx, key, score, member)
+	}
+	mmZAdd.t.Fatalf("Unexpected call to RedisClientMock.ZAdd. %v %v %v %v", ctx, key, score, member)
+	return
+}
+
+// ZAddAfterCounter returns a count of finished RedisClientMock.ZAdd invocations
+func (mmZAdd *RedisClientMock) ZAddAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmZAdd.afterZAddCounter)
+}
+
+// ZAddBeforeCounter returns a count of RedisClientMock.ZAdd invocations
+func (mmZAdd *RedisClientMock) ZAddBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmZAdd.beforeZAddCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.ZAdd.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmZAdd *mRedisClientMockZAdd) Calls() []*RedisClientMockZAddParams {
+	mmZAdd.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockZAddParams, len(mmZAdd.callArgs))
+	copy(argCopy, mmZAdd.callArgs)
+
+	mmZAdd.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockZAddDone returns true if the count of the ZAdd invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockZAddDone() bool {
+	if m.ZAddMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ZAddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ZAddMock.invocationsDone()
+}
+
+// MinimockZAddInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockZAddInspect() {
+	for _, e := range m.ZAddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.ZAdd at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterZAddCounter := mm_atomic.LoadUint64(&m.afterZAddCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ZAddMock.defaultExpectation != nil && afterZAddCounter < 1 {
+		if m.ZAddMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.ZAdd at\n%s", m.ZAddMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.ZAdd at\n%s with params: %#v", m.ZAddMock.defaultExpectation.expectationOrigins.origin, *m.ZAddMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcZAdd != nil && afterZAddCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.ZAdd at\n%s", m.funcZAddOrigin)
+	}
+
+	if !m.ZAddMock.invocationsDone() && afterZAddCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.ZAdd at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ZAddMock.expectedInvocations), m.ZAddMock.expectedInvocationsOrigin, afterZAddCounter)
+	}
+}
+
+type mRedisClientMockZRangeByLex struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockZRangeByLexExpectation
+	expectations       []*RedisClientMockZRangeByLexExpectation
+
+	callArgs []*RedisClientMockZRangeByLexParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockZRangeByLexExpectation specifies expectation struct of the RedisClient.ZRangeByLex
+type RedisClientMockZRangeByLexExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockZRangeByLexParams
+	paramPtrs          *RedisClientMockZRangeByLexParamPtrs
+	expectationOrigins RedisClientMockZRangeByLexExpectationOrigins
+	results            *RedisClientMockZRangeByLexResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockZRangeByLexParams contains parameters of the RedisClient.ZRangeByLex
+type RedisClientMockZRangeByLexParams struct {
+	ctx   context.Context
+	key   string
+	min   string
+	max   string
+	count int64
+}
+
+// RedisClientMockZRangeByLexParamPtrs contains pointers to parameters of the RedisClient.ZRangeByLex
+type RedisClientMockZRangeByLexParamPtrs struct {
+	ctx   *context.Context
+	key   *string
+	min   *string
+	max   *string
+	count *int64
+}
+
+// RedisClientMockZRangeByLexResults contains results of the RedisClient.ZRangeByLex
+type RedisClientMockZRangeByLexResults struct {
+	sa1 []string
+	err error
+}
+
+// RedisClientMockZRangeByLexOrigins contains origins of expectations of the RedisClient.ZRangeByLex
+type RedisClientMockZRangeByLexExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originKey   string
+	originMin   string
+	originMax   string
+	originCount string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmZRangeByLex *mRedisClientMockZRangeByLex) Optional() *mRedisClientMockZRangeByLex {
+	mmZRangeByLex.optional = true
+	return mmZRangeByLex
+}
+
+// Expect sets up expected params for RedisClient.ZRangeByLex
+func (mmZRangeByLex *mRedisClientMockZRangeByLex) Expect(ctx context.Context, key string, min string, max string, count int64) *mRedisClientMockZRangeByLex {
+	if mmZRangeByLex.mock.funcZRangeByLex != nil {
+		mmZRangeByLex.mock.t.Fatalf("RedisClientMock.ZRangeByLex mock is already set by Set")
+	}
+
+	if mmZRangeByLex.defaultExpectation == nil {
+		mmZRangeByLex.defaultExpectation = &RedisClientMockZRangeByLexExpectation{}
+	}
+
+	if mmZRangeByLex.defaultExpectation.paramPtrs != nil {
+		mmZRangeByLex.mock.t.Fatalf("RedisClientMock.ZRangeByLex mock is already set by ExpectParams functions")
+	}
+
+	mmZRangeByLex.defaultExpectation.params = &RedisClientMockZRangeByLexParams{ctx, key, min, max, count}
+	mmZRangeByLex.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmZRangeByLex.expectations {
+		if minimock.Equal(e.params, mmZRangeByLex.defaultExpectation.params) {
+			mmZRangeByLex.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmZRangeByLex.defaultExpectation.params)
+		}
+	}
+
+	return mmZRangeByLex
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.ZRangeByLex
+func (mmZRangeByLex *mRedisClientMockZRangeByLex) ExpectCtxParam1(ctx context.Context) *mRedisClientMockZRangeByLex {
+	if mmZRangeByLex.mock.funcZRangeByLex != nil {
+		mmZRangeByLex.mock.t.Fatalf("RedisClientMock.ZRangeByLex mock is already set by Set")
+	}
+
+	if mmZRangeByLex.defaultExpectation == nil {
+		mmZRangeByLex.defaultExpectation = &RedisClientMockZRangeByLexExpectation{}
+	}
+
+	if mmZRangeByLex.defaultExpectation.params != nil {
+		mmZRangeByLex.mock.t.Fatalf("RedisClientMock.ZRangeByLex mock is already set by Expect")
+	}
+
+	if mmZRangeByLex.defaultExpectation.paramPtrs == nil {
+		mmZRangeByLex.defaultExpectation.paramPtrs = &RedisClientMockZRangeByLexParamPtrs{}
+	}
+	mmZRangeByLex.defaultExpectation.paramPtrs.ctx = &ctx
+	mmZRangeByLex.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmZRangeByLex
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.ZRangeByLex
+func (mmZRangeByLex *mRedisClientMockZRangeByLex) ExpectKeyParam2(key string) *mRedisClientMockZRangeByLex {
+	if mmZRangeByLex.mock.funcZRangeByLex != nil {
+		mmZRangeByLex.mock.t.Fatalf("RedisClientMock.ZRangeByLex mock is already set by Set")
+	}
+
+	if mmZRangeByLex.defaultExpectation == nil {
+		mmZRangeByLex.defaultExpectation = &RedisClientMockZRangeByLexExpectation{}
+	}
+
+	if mmZRangeByLex.defaultExpectation.params != nil {
+		mmZRangeByLex.mock.t.Fatalf("RedisClientMock.ZRangeByLex mock is already set by Expect")
+	}
+
+	if mmZRangeByLex.defaultExpectation.paramPtrs == nil {
+		mmZRangeByLex.defaultExpectation.paramPtrs = &RedisClientMockZRangeByLexParamPtrs{}
+	}
+	mmZRangeByLex.defaultExpectation.paramPtrs.key = &key
+	mmZRangeByLex.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmZRangeByLex
+}
+
+// ExpectMinParam3 sets up expected param min for RedisClient.ZRangeByLex
+func (mmZRangeByLex *mRedisClientMockZRangeByLex) ExpectMinParam3(min string) *mRedisClientMockZRangeByLex {
+	if mmZRangeByLex.mock.funcZRangeByLex != nil {
+		mmZRangeByLex.mock.t.Fatalf("RedisClientMock.ZRangeByLex mock is already set by Set")
+	}
+
+	if mmZRangeByLex.defaultExpectation == nil {
+		mmZRangeByLex.defaultExpectation = &RedisClientMockZRangeByLexExpectation{}
+	}
+
+	if mmZRangeByLex.defaultExpectation.params != nil {
+		mmZRangeByLex.mock.t.Fatalf("RedisClientMock.ZRangeByLex mock is already set by Expect")
+	}
+
+	if mmZRangeByLex.defaultExpectation.paramPtrs == nil {
+		mmZRangeByLex.defaultExpectation.paramPtrs = &RedisClientMockZRangeByLexParamPtrs{}
+	}
+	mmZRangeByLex.defaultExpectation.paramPtrs.min = &min
+	mmZRangeByLex.defaultExpectation.expectationOrigins.originMin = minimock.CallerInfo(1)
+
+	return mmZRangeByLex
+}
+
+// ExpectMaxParam4 sets up expected param max for RedisClient.ZRangeByLex
+func (mmZRangeByLex *mRedisClientMockZRangeByLex) ExpectMaxParam4(max string) *mRedisClientMockZRangeByLex {
+	if mmZRangeByLex.mock.funcZRangeByLex != nil {
+		mmZRangeByLex.mock.t.Fatalf("RedisClientMock.ZRangeByLex mock is already set by Set")
+	}
+
+	if mmZRangeByLex.defaultExpectation == nil {
+		mmZRangeByLex.defaultExpectation = &RedisClientMockZRangeByLexExpectation{}
+	}
+
+	if mmZRangeByLex.defaultExpectation.params != nil {
+		mmZRangeByLex.mock.t.Fatalf("RedisClientMock.ZRangeByLex mock is already set by Expect")
+	}
+
+	if mmZRangeByLex.defaultExpectation.paramPtrs == nil {
+		mmZRangeByLex.defaultExpectation.paramPtrs = &RedisClientMockZRangeByLexParamPtrs{}
+	}
+	mmZRangeByLex.defaultExpectation.paramPtrs.max = &max
+	mmZRangeByLex.defaultExpectation.expectationOrigins.originMax = minimock.CallerInfo(1)
+
+	return mmZRangeByLex
+}
+
+// ExpectCountParam5 sets up expected param count for RedisClient.ZRangeByLex
+func (mmZRangeByLex *mRedisClientMockZRangeByLex) ExpectCountParam5(count int64) *mRedisClientMockZRangeByLex {
+	if mmZRangeByLex.mock.funcZRangeByLex != nil {
+		mmZRangeByLex.mock.t.Fatalf("RedisClientMock.ZRangeByLex mock is already set by Set")
+	}
+
+	if mmZRangeByLex.defaultExpectation == nil {
+		mmZRangeByLex.defaultExpectation = &RedisClientMockZRangeByLexExpectation{}
+	}
+
+	if mmZRangeByLex.defaultExpectation.params != nil {
+		mmZRangeByLex.mock.t.Fatalf("RedisClientMock.ZRangeByLex mock is already set by Expect")
+	}
+
+	if mmZRangeByLex.defaultExpectation.paramPtrs == nil {
+		mmZRangeByLex.defaultExpectation.paramPtrs = &RedisClientMockZRangeByLexParamPtrs{}
+	}
+	mmZRangeByLex.defaultExpectation.paramPtrs.count = &count
+	mmZRangeByLex.defaultExpectation.expectationOrigins.originCount = minimock.CallerInfo(1)
+
+	return mmZRangeByLex
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.ZRangeByLex
+func (mmZRangeByLex *mRedisClientMockZRangeByLex) Inspect(f func(ctx context.Context, key string, min string, max string, count int64)) *mRedisClientMockZRangeByLex {
+	if mmZRangeByLex.mock.inspectFuncZRangeByLex != nil {
+		mmZRangeByLex.mock.t.Fatalf("Inspect function is already set for RedisClientMock.ZRangeByLex")
+	}
+
+	mmZRangeByLex.mock.inspectFuncZRangeByLex = f
+
+	return mmZRangeByLex
+}
+
+// Return sets up results that will be returned by RedisClient.ZRangeByLex
+func (mmZRangeByLex *mRedisClientMockZRangeByLex) Return(sa1 []string, err error) *RedisClientMock {
+	if mmZRangeByLex.mock.funcZRangeByLex != nil {
+		mmZRangeByLex.mock.t.Fatalf("RedisClientMock.ZRangeByLex mock is already set by Set")
+	}
+
+	if mmZRangeByLex.defaultExpectation == nil {
+		mmZRangeByLex.defaultExpectation = &RedisClientMockZRangeByLexExpectation{mock: mmZRangeByLex.mock}
+	}
+	mmZRangeByLex.defaultExpectation.results = &RedisClientMockZRangeByLexResults{sa1, err}
+	mmZRangeByLex.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmZRangeByLex.mock
+}
+
+// Set uses given function f to mock the RedisClient.ZRangeByLex method
+func (mmZRangeByLex *mRedisClientMockZRangeByLex) Set(f func(ctx context.Context, key string, min string, max string, count int64) (sa1 []string, err error)) *RedisClientMock {
+	if mmZRangeByLex.defaultExpectation != nil {
+		mmZRangeByLex.mock.t.Fatalf("Default expectation is already set for the RedisClient.ZRangeByLex method")
+	}
+
+	if len(mmZRangeByLex.expectations) > 0 {
+		mmZRangeByLex.mock.t.Fatalf("Some expectations are already set for the RedisClient.ZRangeByLex method")
+	}
+
+	mmZRangeByLex.mock.funcZRangeByLex = f
+	mmZRangeByLex.mock.funcZRangeByLexOrigin = minimock.CallerInfo(1)
+	return mmZRangeByLex.mock
+}
+
+// When sets expectation for the RedisClient.ZRangeByLex which will trigger the result defined by the following
+// Then helper
+func (mmZRangeByLex *mRedisClientMockZRangeByLex) When(ctx context.Context, key string, min string, max string, count int64) *RedisClientMockZRangeByLexExpectation {
+	if mmZRangeByLex.mock.funcZRangeByLex != nil {
+		mmZRangeByLex.mock.t.Fatalf("RedisClientMock.ZRangeByLex mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockZRangeByLexExpectation{
+		mock:               mmZRangeByLex.mock,
+		params:             &RedisClientMockZRangeByLexParams{ctx, key, min, max, count},
+		expectationOrigins: RedisClientMockZRangeByLexExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmZRangeByLex.expectations = append(mmZRangeByLex.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.ZRangeByLex return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockZRangeByLexExpectation) Then(sa1 []string, err error) *RedisClientMock {
+	e.results = &RedisClientMockZRangeByLexResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.ZRangeByLex should be invoked
+func (mmZRangeByLex *mRedisClientMockZRangeByLex) Times(n uint64) *mRedisClientMockZRangeByLex {
+	if n == 0 {
+		mmZRangeByLex.mock.t.Fatalf("Times of RedisClientMock.ZRangeByLex mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmZRangeByLex.expectedInvocations, n)
+	mmZRangeByLex.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmZRangeByLex
+}
+
+func (mmZRangeByLex *mRedisClientMockZRangeByLex) invocationsDone() bool {
+	if len(mmZRangeByLex.expectations) == 0 && mmZRangeByLex.defaultExpectation == nil && mmZRangeByLex.mock.funcZRangeByLex == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmZRangeByLex.mock.afterZRangeByLexCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmZRangeByLex.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ZRangeByLex implements mm_cache.RedisClient
+func (mmZRangeByLex *RedisClientMock) ZRangeByLex(ctx context.Context, key string, min string, max string, count int64) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmZRangeByLex.beforeZRangeByLexCounter, 1)
+	defer mm_atomic.AddUint64(&mmZRangeByLex.afterZRangeByLexCounter, 1)
+
+	mmZRangeByLex.t.Helper()
+
+	if mmZRangeByLex.inspectFuncZRangeByLex != nil {
+		mmZRangeByLex.inspectFuncZRangeByLex(ctx, key, min, max, count)
+	}
+
+	mm_params := RedisClientMockZRangeByLexParams{ctx, key, min, max, count}
+
+	// Record call args
+	mmZRangeByLex.ZRangeByLexMock.mutex.Lock()
+	mmZRangeByLex.ZRangeByLexMock.callArgs = append(mmZRangeByLex.ZRangeByLexMock.callArgs, &mm_params)
+	mmZRangeByLex.ZRangeByLexMock.mutex.Unlock()
+
+	for _, e := range mmZRangeByLex.ZRangeByLexMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmZRangeByLex.ZRangeByLexMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmZRangeByLex.ZRangeByLexMock.defaultExpectation.Counter, 1)
+		mm_want := mmZRangeByLex.ZRangeByLexMock.defaultExpectation.params
+		mm_want_ptrs := mmZRangeByLex.ZRangeByLexMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockZRangeByLexParams{ctx, key, min, max, count}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmZRangeByLex.t.Errorf("RedisClientMock.ZRangeByLex got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRangeByLex.ZRangeByLexMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmZRangeByLex.t.Errorf("RedisClientMock.ZRangeByLex got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRangeByLex.ZRangeByLexMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.min != nil && !minimock.Equal(*mm_want_ptrs.min, mm_got.min) {
+				mmZRangeByLex.t.Errorf("RedisClientMock.ZRangeByLex got unexpected parameter min, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRangeByLex.ZRangeByLexMock.defaultExpectation.expectationOrigins.originMin, *mm_want_ptrs.min, mm_got.min, minimock.Diff(*mm_want_ptrs.min, mm_got.min))
+			}
+
+			if mm_want_ptrs.max != nil && !minimock.Equal(*mm_want_ptrs.max, mm_got.max) {
+				mmZRangeByLex.t.Errorf("RedisClientMock.ZRangeByLex got unexpected parameter max, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRangeByLex.ZRangeByLexMock.defaultExpectation.expectationOrigins.originMax, *mm_want_ptrs.max, mm_got.max, minimock.Diff(*mm_want_ptrs.max, mm_got.max))
+			}
+
+			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
+				mmZRangeByLex.t.Errorf("RedisClientMock.ZRangeByLex got unexpected parameter count, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRangeByLex.ZRangeByLexMock.defaultExpectation.expectationOrigins.originCount, *mm_want_ptrs.count, mm_got.count, minimock.Diff(*mm_want_ptrs.count, mm_got.count))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmZRangeByLex.t.Errorf("RedisClientMock.ZRangeByLex got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmZRangeByLex.ZRangeByLexMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmZRangeByLex.ZRangeByLexMock.defaultExpectation.results
+		if mm_results == nil {
+			mmZRangeByLex.t.Fatal("No results are set for the RedisClientMock.ZRangeByLex")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmZRangeByLex.funcZRangeByLex != nil {
+		return mmZRangeByLex.funcZRangeByLex(ctx, key, min, max, count)
+	}
+	mmZRangeByLex.t.Fatalf("Unexpected call to RedisClientMock.ZRangeByLex. %v %v %v %v %v", ctx, key, min, max, count)
+	return
+}
+
+// ZRangeByLexAfterCounter returns a count of finished RedisClientMock.ZRangeByLex invocations
+func (mmZRangeByLex *RedisClientMock) ZRangeByLexAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmZRangeByLex.afterZRangeByLexCounter)
+}
+
+// ZRangeByLexBeforeCounter returns a count of RedisClientMock.ZRangeByLex invocations
+func (mmZRangeByLex *RedisClientMock) ZRangeByLexBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmZRangeByLex.beforeZRangeByLexCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.ZRangeByLex.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmZRangeByLex *mRedisClientMockZRangeByLex) Calls() []*RedisClientMockZRangeByLexParams {
+	mmZRangeByLex.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockZRangeByLexParams, len(mmZRangeByLex.callArgs))
+	copy(argCopy, mmZRangeByLex.callArgs)
+
+	mmZRangeByLex.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockZRangeByLexDone returns true if the count of the ZRangeByLex invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockZRangeByLexDone() bool {
+	if m.ZRangeByLexMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ZRangeByLexMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ZRangeByLexMock.invocationsDone()
+}
+
+// MinimockZRangeByLexInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockZRangeByLexInspect() {
+	for _, e := range m.ZRangeByLexMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.ZRangeByLex at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterZRangeByLexCounter := mm_atomic.LoadUint64(&m.afterZRangeByLexCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ZRangeByLexMock.defaultExpectation != nil && afterZRangeByLexCounter < 1 {
+		if m.ZRangeByLexMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.ZRangeByLex at\n%s", m.ZRangeByLexMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.ZRangeByLex at\n%s with params: %#v", m.ZRangeByLexMock.defaultExpectation.expectationOrigins.origin, *m.ZRangeByLexMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcZRangeByLex != nil && afterZRangeByLexCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.ZRangeByLex at\n%s", m.funcZRangeByLexOrigin)
+	}
+
+	if !m.ZRangeByLexMock.invocationsDone() && afterZRangeByLexCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.ZRangeByLex at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ZRangeByLexMock.expectedInvocations), m.ZRangeByLexMock.expectedInvocationsOrigin, afterZRangeByLexCounter)
+	}
+}
+
+type mRedisClientMockZRangeByScore struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockZRangeByScoreExpectation
+	expectations       []*RedisClientMockZRangeByScoreExpectation
+
+	callArgs []*RedisClientMockZRangeByScoreParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockZRangeByScoreExpectation specifies expectation struct of the RedisClient.ZRangeByScore
+type RedisClientMockZRangeByScoreExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockZRangeByScoreParams
+	paramPtrs          *RedisClientMockZRangeByScoreParamPtrs
+	expectationOrigins RedisClientMockZRangeByScoreExpectationOrigins
+	results            *RedisClientMockZRangeByScoreResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockZRangeByScoreParams contains parameters of the RedisClient.ZRangeByScore
+type RedisClientMockZRangeByScoreParams struct {
+	ctx   context.Context
+	key   string
+	min   string
+	max   string
+	count int64
+}
+
+// RedisClientMockZRangeByScoreParamPtrs contains pointers to parameters of the RedisClient.ZRangeByScore
+type RedisClientMockZRangeByScoreParamPtrs struct {
+	ctx   *context.Context
+	key   *string
+	min   *string
+	max   *string
+	count *int64
+}
+
+// RedisClientMockZRangeByScoreResults contains results of the RedisClient.ZRangeByScore
+type RedisClientMockZRangeByScoreResults struct {
+	sa1 []string
+	err error
+}
+
+// RedisClientMockZRangeByScoreOrigins contains origins of expectations of the RedisClient.ZRangeByScore
+type RedisClientMockZRangeByScoreExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originKey   string
+	originMin   string
+	originMax   string
+	originCount string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmZRangeByScore *mRedisClientMockZRangeByScore) Optional() *mRedisClientMockZRangeByScore {
+	mmZRangeByScore.optional = true
+	return mmZRangeByScore
+}
+
+// Expect sets up expected params for RedisClient.ZRangeByScore
+func (mmZRangeByScore *mRedisClientMockZRangeByScore) Expect(ctx context.Context, key string, min string, max string, count int64) *mRedisClientMockZRangeByScore {
+	if mmZRangeByScore.mock.funcZRangeByScore != nil {
+		mmZRangeByScore.mock.t.Fatalf("RedisClientMock.ZRangeByScore mock is already set by Set")
+	}
+
+	if mmZRangeByScore.defaultExpectation == nil {
+		mmZRangeByScore.defaultExpectation = &RedisClientMockZRangeByScoreExpectation{}
+	}
+
+	if mmZRangeByScore.defaultExpectation.paramPtrs != nil {
+		mmZRangeByScore.mock.t.Fatalf("RedisClientMock.ZRangeByScore mock is already set by ExpectParams functions")
+	}
+
+	mmZRangeByScore.defaultExpectation.params = &RedisClientMockZRangeByScoreParams{ctx, key, min, max, count}
+	mmZRangeByScore.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmZRangeByScore.expectations {
+		if minimock.Equal(e.params, mmZRangeByScore.defaultExpectation.params) {
+			mmZRangeByScore.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmZRangeByScore.defaultExpectation.params)
+		}
+	}
+
+	return mmZRangeByScore
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.ZRangeByScore
+func (mmZRangeByScore *mRedisClientMockZRangeByScore) ExpectCtxParam1(ctx context.Context) *mRedisClientMockZRangeByScore {
+	if mmZRangeByScore.mock.funcZRangeByScore != nil {
+		mmZRangeByScore.mock.t.Fatalf("RedisClientMock.ZRangeByScore mock is already set by Set")
+	}
+
+	if mmZRangeByScore.defaultExpectation == nil {
+		mmZRangeByScore.defaultExpectation = &RedisClientMockZRangeByScoreExpectation{}
+	}
+
+	if mmZRangeByScore.defaultExpectation.params != nil {
+		mmZRangeByScore.mock.t.Fatalf("RedisClientMock.ZRangeByScore mock is already set by Expect")
+	}
+
+	if mmZRangeByScore.defaultExpectation.paramPtrs == nil {
+		mmZRangeByScore.defaultExpectation.paramPtrs = &RedisClientMockZRangeByScoreParamPtrs{}
+	}
+	mmZRangeByScore.defaultExpectation.paramPtrs.ctx = &ctx
+	mmZRangeByScore.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmZRangeByScore
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.ZRangeByScore
+func (mmZRangeByScore *mRedisClientMockZRangeByScore) ExpectKeyParam2(key string) *mRedisClientMockZRangeByScore {
+	if mmZRangeByScore.mock.funcZRangeByScore != nil {
+		mmZRangeByScore.mock.t.Fatalf("RedisClientMock.ZRangeByScore mock is already set by Set")
+	}
+
+	if mmZRangeByScore.defaultExpectation == nil {
+		mmZRangeByScore.defaultExpectation = &RedisClientMockZRangeByScoreExpectation{}
+	}
+
+	if mmZRangeByScore.defaultExpectation.params != nil {
+		mmZRangeByScore.mock.t.Fatalf("RedisClientMock.ZRangeByScore mock is already set by Expect")
+	}
+
+	if mmZRangeByScore.defaultExpectation.paramPtrs == nil {
+		mmZRangeByScore.defaultExpectation.paramPtrs = &RedisClientMockZRangeByScoreParamPtrs{}
+	}
+	mmZRangeByScore.defaultExpectation.paramPtrs.key = &key
+	mmZRangeByScore.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmZRangeByScore
+}
+
+// ExpectMinParam3 sets up expected param min for RedisClient.ZRangeByScore
+func (mmZRangeByScore *mRedisClientMockZRangeByScore) ExpectMinParam3(min string) *mRedisClientMockZRangeByScore {
+	if mmZRangeByScore.mock.funcZRangeByScore != nil {
+		mmZRangeByScore.mock.t.Fatalf("RedisClientMock.ZRangeByScore mock is already set by Set")
+	}
+
+	if mmZRangeByScore.defaultExpectation == nil {
+		mmZRangeByScore.defaultExpectation = &RedisClientMockZRangeByScoreExpectation{}
+	}
+
+	if mmZRangeByScore.defaultExpectation.params != nil {
+		mmZRangeByScore.mock.t.Fatalf("RedisClientMock.ZRangeByScore mock is already set by Expect")
+	}
+
+	if mmZRangeByScore.defaultExpectation.paramPtrs == nil {
+		mmZRangeByScore.defaultExpectation.paramPtrs = &RedisClientMockZRangeByScoreParamPtrs{}
+	}
+	mmZRangeByScore.defaultExpectation.paramPtrs.min = &min
+	mmZRangeByScore.defaultExpectation.expectationOrigins.originMin = minimock.CallerInfo(1)
+
+	return mmZRangeByScore
+}
+
+// ExpectMaxParam4 sets up expected param max for RedisClient.ZRangeByScore
+func (mmZRangeByScore *mRedisClientMockZRangeByScore) ExpectMaxParam4(max string) *mRedisClientMockZRangeByScore {
+	if mmZRangeByScore.mock.funcZRangeByScore != nil {
+		mmZRangeByScore.mock.t.Fatalf("RedisClientMock.ZRangeByScore mock is already set by Set")
+	}
+
+	if mmZRangeByScore.defaultExpectation == nil {
+		mmZRangeByScore.defaultExpectation = &RedisClientMockZRangeByScoreExpectation{}
+	}
+
+	if mmZRangeByScore.defaultExpectation.params != nil {
+		mmZRangeByScore.mock.t.Fatalf("RedisClientMock.ZRangeByScore mock is already set by Expect")
+	}
+
+	if mmZRangeByScore.defaultExpectation.paramPtrs == nil {
+		mmZRangeByScore.defaultExpectation.paramPtrs = &RedisClientMockZRangeByScoreParamPtrs{}
+	}
+	mmZRangeByScore.defaultExpectation.paramPtrs.max = &max
+	mmZRangeByScore.defaultExpectation.expectationOrigins.originMax = minimock.CallerInfo(1)
+
+	return mmZRangeByScore
+}
+
+// ExpectCountParam5 sets up expected param count for RedisClient.ZRangeByScore
+func (mmZRangeByScore *mRedisClientMockZRangeByScore) ExpectCountParam5(count int64) *mRedisClientMockZRangeByScore {
+	if mmZRangeByScore.mock.funcZRangeByScore != nil {
+		mmZRangeByScore.mock.t.Fatalf("RedisClientMock.ZRangeByScore mock is already set by Set")
+	}
+
+	if mmZRangeByScore.defaultExpectation == nil {
+		mmZRangeByScore.defaultExpectation = &RedisClientMockZRangeByScoreExpectation{}
+	}
+
+	if mmZRangeByScore.defaultExpectation.params != nil {
+		mmZRangeByScore.mock.t.Fatalf("RedisClientMock.ZRangeByScore mock is already set by Expect")
+	}
+
+	if mmZRangeByScore.defaultExpectation.paramPtrs == nil {
+		mmZRangeByScore.defaultExpectation.paramPtrs = &RedisClientMockZRangeByScoreParamPtrs{}
+	}
+	mmZRangeByScore.defaultExpectation.paramPtrs.count = &count
+	mmZRangeByScore.defaultExpectation.expectationOrigins.originCount = minimock.CallerInfo(1)
+
+	return mmZRangeByScore
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.ZRangeByScore
+func (mmZRangeByScore *mRedisClientMockZRangeByScore) Inspect(f func(ctx context.Context, key string, min string, max string, count int64)) *mRedisClientMockZRangeByScore {
+	if mmZRangeByScore.mock.inspectFuncZRangeByScore != nil {
+		mmZRangeByScore.mock.t.Fatalf("Inspect function is already set for RedisClientMock.ZRangeByScore")
+	}
+
+	mmZRangeByScore.mock.inspectFuncZRangeByScore = f
+
+	return mmZRangeByScore
+}
+
+// Return sets up results that will be returned by RedisClient.ZRangeByScore
+func (mmZRangeByScore *mRedisClientMockZRangeByScore) Return(sa1 []string, err error) *RedisClientMock {
+	if mmZRangeByScore.mock.funcZRangeByScore != nil {
+		mmZRangeByScore.mock.t.Fatalf("RedisClientMock.ZRangeByScore mock is already set by Set")
+	}
+
+	if mmZRangeByScore.defaultExpectation == nil {
+		mmZRangeByScore.defaultExpectation = &RedisClientMockZRangeByScoreExpectation{mock: mmZRangeByScore.mock}
+	}
+	mmZRangeByScore.defaultExpectation.results = &RedisClientMockZRangeByScoreResults{sa1, err}
+	mmZRangeByScore.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmZRangeByScore.mock
+}
+
+// Set uses given function f to mock the RedisClient.ZRangeByScore method
+func (mmZRangeByScore *mRedisClientMockZRangeByScore) Set(f func(ctx context.Context, key string, min string, max string, count int64) (sa1 []string, err error)) *RedisClientMock {
+	if mmZRangeByScore.defaultExpectation != nil {
+		mmZRangeByScore.mock.t.Fatalf("Default expectation is already set for the RedisClient.ZRangeByScore method")
+	}
+
+	if len(mmZRangeByScore.expectations) > 0 {
+		mmZRangeByScore.mock.t.Fatalf("Some expectations are already set for the RedisClient.ZRangeByScore method")
+	}
+
+	mmZRangeByScore.mock.funcZRangeByScore = f
+	mmZRangeByScore.mock.funcZRangeByScoreOrigin = minimock.CallerInfo(1)
+	return mmZRangeByScore.mock
+}
+
+// When sets expectation for the RedisClient.ZRangeByScore which will trigger the result defined by the following
+// Then helper
+func (mmZRangeByScore *mRedisClientMockZRangeByScore) When(ctx context.Context, key string, min string, max string, count int64) *RedisClientMockZRangeByScoreExpectation {
+	if mmZRangeByScore.mock.funcZRangeByScore != nil {
+		mmZRangeByScore.mock.t.Fatalf("RedisClientMock.ZRangeByScore mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockZRangeByScoreExpectation{
+		mock:               mmZRangeByScore.mock,
+		params:             &RedisClientMockZRangeByScoreParams{ctx, key, min, max, count},
+		expectationOrigins: RedisClientMockZRangeByScoreExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmZRangeByScore.expectations = append(mmZRangeByScore.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.ZRangeByScore return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockZRangeByScoreExpectation) Then(sa1 []string, err error) *RedisClientMock {
+	e.results = &RedisClientMockZRangeByScoreResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.ZRangeByScore should be invoked
+func (mmZRangeByScore *mRedisClientMockZRangeByScore) Times(n uint64) *mRedisClientMockZRangeByScore {
+	if n == 0 {
+		mmZRangeByScore.mock.t.Fatalf("Times of RedisClientMock.ZRangeByScore mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmZRangeByScore.expectedInvocations, n)
+	mmZRangeByScore.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmZRangeByScore
+}
+
+func (mmZRangeByScore *mRedisClientMockZRangeByScore) invocationsDone() bool {
+	if len(mmZRangeByScore.expectations) == 0 && mmZRangeByScore.defaultExpectation == nil && mmZRangeByScore.mock.funcZRangeByScore == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmZRangeByScore.mock.afterZRangeByScoreCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmZRangeByScore.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ZRangeByScore implements mm_cache.RedisClient
+func (mmZRangeByScore *RedisClientMock) ZRangeByScore(ctx context.Context, key string, min string, max string, count int64) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmZRangeByScore.beforeZRangeByScoreCounter, 1)
+	defer mm_atomic.AddUint64(&mmZRangeByScore.afterZRangeByScoreCounter, 1)
+
+	mmZRangeByScore.t.Helper()
+
+	if mmZRangeByScore.inspectFuncZRangeByScore != nil {
+		mmZRangeByScore.inspectFuncZRangeByScore(ctx, key, min, max, count)
+	}
+
+	mm_params := RedisClientMockZRangeByScoreParams{ctx, key, min, max, count}
+
+	// Record call args
+	mmZRangeByScore.ZRangeByScoreMock.mutex.Lock()
+	mmZRangeByScore.ZRangeByScoreMock.callArgs = append(mmZRangeByScore.ZRangeByScoreMock.callArgs, &mm_params)
+	mmZRangeByScore.ZRangeByScoreMock.mutex.Unlock()
+
+	for _, e := range mmZRangeByScore.ZRangeByScoreMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmZRangeByScore.ZRangeByScoreMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmZRangeByScore.ZRangeByScoreMock.defaultExpectation.Counter, 1)
+		mm_want := mmZRangeByScore.ZRangeByScoreMock.defaultExpectation.params
+		mm_want_ptrs := mmZRangeByScore.ZRangeByScoreMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockZRangeByScoreParams{ctx, key, min, max, count}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmZRangeByScore.t.Errorf("RedisClientMock.ZRangeByScore got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRangeByScore.ZRangeByScoreMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmZRangeByScore.t.Errorf("RedisClientMock.ZRangeByScore got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRangeByScore.ZRangeByScoreMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.min != nil && !minimock.Equal(*mm_want_ptrs.min, mm_got.min) {
+				mmZRangeByScore.t.Errorf("RedisClientMock.ZRangeByScore got unexpected parameter min, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRangeByScore.ZRangeByScoreMock.defaultExpectation.expectationOrigins.originMin, *mm_want_ptrs.min, mm_got.min, minimock.Diff(*mm_want_ptrs.min, mm_got.min))
+			}
+
+			if mm_want_ptrs.max != nil && !minimock.Equal(*mm_want_ptrs.max, mm_got.max) {
+				mmZRangeByScore.t.Errorf("RedisClientMock.ZRangeByScore got unexpected parameter max, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRangeByScore.ZRangeByScoreMock.defaultExpectation.expectationOrigins.originMax, *mm_want_ptrs.max, mm_got.max, minimock.Diff(*mm_want_ptrs.max, mm_got.max))
+			}
+
+			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
+				mmZRangeByScore.t.Errorf("RedisClientMock.ZRangeByScore got unexpected parameter count, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRangeByScore.ZRangeByScoreMock.defaultExpectation.expectationOrigins.originCount, *mm_want_ptrs.count, mm_got.count, minimock.Diff(*mm_want_ptrs.count, mm_got.count))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmZRangeByScore.t.Errorf("RedisClientMock.ZRangeByScore got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmZRangeByScore.ZRangeByScoreMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmZRangeByScore.ZRangeByScoreMock.defaultExpectation.results
+		if mm_results == nil {
+			mmZRangeByScore.t.Fatal("No results are set for the RedisClientMock.ZRangeByScore")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmZRangeByScore.funcZRangeByScore != nil {
+		return mmZRangeByScore.funcZRangeByScore(ctx, key, min, max, count)
+	}
+	mmZRangeByScore.t.Fatalf("Unexpected call to RedisClientMock.ZRangeByScore. %v %v %v %v %v", ctx, key, min, max, count)
+	return
+}
+
+// ZRangeByScoreAfterCounter returns a count of finished RedisClientMock.ZRangeByScore invocations
+func (mmZRangeByScore *RedisClientMock) ZRangeByScoreAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmZRangeByScore.afterZRangeByScoreCounter)
+}
+
+// ZRangeByScoreBeforeCounter returns a count of RedisClientMock.ZRangeByScore invocations
+func (mmZRangeByScore *RedisClientMock) ZRangeByScoreBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmZRangeByScore.beforeZRangeByScoreCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.ZRangeByScore.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmZRangeByScore *mRedisClientMockZRangeByScore) Calls() []*RedisClientMockZRangeByScoreParams {
+	mmZRangeByScore.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockZRangeByScoreParams, len(mmZRangeByScore.callArgs))
+	copy(argCopy, mmZRangeByScore.callArgs)
+
+	mmZRangeByScore.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockZRangeByScoreDone returns true if the count of the ZRangeByScore invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockZRangeByScoreDone() bool {
+	if m.ZRangeByScoreMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ZRangeByScoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ZRangeByScoreMock.invocationsDone()
+}
+
+// MinimockZRangeByScoreInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockZRangeByScoreInspect() {
+	for _, e := range m.ZRangeByScoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.ZRangeByScore at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterZRangeByScoreCounter := mm_atomic.LoadUint64(&m.afterZRangeByScoreCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ZRangeByScoreMock.defaultExpectation != nil && afterZRangeByScoreCounter < 1 {
+		if m.ZRangeByScoreMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.ZRangeByScore at\n%s", m.ZRangeByScoreMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.ZRangeByScore at\n%s with params: %#v", m.ZRangeByScoreMock.defaultExpectation.expectationOrigins.origin, *m.ZRangeByScoreMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcZRangeByScore != nil && afterZRangeByScoreCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.ZRangeByScore at\n%s", m.funcZRangeByScoreOrigin)
+	}
+
+	if !m.ZRangeByScoreMock.invocationsDone() && afterZRangeByScoreCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.ZRangeByScore at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ZRangeByScoreMock.expectedInvocations), m.ZRangeByScoreMock.expectedInvocationsOrigin, afterZRangeByScoreCounter)
+	}
+}
+
+type mRedisClientMockZRem struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockZRemExpectation
+	expectations       []*RedisClientMockZRemExpectation
+
+	callArgs []*RedisClientMockZRemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockZRemExpectation specifies expectation struct of the RedisClient.ZRem
+type RedisClientMockZRemExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockZRemParams
+	paramPtrs          *RedisClientMockZRemParamPtrs
+	expectationOrigins RedisClientMockZRemExpectationOrigins
+	results            *RedisClientMockZRemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockZRemParams contains parameters of the RedisClient.ZRem
+type RedisClientMockZRemParams struct {
+	ctx    context.Context
+	key    string
+	member interface{}
+}
+
+// RedisClientMockZRemParamPtrs contains pointers to parameters of the RedisClient.ZRem
+type RedisClientMockZRemParamPtrs struct {
+	ctx    *context.Context
+	key    *string
+	member *interface{}
+}
+
+// RedisClientMockZRemResults contains results of the RedisClient.ZRem
+type RedisClientMockZRemResults struct {
+	err error
+}
+
+// RedisClientMockZRemOrigins contains origins of expectations of the RedisClient.ZRem
+type RedisClientMockZRemExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originKey    string
+	originMember string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmZRem *mRedisClientMockZRem) Optional() *mRedisClientMockZRem {
+	mmZRem.optional = true
+	return mmZRem
+}
+
+// Expect sets up expected params for RedisClient.ZRem
+func (mmZRem *mRedisClientMockZRem) Expect(ctx context.Context, key string, member interface{}) *mRedisClientMockZRem {
+	if mmZRem.mock.funcZRem != nil {
+		mmZRem.mock.t.Fatalf("RedisClientMock.ZRem mock is already set by Set")
+	}
+
+	if mmZRem.defaultExpectation == nil {
+		mmZRem.defaultExpectation = &RedisClientMockZRemExpectation{}
+	}
+
+	if mmZRem.defaultExpectation.paramPtrs != nil {
+		mmZRem.mock.t.Fatalf("RedisClientMock.ZRem mock is already set by ExpectParams functions")
+	}
+
+	mmZRem.defaultExpectation.params = &RedisClientMockZRemParams{ctx, key, member}
+	mmZRem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmZRem.expectations {
+		if minimock.Equal(e.params, mmZRem.defaultExpectation.params) {
+			mmZRem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmZRem.defaultExpectation.params)
+		}
+	}
+
+	return mmZRem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.ZRem
+func (mmZRem *mRedisClientMockZRem) ExpectCtxParam1(ctx context.Context) *mRedisClientMockZRem {
+	if mmZRem.mock.funcZRem != nil {
+		mmZRem.mock.t.Fatalf("RedisClientMock.ZRem mock is already set by Set")
+	}
+
+	if mmZRem.defaultExpectation == nil {
+		mmZRem.defaultExpectation = &RedisClientMockZRemExpectation{}
+	}
+
+	if mmZRem.defaultExpectation.params != nil {
+		mmZRem.mock.t.Fatalf("RedisClientMock.ZRem mock is already set by Expect")
+	}
+
+	if mmZRem.defaultExpectation.paramPtrs == nil {
+		mmZRem.defaultExpectation.paramPtrs = &RedisClientMockZRemParamPtrs{}
+	}
+	mmZRem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmZRem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmZRem
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.ZRem
+func (mmZRem *mRedisClientMockZRem) ExpectKeyParam2(key string) *mRedisClientMockZRem {
+	if mmZRem.mock.funcZRem != nil {
+		mmZRem.mock.t.Fatalf("RedisClientMock.ZRem mock is already set by Set")
+	}
+
+	if mmZRem.defaultExpectation == nil {
+		mmZRem.defaultExpectation = &RedisClientMockZRemExpectation{}
+	}
+
+	if mmZRem.defaultExpectation.params != nil {
+		mmZRem.mock.t.Fatalf("RedisClientMock.ZRem mock is already set by Expect")
+	}
+
+	if mmZRem.defaultExpectation.paramPtrs == nil {
+		mmZRem.defaultExpectation.paramPtrs = &RedisClientMockZRemParamPtrs{}
+	}
+	mmZRem.defaultExpectation.paramPtrs.key = &key
+	mmZRem.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmZRem
+}
+
+// ExpectMemberParam3 sets up expected param member for RedisClient.ZRem
+func (mmZRem *mRedisClientMockZRem) ExpectMemberParam3(member interface{}) *mRedisClientMockZRem {
+	if mmZRem.mock.funcZRem != nil {
+		mmZRem.mock.t.Fatalf("RedisClientMock.ZRem mock is already set by Set")
+	}
+
+	if mmZRem.defaultExpectation == nil {
+		mmZRem.defaultExpectation = &RedisClientMockZRemExpectation{}
+	}
+
+	if mmZRem.defaultExpectation.params != nil {
+		mmZRem.mock.t.Fatalf("RedisClientMock.ZRem mock is already set by Expect")
+	}
+
+	if mmZRem.defaultExpectation.paramPtrs == nil {
+		mmZRem.defaultExpectation.paramPtrs = &RedisClientMockZRemParamPtrs{}
+	}
+	mmZRem.defaultExpectation.paramPtrs.member = &member
+	mmZRem.defaultExpectation.expectationOrigins.originMember = minimock.CallerInfo(1)
+
+	return mmZRem
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.ZRem
+func (mmZRem *mRedisClientMockZRem) Inspect(f func(ctx context.Context, key string, member interface{})) *mRedisClientMockZRem {
+	if mmZRem.mock.inspectFuncZRem != nil {
+		mmZRem.mock.t.Fatalf("Inspect function is already set for RedisClientMock.ZRem")
+	}
+
+	mmZRem.mock.inspectFuncZRem = f
+
+	return mmZRem
+}
+
+// Return sets up results that will be returned by RedisClient.ZRem
+func (mmZRem *mRedisClientMockZRem) Return(err error) *RedisClientMock {
+	if mmZRem.mock.funcZRem != nil {
+		mmZRem.mock.t.Fatalf("RedisClientMock.ZRem mock is already set by Set")
+	}
+
+	if mmZRem.defaultExpectation == nil {
+		mmZRem.defaultExpectation = &RedisClientMockZRemExpectation{mock: mmZRem.mock}
+	}
+	mmZRem.defaultExpectation.results = &RedisClientMockZRemResults{err}
+	mmZRem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmZRem.mock
+}
+
+// Set uses given function f to mock the RedisClient.ZRem method
+func (mmZRem *mRedisClientMockZRem) Set(f func(ctx context.Context, key string, member interface{}) (err error)) *RedisClientMock {
+	if mmZRem.defaultExpectation != nil {
+		mmZRem.mock.t.Fatalf("Default expectation is already set for the RedisClient.ZRem method")
+	}
+
+	if len(mmZRem.expectations) > 0 {
+		mmZRem.mock.t.Fatalf("Some expectations are already set for the RedisClient.ZRem method")
+	}
+
+	mmZRem.mock.funcZRem = f
+	mmZRem.mock.funcZRemOrigin = minimock.CallerInfo(1)
+	return mmZRem.mock
+}
+
+// When sets expectation for the RedisClient.ZRem which will trigger the result defined by the following
+// Then helper
+func (mmZRem *mRedisClientMockZRem) When(ctx context.Context, key string, member interface{}) *RedisClientMockZRemExpectation {
+	if mmZRem.mock.funcZRem != nil {
+		mmZRem.mock.t.Fatalf("RedisClientMock.ZRem mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockZRemExpectation{
+		mock:               mmZRem.mock,
+		params:             &RedisClientMockZRemParams{ctx, key, member},
+		expectationOrigins: RedisClientMockZRemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmZRem.expectations = append(mmZRem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.ZRem return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockZRemExpectation) Then(err error) *RedisClientMock {
+	e.results = &RedisClientMockZRemResults{err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.ZRem should be invoked
+func (mmZRem *mRedisClientMockZRem) Times(n uint64) *mRedisClientMockZRem {
+	if n == 0 {
+		mmZRem.mock.t.Fatalf("Times of RedisClientMock.ZRem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmZRem.expectedInvocations, n)
+	mmZRem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmZRem
+}
+
+func (mmZRem *mRedisClientMockZRem) invocationsDone() bool {
+	if len(mmZRem.expectations) == 0 && mmZRem.defaultExpectation == nil && mmZRem.mock.funcZRem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmZRem.mock.afterZRemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmZRem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ZRem implements mm_cache.RedisClient
+func (mmZRem *RedisClientMock) ZRem(ctx context.Context, key string, member interface{}) (err error) {
+	mm_atomic.AddUint64(&mmZRem.beforeZRemCounter, 1)
+	defer mm_atomic.AddUint64(&mmZRem.afterZRemCounter, 1)
+
+	mmZRem.t.Helper()
+
+	if mmZRem.inspectFuncZRem != nil {
+		mmZRem.inspectFuncZRem(ctx, key, member)
+	}
+
+	mm_params := RedisClientMockZRemParams{ctx, key, member}
+
+	// Record call args
+	mmZRem.ZRemMock.mutex.Lock()
+	mmZRem.ZRemMock.callArgs = append(mmZRem.ZRemMock.callArgs, &mm_params)
+	mmZRem.ZRemMock.mutex.Unlock()
+
+	for _, e := range mmZRem.ZRemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmZRem.ZRemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmZRem.ZRemMock.defaultExpectation.Counter, 1)
+		mm_want := mmZRem.ZRemMock.defaultExpectation.params
+		mm_want_ptrs := mmZRem.ZRemMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockZRemParams{ctx, key, member}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmZRem.t.Errorf("RedisClientMock.ZRem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRem.ZRemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmZRem.t.Errorf("RedisClientMock.ZRem got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRem.ZRemMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.member != nil && !minimock.Equal(*mm_want_ptrs.member, mm_got.member) {
+				mmZRem.t.Errorf("RedisClientMock.ZRem got unexpected parameter member, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRem.ZRemMock.defaultExpectation.expectationOrigins.originMember, *mm_want_ptrs.member, mm_got.member, minimock.Diff(*mm_want_ptrs.member, mm_got.member))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmZRem.t.Errorf("RedisClientMock.ZRem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmZRem.ZRemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmZRem.ZRemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmZRem.t.Fatal("No results are set for the RedisClientMock.ZRem")
+		}
+		return (*mm_results).err
+	}
+	if mmZRem.funcZRem != nil {
+		return mmZRem.funcZRem(ctx, key, member)
+	}
+	mmZRem.t.Fatalf("Unexpected call to RedisClientMock.ZRem. %v %v %v", ctx, key, member)
+	return
+}
+
+// ZRemAfterCounter returns a count of finished RedisClientMock.ZRem invocations
+func (mmZRem *RedisClientMock) ZRemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmZRem.afterZRemCounter)
+}
+
+// ZRemBeforeCounter returns a count of RedisClientMock.ZRem invocations
+func (mmZRem *RedisClientMock) ZRemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmZRem.beforeZRemCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.ZRem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmZRem *mRedisClientMockZRem) Calls() []*RedisClientMockZRemParams {
+	mmZRem.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockZRemParams, len(mmZRem.callArgs))
+	copy(argCopy, mmZRem.callArgs)
+
+	mmZRem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockZRemDone returns true if the count of the ZRem invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockZRemDone() bool {
+	if m.ZRemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ZRemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ZRemMock.invocationsDone()
+}
+
+// MinimockZRemInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockZRemInspect() {
+	for _, e := range m.ZRemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.ZRem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterZRemCounter := mm_atomic.LoadUint64(&m.afterZRemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ZRemMock.defaultExpectation != nil && afterZRemCounter < 1 {
+		if m.ZRemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.ZRem at\n%s", m.ZRemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.ZRem at\n%s with params: %#v", m.ZRemMock.defaultExpectation.expectationOrigins.origin, *m.ZRemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcZRem != nil && afterZRemCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.ZRem at\n%s", m.funcZRemOrigin)
+	}
+
+	if !m.ZRemMock.invocationsDone() && afterZRemCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.ZRem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ZRemMock.expectedInvocations), m.ZRemMock.expectedInvocationsOrigin, afterZRemCounter)
+	}
+}
+
+type mRedisClientMockZRevRangeByLex struct {
+	optional           bool
+	mock               *RedisClientMock
+	defaultExpectation *RedisClientMockZRevRangeByLexExpectation
+	expectations       []*RedisClientMockZRevRangeByLexExpectation
+
+	callArgs []*RedisClientMockZRevRangeByLexParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RedisClientMockZRevRangeByLexExpectation specifies expectation struct of the RedisClient.ZRevRangeByLex
+type RedisClientMockZRevRangeByLexExpectation struct {
+	mock               *RedisClientMock
+	params             *RedisClientMockZRevRangeByLexParams
+	paramPtrs          *RedisClientMockZRevRangeByLexParamPtrs
+	expectationOrigins RedisClientMockZRevRangeByLexExpectationOrigins
+	results            *RedisClientMockZRevRangeByLexResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RedisClientMockZRevRangeByLexParams contains parameters of the RedisClient.ZRevRangeByLex
+type RedisClientMockZRevRangeByLexParams struct {
+	ctx   context.Context
+	key   string
+	max   string
+	min   string
+	count int64
+}
+
+// RedisClientMockZRevRangeByLexParamPtrs contains pointers to parameters of the RedisClient.ZRevRangeByLex
+type RedisClientMockZRevRangeByLexParamPtrs struct {
+	ctx   *context.Context
+	key   *string
+	max   *string
+	min   *string
+	count *int64
+}
+
+// RedisClientMockZRevRangeByLexResults contains results of the RedisClient.ZRevRangeByLex
+type RedisClientMockZRevRangeByLexResults struct {
+	sa1 []string
+	err error
+}
+
+// RedisClientMockZRevRangeByLexOrigins contains origins of expectations of the RedisClient.ZRevRangeByLex
+type RedisClientMockZRevRangeByLexExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originKey   string
+	originMax   string
+	originMin   string
+	originCount string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmZRevRangeByLex *mRedisClientMockZRevRangeByLex) Optional() *mRedisClientMockZRevRangeByLex {
+	mmZRevRangeByLex.optional = true
+	return mmZRevRangeByLex
+}
+
+// Expect sets up expected params for RedisClient.ZRevRangeByLex
+func (mmZRevRangeByLex *mRedisClientMockZRevRangeByLex) Expect(ctx context.Context, key string, max string, min string, count int64) *mRedisClientMockZRevRangeByLex {
+	if mmZRevRangeByLex.mock.funcZRevRangeByLex != nil {
+		mmZRevRangeByLex.mock.t.Fatalf("RedisClientMock.ZRevRangeByLex mock is already set by Set")
+	}
+
+	if mmZRevRangeByLex.defaultExpectation == nil {
+		mmZRevRangeByLex.defaultExpectation = &RedisClientMockZRevRangeByLexExpectation{}
+	}
+
+	if mmZRevRangeByLex.defaultExpectation.paramPtrs != nil {
+		mmZRevRangeByLex.mock.t.Fatalf("RedisClientMock.ZRevRangeByLex mock is already set by ExpectParams functions")
+	}
+
+	mmZRevRangeByLex.defaultExpectation.params = &RedisClientMockZRevRangeByLexParams{ctx, key, max, min, count}
+	mmZRevRangeByLex.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmZRevRangeByLex.expectations {
+		if minimock.Equal(e.params, mmZRevRangeByLex.defaultExpectation.params) {
+			mmZRevRangeByLex.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmZRevRangeByLex.defaultExpectation.params)
+		}
+	}
+
+	return mmZRevRangeByLex
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RedisClient.ZRevRangeByLex
+func (mmZRevRangeByLex *mRedisClientMockZRevRangeByLex) ExpectCtxParam1(ctx context.Context) *mRedisClientMockZRevRangeByLex {
+	if mmZRevRangeByLex.mock.funcZRevRangeByLex != nil {
+		mmZRevRangeByLex.mock.t.Fatalf("RedisClientMock.ZRevRangeByLex mock is already set by Set")
+	}
+
+	if mmZRevRangeByLex.defaultExpectation == nil {
+		mmZRevRangeByLex.defaultExpectation = &RedisClientMockZRevRangeByLexExpectation{}
+	}
+
+	if mmZRevRangeByLex.defaultExpectation.params != nil {
+		mmZRevRangeByLex.mock.t.Fatalf("RedisClientMock.ZRevRangeByLex mock is already set by Expect")
+	}
+
+	if mmZRevRangeByLex.defaultExpectation.paramPtrs == nil {
+		mmZRevRangeByLex.defaultExpectation.paramPtrs = &RedisClientMockZRevRangeByLexParamPtrs{}
+	}
+	mmZRevRangeByLex.defaultExpectation.paramPtrs.ctx = &ctx
+	mmZRevRangeByLex.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmZRevRangeByLex
+}
+
+// ExpectKeyParam2 sets up expected param key for RedisClient.ZRevRangeByLex
+func (mmZRevRangeByLex *mRedisClientMockZRevRangeByLex) ExpectKeyParam2(key string) *mRedisClientMockZRevRangeByLex {
+	if mmZRevRangeByLex.mock.funcZRevRangeByLex != nil {
+		mmZRevRangeByLex.mock.t.Fatalf("RedisClientMock.ZRevRangeByLex mock is already set by Set")
+	}
+
+	if mmZRevRangeByLex.defaultExpectation == nil {
+		mmZRevRangeByLex.defaultExpectation = &RedisClientMockZRevRangeByLexExpectation{}
+	}
+
+	if mmZRevRangeByLex.defaultExpectation.params != nil {
+		mmZRevRangeByLex.mock.t.Fatalf("RedisClientMock.ZRevRangeByLex mock is already set by Expect")
+	}
+
+	if mmZRevRangeByLex.defaultExpectation.paramPtrs == nil {
+		mmZRevRangeByLex.defaultExpectation.paramPtrs = &RedisClientMockZRevRangeByLexParamPtrs{}
+	}
+	mmZRevRangeByLex.defaultExpectation.paramPtrs.key = &key
+	mmZRevRangeByLex.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmZRevRangeByLex
+}
+
+// ExpectMaxParam3 sets up expected param max for RedisClient.ZRevRangeByLex
+func (mmZRevRangeByLex *mRedisClientMockZRevRangeByLex) ExpectMaxParam3(max string) *mRedisClientMockZRevRangeByLex {
+	if mmZRevRangeByLex.mock.funcZRevRangeByLex != nil {
+		mmZRevRangeByLex.mock.t.Fatalf("RedisClientMock.ZRevRangeByLex mock is already set by Set")
+	}
+
+	if mmZRevRangeByLex.defaultExpectation == nil {
+		mmZRevRangeByLex.defaultExpectation = &RedisClientMockZRevRangeByLexExpectation{}
+	}
+
+	if mmZRevRangeByLex.defaultExpectation.params != nil {
+		mmZRevRangeByLex.mock.t.Fatalf("RedisClientMock.ZRevRangeByLex mock is already set by Expect")
+	}
+
+	if mmZRevRangeByLex.defaultExpectation.paramPtrs == nil {
+		mmZRevRangeByLex.defaultExpectation.paramPtrs = &RedisClientMockZRevRangeByLexParamPtrs{}
+	}
+	mmZRevRangeByLex.defaultExpectation.paramPtrs.max = &max
+	mmZRevRangeByLex.defaultExpectation.expectationOrigins.originMax = minimock.CallerInfo(1)
+
+	return mmZRevRangeByLex
+}
+
+// ExpectMinParam4 sets up expected param min for RedisClient.ZRevRangeByLex
+func (mmZRevRangeByLex *mRedisClientMockZRevRangeByLex) ExpectMinParam4(min string) *mRedisClientMockZRevRangeByLex {
+	if mmZRevRangeByLex.mock.funcZRevRangeByLex != nil {
+		mmZRevRangeByLex.mock.t.Fatalf("RedisClientMock.ZRevRangeByLex mock is already set by Set")
+	}
+
+	if mmZRevRangeByLex.defaultExpectation == nil {
+		mmZRevRangeByLex.defaultExpectation = &RedisClientMockZRevRangeByLexExpectation{}
+	}
+
+	if mmZRevRangeByLex.defaultExpectation.params != nil {
+		mmZRevRangeByLex.mock.t.Fatalf("RedisClientMock.ZRevRangeByLex mock is already set by Expect")
+	}
+
+	if mmZRevRangeByLex.defaultExpectation.paramPtrs == nil {
+		mmZRevRangeByLex.defaultExpectation.paramPtrs = &RedisClientMockZRevRangeByLexParamPtrs{}
+	}
+	mmZRevRangeByLex.defaultExpectation.paramPtrs.min = &min
+	mmZRevRangeByLex.defaultExpectation.expectationOrigins.originMin = minimock.CallerInfo(1)
+
+	return mmZRevRangeByLex
+}
+
+// ExpectCountParam5 sets up expected param count for RedisClient.ZRevRangeByLex
+func (mmZRevRangeByLex *mRedisClientMockZRevRangeByLex) ExpectCountParam5(count int64) *mRedisClientMockZRevRangeByLex {
+	if mmZRevRangeByLex.mock.funcZRevRangeByLex != nil {
+		mmZRevRangeByLex.mock.t.Fatalf("RedisClientMock.ZRevRangeByLex mock is already set by Set")
+	}
+
+	if mmZRevRangeByLex.defaultExpectation == nil {
+		mmZRevRangeByLex.defaultExpectation = &RedisClientMockZRevRangeByLexExpectation{}
+	}
+
+	if mmZRevRangeByLex.defaultExpectation.params != nil {
+		mmZRevRangeByLex.mock.t.Fatalf("RedisClientMock.ZRevRangeByLex mock is already set by Expect")
+	}
+
+	if mmZRevRangeByLex.defaultExpectation.paramPtrs == nil {
+		mmZRevRangeByLex.defaultExpectation.paramPtrs = &RedisClientMockZRevRangeByLexParamPtrs{}
+	}
+	mmZRevRangeByLex.defaultExpectation.paramPtrs.count = &count
+	mmZRevRangeByLex.defaultExpectation.expectationOrigins.originCount = minimock.CallerInfo(1)
+
+	return mmZRevRangeByLex
+}
+
+// Inspect accepts an inspector function that has same arguments as the RedisClient.ZRevRangeByLex
+func (mmZRevRangeByLex *mRedisClientMockZRevRangeByLex) Inspect(f func(ctx context.Context, key string, max string, min string, count int64)) *mRedisClientMockZRevRangeByLex {
+	if mmZRevRangeByLex.mock.inspectFuncZRevRangeByLex != nil {
+		mmZRevRangeByLex.mock.t.Fatalf("Inspect function is already set for RedisClientMock.ZRevRangeByLex")
+	}
+
+	mmZRevRangeByLex.mock.inspectFuncZRevRangeByLex = f
+
+	return mmZRevRangeByLex
+}
+
+// Return sets up results that will be returned by RedisClient.ZRevRangeByLex
+func (mmZRevRangeByLex *mRedisClientMockZRevRangeByLex) Return(sa1 []string, err error) *RedisClientMock {
+	if mmZRevRangeByLex.mock.funcZRevRangeByLex != nil {
+		mmZRevRangeByLex.mock.t.Fatalf("RedisClientMock.ZRevRangeByLex mock is already set by Set")
+	}
+
+	if mmZRevRangeByLex.defaultExpectation == nil {
+		mmZRevRangeByLex.defaultExpectation = &RedisClientMockZRevRangeByLexExpectation{mock: mmZRevRangeByLex.mock}
+	}
+	mmZRevRangeByLex.defaultExpectation.results = &RedisClientMockZRevRangeByLexResults{sa1, err}
+	mmZRevRangeByLex.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmZRevRangeByLex.mock
+}
+
+// Set uses given function f to mock the RedisClient.ZRevRangeByLex method
+func (mmZRevRangeByLex *mRedisClientMockZRevRangeByLex) Set(f func(ctx context.Context, key string, max string, min string, count int64) (sa1 []string, err error)) *RedisClientMock {
+	if mmZRevRangeByLex.defaultExpectation != nil {
+		mmZRevRangeByLex.mock.t.Fatalf("Default expectation is already set for the RedisClient.ZRevRangeByLex method")
+	}
+
+	if len(mmZRevRangeByLex.expectations) > 0 {
+		mmZRevRangeByLex.mock.t.Fatalf("Some expectations are already set for the RedisClient.ZRevRangeByLex method")
+	}
+
+	mmZRevRangeByLex.mock.funcZRevRangeByLex = f
+	mmZRevRangeByLex.mock.funcZRevRangeByLexOrigin = minimock.CallerInfo(1)
+	return mmZRevRangeByLex.mock
+}
+
+// When sets expectation for the RedisClient.ZRevRangeByLex which will trigger the result defined by the following
+// Then helper
+func (mmZRevRangeByLex *mRedisClientMockZRevRangeByLex) When(ctx context.Context, key string, max string, min string, count int64) *RedisClientMockZRevRangeByLexExpectation {
+	if mmZRevRangeByLex.mock.funcZRevRangeByLex != nil {
+		mmZRevRangeByLex.mock.t.Fatalf("RedisClientMock.ZRevRangeByLex mock is already set by Set")
+	}
+
+	expectation := &RedisClientMockZRevRangeByLexExpectation{
+		mock:               mmZRevRangeByLex.mock,
+		params:             &RedisClientMockZRevRangeByLexParams{ctx, key, max, min, count},
+		expectationOrigins: RedisClientMockZRevRangeByLexExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmZRevRangeByLex.expectations = append(mmZRevRangeByLex.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RedisClient.ZRevRangeByLex return parameters for the expectation previously defined by the When method
+func (e *RedisClientMockZRevRangeByLexExpectation) Then(sa1 []string, err error) *RedisClientMock {
+	e.results = &RedisClientMockZRevRangeByLexResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times RedisClient.ZRevRangeByLex should be invoked
+func (mmZRevRangeByLex *mRedisClientMockZRevRangeByLex) Times(n uint64) *mRedisClientMockZRevRangeByLex {
+	if n == 0 {
+		mmZRevRangeByLex.mock.t.Fatalf("Times of RedisClientMock.ZRevRangeByLex mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmZRevRangeByLex.expectedInvocations, n)
+	mmZRevRangeByLex.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmZRevRangeByLex
+}
+
+func (mmZRevRangeByLex *mRedisClientMockZRevRangeByLex) invocationsDone() bool {
+	if len(mmZRevRangeByLex.expectations) == 0 && mmZRevRangeByLex.defaultExpectation == nil && mmZRevRangeByLex.mock.funcZRevRangeByLex == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmZRevRangeByLex.mock.afterZRevRangeByLexCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmZRevRangeByLex.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ZRevRangeByLex implements mm_cache.RedisClient
+func (mmZRevRangeByLex *RedisClientMock) ZRevRangeByLex(ctx context.Context, key string, max string, min string, count int64) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmZRevRangeByLex.beforeZRevRangeByLexCounter, 1)
+	defer mm_atomic.AddUint64(&mmZRevRangeByLex.afterZRevRangeByLexCounter, 1)
+
+	mmZRevRangeByLex.t.Helper()
+
+	if mmZRevRangeByLex.inspectFuncZRevRangeByLex != nil {
+		mmZRevRangeByLex.inspectFuncZRevRangeByLex(ctx, key, max, min, count)
+	}
+
+	mm_params := RedisClientMockZRevRangeByLexParams{ctx, key, max, min, count}
+
+	// Record call args
+	mmZRevRangeByLex.ZRevRangeByLexMock.mutex.Lock()
+	mmZRevRangeByLex.ZRevRangeByLexMock.callArgs = append(mmZRevRangeByLex.ZRevRangeByLexMock.callArgs, &mm_params)
+	mmZRevRangeByLex.ZRevRangeByLexMock.mutex.Unlock()
+
+	for _, e := range mmZRevRangeByLex.ZRevRangeByLexMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmZRevRangeByLex.ZRevRangeByLexMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmZRevRangeByLex.ZRevRangeByLexMock.defaultExpectation.Counter, 1)
+		mm_want := mmZRevRangeByLex.ZRevRangeByLexMock.defaultExpectation.params
+		mm_want_ptrs := mmZRevRangeByLex.ZRevRangeByLexMock.defaultExpectation.paramPtrs
+
+		mm_got := RedisClientMockZRevRangeByLexParams{ctx, key, max, min, count}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmZRevRangeByLex.t.Errorf("RedisClientMock.ZRevRangeByLex got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRevRangeByLex.ZRevRangeByLexMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmZRevRangeByLex.t.Errorf("RedisClientMock.ZRevRangeByLex got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRevRangeByLex.ZRevRangeByLexMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.max != nil && !minimock.Equal(*mm_want_ptrs.max, mm_got.max) {
+				mmZRevRangeByLex.t.Errorf("RedisClientMock.ZRevRangeByLex got unexpected parameter max, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRevRangeByLex.ZRevRangeByLexMock.defaultExpectation.expectationOrigins.originMax, *mm_want_ptrs.max, mm_got.max, minimock.Diff(*mm_want_ptrs.max, mm_got.max))
+			}
+
+			if mm_want_ptrs.min != nil && !minimock.Equal(*mm_want_ptrs.min, mm_got.min) {
+				mmZRevRangeByLex.t.Errorf("RedisClientMock.ZRevRangeByLex got unexpected parameter min, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRevRangeByLex.ZRevRangeByLexMock.defaultExpectation.expectationOrigins.originMin, *mm_want_ptrs.min, mm_got.min, minimock.Diff(*mm_want_ptrs.min, mm_got.min))
+			}
+
+			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
+				mmZRevRangeByLex.t.Errorf("RedisClientMock.ZRevRangeByLex got unexpected parameter count, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmZRevRangeByLex.ZRevRangeByLexMock.defaultExpectation.expectationOrigins.originCount, *mm_want_ptrs.count, mm_got.count, minimock.Diff(*mm_want_ptrs.count, mm_got.count))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmZRevRangeByLex.t.Errorf("RedisClientMock.ZRevRangeByLex got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmZRevRangeByLex.ZRevRangeByLexMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmZRevRangeByLex.ZRevRangeByLexMock.defaultExpectation.results
+		if mm_results == nil {
+			mmZRevRangeByLex.t.Fatal("No results are set for the RedisClientMock.ZRevRangeByLex")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmZRevRangeByLex.funcZRevRangeByLex != nil {
+		return mmZRevRangeByLex.funcZRevRangeByLex(ctx, key, max, min, count)
+	}
+	mmZRevRangeByLex.t.Fatalf("Unexpected call to RedisClientMock.ZRevRangeByLex. %v %v %v %v %v", ctx, key, max, min, count)
+	return
+}
+
+// ZRevRangeByLexAfterCounter returns a count of finished RedisClientMock.ZRevRangeByLex invocations
+func (mmZRevRangeByLex *RedisClientMock) ZRevRangeByLexAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmZRevRangeByLex.afterZRevRangeByLexCounter)
+}
+
+// ZRevRangeByLexBeforeCounter returns a count of RedisClientMock.ZRevRangeByLex invocations
+func (mmZRevRangeByLex *RedisClientMock) ZRevRangeByLexBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmZRevRangeByLex.beforeZRevRangeByLexCounter)
+}
+
+// Calls returns a list of arguments used in each call to RedisClientMock.ZRevRangeByLex.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmZRevRangeByLex *mRedisClientMockZRevRangeByLex) Calls() []*RedisClientMockZRevRangeByLexParams {
+	mmZRevRangeByLex.mutex.RLock()
+
+	argCopy := make([]*RedisClientMockZRevRangeByLexParams, len(mmZRevRangeByLex.callArgs))
+	copy(argCopy, mmZRevRangeByLex.callArgs)
+
+	mmZRevRangeByLex.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockZRevRangeByLexDone returns true if the count of the ZRevRangeByLex invocations corresponds
+// the number of defined expectations
+func (m *RedisClientMock) MinimockZRevRangeByLexDone() bool {
+	if m.ZRevRangeByLexMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ZRevRangeByLexMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ZRevRangeByLexMock.invocationsDone()
+}
+
+// MinimockZRevRangeByLexInspect logs each unmet expectation
+func (m *RedisClientMock) MinimockZRevRangeByLexInspect() {
+	for _, e := range m.ZRevRangeByLexMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RedisClientMock.ZRevRangeByLex at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterZRevRangeByLexCounter := mm_atomic.LoadUint64(&m.afterZRevRangeByLexCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ZRevRangeByLexMock.defaultExpectation != nil && afterZRevRangeByLexCounter < 1 {
+		if m.ZRevRangeByLexMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RedisClientMock.ZRevRangeByLex at\n%s", m.ZRevRangeByLexMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RedisClientMock.ZRevRangeByLex at\n%s with params: %#v", m.ZRevRangeByLexMock.defaultExpectation.expectationOrigins.origin, *m.ZRevRangeByLexMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcZRevRangeByLex != nil && afterZRevRangeByLexCounter < 1 {
+		m.t.Errorf("Expected call to RedisClientMock.ZRevRangeByLex at\n%s", m.funcZRevRangeByLexOrigin)
+	}
+
+	if !m.ZRevRangeByLexMock.invocationsDone() && afterZRevRangeByLexCounter > 0 {
+		m.t.Errorf("Expected %d calls to RedisClientMock.ZRevRangeByLex at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ZRevRangeByLexMock.expectedInvocations), m.ZRevRangeByLexMock.expectedInvocationsOrigin, afterZRevRangeByLexCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RedisClientMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteInspect()
+
+			m.MinimockEvalInspect()
+
+			m.MinimockExpireInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockHGetAllInspect()
+
+			m.MinimockHashSetInspect()
+
+			m.MinimockPingInspect()
+
+			m.MinimockSAddInspect()
+
+			m.MinimockSMembersInspect()
+
+			m.MinimockSRemInspect()
+
+			m.MinimockSetInspect()
+
+			m.MinimockSetExInspect()
+
+			m.MinimockZAddInspect()
+
+			m.MinimockZRangeByLexInspect()
+
+			m.MinimockZRangeByScoreInspect()
+
+			m.MinimockZRemInspect()
+
+			m.MinimockZRevRangeByLexInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RedisClientMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RedisClientMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteDone() &&
+		m.MinimockEvalDone() &&
+		m.MinimockExpireDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockHGetAllDone() &&
+		m.MinimockHashSetDone() &&
+		m.MinimockPingDone() &&
+		m.MinimockSAddDone() &&
+		m.MinimockSMembersDone() &&
+		m.MinimockSRemDone() &&
+		m.MinimockSetDone() &&
+		m.MinimockSetExDone() &&
+		m.MinimockZAddDone() &&
+		m.MinimockZRangeByLexDone() &&
+		m.MinimockZRangeByScoreDone() &&
+		m.MinimockZRemDone() &&
+		m.MinimockZRevRangeByLexDone()
+}
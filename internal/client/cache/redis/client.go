@@ -6,6 +6,11 @@ import (
 	"time"
 
 	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/ipv02/auth/internal/client/cache"
 	"github.com/ipv02/auth/internal/config"
@@ -13,6 +18,8 @@ import (
 
 var _ cache.RedisClient = (*client)(nil)
 
+const tracerName = "github.com/ipv02/auth/internal/client/cache/redis"
+
 type handler func(ctx context.Context, conn redis.Conn) error
 
 type client struct {
@@ -30,7 +37,7 @@ func NewClient(pool *redis.Pool, config config.RedisConfig) *client {
 
 // HashSet сохраняет несколько значений в Redis-хеш, ассоциированных с указанным ключом
 func (c *client) HashSet(ctx context.Context, key string, values interface{}) error {
-	err := c.execute(ctx, func(_ context.Context, conn redis.Conn) error {
+	err := c.execute(ctx, "HSET", func(_ context.Context, conn redis.Conn) error {
 		_, err := conn.Do("HSET", redis.Args{key}.AddFlat(values)...)
 		if err != nil {
 			return err
@@ -47,7 +54,7 @@ func (c *client) HashSet(ctx context.Context, key string, values interface{}) er
 
 // Set устанавливает одиночное значение по ключу в redis
 func (c *client) Set(ctx context.Context, key string, value interface{}) error {
-	err := c.execute(ctx, func(_ context.Context, conn redis.Conn) error {
+	err := c.execute(ctx, "SET", func(_ context.Context, conn redis.Conn) error {
 		_, err := conn.Do("SET", redis.Args{key}.Add(value)...)
 		if err != nil {
 			return err
@@ -62,10 +69,18 @@ func (c *client) Set(ctx context.Context, key string, value interface{}) error {
 	return nil
 }
 
+// SetEx устанавливает одиночное значение по ключу в redis вместе с временем жизни
+func (c *client) SetEx(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	return c.execute(ctx, "SET", func(_ context.Context, conn redis.Conn) error {
+		_, err := conn.Do("SET", key, value, "PX", expiration.Milliseconds())
+		return err
+	})
+}
+
 // HGetAll получает все поля и значения из redis-хеша по указанному ключу
 func (c *client) HGetAll(ctx context.Context, key string) ([]interface{}, error) {
 	var values []interface{}
-	err := c.execute(ctx, func(_ context.Context, conn redis.Conn) error {
+	err := c.execute(ctx, "HGETALL", func(_ context.Context, conn redis.Conn) error {
 		var errEx error
 		values, errEx = redis.Values(conn.Do("HGETALL", key))
 		if errEx != nil {
@@ -84,7 +99,7 @@ func (c *client) HGetAll(ctx context.Context, key string) ([]interface{}, error)
 // Get получает значение по ключу из redis
 func (c *client) Get(ctx context.Context, key string) (interface{}, error) {
 	var value interface{}
-	err := c.execute(ctx, func(_ context.Context, conn redis.Conn) error {
+	err := c.execute(ctx, "GET", func(_ context.Context, conn redis.Conn) error {
 		var errEx error
 		value, errEx = conn.Do("GET", key)
 		if errEx != nil {
@@ -102,7 +117,7 @@ func (c *client) Get(ctx context.Context, key string) (interface{}, error) {
 
 // Expire устанавливает время жизни (TTL) для ключа в redis.
 func (c *client) Expire(ctx context.Context, key string, expiration time.Duration) error {
-	err := c.execute(ctx, func(_ context.Context, conn redis.Conn) error {
+	err := c.execute(ctx, "EXPIRE", func(_ context.Context, conn redis.Conn) error {
 		_, err := conn.Do("EXPIRE", key, int(expiration.Seconds()))
 		if err != nil {
 			return err
@@ -119,7 +134,7 @@ func (c *client) Expire(ctx context.Context, key string, expiration time.Duratio
 
 // Ping проверяет доступность redis
 func (c *client) Ping(ctx context.Context) error {
-	err := c.execute(ctx, func(_ context.Context, conn redis.Conn) error {
+	err := c.execute(ctx, "PING", func(_ context.Context, conn redis.Conn) error {
 		_, err := conn.Do("PING")
 		if err != nil {
 			return err
@@ -134,16 +149,32 @@ func (c *client) Ping(ctx context.Context) error {
 	return nil
 }
 
-// execute оборачивает выполнение команды redis, управляя подключением и его закрытием
-func (c *client) execute(ctx context.Context, handler handler) error {
+// execute оборачивает выполнение команды redis, управляя подключением и его закрытием.
+// Выполнение записывается в span с именем команды command
+func (c *client) execute(ctx context.Context, command string, handler handler) (err error) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, command,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemRedis,
+			semconv.DBOperationName(command),
+		),
+	)
+	defer func() {
+		if err != nil && !errors.Is(err, redis.ErrNil) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	conn, err := c.getConnect(ctx)
 	if err != nil {
 		return err
 	}
 	defer func() {
-		err = conn.Close()
-		if err != nil {
-			log.Printf("failed to close redis connection: %v\n", err)
+		errClose := conn.Close()
+		if errClose != nil {
+			log.Printf("failed to close redis connection: %v\n", errClose)
 		}
 	}()
 
@@ -173,8 +204,118 @@ func (c *client) getConnect(ctx context.Context) (redis.Conn, error) {
 
 // Delete удаляет ключ из redis
 func (c *client) Delete(ctx context.Context, key string) error {
-	return c.execute(ctx, func(_ context.Context, conn redis.Conn) error {
+	return c.execute(ctx, "DEL", func(_ context.Context, conn redis.Conn) error {
 		_, err := conn.Do("DEL", key)
 		return err
 	})
 }
+
+// SAdd добавляет элемент в множество
+func (c *client) SAdd(ctx context.Context, key string, member interface{}) error {
+	return c.execute(ctx, "SADD", func(_ context.Context, conn redis.Conn) error {
+		_, err := conn.Do("SADD", key, member)
+		return err
+	})
+}
+
+// SRem удаляет элемент из множества
+func (c *client) SRem(ctx context.Context, key string, member interface{}) error {
+	return c.execute(ctx, "SREM", func(_ context.Context, conn redis.Conn) error {
+		_, err := conn.Do("SREM", key, member)
+		return err
+	})
+}
+
+// SMembers возвращает все элементы множества
+func (c *client) SMembers(ctx context.Context, key string) ([]string, error) {
+	var members []string
+	err := c.execute(ctx, "SMEMBERS", func(_ context.Context, conn redis.Conn) error {
+		var errEx error
+		members, errEx = redis.Strings(conn.Do("SMEMBERS", key))
+		return errEx
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// ZAdd добавляет элемент в упорядоченное множество
+func (c *client) ZAdd(ctx context.Context, key string, score int64, member interface{}) error {
+	return c.execute(ctx, "ZADD", func(_ context.Context, conn redis.Conn) error {
+		_, err := conn.Do("ZADD", key, score, member)
+		return err
+	})
+}
+
+// ZRem удаляет элемент из упорядоченного множества
+func (c *client) ZRem(ctx context.Context, key string, member interface{}) error {
+	return c.execute(ctx, "ZREM", func(_ context.Context, conn redis.Conn) error {
+		_, err := conn.Do("ZREM", key, member)
+		return err
+	})
+}
+
+// ZRangeByScore возвращает не более count элементов упорядоченного множества
+// по возрастанию score в диапазоне [min, max]
+func (c *client) ZRangeByScore(ctx context.Context, key, min, max string, count int64) ([]string, error) {
+	var members []string
+	err := c.execute(ctx, "ZRANGEBYSCORE", func(_ context.Context, conn redis.Conn) error {
+		var errEx error
+		members, errEx = redis.Strings(conn.Do("ZRANGEBYSCORE", key, min, max, "LIMIT", 0, count))
+		return errEx
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// ZRangeByLex возвращает не более count элементов упорядоченного множества
+// в лексикографическом порядке в диапазоне [min, max]
+func (c *client) ZRangeByLex(ctx context.Context, key, min, max string, count int64) ([]string, error) {
+	var members []string
+	err := c.execute(ctx, "ZRANGEBYLEX", func(_ context.Context, conn redis.Conn) error {
+		var errEx error
+		members, errEx = redis.Strings(conn.Do("ZRANGEBYLEX", key, min, max, "LIMIT", 0, count))
+		return errEx
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// ZRevRangeByLex возвращает не более count элементов упорядоченного множества
+// в обратном лексикографическом порядке в диапазоне [min, max]
+func (c *client) ZRevRangeByLex(ctx context.Context, key, max, min string, count int64) ([]string, error) {
+	var members []string
+	err := c.execute(ctx, "ZREVRANGEBYLEX", func(_ context.Context, conn redis.Conn) error {
+		var errEx error
+		members, errEx = redis.Strings(conn.Do("ZREVRANGEBYLEX", key, max, min, "LIMIT", 0, count))
+		return errEx
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// Eval атомарно выполняет lua скрипт, используя EVALSHA и EVAL, если скрипт еще не загружен
+func (c *client) Eval(ctx context.Context, script string, keyCount int, keysAndArgs ...interface{}) (interface{}, error) {
+	var reply interface{}
+	err := c.execute(ctx, "EVAL", func(_ context.Context, conn redis.Conn) error {
+		var errEx error
+		reply, errEx = redis.NewScript(keyCount, script).Do(conn, keysAndArgs...)
+		return errEx
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
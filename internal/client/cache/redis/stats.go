@@ -0,0 +1,46 @@
+package redis
+
+import (
+	"github.com/gomodule/redigo/redis"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ipv02/auth/internal/metric"
+)
+
+var (
+	activeConnsDesc  = newPoolDesc("active_conns", "Число открытых соединений пула, включая свободные")
+	idleConnsDesc    = newPoolDesc("idle_conns", "Число свободных соединений пула")
+	waitCountDesc    = newPoolDesc("waits_total", "Число ожиданий соединения при исчерпанном пуле")
+	waitDurationDesc = newPoolDesc("wait_duration_seconds_total", "Суммарное время ожидания соединений пула")
+)
+
+func newPoolDesc(name, help string) *prometheus.Desc {
+	return prometheus.NewDesc(prometheus.BuildFQName(metric.Namespace, "redis_pool", name), help, nil, nil)
+}
+
+type poolCollector struct {
+	pool *redis.Pool
+}
+
+// NewPoolCollector создает коллектор, который отдает статистику пула соединений redis в момент сбора метрик
+func NewPoolCollector(pool *redis.Pool) prometheus.Collector {
+	return &poolCollector{
+		pool: pool,
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- activeConnsDesc
+	ch <- idleConnsDesc
+	ch <- waitCountDesc
+	ch <- waitDurationDesc
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.pool.Stats()
+
+	ch <- prometheus.MustNewConstMetric(activeConnsDesc, prometheus.GaugeValue, float64(stats.ActiveCount))
+	ch <- prometheus.MustNewConstMetric(idleConnsDesc, prometheus.GaugeValue, float64(stats.IdleCount))
+	ch <- prometheus.MustNewConstMetric(waitCountDesc, prometheus.CounterValue, float64(stats.WaitCount))
+	ch <- prometheus.MustNewConstMetric(waitDurationDesc, prometheus.CounterValue, stats.WaitDuration.Seconds())
+}
@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+	"sync"
+)
+
+type masterReadKey struct{}
+
+type afterCommitKey struct{}
+
+// afterCommitHooks функции, отложенные до коммита транзакции
+type afterCommitHooks struct {
+	mu    sync.Mutex
+	hooks []func(ctx context.Context)
+}
+
+// ContextWithMasterRead помечает контекст, чтобы чтение вне транзакции выполнялось на мастере.
+// Нужно, когда читаются только что записанные данные, которые реплика могла еще не получить
+func ContextWithMasterRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, masterReadKey{}, true)
+}
+
+// MasterReadFromContext проверяет, что чтение должно выполняться на мастере
+func MasterReadFromContext(ctx context.Context) bool {
+	masterRead, _ := ctx.Value(masterReadKey{}).(bool)
+	return masterRead
+}
+
+// AfterCommit откладывает f до коммита транзакции, в которой выполняется ctx.
+// Если транзакция откатится, f не выполняется. Вне транзакции f выполняется сразу
+func AfterCommit(ctx context.Context, f func(ctx context.Context)) {
+	hooks, ok := ctx.Value(afterCommitKey{}).(*afterCommitHooks)
+	if !ok {
+		f(ctx)
+		return
+	}
+
+	hooks.mu.Lock()
+	hooks.hooks = append(hooks.hooks, f)
+	hooks.mu.Unlock()
+}
+
+// InTransaction проверяет, что ctx выполняется в транзакции менеджера транзакций
+func InTransaction(ctx context.Context) bool {
+	_, ok := ctx.Value(afterCommitKey{}).(*afterCommitHooks)
+	return ok
+}
+
+// ContextWithAfterCommit возвращает контекст, в котором AfterCommit откладывает функции,
+// и функцию, выполняющую отложенные функции. Используется менеджером транзакций
+func ContextWithAfterCommit(ctx context.Context) (context.Context, func(ctx context.Context)) {
+	hooks := &afterCommitHooks{}
+
+	return context.WithValue(ctx, afterCommitKey{}, hooks), func(ctx context.Context) {
+		hooks.mu.Lock()
+		defer hooks.mu.Unlock()
+
+		for _, f := range hooks.hooks {
+			f(ctx)
+		}
+	}
+}
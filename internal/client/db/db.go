@@ -10,19 +10,63 @@ import (
 // Handler - функция, которая выполняется в транзакции
 type Handler func(ctx context.Context) error
 
-// Client клиент для работы с БД
+// Client клиент для работы с БД.
+// Replica возвращает DB для чтения, которое допускает отставание от мастера: ScanOneContext и ScanAllContext
+// вне транзакции выполняются на реплике, если она настроена и доступна и контекст не помечен ContextWithMasterRead,
+// остальные запросы - на мастере
 type Client interface {
 	DB() DB
+	Replica() DB
 	Close() error
 }
 
-// TxManager менеджер транзакций, который выполняет указанный пользователем обработчик в транзакции
+// TxManager менеджер транзакций, который выполняет указанный пользователем обработчик в транзакции.
+// Вложенный вызов выполняет обработчик в точке сохранения внешней транзакции и наследует ее уровень изоляции
 type TxManager interface {
-	ReadCommitted(ctx context.Context, f Handler) error
+	ReadCommitted(ctx context.Context, f Handler, opts ...TxOption) error
+	RepeatableRead(ctx context.Context, f Handler, opts ...TxOption) error
+	Serializable(ctx context.Context, f Handler, opts ...TxOption) error
 }
 
-// Query обертка над запросом, хранящая имя запроса и сам запрос
-// Имя запроса используется для логирования и потенциально может использоваться еще где-то, например, для трейсинга
+// TxOptions дополнительные параметры транзакции
+type TxOptions struct {
+	// ReadOnly транзакция только для чтения
+	ReadOnly bool
+	// Deferrable serializable транзакция только для чтения ждет снимка, который не приведет к ошибке сериализации
+	Deferrable bool
+	// RetrySafe обработчик можно выполнить повторно целиком, если транзакция завершилась
+	// ошибкой сериализации или взаимоблокировкой
+	RetrySafe bool
+}
+
+// TxOption задает параметр транзакции
+type TxOption func(opts *TxOptions)
+
+// ReadOnly запускает транзакцию только для чтения
+func ReadOnly() TxOption {
+	return func(opts *TxOptions) {
+		opts.ReadOnly = true
+	}
+}
+
+// Deferrable запускает отложенную транзакцию, действует только вместе с Serializable и ReadOnly
+func Deferrable() TxOption {
+	return func(opts *TxOptions) {
+		opts.Deferrable = true
+	}
+}
+
+// RetrySafe помечает обработчик безопасным для повтора: он не имеет побочных эффектов вне транзакции.
+// Повторяется только внешняя транзакция, вложенная завершается вместе с ней
+func RetrySafe() TxOption {
+	return func(opts *TxOptions) {
+		opts.RetrySafe = true
+	}
+}
+
+// Query обертка над запросом, хранящая имя запроса и сам запрос.
+// Имя запроса используется в логах, как метка метрик длительности и как имя span трейсинга,
+// поэтому оно должно быть постоянным и не содержать значений аргументов
 type Query struct {
 	Name     string
 	QueryRaw string
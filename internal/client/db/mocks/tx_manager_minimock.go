@@ -0,0 +1,1220 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/ipv02/auth/internal/client/db.TxManager -o tx_manager_minimock.go -n TxManagerMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_db "github.com/ipv02/auth/internal/client/db"
+)
+
+// TxManagerMock implements mm_db.TxManager
+type TxManagerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcReadCommitted          func(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption) (err error)
+	funcReadCommittedOrigin    string
+	inspectFuncReadCommitted   func(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption)
+	afterReadCommittedCounter  uint64
+	beforeReadCommittedCounter uint64
+	ReadCommittedMock          mTxManagerMockReadCommitted
+
+	funcRepeatableRead          func(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption) (err error)
+	funcRepeatableReadOrigin    string
+	inspectFuncRepeatableRead   func(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption)
+	afterRepeatableReadCounter  uint64
+	beforeRepeatableReadCounter uint64
+	RepeatableReadMock          mTxManagerMockRepeatableRead
+
+	funcSerializable          func(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption) (err error)
+	funcSerializableOrigin    string
+	inspectFuncSerializable   func(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption)
+	afterSerializableCounter  uint64
+	beforeSerializableCounter uint64
+	SerializableMock          mTxManagerMockSerializable
+}
+
+// NewTxManagerMock returns a mock for mm_db.TxManager
+func NewTxManagerMock(t minimock.Tester) *TxManagerMock {
+	m := &TxManagerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ReadCommittedMock = mTxManagerMockReadCommitted{mock: m}
+	m.ReadCommittedMock.callArgs = []*TxManagerMockReadCommittedParams{}
+
+	m.RepeatableReadMock = mTxManagerMockRepeatableRead{mock: m}
+	m.RepeatableReadMock.callArgs = []*TxManagerMockRepeatableReadParams{}
+
+	m.SerializableMock = mTxManagerMockSerializable{mock: m}
+	m.SerializableMock.callArgs = []*TxManagerMockSerializableParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mTxManagerMockReadCommitted struct {
+	optional           bool
+	mock               *TxManagerMock
+	defaultExpectation *TxManagerMockReadCommittedExpectation
+	expectations       []*TxManagerMockReadCommittedExpectation
+
+	callArgs []*TxManagerMockReadCommittedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TxManagerMockReadCommittedExpectation specifies expectation struct of the TxManager.ReadCommitted
+type TxManagerMockReadCommittedExpectation struct {
+	mock               *TxManagerMock
+	params             *TxManagerMockReadCommittedParams
+	paramPtrs          *TxManagerMockReadCommittedParamPtrs
+	expectationOrigins TxManagerMockReadCommittedExpectationOrigins
+	results            *TxManagerMockReadCommittedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TxManagerMockReadCommittedParams contains parameters of the TxManager.ReadCommitted
+type TxManagerMockReadCommittedParams struct {
+	ctx  context.Context
+	f    mm_db.Handler
+	opts []mm_db.TxOption
+}
+
+// TxManagerMockReadCommittedParamPtrs contains pointers to parameters of the TxManager.ReadCommitted
+type TxManagerMockReadCommittedParamPtrs struct {
+	ctx  *context.Context
+	f    *mm_db.Handler
+	opts *[]mm_db.TxOption
+}
+
+// TxManagerMockReadCommittedResults contains results of the TxManager.ReadCommitted
+type TxManagerMockReadCommittedResults struct {
+	err error
+}
+
+// TxManagerMockReadCommittedOrigins contains origins of expectations of the TxManager.ReadCommitted
+type TxManagerMockReadCommittedExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originF    string
+	originOpts string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReadCommitted *mTxManagerMockReadCommitted) Optional() *mTxManagerMockReadCommitted {
+	mmReadCommitted.optional = true
+	return mmReadCommitted
+}
+
+// Expect sets up expected params for TxManager.ReadCommitted
+func (mmReadCommitted *mTxManagerMockReadCommitted) Expect(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption) *mTxManagerMockReadCommitted {
+	if mmReadCommitted.mock.funcReadCommitted != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by Set")
+	}
+
+	if mmReadCommitted.defaultExpectation == nil {
+		mmReadCommitted.defaultExpectation = &TxManagerMockReadCommittedExpectation{}
+	}
+
+	if mmReadCommitted.defaultExpectation.paramPtrs != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by ExpectParams functions")
+	}
+
+	mmReadCommitted.defaultExpectation.params = &TxManagerMockReadCommittedParams{ctx, f, opts}
+	mmReadCommitted.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReadCommitted.expectations {
+		if minimock.Equal(e.params, mmReadCommitted.defaultExpectation.params) {
+			mmReadCommitted.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReadCommitted.defaultExpectation.params)
+		}
+	}
+
+	return mmReadCommitted
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TxManager.ReadCommitted
+func (mmReadCommitted *mTxManagerMockReadCommitted) ExpectCtxParam1(ctx context.Context) *mTxManagerMockReadCommitted {
+	if mmReadCommitted.mock.funcReadCommitted != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by Set")
+	}
+
+	if mmReadCommitted.defaultExpectation == nil {
+		mmReadCommitted.defaultExpectation = &TxManagerMockReadCommittedExpectation{}
+	}
+
+	if mmReadCommitted.defaultExpectation.params != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by Expect")
+	}
+
+	if mmReadCommitted.defaultExpectation.paramPtrs == nil {
+		mmReadCommitted.defaultExpectation.paramPtrs = &TxManagerMockReadCommittedParamPtrs{}
+	}
+	mmReadCommitted.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReadCommitted.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReadCommitted
+}
+
+// ExpectFParam2 sets up expected param f for TxManager.ReadCommitted
+func (mmReadCommitted *mTxManagerMockReadCommitted) ExpectFParam2(f mm_db.Handler) *mTxManagerMockReadCommitted {
+	if mmReadCommitted.mock.funcReadCommitted != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by Set")
+	}
+
+	if mmReadCommitted.defaultExpectation == nil {
+		mmReadCommitted.defaultExpectation = &TxManagerMockReadCommittedExpectation{}
+	}
+
+	if mmReadCommitted.defaultExpectation.params != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by Expect")
+	}
+
+	if mmReadCommitted.defaultExpectation.paramPtrs == nil {
+		mmReadCommitted.defaultExpectation.paramPtrs = &TxManagerMockReadCommittedParamPtrs{}
+	}
+	mmReadCommitted.defaultExpectation.paramPtrs.f = &f
+	mmReadCommitted.defaultExpectation.expectationOrigins.originF = minimock.CallerInfo(1)
+
+	return mmReadCommitted
+}
+
+// ExpectOptsParam3 sets up expected param opts for TxManager.ReadCommitted
+func (mmReadCommitted *mTxManagerMockReadCommitted) ExpectOptsParam3(opts ...mm_db.TxOption) *mTxManagerMockReadCommitted {
+	if mmReadCommitted.mock.funcReadCommitted != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by Set")
+	}
+
+	if mmReadCommitted.defaultExpectation == nil {
+		mmReadCommitted.defaultExpectation = &TxManagerMockReadCommittedExpectation{}
+	}
+
+	if mmReadCommitted.defaultExpectation.params != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by Expect")
+	}
+
+	if mmReadCommitted.defaultExpectation.paramPtrs == nil {
+		mmReadCommitted.defaultExpectation.paramPtrs = &TxManagerMockReadCommittedParamPtrs{}
+	}
+	mmReadCommitted.defaultExpectation.paramPtrs.opts = &opts
+	mmReadCommitted.defaultExpectation.expectationOrigins.originOpts = minimock.CallerInfo(1)
+
+	return mmReadCommitted
+}
+
+// Inspect accepts an inspector function that has same arguments as the TxManager.ReadCommitted
+func (mmReadCommitted *mTxManagerMockReadCommitted) Inspect(f func(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption)) *mTxManagerMockReadCommitted {
+	if mmReadCommitted.mock.inspectFuncReadCommitted != nil {
+		mmReadCommitted.mock.t.Fatalf("Inspect function is already set for TxManagerMock.ReadCommitted")
+	}
+
+	mmReadCommitted.mock.inspectFuncReadCommitted = f
+
+	return mmReadCommitted
+}
+
+// Return sets up results that will be returned by TxManager.ReadCommitted
+func (mmReadCommitted *mTxManagerMockReadCommitted) Return(err error) *TxManagerMock {
+	if mmReadCommitted.mock.funcReadCommitted != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by Set")
+	}
+
+	if mmReadCommitted.defaultExpectation == nil {
+		mmReadCommitted.defaultExpectation = &TxManagerMockReadCommittedExpectation{mock: mmReadCommitted.mock}
+	}
+	mmReadCommitted.defaultExpectation.results = &TxManagerMockReadCommittedResults{err}
+	mmReadCommitted.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReadCommitted.mock
+}
+
+// Set uses given function f to mock the TxManager.ReadCommitted method
+func (mmReadCommitted *mTxManagerMockReadCommitted) Set(f func(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption) (err error)) *TxManagerMock {
+	if mmReadCommitted.defaultExpectation != nil {
+		mmReadCommitted.mock.t.Fatalf("Default expectation is already set for the TxManager.ReadCommitted method")
+	}
+
+	if len(mmReadCommitted.expectations) > 0 {
+		mmReadCommitted.mock.t.Fatalf("Some expectations are already set for the TxManager.ReadCommitted method")
+	}
+
+	mmReadCommitted.mock.funcReadCommitted = f
+	mmReadCommitted.mock.funcReadCommittedOrigin = minimock.CallerInfo(1)
+	return mmReadCommitted.mock
+}
+
+// When sets expectation for the TxManager.ReadCommitted which will trigger the result defined by the following
+// Then helper
+func (mmReadCommitted *mTxManagerMockReadCommitted) When(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption) *TxManagerMockReadCommittedExpectation {
+	if mmReadCommitted.mock.funcReadCommitted != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by Set")
+	}
+
+	expectation := &TxManagerMockReadCommittedExpectation{
+		mock:               mmReadCommitted.mock,
+		params:             &TxManagerMockReadCommittedParams{ctx, f, opts},
+		expectationOrigins: TxManagerMockReadCommittedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReadCommitted.expectations = append(mmReadCommitted.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TxManager.ReadCommitted return parameters for the expectation previously defined by the When method
+func (e *TxManagerMockReadCommittedExpectation) Then(err error) *TxManagerMock {
+	e.results = &TxManagerMockReadCommittedResults{err}
+	return e.mock
+}
+
+// Times sets number of times TxManager.ReadCommitted should be invoked
+func (mmReadCommitted *mTxManagerMockReadCommitted) Times(n uint64) *mTxManagerMockReadCommitted {
+	if n == 0 {
+		mmReadCommitted.mock.t.Fatalf("Times of TxManagerMock.ReadCommitted mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReadCommitted.expectedInvocations, n)
+	mmReadCommitted.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReadCommitted
+}
+
+func (mmReadCommitted *mTxManagerMockReadCommitted) invocationsDone() bool {
+	if len(mmReadCommitted.expectations) == 0 && mmReadCommitted.defaultExpectation == nil && mmReadCommitted.mock.funcReadCommitted == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReadCommitted.mock.afterReadCommittedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReadCommitted.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReadCommitted implements mm_db.TxManager
+func (mmReadCommitted *TxManagerMock) ReadCommitted(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption) (err error) {
+	mm_atomic.AddUint64(&mmReadCommitted.beforeReadCommittedCounter, 1)
+	defer mm_atomic.AddUint64(&mmReadCommitted.afterReadCommittedCounter, 1)
+
+	mmReadCommitted.t.Helper()
+
+	if mmReadCommitted.inspectFuncReadCommitted != nil {
+		mmReadCommitted.inspectFuncReadCommitted(ctx, f, opts...)
+	}
+
+	mm_params := TxManagerMockReadCommittedParams{ctx, f, opts}
+
+	// Record call args
+	mmReadCommitted.ReadCommittedMock.mutex.Lock()
+	mmReadCommitted.ReadCommittedMock.callArgs = append(mmReadCommitted.ReadCommittedMock.callArgs, &mm_params)
+	mmReadCommitted.ReadCommittedMock.mutex.Unlock()
+
+	for _, e := range mmReadCommitted.ReadCommittedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReadCommitted.ReadCommittedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReadCommitted.ReadCommittedMock.defaultExpectation.Counter, 1)
+		mm_want := mmReadCommitted.ReadCommittedMock.defaultExpectation.params
+		mm_want_ptrs := mmReadCommitted.ReadCommittedMock.defaultExpectation.paramPtrs
+
+		mm_got := TxManagerMockReadCommittedParams{ctx, f, opts}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReadCommitted.t.Errorf("TxManagerMock.ReadCommitted got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReadCommitted.ReadCommittedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.f != nil && !minimock.Equal(*mm_want_ptrs.f, mm_got.f) {
+				mmReadCommitted.t.Errorf("TxManagerMock.ReadCommitted got unexpected parameter f, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReadCommitted.ReadCommittedMock.defaultExpectation.expectationOrigins.originF, *mm_want_ptrs.f, mm_got.f, minimock.Diff(*mm_want_ptrs.f, mm_got.f))
+			}
+
+			if mm_want_ptrs.opts != nil && !minimock.Equal(*mm_want_ptrs.opts, mm_got.opts) {
+				mmReadCommitted.t.Errorf("TxManagerMock.ReadCommitted got unexpected parameter opts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReadCommitted.ReadCommittedMock.defaultExpectation.expectationOrigins.originOpts, *mm_want_ptrs.opts, mm_got.opts, minimock.Diff(*mm_want_ptrs.opts, mm_got.opts))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReadCommitted.t.Errorf("TxManagerMock.ReadCommitted got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReadCommitted.ReadCommittedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReadCommitted.ReadCommittedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReadCommitted.t.Fatal("No results are set for the TxManagerMock.ReadCommitted")
+		}
+		return (*mm_results).err
+	}
+	if mmReadCommitted.funcReadCommitted != nil {
+		return mmReadCommitted.funcReadCommitted(ctx, f, opts...)
+	}
+	mmReadCommitted.t.Fatalf("Unexpected call to TxManagerMock.ReadCommitted. %v %v %v", ctx, f, opts)
+	return
+}
+
+// ReadCommittedAfterCounter returns a count of finished TxManagerMock.ReadCommitted invocations
+func (mmReadCommitted *TxManagerMock) ReadCommittedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReadCommitted.afterReadCommittedCounter)
+}
+
+// ReadCommittedBeforeCounter returns a count of TxManagerMock.ReadCommitted invocations
+func (mmReadCommitted *TxManagerMock) ReadCommittedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReadCommitted.beforeReadCommittedCounter)
+}
+
+// Calls returns a list of arguments used in each call to TxManagerMock.ReadCommitted.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReadCommitted *mTxManagerMockReadCommitted) Calls() []*TxManagerMockReadCommittedParams {
+	mmReadCommitted.mutex.RLock()
+
+	argCopy := make([]*TxManagerMockReadCommittedParams, len(mmReadCommitted.callArgs))
+	copy(argCopy, mmReadCommitted.callArgs)
+
+	mmReadCommitted.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReadCommittedDone returns true if the count of the ReadCommitted invocations corresponds
+// the number of defined expectations
+func (m *TxManagerMock) MinimockReadCommittedDone() bool {
+	if m.ReadCommittedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReadCommittedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReadCommittedMock.invocationsDone()
+}
+
+// MinimockReadCommittedInspect logs each unmet expectation
+func (m *TxManagerMock) MinimockReadCommittedInspect() {
+	for _, e := range m.ReadCommittedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TxManagerMock.ReadCommitted at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReadCommittedCounter := mm_atomic.LoadUint64(&m.afterReadCommittedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReadCommittedMock.defaultExpectation != nil && afterReadCommittedCounter < 1 {
+		if m.ReadCommittedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TxManagerMock.ReadCommitted at\n%s", m.ReadCommittedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TxManagerMock.ReadCommitted at\n%s with params: %#v", m.ReadCommittedMock.defaultExpectation.expectationOrigins.origin, *m.ReadCommittedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReadCommitted != nil && afterReadCommittedCounter < 1 {
+		m.t.Errorf("Expected call to TxManagerMock.ReadCommitted at\n%s", m.funcReadCommittedOrigin)
+	}
+
+	if !m.ReadCommittedMock.invocationsDone() && afterReadCommittedCounter > 0 {
+		m.t.Errorf("Expected %d calls to TxManagerMock.ReadCommitted at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReadCommittedMock.expectedInvocations), m.ReadCommittedMock.expectedInvocationsOrigin, afterReadCommittedCounter)
+	}
+}
+
+type mTxManagerMockRepeatableRead struct {
+	optional           bool
+	mock               *TxManagerMock
+	defaultExpectation *TxManagerMockRepeatableReadExpectation
+	expectations       []*TxManagerMockRepeatableReadExpectation
+
+	callArgs []*TxManagerMockRepeatableReadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TxManagerMockRepeatableReadExpectation specifies expectation struct of the TxManager.RepeatableRead
+type TxManagerMockRepeatableReadExpectation struct {
+	mock               *TxManagerMock
+	params             *TxManagerMockRepeatableReadParams
+	paramPtrs          *TxManagerMockRepeatableReadParamPtrs
+	expectationOrigins TxManagerMockRepeatableReadExpectationOrigins
+	results            *TxManagerMockRepeatableReadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TxManagerMockRepeatableReadParams contains parameters of the TxManager.RepeatableRead
+type TxManagerMockRepeatableReadParams struct {
+	ctx  context.Context
+	f    mm_db.Handler
+	opts []mm_db.TxOption
+}
+
+// TxManagerMockRepeatableReadParamPtrs contains pointers to parameters of the TxManager.RepeatableRead
+type TxManagerMockRepeatableReadParamPtrs struct {
+	ctx  *context.Context
+	f    *mm_db.Handler
+	opts *[]mm_db.TxOption
+}
+
+// TxManagerMockRepeatableReadResults contains results of the TxManager.RepeatableRead
+type TxManagerMockRepeatableReadResults struct {
+	err error
+}
+
+// TxManagerMockRepeatableReadOrigins contains origins of expectations of the TxManager.RepeatableRead
+type TxManagerMockRepeatableReadExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originF    string
+	originOpts string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) Optional() *mTxManagerMockRepeatableRead {
+	mmRepeatableRead.optional = true
+	return mmRepeatableRead
+}
+
+// Expect sets up expected params for TxManager.RepeatableRead
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) Expect(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption) *mTxManagerMockRepeatableRead {
+	if mmRepeatableRead.mock.funcRepeatableRead != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by Set")
+	}
+
+	if mmRepeatableRead.defaultExpectation == nil {
+		mmRepeatableRead.defaultExpectation = &TxManagerMockRepeatableReadExpectation{}
+	}
+
+	if mmRepeatableRead.defaultExpectation.paramPtrs != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by ExpectParams functions")
+	}
+
+	mmRepeatableRead.defaultExpectation.params = &TxManagerMockRepeatableReadParams{ctx, f, opts}
+	mmRepeatableRead.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRepeatableRead.expectations {
+		if minimock.Equal(e.params, mmRepeatableRead.defaultExpectation.params) {
+			mmRepeatableRead.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRepeatableRead.defaultExpectation.params)
+		}
+	}
+
+	return mmRepeatableRead
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TxManager.RepeatableRead
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) ExpectCtxParam1(ctx context.Context) *mTxManagerMockRepeatableRead {
+	if mmRepeatableRead.mock.funcRepeatableRead != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by Set")
+	}
+
+	if mmRepeatableRead.defaultExpectation == nil {
+		mmRepeatableRead.defaultExpectation = &TxManagerMockRepeatableReadExpectation{}
+	}
+
+	if mmRepeatableRead.defaultExpectation.params != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by Expect")
+	}
+
+	if mmRepeatableRead.defaultExpectation.paramPtrs == nil {
+		mmRepeatableRead.defaultExpectation.paramPtrs = &TxManagerMockRepeatableReadParamPtrs{}
+	}
+	mmRepeatableRead.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRepeatableRead.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRepeatableRead
+}
+
+// ExpectFParam2 sets up expected param f for TxManager.RepeatableRead
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) ExpectFParam2(f mm_db.Handler) *mTxManagerMockRepeatableRead {
+	if mmRepeatableRead.mock.funcRepeatableRead != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by Set")
+	}
+
+	if mmRepeatableRead.defaultExpectation == nil {
+		mmRepeatableRead.defaultExpectation = &TxManagerMockRepeatableReadExpectation{}
+	}
+
+	if mmRepeatableRead.defaultExpectation.params != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by Expect")
+	}
+
+	if mmRepeatableRead.defaultExpectation.paramPtrs == nil {
+		mmRepeatableRead.defaultExpectation.paramPtrs = &TxManagerMockRepeatableReadParamPtrs{}
+	}
+	mmRepeatableRead.defaultExpectation.paramPtrs.f = &f
+	mmRepeatableRead.defaultExpectation.expectationOrigins.originF = minimock.CallerInfo(1)
+
+	return mmRepeatableRead
+}
+
+// ExpectOptsParam3 sets up expected param opts for TxManager.RepeatableRead
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) ExpectOptsParam3(opts ...mm_db.TxOption) *mTxManagerMockRepeatableRead {
+	if mmRepeatableRead.mock.funcRepeatableRead != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by Set")
+	}
+
+	if mmRepeatableRead.defaultExpectation == nil {
+		mmRepeatableRead.defaultExpectation = &TxManagerMockRepeatableReadExpectation{}
+	}
+
+	if mmRepeatableRead.defaultExpectation.params != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by Expect")
+	}
+
+	if mmRepeatableRead.defaultExpectation.paramPtrs == nil {
+		mmRepeatableRead.defaultExpectation.paramPtrs = &TxManagerMockRepeatableReadParamPtrs{}
+	}
+	mmRepeatableRead.defaultExpectation.paramPtrs.opts = &opts
+	mmRepeatableRead.defaultExpectation.expectationOrigins.originOpts = minimock.CallerInfo(1)
+
+	return mmRepeatableRead
+}
+
+// Inspect accepts an inspector function that has same arguments as the TxManager.RepeatableRead
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) Inspect(f func(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption)) *mTxManagerMockRepeatableRead {
+	if mmRepeatableRead.mock.inspectFuncRepeatableRead != nil {
+		mmRepeatableRead.mock.t.Fatalf("Inspect function is already set for TxManagerMock.RepeatableRead")
+	}
+
+	mmRepeatableRead.mock.inspectFuncRepeatableRead = f
+
+	return mmRepeatableRead
+}
+
+// Return sets up results that will be returned by TxManager.RepeatableRead
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) Return(err error) *TxManagerMock {
+	if mmRepeatableRead.mock.funcRepeatableRead != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by Set")
+	}
+
+	if mmRepeatableRead.defaultExpectation == nil {
+		mmRepeatableRead.defaultExpectation = &TxManagerMockRepeatableReadExpectation{mock: mmRepeatableRead.mock}
+	}
+	mmRepeatableRead.defaultExpectation.results = &TxManagerMockRepeatableReadResults{err}
+	mmRepeatableRead.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRepeatableRead.mock
+}
+
+// Set uses given function f to mock the TxManager.RepeatableRead method
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) Set(f func(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption) (err error)) *TxManagerMock {
+	if mmRepeatableRead.defaultExpectation != nil {
+		mmRepeatableRead.mock.t.Fatalf("Default expectation is already set for the TxManager.RepeatableRead method")
+	}
+
+	if len(mmRepeatableRead.expectations) > 0 {
+		mmRepeatableRead.mock.t.Fatalf("Some expectations are already set for the TxManager.RepeatableRead method")
+	}
+
+	mmRepeatableRead.mock.funcRepeatableRead = f
+	mmRepeatableRead.mock.funcRepeatableReadOrigin = minimock.CallerInfo(1)
+	return mmRepeatableRead.mock
+}
+
+// When sets expectation for the TxManager.RepeatableRead which will trigger the result defined by the following
+// Then helper
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) When(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption) *TxManagerMockRepeatableReadExpectation {
+	if mmRepeatableRead.mock.funcRepeatableRead != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by Set")
+	}
+
+	expectation := &TxManagerMockRepeatableReadExpectation{
+		mock:               mmRepeatableRead.mock,
+		params:             &TxManagerMockRepeatableReadParams{ctx, f, opts},
+		expectationOrigins: TxManagerMockRepeatableReadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRepeatableRead.expectations = append(mmRepeatableRead.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TxManager.RepeatableRead return parameters for the expectation previously defined by the When method
+func (e *TxManagerMockRepeatableReadExpectation) Then(err error) *TxManagerMock {
+	e.results = &TxManagerMockRepeatableReadResults{err}
+	return e.mock
+}
+
+// Times sets number of times TxManager.RepeatableRead should be invoked
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) Times(n uint64) *mTxManagerMockRepeatableRead {
+	if n == 0 {
+		mmRepeatableRead.mock.t.Fatalf("Times of TxManagerMock.RepeatableRead mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRepeatableRead.expectedInvocations, n)
+	mmRepeatableRead.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRepeatableRead
+}
+
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) invocationsDone() bool {
+	if len(mmRepeatableRead.expectations) == 0 && mmRepeatableRead.defaultExpectation == nil && mmRepeatableRead.mock.funcRepeatableRead == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRepeatableRead.mock.afterRepeatableReadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRepeatableRead.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RepeatableRead implements mm_db.TxManager
+func (mmRepeatableRead *TxManagerMock) RepeatableRead(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption) (err error) {
+	mm_atomic.AddUint64(&mmRepeatableRead.beforeRepeatableReadCounter, 1)
+	defer mm_atomic.AddUint64(&mmRepeatableRead.afterRepeatableReadCounter, 1)
+
+	mmRepeatableRead.t.Helper()
+
+	if mmRepeatableRead.inspectFuncRepeatableRead != nil {
+		mmRepeatableRead.inspectFuncRepeatableRead(ctx, f, opts...)
+	}
+
+	mm_params := TxManagerMockRepeatableReadParams{ctx, f, opts}
+
+	// Record call args
+	mmRepeatableRead.RepeatableReadMock.mutex.Lock()
+	mmRepeatableRead.RepeatableReadMock.callArgs = append(mmRepeatableRead.RepeatableReadMock.callArgs, &mm_params)
+	mmRepeatableRead.RepeatableReadMock.mutex.Unlock()
+
+	for _, e := range mmRepeatableRead.RepeatableReadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRepeatableRead.RepeatableReadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRepeatableRead.RepeatableReadMock.defaultExpectation.Counter, 1)
+		mm_want := mmRepeatableRead.RepeatableReadMock.defaultExpectation.params
+		mm_want_ptrs := mmRepeatableRead.RepeatableReadMock.defaultExpectation.paramPtrs
+
+		mm_got := TxManagerMockRepeatableReadParams{ctx, f, opts}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRepeatableRead.t.Errorf("TxManagerMock.RepeatableRead got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRepeatableRead.RepeatableReadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.f != nil && !minimock.Equal(*mm_want_ptrs.f, mm_got.f) {
+				mmRepeatableRead.t.Errorf("TxManagerMock.RepeatableRead got unexpected parameter f, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRepeatableRead.RepeatableReadMock.defaultExpectation.expectationOrigins.originF, *mm_want_ptrs.f, mm_got.f, minimock.Diff(*mm_want_ptrs.f, mm_got.f))
+			}
+
+			if mm_want_ptrs.opts != nil && !minimock.Equal(*mm_want_ptrs.opts, mm_got.opts) {
+				mmRepeatableRead.t.Errorf("TxManagerMock.RepeatableRead got unexpected parameter opts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRepeatableRead.RepeatableReadMock.defaultExpectation.expectationOrigins.originOpts, *mm_want_ptrs.opts, mm_got.opts, minimock.Diff(*mm_want_ptrs.opts, mm_got.opts))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRepeatableRead.t.Errorf("TxManagerMock.RepeatableRead got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRepeatableRead.RepeatableReadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRepeatableRead.RepeatableReadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRepeatableRead.t.Fatal("No results are set for the TxManagerMock.RepeatableRead")
+		}
+		return (*mm_results).err
+	}
+	if mmRepeatableRead.funcRepeatableRead != nil {
+		return mmRepeatableRead.funcRepeatableRead(ctx, f, opts...)
+	}
+	mmRepeatableRead.t.Fatalf("Unexpected call to TxManagerMock.RepeatableRead. %v %v %v", ctx, f, opts)
+	return
+}
+
+// RepeatableReadAfterCounter returns a count of finished TxManagerMock.RepeatableRead invocations
+func (mmRepeatableRead *TxManagerMock) RepeatableReadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRepeatableRead.afterRepeatableReadCounter)
+}
+
+// RepeatableReadBeforeCounter returns a count of TxManagerMock.RepeatableRead invocations
+func (mmRepeatableRead *TxManagerMock) RepeatableReadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRepeatableRead.beforeRepeatableReadCounter)
+}
+
+// Calls returns a list of arguments used in each call to TxManagerMock.RepeatableRead.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) Calls() []*TxManagerMockRepeatableReadParams {
+	mmRepeatableRead.mutex.RLock()
+
+	argCopy := make([]*TxManagerMockRepeatableReadParams, len(mmRepeatableRead.callArgs))
+	copy(argCopy, mmRepeatableRead.callArgs)
+
+	mmRepeatableRead.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRepeatableReadDone returns true if the count of the RepeatableRead invocations corresponds
+// the number of defined expectations
+func (m *TxManagerMock) MinimockRepeatableReadDone() bool {
+	if m.RepeatableReadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RepeatableReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RepeatableReadMock.invocationsDone()
+}
+
+// MinimockRepeatableReadInspect logs each unmet expectation
+func (m *TxManagerMock) MinimockRepeatableReadInspect() {
+	for _, e := range m.RepeatableReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TxManagerMock.RepeatableRead at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRepeatableReadCounter := mm_atomic.LoadUint64(&m.afterRepeatableReadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RepeatableReadMock.defaultExpectation != nil && afterRepeatableReadCounter < 1 {
+		if m.RepeatableReadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TxManagerMock.RepeatableRead at\n%s", m.RepeatableReadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TxManagerMock.RepeatableRead at\n%s with params: %#v", m.RepeatableReadMock.defaultExpectation.expectationOrigins.origin, *m.RepeatableReadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRepeatableRead != nil && afterRepeatableReadCounter < 1 {
+		m.t.Errorf("Expected call to TxManagerMock.RepeatableRead at\n%s", m.funcRepeatableReadOrigin)
+	}
+
+	if !m.RepeatableReadMock.invocationsDone() && afterRepeatableReadCounter > 0 {
+		m.t.Errorf("Expected %d calls to TxManagerMock.RepeatableRead at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RepeatableReadMock.expectedInvocations), m.RepeatableReadMock.expectedInvocationsOrigin, afterRepeatableReadCounter)
+	}
+}
+
+type mTxManagerMockSerializable struct {
+	optional           bool
+	mock               *TxManagerMock
+	defaultExpectation *TxManagerMockSerializableExpectation
+	expectations       []*TxManagerMockSerializableExpectation
+
+	callArgs []*TxManagerMockSerializableParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TxManagerMockSerializableExpectation specifies expectation struct of the TxManager.Serializable
+type TxManagerMockSerializableExpectation struct {
+	mock               *TxManagerMock
+	params             *TxManagerMockSerializableParams
+	paramPtrs          *TxManagerMockSerializableParamPtrs
+	expectationOrigins TxManagerMockSerializableExpectationOrigins
+	results            *TxManagerMockSerializableResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TxManagerMockSerializableParams contains parameters of the TxManager.Serializable
+type TxManagerMockSerializableParams struct {
+	ctx  context.Context
+	f    mm_db.Handler
+	opts []mm_db.TxOption
+}
+
+// TxManagerMockSerializableParamPtrs contains pointers to parameters of the TxManager.Serializable
+type TxManagerMockSerializableParamPtrs struct {
+	ctx  *context.Context
+	f    *mm_db.Handler
+	opts *[]mm_db.TxOption
+}
+
+// TxManagerMockSerializableResults contains results of the TxManager.Serializable
+type TxManagerMockSerializableResults struct {
+	err error
+}
+
+// TxManagerMockSerializableOrigins contains origins of expectations of the TxManager.Serializable
+type TxManagerMockSerializableExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originF    string
+	originOpts string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSerializable *mTxManagerMockSerializable) Optional() *mTxManagerMockSerializable {
+	mmSerializable.optional = true
+	return mmSerializable
+}
+
+// Expect sets up expected params for TxManager.Serializable
+func (mmSerializable *mTxManagerMockSerializable) Expect(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption) *mTxManagerMockSerializable {
+	if mmSerializable.mock.funcSerializable != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by Set")
+	}
+
+	if mmSerializable.defaultExpectation == nil {
+		mmSerializable.defaultExpectation = &TxManagerMockSerializableExpectation{}
+	}
+
+	if mmSerializable.defaultExpectation.paramPtrs != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by ExpectParams functions")
+	}
+
+	mmSerializable.defaultExpectation.params = &TxManagerMockSerializableParams{ctx, f, opts}
+	mmSerializable.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSerializable.expectations {
+		if minimock.Equal(e.params, mmSerializable.defaultExpectation.params) {
+			mmSerializable.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSerializable.defaultExpectation.params)
+		}
+	}
+
+	return mmSerializable
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TxManager.Serializable
+func (mmSerializable *mTxManagerMockSerializable) ExpectCtxParam1(ctx context.Context) *mTxManagerMockSerializable {
+	if mmSerializable.mock.funcSerializable != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by Set")
+	}
+
+	if mmSerializable.defaultExpectation == nil {
+		mmSerializable.defaultExpectation = &TxManagerMockSerializableExpectation{}
+	}
+
+	if mmSerializable.defaultExpectation.params != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by Expect")
+	}
+
+	if mmSerializable.defaultExpectation.paramPtrs == nil {
+		mmSerializable.defaultExpectation.paramPtrs = &TxManagerMockSerializableParamPtrs{}
+	}
+	mmSerializable.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSerializable.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSerializable
+}
+
+// ExpectFParam2 sets up expected param f for TxManager.Serializable
+func (mmSerializable *mTxManagerMockSerializable) ExpectFParam2(f mm_db.Handler) *mTxManagerMockSerializable {
+	if mmSerializable.mock.funcSerializable != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by Set")
+	}
+
+	if mmSerializable.defaultExpectation == nil {
+		mmSerializable.defaultExpectation = &TxManagerMockSerializableExpectation{}
+	}
+
+	if mmSerializable.defaultExpectation.params != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by Expect")
+	}
+
+	if mmSerializable.defaultExpectation.paramPtrs == nil {
+		mmSerializable.defaultExpectation.paramPtrs = &TxManagerMockSerializableParamPtrs{}
+	}
+	mmSerializable.defaultExpectation.paramPtrs.f = &f
+	mmSerializable.defaultExpectation.expectationOrigins.originF = minimock.CallerInfo(1)
+
+	return mmSerializable
+}
+
+// ExpectOptsParam3 sets up expected param opts for TxManager.Serializable
+func (mmSerializable *mTxManagerMockSerializable) ExpectOptsParam3(opts ...mm_db.TxOption) *mTxManagerMockSerializable {
+	if mmSerializable.mock.funcSerializable != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by Set")
+	}
+
+	if mmSerializable.defaultExpectation == nil {
+		mmSerializable.defaultExpectation = &TxManagerMockSerializableExpectation{}
+	}
+
+	if mmSerializable.defaultExpectation.params != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by Expect")
+	}
+
+	if mmSerializable.defaultExpectation.paramPtrs == nil {
+		mmSerializable.defaultExpectation.paramPtrs = &TxManagerMockSerializableParamPtrs{}
+	}
+	mmSerializable.defaultExpectation.paramPtrs.opts = &opts
+	mmSerializable.defaultExpectation.expectationOrigins.originOpts = minimock.CallerInfo(1)
+
+	return mmSerializable
+}
+
+// Inspect accepts an inspector function that has same arguments as the TxManager.Serializable
+func (mmSerializable *mTxManagerMockSerializable) Inspect(f func(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption)) *mTxManagerMockSerializable {
+	if mmSerializable.mock.inspectFuncSerializable != nil {
+		mmSerializable.mock.t.Fatalf("Inspect function is already set for TxManagerMock.Serializable")
+	}
+
+	mmSerializable.mock.inspectFuncSerializable = f
+
+	return mmSerializable
+}
+
+// Return sets up results that will be returned by TxManager.Serializable
+func (mmSerializable *mTxManagerMockSerializable) Return(err error) *TxManagerMock {
+	if mmSerializable.mock.funcSerializable != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by Set")
+	}
+
+	if mmSerializable.defaultExpectation == nil {
+		mmSerializable.defaultExpectation = &TxManagerMockSerializableExpectation{mock: mmSerializable.mock}
+	}
+	mmSerializable.defaultExpectation.results = &TxManagerMockSerializableResults{err}
+	mmSerializable.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSerializable.mock
+}
+
+// Set uses given function f to mock the TxManager.Serializable method
+func (mmSerializable *mTxManagerMockSerializable) Set(f func(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption) (err error)) *TxManagerMock {
+	if mmSerializable.defaultExpectation != nil {
+		mmSerializable.mock.t.Fatalf("Default expectation is already set for the TxManager.Serializable method")
+	}
+
+	if len(mmSerializable.expectations) > 0 {
+		mmSerializable.mock.t.Fatalf("Some expectations are already set for the TxManager.Serializable method")
+	}
+
+	mmSerializable.mock.funcSerializable = f
+	mmSerializable.mock.funcSerializableOrigin = minimock.CallerInfo(1)
+	return mmSerializable.mock
+}
+
+// When sets expectation for the TxManager.Serializable which will trigger the result defined by the following
+// Then helper
+func (mmSerializable *mTxManagerMockSerializable) When(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption) *TxManagerMockSerializableExpectation {
+	if mmSerializable.mock.funcSerializable != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by Set")
+	}
+
+	expectation := &TxManagerMockSerializableExpectation{
+		mock:               mmSerializable.mock,
+		params:             &TxManagerMockSerializableParams{ctx, f, opts},
+		expectationOrigins: TxManagerMockSerializableExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSerializable.expectations = append(mmSerializable.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TxManager.Serializable return parameters for the expectation previously defined by the When method
+func (e *TxManagerMockSerializableExpectation) Then(err error) *TxManagerMock {
+	e.results = &TxManagerMockSerializableResults{err}
+	return e.mock
+}
+
+// Times sets number of times TxManager.Serializable should be invoked
+func (mmSerializable *mTxManagerMockSerializable) Times(n uint64) *mTxManagerMockSerializable {
+	if n == 0 {
+		mmSerializable.mock.t.Fatalf("Times of TxManagerMock.Serializable mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSerializable.expectedInvocations, n)
+	mmSerializable.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSerializable
+}
+
+func (mmSerializable *mTxManagerMockSerializable) invocationsDone() bool {
+	if len(mmSerializable.expectations) == 0 && mmSerializable.defaultExpectation == nil && mmSerializable.mock.funcSerializable == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSerializable.mock.afterSerializableCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSerializable.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Serializable implements mm_db.TxManager
+func (mmSerializable *TxManagerMock) Serializable(ctx context.Context, f mm_db.Handler, opts ...mm_db.TxOption) (err error) {
+	mm_atomic.AddUint64(&mmSerializable.beforeSerializableCounter, 1)
+	defer mm_atomic.AddUint64(&mmSerializable.afterSerializableCounter, 1)
+
+	mmSerializable.t.Helper()
+
+	if mmSerializable.inspectFuncSerializable != nil {
+		mmSerializable.inspectFuncSerializable(ctx, f, opts...)
+	}
+
+	mm_params := TxManagerMockSerializableParams{ctx, f, opts}
+
+	// Record call args
+	mmSerializable.SerializableMock.mutex.Lock()
+	mmSerializable.SerializableMock.callArgs = append(mmSerializable.SerializableMock.callArgs, &mm_params)
+	mmSerializable.SerializableMock.mutex.Unlock()
+
+	for _, e := range mmSerializable.SerializableMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSerializable.SerializableMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSerializable.SerializableMock.defaultExpectation.Counter, 1)
+		mm_want := mmSerializable.SerializableMock.defaultExpectation.params
+		mm_want_ptrs := mmSerializable.SerializableMock.defaultExpectation.paramPtrs
+
+		mm_got := TxManagerMockSerializableParams{ctx, f, opts}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSerializable.t.Errorf("TxManagerMock.Serializable got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSerializable.SerializableMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.f != nil && !minimock.Equal(*mm_want_ptrs.f, mm_got.f) {
+				mmSerializable.t.Errorf("TxManagerMock.Serializable got unexpected parameter f, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSerializable.SerializableMock.defaultExpectation.expectationOrigins.originF, *mm_want_ptrs.f, mm_got.f, minimock.Diff(*mm_want_ptrs.f, mm_got.f))
+			}
+
+			if mm_want_ptrs.opts != nil && !minimock.Equal(*mm_want_ptrs.opts, mm_got.opts) {
+				mmSerializable.t.Errorf("TxManagerMock.Serializable got unexpected parameter opts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSerializable.SerializableMock.defaultExpectation.expectationOrigins.originOpts, *mm_want_ptrs.opts, mm_got.opts, minimock.Diff(*mm_want_ptrs.opts, mm_got.opts))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSerializable.t.Errorf("TxManagerMock.Serializable got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSerializable.SerializableMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSerializable.SerializableMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSerializable.t.Fatal("No results are set for the TxManagerMock.Serializable")
+		}
+		return (*mm_results).err
+	}
+	if mmSerializable.funcSerializable != nil {
+		return mmSerializable.funcSerializable(ctx, f, opts...)
+	}
+	mmSerializable.t.Fatalf("Unexpected call to TxManagerMock.Serializable. %v %v %v", ctx, f, opts)
+	return
+}
+
+// SerializableAfterCounter returns a count of finished TxManagerMock.Serializable invocations
+func (mmSerializable *TxManagerMock) SerializableAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSerializable.afterSerializableCounter)
+}
+
+// SerializableBeforeCounter returns a count of TxManagerMock.Serializable invocations
+func (mmSerializable *TxManagerMock) SerializableBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSerializable.beforeSerializableCounter)
+}
+
+// Calls returns a list of arguments used in each call to TxManagerMock.Serializable.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSerializable *mTxManagerMockSerializable) Calls() []*TxManagerMockSerializableParams {
+	mmSerializable.mutex.RLock()
+
+	argCopy := make([]*TxManagerMockSerializableParams, len(mmSerializable.callArgs))
+	copy(argCopy, mmSerializable.callArgs)
+
+	mmSerializable.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSerializableDone returns true if the count of the Serializable invocations corresponds
+// the number of defined expectations
+func (m *TxManagerMock) MinimockSerializableDone() bool {
+	if m.SerializableMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SerializableMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SerializableMock.invocationsDone()
+}
+
+// MinimockSerializableInspect logs each unmet expectation
+func (m *TxManagerMock) MinimockSerializableInspect() {
+	for _, e := range m.SerializableMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TxManagerMock.Serializable at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSerializableCounter := mm_atomic.LoadUint64(&m.afterSerializableCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SerializableMock.defaultExpectation != nil && afterSerializableCounter < 1 {
+		if m.SerializableMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TxManagerMock.Serializable at\n%s", m.SerializableMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TxManagerMock.Serializable at\n%s with params: %#v", m.SerializableMock.defaultExpectation.expectationOrigins.origin, *m.SerializableMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSerializable != nil && afterSerializableCounter < 1 {
+		m.t.Errorf("Expected call to TxManagerMock.Serializable at\n%s", m.funcSerializableOrigin)
+	}
+
+	if !m.SerializableMock.invocationsDone() && afterSerializableCounter > 0 {
+		m.t.Errorf("Expected %d calls to TxManagerMock.Serializable at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SerializableMock.expectedInvocations), m.SerializableMock.expectedInvocationsOrigin, afterSerializableCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TxManagerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockReadCommittedInspect()
+
+			m.MinimockRepeatableReadInspect()
+
+			m.MinimockSerializableInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TxManagerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *TxManagerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockReadCommittedDone() &&
+		m.MinimockRepeatableReadDone() &&
+		m.MinimockSerializableDone()
+}
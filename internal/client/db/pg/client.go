@@ -2,34 +2,132 @@ package pg
 
 import (
 	"context"
+	"log/slog"
+	"time"
 
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/ipv02/auth/internal/client/db"
 )
 
 type pgClient struct {
-	masterDBC db.DB
+	masterDBC  db.DB
+	replicaDBC db.DB
+
+	stopReplicaCheck context.CancelFunc
+	unregister       func()
+}
+
+// Options параметры клиента базы данных
+type Options struct {
+	// ReplicaDSNs DSN реплик для чтения, пустой список - чтение с мастера
+	ReplicaDSNs []string
+	Replica     ReplicaOptions
+
+	// SlowQueryThreshold длительность, начиная с которой запрос логируется как медленный, 0 - не проверять
+	SlowQueryThreshold time.Duration
+
+	// Logger логирует запросы: обычные на уровне debug, медленные на уровне warn, ошибки на уровне error.
+	// nil - логгер по умолчанию
+	Logger *slog.Logger
+
+	// Registerer регистрирует метрики пулов соединений мастера и реплик, nil - не регистрировать
+	Registerer prometheus.Registerer
 }
 
-// New создаёт и инициализирует новый клиент базы данных, используя переданный DSN
-func New(ctx context.Context, dsn string) (db.Client, error) {
+// New создаёт и инициализирует новый клиент базы данных, используя переданный DSN.
+// Соединения с репликами из opts.ReplicaDSNs устанавливаются лениво, поэтому недоступная реплика
+// не мешает запуску и исключается из чтения до следующей успешной проверки
+func New(ctx context.Context, dsn string, opts Options) (db.Client, error) {
 	dbc, err := pgxpool.Connect(ctx, dsn)
 	if err != nil {
 		return nil, errors.Errorf("failed to connect to db: %v", err)
 	}
 
-	return &pgClient{
-		masterDBC: &pg{dbc: dbc},
-	}, nil
+	logger := opts.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	master := &pg{dbc: dbc, slowQueryThreshold: opts.SlowQueryThreshold, logger: logger}
+	client := &pgClient{
+		masterDBC:        master,
+		replicaDBC:       master,
+		stopReplicaCheck: func() {},
+		unregister:       func() {},
+	}
+	collector := &poolCollector{pools: []namedPool{{name: masterPoolName, pool: dbc}}}
+
+	if len(opts.ReplicaDSNs) > 0 {
+		router := newReplicaRouter(master, make([]*replica, 0, len(opts.ReplicaDSNs)), opts.Replica)
+		for i, replicaDSN := range opts.ReplicaDSNs {
+			replicaDBC, err := connectReplica(ctx, replicaDSN)
+			if err != nil {
+				router.Close()
+				master.Close()
+				return nil, errors.Errorf("failed to connect to replica %d: %v", i, err)
+			}
+
+			router.replicas = append(router.replicas, &replica{dsnIndex: i, db: &pg{
+				dbc:                replicaDBC,
+				slowQueryThreshold: opts.SlowQueryThreshold,
+				logger:             logger,
+			}})
+			collector.pools = append(collector.pools, namedPool{name: replicaPoolName(i), pool: replicaDBC})
+		}
+
+		router.check(ctx)
+
+		checkCtx, cancel := context.WithCancel(context.Background())
+		go router.run(checkCtx)
+
+		client.replicaDBC = router
+		client.stopReplicaCheck = cancel
+	}
+
+	if opts.Registerer != nil {
+		err = opts.Registerer.Register(collector)
+		if err != nil {
+			_ = client.Close()
+			return nil, errors.Errorf("failed to register db pool metrics: %v", err)
+		}
+
+		client.unregister = func() {
+			opts.Registerer.Unregister(collector)
+		}
+	}
+
+	return client, nil
+}
+
+func connectReplica(ctx context.Context, dsn string) (*pgxpool.Pool, error) {
+	cfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+	cfg.LazyConnect = true
+
+	return pgxpool.ConnectConfig(ctx, cfg)
 }
 
 func (c *pgClient) DB() db.DB {
 	return c.masterDBC
 }
 
+func (c *pgClient) Replica() db.DB {
+	return c.replicaDBC
+}
+
 func (c *pgClient) Close() error {
+	c.unregister()
+	c.stopReplicaCheck()
+
+	if c.replicaDBC != nil && c.replicaDBC != c.masterDBC {
+		c.replicaDBC.Close()
+	}
+
 	if c.masterDBC != nil {
 		c.masterDBC.Close()
 	}
@@ -0,0 +1,104 @@
+package pg
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ipv02/auth/internal/client/db"
+)
+
+const (
+	tracerName = "github.com/ipv02/auth/internal/client/db/pg"
+
+	// redactedArg заменяет в логах значение аргумента, которое может содержать персональные данные
+	redactedArg = "[REDACTED]"
+
+	queryStatusOK    = "ok"
+	queryStatusError = "error"
+)
+
+var queryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "auth",
+	Subsystem: "db",
+	Name:      "query_duration_seconds",
+	Help:      "Длительность sql запросов по имени запроса",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"query", "status"})
+
+// observe начинает span запроса с именем q.Name и возвращает функцию, которая по результату запроса
+// завершает span, записывает длительность в гистограмму и логирует запрос: ошибки на уровне error,
+// запросы дольше slowQueryThreshold на уровне warn вместе с текстом запроса, остальные на уровне debug
+func (p *pg) observe(ctx context.Context, q db.Query, args []interface{}) (context.Context, func(err error)) {
+	start := time.Now()
+
+	ctx, span := otel.Tracer(tracerName).Start(ctx, q.Name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBQueryText(q.QueryRaw),
+		),
+	)
+
+	return ctx, func(err error) {
+		duration := time.Since(start)
+
+		// отсутствие строк - ожидаемый результат запроса, а не ошибка
+		status := queryStatusOK
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			status = queryStatusError
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+		queryDuration.WithLabelValues(q.Name, status).Observe(duration.Seconds())
+
+		attrs := []any{
+			slog.String("query", q.Name),
+			slog.Duration("duration", duration),
+			slog.Any("args", redactArgs(args)),
+		}
+
+		switch {
+		case status == queryStatusError:
+			attrs = append(attrs, slog.String("error", err.Error()))
+			p.logger.ErrorContext(ctx, "sql query failed", attrs...)
+		case p.slowQueryThreshold > 0 && duration >= p.slowQueryThreshold:
+			attrs = append(attrs, slog.String("sql", q.QueryRaw))
+			p.logger.WarnContext(ctx, "slow sql query", attrs...)
+		default:
+			p.logger.DebugContext(ctx, "sql query", attrs...)
+		}
+	}
+}
+
+// redactArgs оставляет в аргументах запроса только числа, флаги и время.
+// Строки и остальные типы скрываются: в них передаются пароли, email и токены
+func redactArgs(args []interface{}) []interface{} {
+	res := make([]interface{}, len(args))
+	for i, arg := range args {
+		switch arg.(type) {
+		case nil, bool,
+			int, int8, int16, int32, int64,
+			uint, uint8, uint16, uint32, uint64,
+			float32, float64,
+			time.Time, time.Duration,
+			[]int64:
+			res[i] = arg
+		default:
+			res[i] = redactedArg
+		}
+	}
+
+	return res
+}
@@ -2,8 +2,8 @@ package pg
 
 import (
 	"context"
-	"fmt"
-	"log"
+	"log/slog"
+	"time"
 
 	"github.com/georgysavva/scany/pgxscan"
 	"github.com/jackc/pgconn"
@@ -11,7 +11,6 @@ import (
 	"github.com/jackc/pgx/v4/pgxpool"
 
 	"github.com/ipv02/auth/internal/client/db"
-	"github.com/ipv02/auth/internal/client/db/prettier"
 )
 
 type key string
@@ -23,19 +22,26 @@ const (
 
 type pg struct {
 	dbc *pgxpool.Pool
+
+	slowQueryThreshold time.Duration
+	logger             *slog.Logger
 }
 
-// NewDB создаёт новый экземпляр соединения с базой данных, используя переданный пул соединений pgxpool
-func NewDB(dbc *pgxpool.Pool) db.DB {
+// NewDB создаёт новый экземпляр соединения с базой данных, используя переданный пул соединений pgxpool.
+// Запросы дольше slowQueryThreshold логируются как медленные, нулевое значение отключает проверку
+func NewDB(dbc *pgxpool.Pool, slowQueryThreshold time.Duration, logger *slog.Logger) db.DB {
 	return &pg{
-		dbc: dbc,
+		dbc:                dbc,
+		slowQueryThreshold: slowQueryThreshold,
+		logger:             logger,
 	}
 }
 
-func (p *pg) ScanOneContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
-	logQuery(ctx, q, args...)
+func (p *pg) ScanOneContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) (err error) {
+	ctx, done := p.observe(ctx, q, args)
+	defer func() { done(err) }()
 
-	row, err := p.QueryContext(ctx, q, args...)
+	row, err := p.query(ctx, q, args...)
 	if err != nil {
 		return err
 	}
@@ -43,10 +49,11 @@ func (p *pg) ScanOneContext(ctx context.Context, dest interface{}, q db.Query, a
 	return pgxscan.ScanOne(dest, row)
 }
 
-func (p *pg) ScanAllContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
-	logQuery(ctx, q, args...)
+func (p *pg) ScanAllContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) (err error) {
+	ctx, done := p.observe(ctx, q, args)
+	defer func() { done(err) }()
 
-	rows, err := p.QueryContext(ctx, q, args...)
+	rows, err := p.query(ctx, q, args...)
 	if err != nil {
 		return err
 	}
@@ -54,8 +61,9 @@ func (p *pg) ScanAllContext(ctx context.Context, dest interface{}, q db.Query, a
 	return pgxscan.ScanAll(dest, rows)
 }
 
-func (p *pg) ExecContext(ctx context.Context, q db.Query, args ...interface{}) (pgconn.CommandTag, error) {
-	logQuery(ctx, q, args...)
+func (p *pg) ExecContext(ctx context.Context, q db.Query, args ...interface{}) (tag pgconn.CommandTag, err error) {
+	ctx, done := p.observe(ctx, q, args)
+	defer func() { done(err) }()
 
 	tx, ok := ctx.Value(TxKey).(pgx.Tx)
 	if ok {
@@ -65,19 +73,18 @@ func (p *pg) ExecContext(ctx context.Context, q db.Query, args ...interface{}) (
 	return p.dbc.Exec(ctx, q.QueryRaw, args...)
 }
 
-func (p *pg) QueryContext(ctx context.Context, q db.Query, args ...interface{}) (pgx.Rows, error) {
-	logQuery(ctx, q, args...)
+// QueryContext учитывает в логах и метриках только выполнение запроса, время чтения строк вызывающим не входит
+func (p *pg) QueryContext(ctx context.Context, q db.Query, args ...interface{}) (rows pgx.Rows, err error) {
+	ctx, done := p.observe(ctx, q, args)
+	defer func() { done(err) }()
 
-	tx, ok := ctx.Value(TxKey).(pgx.Tx)
-	if ok {
-		return tx.Query(ctx, q.QueryRaw, args...)
-	}
-
-	return p.dbc.Query(ctx, q.QueryRaw, args...)
+	return p.query(ctx, q, args...)
 }
 
+// QueryRowContext учитывает в логах и метриках только отправку запроса, ошибка которого вернется при Scan
 func (p *pg) QueryRowContext(ctx context.Context, q db.Query, args ...interface{}) pgx.Row {
-	logQuery(ctx, q, args...)
+	ctx, done := p.observe(ctx, q, args)
+	defer done(nil)
 
 	tx, ok := ctx.Value(TxKey).(pgx.Tx)
 	if ok {
@@ -87,6 +94,15 @@ func (p *pg) QueryRowContext(ctx context.Context, q db.Query, args ...interface{
 	return p.dbc.QueryRow(ctx, q.QueryRaw, args...)
 }
 
+func (p *pg) query(ctx context.Context, q db.Query, args ...interface{}) (pgx.Rows, error) {
+	tx, ok := ctx.Value(TxKey).(pgx.Tx)
+	if ok {
+		return tx.Query(ctx, q.QueryRaw, args...)
+	}
+
+	return p.dbc.Query(ctx, q.QueryRaw, args...)
+}
+
 func (p *pg) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error) {
 	return p.dbc.BeginTx(ctx, txOptions)
 }
@@ -103,12 +119,3 @@ func (p *pg) Close() {
 func MakeContextTx(ctx context.Context, tx pgx.Tx) context.Context {
 	return context.WithValue(ctx, TxKey, tx)
 }
-
-func logQuery(ctx context.Context, q db.Query, args ...interface{}) {
-	prettyQuery := prettier.Pretty(q.QueryRaw, prettier.PlaceholderDollar, args...)
-	log.Println(
-		ctx,
-		fmt.Sprintf("sql: %s", q.Name),
-		fmt.Sprintf("query: %s", prettyQuery),
-	)
-}
@@ -0,0 +1,135 @@
+package pg
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/client/db"
+)
+
+// replicationLagQuery возвращает отставание реплики в секундах.
+// Реплика, которая применила весь полученный WAL, не отстает, даже если на мастере давно не было записи
+const replicationLagQuery = `
+SELECT CASE
+	WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END`
+
+// ReplicaOptions параметры проверки реплик
+type ReplicaOptions struct {
+	// MaxLag отставание, после которого реплика исключается из чтения
+	MaxLag time.Duration
+	// CheckInterval интервал проверки доступности и отставания реплик
+	CheckInterval time.Duration
+}
+
+type replica struct {
+	dsnIndex int
+	db       *pg
+	healthy  atomic.Bool
+}
+
+// replicaRouter отправляет ScanOneContext и ScanAllContext вне транзакции на доступную реплику по кругу,
+// остальные запросы и запросы в транзакции выполняются на мастере. Без доступных реплик чтение идет на мастер
+type replicaRouter struct {
+	db.DB
+
+	replicas []*replica
+	next     atomic.Uint64
+	options  ReplicaOptions
+}
+
+func newReplicaRouter(master db.DB, replicas []*replica, options ReplicaOptions) *replicaRouter {
+	return &replicaRouter{
+		DB:       master,
+		replicas: replicas,
+		options:  options,
+	}
+}
+
+func (r *replicaRouter) ScanOneContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
+	return r.reader(ctx).ScanOneContext(ctx, dest, q, args...)
+}
+
+func (r *replicaRouter) ScanAllContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
+	return r.reader(ctx).ScanAllContext(ctx, dest, q, args...)
+}
+
+// Close закрывает соединения с репликами, соединение с мастером закрывает клиент
+func (r *replicaRouter) Close() {
+	for _, rep := range r.replicas {
+		rep.db.Close()
+	}
+}
+
+// reader возвращает следующую доступную реплику или мастер, если запрос выполняется в транзакции
+// или контекст помечен db.ContextWithMasterRead
+func (r *replicaRouter) reader(ctx context.Context) db.DB {
+	if _, ok := ctx.Value(TxKey).(pgx.Tx); ok || db.MasterReadFromContext(ctx) {
+		return r.DB
+	}
+
+	n := uint64(len(r.replicas))
+	start := r.next.Add(1)
+	for i := uint64(0); i < n; i++ {
+		rep := r.replicas[(start+i)%n]
+		if rep.healthy.Load() {
+			return rep.db
+		}
+	}
+
+	return r.DB
+}
+
+// run проверяет реплики с интервалом options.CheckInterval, пока не завершится контекст
+func (r *replicaRouter) run(ctx context.Context) {
+	ticker := time.NewTicker(r.options.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.check(ctx)
+		}
+	}
+}
+
+// check исключает из чтения недоступные реплики и реплики с отставанием больше options.MaxLag
+func (r *replicaRouter) check(ctx context.Context) {
+	for _, rep := range r.replicas {
+		healthy := true
+
+		lag, err := replicationLag(ctx, rep.db)
+		if err != nil {
+			log.Printf("replica %d is unavailable: %v\n", rep.dsnIndex, err)
+			healthy = false
+		} else if lag > r.options.MaxLag {
+			log.Printf("replica %d lags behind by %s\n", rep.dsnIndex, lag)
+			healthy = false
+		}
+
+		if rep.healthy.Swap(healthy) != healthy && healthy {
+			log.Printf("replica %d is back in rotation\n", rep.dsnIndex)
+		}
+	}
+}
+
+func replicationLag(ctx context.Context, p *pg) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+
+	var lagSec float64
+	err := p.dbc.QueryRow(ctx, replicationLagQuery).Scan(&lagSec)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get replication lag")
+	}
+
+	return time.Duration(lagSec * float64(time.Second)), nil
+}
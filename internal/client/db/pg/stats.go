@@ -0,0 +1,67 @@
+package pg
+
+import (
+	"strconv"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ipv02/auth/internal/metric"
+)
+
+const masterPoolName = "master"
+
+var (
+	acquiredConnsDesc   = newPoolDesc("acquired_conns", "Число занятых соединений пула")
+	idleConnsDesc       = newPoolDesc("idle_conns", "Число свободных соединений пула")
+	totalConnsDesc      = newPoolDesc("total_conns", "Число открытых соединений пула")
+	maxConnsDesc        = newPoolDesc("max_conns", "Максимальное число соединений пула")
+	acquireCountDesc    = newPoolDesc("acquires_total", "Число выданных соединений пула")
+	acquireDurationDesc = newPoolDesc("acquire_duration_seconds_total", "Суммарное время ожидания соединений пула")
+	emptyAcquireDesc    = newPoolDesc("empty_acquires_total", "Число ожиданий соединения при пустом пуле")
+	canceledAcquireDesc = newPoolDesc("canceled_acquires_total", "Число ожиданий соединения, прерванных отменой контекста")
+)
+
+func newPoolDesc(name, help string) *prometheus.Desc {
+	return prometheus.NewDesc(prometheus.BuildFQName(metric.Namespace, "db_pool", name), help, []string{"pool"}, nil)
+}
+
+type namedPool struct {
+	name string
+	pool *pgxpool.Pool
+}
+
+// poolCollector отдает статистику пулов соединений мастера и реплик в момент сбора метрик
+type poolCollector struct {
+	pools []namedPool
+}
+
+func replicaPoolName(dsnIndex int) string {
+	return "replica_" + strconv.Itoa(dsnIndex)
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- acquiredConnsDesc
+	ch <- idleConnsDesc
+	ch <- totalConnsDesc
+	ch <- maxConnsDesc
+	ch <- acquireCountDesc
+	ch <- acquireDurationDesc
+	ch <- emptyAcquireDesc
+	ch <- canceledAcquireDesc
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, p := range c.pools {
+		stat := p.pool.Stat()
+
+		ch <- prometheus.MustNewConstMetric(acquiredConnsDesc, prometheus.GaugeValue, float64(stat.AcquiredConns()), p.name)
+		ch <- prometheus.MustNewConstMetric(idleConnsDesc, prometheus.GaugeValue, float64(stat.IdleConns()), p.name)
+		ch <- prometheus.MustNewConstMetric(totalConnsDesc, prometheus.GaugeValue, float64(stat.TotalConns()), p.name)
+		ch <- prometheus.MustNewConstMetric(maxConnsDesc, prometheus.GaugeValue, float64(stat.MaxConns()), p.name)
+		ch <- prometheus.MustNewConstMetric(acquireCountDesc, prometheus.CounterValue, float64(stat.AcquireCount()), p.name)
+		ch <- prometheus.MustNewConstMetric(acquireDurationDesc, prometheus.CounterValue, stat.AcquireDuration().Seconds(), p.name)
+		ch <- prometheus.MustNewConstMetric(emptyAcquireDesc, prometheus.CounterValue, float64(stat.EmptyAcquireCount()), p.name)
+		ch <- prometheus.MustNewConstMetric(canceledAcquireDesc, prometheus.CounterValue, float64(stat.CanceledAcquireCount()), p.name)
+	}
+}
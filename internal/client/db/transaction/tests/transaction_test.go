@@ -0,0 +1,242 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/auth/internal/client/db"
+	"github.com/ipv02/auth/internal/client/db/transaction"
+)
+
+// transactor запоминает параметры начатых транзакций и их завершение
+type transactor struct {
+	opts []pgx.TxOptions
+	txs  []*tx
+}
+
+func (t *transactor) BeginTx(_ context.Context, opts pgx.TxOptions) (pgx.Tx, error) {
+	t.opts = append(t.opts, opts)
+	tx := &tx{}
+	t.txs = append(t.txs, tx)
+
+	return tx, nil
+}
+
+type tx struct {
+	pgx.Tx
+
+	committed  bool
+	rolledBack bool
+	savepoints []*tx
+}
+
+func (t *tx) Begin(context.Context) (pgx.Tx, error) {
+	savepoint := &tx{}
+	t.savepoints = append(t.savepoints, savepoint)
+
+	return savepoint, nil
+}
+
+func (t *tx) Commit(context.Context) error {
+	t.committed = true
+	return nil
+}
+
+func (t *tx) Rollback(context.Context) error {
+	t.rolledBack = true
+	return nil
+}
+
+func TestTransaction(t *testing.T) {
+	t.Parallel()
+
+	var (
+		handlerErr       = fmt.Errorf("handler error")
+		serializationErr = &pgconn.PgError{Code: "40001"}
+
+		retryPolicy = transaction.RetryPolicy{
+			Attempts:       3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     2 * time.Millisecond,
+		}
+	)
+
+	tests := []struct {
+		name         string
+		opts         []db.TxOption
+		failures     int
+		failErr      error
+		err          error
+		wantAttempts int
+		wantOpts     pgx.TxOptions
+	}{
+		{
+			name:         "success case",
+			wantAttempts: 1,
+			wantOpts:     pgx.TxOptions{IsoLevel: pgx.Serializable},
+		},
+		{
+			name:         "read only deferrable case",
+			opts:         []db.TxOption{db.ReadOnly(), db.Deferrable()},
+			wantAttempts: 1,
+			wantOpts:     pgx.TxOptions{IsoLevel: pgx.Serializable, AccessMode: pgx.ReadOnly, DeferrableMode: pgx.Deferrable},
+		},
+		{
+			name:         "handler error case",
+			failures:     1,
+			failErr:      handlerErr,
+			err:          handlerErr,
+			opts:         []db.TxOption{db.RetrySafe()},
+			wantAttempts: 1,
+			wantOpts:     pgx.TxOptions{IsoLevel: pgx.Serializable},
+		},
+		{
+			name:         "retry after serialization failure case",
+			failures:     2,
+			failErr:      serializationErr,
+			opts:         []db.TxOption{db.RetrySafe()},
+			wantAttempts: 3,
+			wantOpts:     pgx.TxOptions{IsoLevel: pgx.Serializable},
+		},
+		{
+			name:         "retry attempts exhausted case",
+			failures:     3,
+			failErr:      serializationErr,
+			err:          serializationErr,
+			opts:         []db.TxOption{db.RetrySafe()},
+			wantAttempts: 3,
+			wantOpts:     pgx.TxOptions{IsoLevel: pgx.Serializable},
+		},
+		{
+			name:         "no retry without retry safe case",
+			failures:     1,
+			failErr:      serializationErr,
+			err:          serializationErr,
+			wantAttempts: 1,
+			wantOpts:     pgx.TxOptions{IsoLevel: pgx.Serializable},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := &transactor{}
+			m := transaction.NewTransactionManager(tr, retryPolicy)
+
+			calls := 0
+			err := m.Serializable(context.Background(), func(context.Context) error {
+				calls++
+				if calls <= tt.failures {
+					return tt.failErr
+				}
+
+				return nil
+			}, tt.opts...)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.wantAttempts, calls)
+			require.Len(t, tr.opts, tt.wantAttempts)
+			require.Equal(t, tt.wantOpts, tr.opts[0])
+
+			for i, tx := range tr.txs {
+				succeeded := i == len(tr.txs)-1 && tt.err == nil
+				require.Equal(t, succeeded, tx.committed)
+				require.Equal(t, !succeeded, tx.rolledBack)
+			}
+		})
+	}
+}
+
+func TestNestedTransaction(t *testing.T) {
+	t.Parallel()
+
+	tr := &transactor{}
+	m := transaction.NewTransactionManager(tr, transaction.RetryPolicy{Attempts: 1})
+
+	innerErr := fmt.Errorf("inner error")
+	err := m.ReadCommitted(context.Background(), func(ctx context.Context) error {
+		err := m.ReadCommitted(ctx, func(context.Context) error {
+			return innerErr
+		})
+		require.ErrorIs(t, err, innerErr)
+
+		return m.ReadCommitted(ctx, func(context.Context) error {
+			return nil
+		})
+	})
+	require.NoError(t, err)
+
+	require.Len(t, tr.txs, 1)
+	outer := tr.txs[0]
+	require.True(t, outer.committed)
+	require.Len(t, outer.savepoints, 2)
+	require.True(t, outer.savepoints[0].rolledBack)
+	require.False(t, outer.savepoints[0].committed)
+	require.True(t, outer.savepoints[1].committed)
+}
+
+func TestAfterCommit(t *testing.T) {
+	t.Parallel()
+
+	handlerErr := fmt.Errorf("handler error")
+
+	tests := []struct {
+		name      string
+		err       error
+		wantCalls int
+	}{
+		{
+			name:      "commit case",
+			wantCalls: 1,
+		},
+		{
+			name:      "rollback case",
+			err:       handlerErr,
+			wantCalls: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := &transactor{}
+			m := transaction.NewTransactionManager(tr, transaction.RetryPolicy{Attempts: 1})
+
+			calls := 0
+			err := m.ReadCommitted(context.Background(), func(ctx context.Context) error {
+				err := m.ReadCommitted(ctx, func(ctx context.Context) error {
+					db.AfterCommit(ctx, func(context.Context) {
+						require.True(t, tr.txs[0].committed)
+						calls++
+					})
+
+					return nil
+				})
+				require.NoError(t, err)
+
+				return tt.err
+			})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
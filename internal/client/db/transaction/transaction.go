@@ -2,39 +2,111 @@ package transaction
 
 import (
 	"context"
+	"log"
 
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
 
 	"github.com/ipv02/auth/internal/client/db"
 	"github.com/ipv02/auth/internal/client/db/pg"
+	"github.com/ipv02/auth/internal/client/retry"
 )
 
+// Коды postgres ошибок, после которых транзакцию можно повторить
+const (
+	serializationFailureCode = "40001"
+	deadlockDetectedCode     = "40P01"
+)
+
+// RetryPolicy политика повтора транзакций, помеченных db.RetrySafe.
+// Attempts - число попыток, включая первую, задержка между попытками растет экспоненциально со случайным разбросом
+type RetryPolicy = retry.Policy
+
 type manager struct {
-	db db.Transactor
+	db          db.Transactor
+	retryPolicy RetryPolicy
 }
 
 // NewTransactionManager создает новый менеджер транзакций, который удовлетворяет интерфейсу db.TxManager
-func NewTransactionManager(db db.Transactor) db.TxManager {
+func NewTransactionManager(db db.Transactor, retryPolicy RetryPolicy) db.TxManager {
 	return &manager{
-		db: db,
+		db:          db,
+		retryPolicy: retryPolicy,
 	}
 }
 
 // transaction основная функция, которая выполняет указанный пользователем обработчик в транзакции
-func (m *manager) transaction(ctx context.Context, opts pgx.TxOptions, fn db.Handler) (err error) {
-	// Если это вложенная транзакция, пропускаем инициацию новой транзакции и выполняем обработчик.
+// и повторяет ее после ошибки сериализации или взаимоблокировки, если обработчик помечен безопасным для повтора
+func (m *manager) transaction(ctx context.Context, isoLevel pgx.TxIsoLevel, fn db.Handler, opts []db.TxOption) error {
+	var txOptions db.TxOptions
+	for _, opt := range opts {
+		opt(&txOptions)
+	}
+
+	// Если это вложенная транзакция, выполняем обработчик в точке сохранения внешней транзакции.
+	// Повторять имеет смысл только внешнюю транзакцию, потому что ошибка сериализации прерывает ее целиком
 	tx, ok := ctx.Value(pg.TxKey).(pgx.Tx)
 	if ok {
-		return fn(ctx)
+		return m.savepoint(ctx, tx, fn)
+	}
+
+	pgxOptions := pgx.TxOptions{IsoLevel: isoLevel}
+	if txOptions.ReadOnly {
+		pgxOptions.AccessMode = pgx.ReadOnly
+	}
+	if txOptions.Deferrable {
+		pgxOptions.DeferrableMode = pgx.Deferrable
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := m.begin(ctx, pgxOptions, fn)
+		if err == nil || !txOptions.RetrySafe || !isRetryable(err) || attempt >= m.retryPolicy.Attempts {
+			return err
+		}
+
+		log.Printf("retrying transaction after attempt %d: %v\n", attempt, err)
+
+		if !retry.Sleep(ctx, m.retryPolicy.JitteredBackoff(attempt)) {
+			return err
+		}
 	}
+}
 
-	// Стартуем новую транзакцию.
-	tx, err = m.db.BeginTx(ctx, opts)
+// begin стартует новую транзакцию и выполняет в ней обработчик.
+// После коммита выполняются функции, отложенные обработчиком через db.AfterCommit
+func (m *manager) begin(ctx context.Context, opts pgx.TxOptions, fn db.Handler) error {
+	tx, err := m.db.BeginTx(ctx, opts)
 	if err != nil {
 		return errors.Wrap(err, "can't begin transaction")
 	}
 
+	txCtx, runAfterCommit := db.ContextWithAfterCommit(ctx)
+
+	err = run(txCtx, tx, fn)
+	if err != nil {
+		return err
+	}
+
+	runAfterCommit(ctx)
+
+	return nil
+}
+
+// savepoint создает точку сохранения во внешней транзакции и выполняет в ней обработчик.
+// Ошибка обработчика откатывает только изменения с точки сохранения, решение об откате внешней транзакции
+// остается за ее обработчиком
+func (m *manager) savepoint(ctx context.Context, tx pgx.Tx, fn db.Handler) error {
+	nested, err := tx.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "can't create savepoint")
+	}
+
+	return run(ctx, nested, fn)
+}
+
+// run выполняет обработчик в транзакции или точке сохранения tx, после чего коммитит или откатывает ее
+func run(ctx context.Context, tx pgx.Tx, fn db.Handler) (err error) {
 	// Кладем транзакцию в контекст.
 	ctx = pg.MakeContextTx(ctx, tx)
 
@@ -73,7 +145,24 @@ func (m *manager) transaction(ctx context.Context, opts pgx.TxOptions, fn db.Han
 	return err
 }
 
-func (m *manager) ReadCommitted(ctx context.Context, f db.Handler) error {
-	txOpts := pgx.TxOptions{IsoLevel: pgx.ReadCommitted}
-	return m.transaction(ctx, txOpts, f)
+func (m *manager) ReadCommitted(ctx context.Context, f db.Handler, opts ...db.TxOption) error {
+	return m.transaction(ctx, pgx.ReadCommitted, f, opts)
+}
+
+func (m *manager) RepeatableRead(ctx context.Context, f db.Handler, opts ...db.TxOption) error {
+	return m.transaction(ctx, pgx.RepeatableRead, f, opts)
+}
+
+func (m *manager) Serializable(ctx context.Context, f db.Handler, opts ...db.TxOption) error {
+	return m.transaction(ctx, pgx.Serializable, f, opts)
+}
+
+// isRetryable проверяет, что транзакция завершилась ошибкой сериализации или взаимоблокировкой
+func isRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	return pgErr.Code == serializationFailureCode || pgErr.Code == deadlockDetectedCode
 }
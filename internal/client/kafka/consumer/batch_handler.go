@@ -0,0 +1,200 @@
+package consumer
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// BatchHandler определяет тип функции для обработки пакета сообщений одной партиции
+type BatchHandler func(ctx context.Context, msgs []*sarama.ConsumerMessage) error
+
+// BatchGroupHandler собирает сообщения партиции в пакеты
+type BatchGroupHandler struct {
+	batchHandler   BatchHandler
+	messageHandler *GroupHandler
+	batchSize      int
+	batchTimeout   time.Duration
+}
+
+// NewBatchGroupHandler создает группу, которая передает обработчику пакет из batchSize сообщений
+// или сообщения, накопленные за batchTimeout с получения первого сообщения пакета.
+// Если пакет не удалось обработать, его сообщения обрабатываются по одному с повторами по retryPolicy
+// и отправкой в dead letter топик, поэтому одно некорректное сообщение не останавливает партицию.
+// Сообщения из PermanentBatchError сразу отправляются в dead letter топик, остальные обрабатываются пакетом
+func NewBatchGroupHandler(
+	retryPolicy RetryPolicy,
+	deadLetterProducer DeadLetterProducer,
+	batchSize int,
+	batchTimeout time.Duration,
+) *BatchGroupHandler {
+	c := &BatchGroupHandler{
+		messageHandler: NewGroupHandler(retryPolicy, deadLetterProducer, 1),
+		batchSize:      batchSize,
+		batchTimeout:   batchTimeout,
+	}
+	c.messageHandler.msgHandler = func(ctx context.Context, msg *sarama.ConsumerMessage) error {
+		err := c.batchHandler(ctx, []*sarama.ConsumerMessage{msg})
+
+		var batchErr *PermanentBatchError
+		if errors.As(err, &batchErr) {
+			if msgErr, ok := batchErr.Errors[msg]; ok {
+				return Permanent(msgErr)
+			}
+		}
+
+		return err
+	}
+
+	return c
+}
+
+// Setup запускается в начале новой сессии до вызова ConsumeClaim
+func (c *BatchGroupHandler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+// Cleanup запускается в конце жизни сессии после того как все горутины ConsumeClaim завершаться
+func (c *BatchGroupHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+// ConsumeClaim собирает сообщения партиции в пакеты и помечает смещение только после обработки пакета.
+// Незавершенный пакет не помечается при завершении сессии и будет получен повторно после перебалансировки
+func (c *BatchGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	var (
+		batch   = make([]*sarama.ConsumerMessage, 0, c.batchSize)
+		timeout <-chan time.Time
+	)
+
+	for {
+		select {
+		case message, ok := <-claim.Messages():
+			if !ok {
+				log.Printf("message channel was closed\n")
+				c.flush(session, batch)
+				return nil
+			}
+
+			observeLag(claim, message)
+
+			batch = append(batch, message)
+			if len(batch) == 1 {
+				timeout = time.After(c.batchTimeout)
+			}
+
+			if len(batch) < c.batchSize {
+				continue
+			}
+
+			if !c.flush(session, batch) {
+				return nil
+			}
+
+			batch = make([]*sarama.ConsumerMessage, 0, c.batchSize)
+			timeout = nil
+
+		case <-timeout:
+			if !c.flush(session, batch) {
+				return nil
+			}
+
+			batch = make([]*sarama.ConsumerMessage, 0, c.batchSize)
+			timeout = nil
+
+		case <-session.Context().Done():
+			log.Printf("session context done\n")
+			return nil
+		}
+	}
+}
+
+// flush обрабатывает пакет и помечает его сообщения.
+// Возвращает false, если сессия завершилась раньше, чем пакет был обработан
+func (c *BatchGroupHandler) flush(session sarama.ConsumerGroupSession, batch []*sarama.ConsumerMessage) bool {
+	if len(batch) == 0 {
+		return true
+	}
+
+	ctx, span := startBatchSpan(session.Context(), batch)
+	defer span.End()
+
+	return c.handleBatch(ctx, session, batch, span)
+}
+
+// handleBatch обрабатывает пакет и помечает его последнее сообщение. Сообщения с постоянной ошибкой
+// отправляются в dead letter топик, после чего остальные сообщения обрабатываются пакетом,
+// при другой ошибке сообщения обрабатываются и помечаются по одному
+func (c *BatchGroupHandler) handleBatch(
+	ctx context.Context,
+	session sarama.ConsumerGroupSession,
+	batch []*sarama.ConsumerMessage,
+	span trace.Span,
+) bool {
+	if len(batch) == 0 {
+		return true
+	}
+
+	first, last := batch[0], batch[len(batch)-1]
+
+	err := c.batchHandler(ctx, batch)
+	if err == nil {
+		log.Printf("batch of %d messages from %s/%d at offsets %d-%d handled\n", len(batch), first.Topic, first.Partition, first.Offset, last.Offset)
+		session.MarkMessage(last, "")
+		observeOutcome(first.Topic, outcomeHandled, len(batch))
+		return true
+	}
+
+	recordError(span, err)
+
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var batchErr *PermanentBatchError
+	if errors.As(err, &batchErr) && c.messageHandler.deadLetterProducer != nil {
+		log.Printf("batch from %s/%d at offsets %d-%d has %d messages that can't be handled: %v\n", first.Topic, first.Partition, first.Offset, last.Offset, len(batchErr.Errors), err)
+
+		rest := make([]*sarama.ConsumerMessage, 0, len(batch))
+		for _, msg := range batch {
+			msgErr, ok := batchErr.Errors[msg]
+			if !ok {
+				rest = append(rest, msg)
+				continue
+			}
+
+			if !c.messageHandler.sendToDeadLetter(ctx, msg, msgErr, 1) {
+				return false
+			}
+		}
+
+		// если ошибка не относится ни к одному сообщению пакета, сообщения обрабатываются по одному
+		if len(rest) < len(batch) {
+			if !c.handleBatch(ctx, session, rest, span) {
+				return false
+			}
+
+			if len(rest) == 0 || rest[len(rest)-1] != last {
+				session.MarkMessage(last, "")
+			}
+
+			return true
+		}
+	}
+
+	log.Printf("error handling batch from %s/%d at offsets %d-%d, handling messages one by one: %v\n", first.Topic, first.Partition, first.Offset, last.Offset, err)
+
+	for _, msg := range batch {
+		if !c.messageHandler.handle(ctx, msg) {
+			return false
+		}
+
+		session.MarkMessage(msg, "")
+	}
+
+	return true
+}
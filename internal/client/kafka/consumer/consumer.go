@@ -3,7 +3,6 @@ package consumer
 import (
 	"context"
 	"log"
-	"strings"
 
 	"github.com/IBM/sarama"
 	"github.com/pkg/errors"
@@ -26,10 +25,10 @@ func NewConsumer(
 }
 
 // Consume устанавливает обработчик сообщений и запускает процесс потребления сообщений
-func (c *consumer) Consume(ctx context.Context, topicName string, handler Handler) error {
+func (c *consumer) Consume(ctx context.Context, topics []string, handler Handler) error {
 	c.consumerGroupHandler.msgHandler = handler
 
-	return c.consume(ctx, topicName)
+	return consume(ctx, c.consumerGroup, topics, c.consumerGroupHandler)
 }
 
 // Close вызывает у группы функцию Close
@@ -37,9 +36,37 @@ func (c *consumer) Close() error {
 	return c.consumerGroup.Close()
 }
 
-func (c *consumer) consume(ctx context.Context, topicName string) error {
+type batchConsumer struct {
+	consumerGroup        sarama.ConsumerGroup
+	consumerGroupHandler *BatchGroupHandler
+}
+
+// NewBatchConsumer создает consumer, который обрабатывает сообщения пакетами
+func NewBatchConsumer(
+	consumerGroup sarama.ConsumerGroup,
+	consumerGroupHandler *BatchGroupHandler,
+) *batchConsumer {
+	return &batchConsumer{
+		consumerGroup:        consumerGroup,
+		consumerGroupHandler: consumerGroupHandler,
+	}
+}
+
+// Consume устанавливает обработчик пакетов сообщений и запускает процесс потребления сообщений
+func (c *batchConsumer) Consume(ctx context.Context, topics []string, handler BatchHandler) error {
+	c.consumerGroupHandler.batchHandler = handler
+
+	return consume(ctx, c.consumerGroup, topics, c.consumerGroupHandler)
+}
+
+// Close вызывает у группы функцию Close
+func (c *batchConsumer) Close() error {
+	return c.consumerGroup.Close()
+}
+
+func consume(ctx context.Context, consumerGroup sarama.ConsumerGroup, topics []string, handler sarama.ConsumerGroupHandler) error {
 	for {
-		err := c.consumerGroup.Consume(ctx, strings.Split(topicName, ","), c.consumerGroupHandler)
+		err := consumerGroup.Consume(ctx, topics, handler)
 		if err != nil {
 			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
 				return nil
@@ -0,0 +1,91 @@
+package consumer
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/sarama"
+
+	"github.com/ipv02/auth/internal/client/kafka/producer"
+)
+
+// DeadLetterTopicSuffix суффикс топика, в который попадают сообщения после исчерпания попыток обработки
+const DeadLetterTopicSuffix = ".dlq"
+
+// Заголовки, которые добавляются к сообщению при отправке в dead letter топик
+const (
+	DeadLetterErrorHeader             = "dlq-error"
+	DeadLetterAttemptsHeader          = "dlq-attempts"
+	DeadLetterOriginalTopicHeader     = "dlq-original-topic"
+	DeadLetterOriginalPartitionHeader = "dlq-original-partition"
+	DeadLetterOriginalOffsetHeader    = "dlq-original-offset"
+
+	deadLetterHeaderPrefix = "dlq-"
+)
+
+// DeadLetterProducer отправляет сообщения в dead letter топик
+type DeadLetterProducer interface {
+	SendMessage(ctx context.Context, msg *producer.Message) error
+}
+
+// toDeadLetterMessage копирует ключ, значение и заголовки сообщения и добавляет
+// к ним ошибку, число попыток и исходное положение сообщения
+func toDeadLetterMessage(msg *sarama.ConsumerMessage, err error, attempts int) *producer.Message {
+	headers := copyHeaders(msg.Headers)
+	headers = append(headers,
+		producer.Header{Key: DeadLetterErrorHeader, Value: []byte(err.Error())},
+		producer.Header{Key: DeadLetterAttemptsHeader, Value: []byte(strconv.Itoa(attempts))},
+		producer.Header{Key: DeadLetterOriginalTopicHeader, Value: []byte(msg.Topic)},
+		producer.Header{Key: DeadLetterOriginalPartitionHeader, Value: []byte(strconv.FormatInt(int64(msg.Partition), 10))},
+		producer.Header{Key: DeadLetterOriginalOffsetHeader, Value: []byte(strconv.FormatInt(msg.Offset, 10))},
+	)
+
+	return &producer.Message{
+		Topic:   msg.Topic + DeadLetterTopicSuffix,
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+	}
+}
+
+// ToReplayMessage возвращает сообщение из dead letter топика в исходный топик без служебных заголовков
+func ToReplayMessage(msg *sarama.ConsumerMessage) *producer.Message {
+	topic := strings.TrimSuffix(msg.Topic, DeadLetterTopicSuffix)
+
+	headers := make([]producer.Header, 0, len(msg.Headers))
+	for _, header := range copyHeaders(msg.Headers) {
+		if header.Key == DeadLetterOriginalTopicHeader {
+			topic = string(header.Value)
+		}
+
+		if strings.HasPrefix(header.Key, deadLetterHeaderPrefix) {
+			continue
+		}
+
+		headers = append(headers, header)
+	}
+
+	return &producer.Message{
+		Topic:   topic,
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+	}
+}
+
+func copyHeaders(headers []*sarama.RecordHeader) []producer.Header {
+	res := make([]producer.Header, 0, len(headers))
+	for _, header := range headers {
+		if header == nil {
+			continue
+		}
+
+		res = append(res, producer.Header{
+			Key:   string(header.Key),
+			Value: header.Value,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,48 @@
+package consumer
+
+import (
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"github.com/pkg/errors"
+)
+
+// permanentError ошибка, которая повторится при любой следующей попытке обработки сообщения
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent помечает ошибку обработки сообщения постоянной, например ошибку декодирования или валидации.
+// Такое сообщение не повторяется и сразу отправляется в dead letter топик
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &permanentError{err: err}
+}
+
+// IsPermanent проверяет, что ошибка обработки сообщения постоянная
+func IsPermanent(err error) bool {
+	var permanentErr *permanentError
+	return errors.As(err, &permanentErr)
+}
+
+// PermanentBatchError ошибка пакета, часть сообщений которого невозможно обработать.
+// Обработчик, вернувший ее, не должен применять изменения остальных сообщений пакета:
+// сообщения из Errors отправляются в dead letter топик, а остальные передаются обработчику повторно
+type PermanentBatchError struct {
+	Errors map[*sarama.ConsumerMessage]error
+}
+
+func (e *PermanentBatchError) Error() string {
+	return fmt.Sprintf("%d messages of batch can't be handled", len(e.Errors))
+}
@@ -5,6 +5,8 @@ import (
 	"log"
 
 	"github.com/IBM/sarama"
+
+	"github.com/ipv02/auth/internal/client/retry"
 )
 
 // Handler определяет тип функции для обработки сообщений
@@ -12,12 +14,22 @@ type Handler func(ctx context.Context, msg *sarama.ConsumerMessage) error
 
 // GroupHandler структура описывающая группу
 type GroupHandler struct {
-	msgHandler Handler
+	msgHandler         Handler
+	retryPolicy        RetryPolicy
+	deadLetterProducer DeadLetterProducer
+	workers            int
 }
 
-// NewGroupHandler создает новую группу
-func NewGroupHandler() *GroupHandler {
-	return &GroupHandler{}
+// NewGroupHandler создает новую группу.
+// Сообщение, которое не удалось обработать за retryPolicy.Attempts попыток или с постоянной ошибкой,
+// отправляется в dead letter топик. Без deadLetterProducer обработка повторяется, пока не завершится сессия.
+// workers - число воркеров на партицию, при значении больше 1 сообщения с разными ключами обрабатываются параллельно
+func NewGroupHandler(retryPolicy RetryPolicy, deadLetterProducer DeadLetterProducer, workers int) *GroupHandler {
+	return &GroupHandler{
+		retryPolicy:        retryPolicy,
+		deadLetterProducer: deadLetterProducer,
+		workers:            workers,
+	}
 }
 
 // Setup запускается в начале новой сессии до вызова ConsumeClaim
@@ -33,6 +45,10 @@ func (c *GroupHandler) Cleanup(sarama.ConsumerGroupSession) error {
 // ConsumeClaim должен запустить потребительский цикл сообщений ConsumerGroupClaim().
 // После закрытия канала Messages() обработчик должен завершить обработку
 func (c *GroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	if c.workers > 1 {
+		return c.consumeClaimConcurrently(session, claim)
+	}
+
 	// Код ниже не стоит перемещать в горутину, так как ConsumeClaim
 	// уже запускается в горутине, см.:
 	// https://github.com/IBM/sarama/blob/main/consumer_group.go#L869
@@ -44,12 +60,14 @@ func (c *GroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim s
 				return nil
 			}
 
-			log.Printf("message claimed: value = %s, timestamp = %v, topic = %s\n", string(message.Value), message.Timestamp, message.Topic)
+			log.Printf("message claimed from %s/%d at offset %d\n", message.Topic, message.Partition, message.Offset)
 
-			err := c.msgHandler(session.Context(), message)
-			if err != nil {
-				log.Printf("error handling message: %v\n", err)
-				continue
+			observeLag(claim, message)
+
+			// сообщение не помечается, если сессия завершилась до его обработки,
+			// и будет получено повторно после перебалансировки
+			if !c.handle(session.Context(), message) {
+				return nil
 			}
 
 			session.MarkMessage(message, "")
@@ -63,3 +81,54 @@ func (c *GroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim s
 		}
 	}
 }
+
+// handle обрабатывает сообщение с повторами и после исчерпания попыток или постоянной ошибки
+// отправляет его в dead letter топик.
+// Все попытки записываются в один span, который продолжает трейс отправителя сообщения.
+// Возвращает false, если контекст завершился раньше, чем сообщение было обработано или отправлено
+func (c *GroupHandler) handle(ctx context.Context, msg *sarama.ConsumerMessage) bool {
+	ctx, span := startSpan(ctx, msg)
+	defer span.End()
+
+	for attempt := 1; ; attempt++ {
+		err := c.msgHandler(ctx, msg)
+		if err == nil {
+			observeOutcome(msg.Topic, outcomeHandled, 1)
+			return true
+		}
+
+		recordError(span, err)
+
+		log.Printf("error handling message from %s/%d at offset %d, attempt %d: %v\n", msg.Topic, msg.Partition, msg.Offset, attempt, err)
+
+		if (attempt >= c.retryPolicy.Attempts || IsPermanent(err)) && c.deadLetterProducer != nil {
+			return c.sendToDeadLetter(ctx, msg, err, attempt)
+		}
+
+		observeOutcome(msg.Topic, outcomeRetried, 1)
+
+		if !retry.Sleep(ctx, c.retryPolicy.Backoff(attempt)) {
+			return false
+		}
+	}
+}
+
+// sendToDeadLetter повторяет отправку, пока она не удастся, чтобы сообщение не было помечено без сохранения
+func (c *GroupHandler) sendToDeadLetter(ctx context.Context, msg *sarama.ConsumerMessage, handleErr error, attempts int) bool {
+	deadLetterMsg := toDeadLetterMessage(msg, handleErr, attempts)
+
+	for {
+		err := c.deadLetterProducer.SendMessage(ctx, deadLetterMsg)
+		if err == nil {
+			log.Printf("message from %s/%d at offset %d sent to %s\n", msg.Topic, msg.Partition, msg.Offset, deadLetterMsg.Topic)
+			observeOutcome(msg.Topic, outcomeDeadLettered, 1)
+			return true
+		}
+
+		log.Printf("failed to send message to %s: %v\n", deadLetterMsg.Topic, err)
+
+		if !retry.Sleep(ctx, c.retryPolicy.MaxBackoff) {
+			return false
+		}
+	}
+}
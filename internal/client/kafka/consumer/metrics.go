@@ -0,0 +1,49 @@
+package consumer
+
+import (
+	"strconv"
+
+	"github.com/IBM/sarama"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/ipv02/auth/internal/metric"
+)
+
+// Результаты обработки сообщения
+const (
+	outcomeHandled      = "handled"
+	outcomeRetried      = "retried"
+	outcomeDeadLettered = "dead_lettered"
+)
+
+var (
+	consumerLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metric.Namespace,
+		Subsystem: "kafka_consumer",
+		Name:      "lag",
+		Help:      "Число сообщений партиции после последнего полученного сообщения",
+	}, []string{"topic", "partition"})
+
+	handledMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metric.Namespace,
+		Subsystem: "kafka_consumer",
+		Name:      "messages_total",
+		Help:      "Число сообщений по топику и результату обработки: handled, retried, dead_lettered",
+	}, []string{"topic", "outcome"})
+)
+
+// observeLag запоминает отставание партиции по смещению полученного сообщения
+func observeLag(claim sarama.ConsumerGroupClaim, msg *sarama.ConsumerMessage) {
+	lag := claim.HighWaterMarkOffset() - msg.Offset - 1
+	if lag < 0 {
+		lag = 0
+	}
+
+	consumerLag.WithLabelValues(msg.Topic, strconv.FormatInt(int64(msg.Partition), 10)).Set(float64(lag))
+}
+
+// observeOutcome учитывает n сообщений топика с результатом outcome
+func observeOutcome(topic, outcome string, n int) {
+	handledMessages.WithLabelValues(topic, outcome).Add(float64(n))
+}
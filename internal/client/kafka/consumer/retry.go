@@ -0,0 +1,9 @@
+package consumer
+
+import (
+	"github.com/ipv02/auth/internal/client/retry"
+)
+
+// RetryPolicy политика повторной обработки сообщения.
+// Attempts - число попыток обработки, включая первую, задержка между попытками растет экспоненциально
+type RetryPolicy = retry.Policy
@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/auth/internal/client/kafka/consumer"
+)
+
+func TestBatchGroupHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		handlerErr = fmt.Errorf("handler error")
+
+		retryPolicy = consumer.RetryPolicy{
+			Attempts:       2,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     2 * time.Millisecond,
+		}
+	)
+
+	msgs := make([]*sarama.ConsumerMessage, 0, 5)
+	for offset := int64(0); offset < 5; offset++ {
+		msgs = append(msgs, &sarama.ConsumerMessage{Topic: "users", Offset: offset})
+	}
+
+	tests := []struct {
+		name           string
+		failOffset     int64
+		permanent      bool
+		wantBatches    [][]int64
+		wantMarked     []int64
+		wantDeadLetter []int64
+	}{
+		{
+			name:        "success case",
+			failOffset:  -1,
+			wantBatches: [][]int64{{0, 1}, {2, 3}, {4}},
+			wantMarked:  []int64{1, 3, 4},
+		},
+		{
+			name:           "one by one after batch error case",
+			failOffset:     2,
+			wantBatches:    [][]int64{{0, 1}, {2, 3}, {2}, {2}, {3}, {4}},
+			wantMarked:     []int64{1, 2, 3, 4},
+			wantDeadLetter: []int64{2},
+		},
+		{
+			name:           "permanent error case",
+			failOffset:     2,
+			permanent:      true,
+			wantBatches:    [][]int64{{0, 1}, {2, 3}, {3}, {4}},
+			wantMarked:     []int64{1, 3, 4},
+			wantDeadLetter: []int64{2},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			p := &deadLetterProducer{}
+			s := &session{}
+			c := consumer.NewBatchConsumer(
+				&consumerGroup{session: s, msgs: msgs, cancel: cancel},
+				consumer.NewBatchGroupHandler(retryPolicy, p, 2, time.Hour),
+			)
+
+			var batches [][]int64
+			err := c.Consume(ctx, []string{"users"}, func(_ context.Context, batch []*sarama.ConsumerMessage) error {
+				offsets := make([]int64, 0, len(batch))
+				for _, msg := range batch {
+					offsets = append(offsets, msg.Offset)
+				}
+				batches = append(batches, offsets)
+
+				for _, msg := range batch {
+					if msg.Offset == tt.failOffset && tt.permanent {
+						return &consumer.PermanentBatchError{
+							Errors: map[*sarama.ConsumerMessage]error{msg: handlerErr},
+						}
+					}
+
+					if msg.Offset == tt.failOffset {
+						return handlerErr
+					}
+				}
+
+				return nil
+			})
+			require.ErrorIs(t, err, context.Canceled)
+
+			require.Equal(t, tt.wantBatches, batches)
+			require.Equal(t, tt.wantMarked, offsets(s.marked))
+
+			var deadLetter []int64
+			for _, msg := range p.sent {
+				for _, header := range msg.Headers {
+					if header.Key == consumer.DeadLetterOriginalOffsetHeader {
+						var offset int64
+						_, err = fmt.Sscan(string(header.Value), &offset)
+						require.NoError(t, err)
+						deadLetter = append(deadLetter, offset)
+					}
+				}
+			}
+			require.Equal(t, tt.wantDeadLetter, deadLetter)
+		})
+	}
+}
+
+func offsets(msgs []*sarama.ConsumerMessage) []int64 {
+	res := make([]int64, 0, len(msgs))
+	for _, msg := range msgs {
+		res = append(res, msg.Offset)
+	}
+
+	return res
+}
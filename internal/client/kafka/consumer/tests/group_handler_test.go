@@ -0,0 +1,282 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/auth/internal/client/kafka/consumer"
+	"github.com/ipv02/auth/internal/client/kafka/producer"
+)
+
+// consumerGroup передает сообщения в ConsumeClaim и завершает потребление
+type consumerGroup struct {
+	sarama.ConsumerGroup
+
+	session *session
+	msgs    []*sarama.ConsumerMessage
+	cancel  context.CancelFunc
+}
+
+func (g *consumerGroup) Consume(ctx context.Context, _ []string, handler sarama.ConsumerGroupHandler) error {
+	messages := make(chan *sarama.ConsumerMessage, len(g.msgs))
+	for _, msg := range g.msgs {
+		messages <- msg
+	}
+	close(messages)
+
+	g.session.ctx = ctx
+	err := handler.ConsumeClaim(g.session, claim{messages: messages})
+	g.cancel()
+
+	return err
+}
+
+type session struct {
+	sarama.ConsumerGroupSession
+
+	ctx    context.Context
+	mu     sync.Mutex
+	marked []*sarama.ConsumerMessage
+}
+
+func (s *session) Context() context.Context {
+	return s.ctx
+}
+
+func (s *session) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.marked = append(s.marked, msg)
+}
+
+type claim struct {
+	sarama.ConsumerGroupClaim
+
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c claim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
+
+func (c claim) HighWaterMarkOffset() int64 {
+	return 0
+}
+
+// deadLetterProducer запоминает отправленные сообщения и возвращает ошибку на первых failures отправках
+type deadLetterProducer struct {
+	mu       sync.Mutex
+	sent     []*producer.Message
+	failures int
+}
+
+func (p *deadLetterProducer) SendMessage(_ context.Context, msg *producer.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.failures > 0 {
+		p.failures--
+		return fmt.Errorf("producer error")
+	}
+
+	p.sent = append(p.sent, msg)
+	return nil
+}
+
+func TestGroupHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		handlerErr = fmt.Errorf("handler error")
+
+		retryPolicy = consumer.RetryPolicy{
+			Attempts:       3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     2 * time.Millisecond,
+		}
+
+		msg = &sarama.ConsumerMessage{
+			Topic:     "users",
+			Partition: 2,
+			Offset:    42,
+			Key:       []byte("key"),
+			Value:     []byte("value"),
+			Headers:   []*sarama.RecordHeader{{Key: []byte("content-type"), Value: []byte("application/json")}},
+		}
+
+		deadLetterMsg = func(attempts string) *producer.Message {
+			return &producer.Message{
+				Topic: "users.dlq",
+				Key:   []byte("key"),
+				Value: []byte("value"),
+				Headers: []producer.Header{
+					{Key: "content-type", Value: []byte("application/json")},
+					{Key: consumer.DeadLetterErrorHeader, Value: []byte("handler error")},
+					{Key: consumer.DeadLetterAttemptsHeader, Value: []byte(attempts)},
+					{Key: consumer.DeadLetterOriginalTopicHeader, Value: []byte("users")},
+					{Key: consumer.DeadLetterOriginalPartitionHeader, Value: []byte("2")},
+					{Key: consumer.DeadLetterOriginalOffsetHeader, Value: []byte("42")},
+				},
+			}
+		}
+	)
+
+	tests := []struct {
+		name               string
+		handlerFailures    int
+		producerFailures   int
+		permanent          bool
+		wantHandlerCalls   int
+		wantDeadLetterMsgs []*producer.Message
+	}{
+		{
+			name:             "success case",
+			wantHandlerCalls: 1,
+		},
+		{
+			name:             "success after retries case",
+			handlerFailures:  2,
+			wantHandlerCalls: 3,
+		},
+		{
+			name:               "dead letter case",
+			handlerFailures:    3,
+			wantHandlerCalls:   3,
+			wantDeadLetterMsgs: []*producer.Message{deadLetterMsg("3")},
+		},
+		{
+			name:               "dead letter producer error case",
+			handlerFailures:    3,
+			producerFailures:   2,
+			wantHandlerCalls:   3,
+			wantDeadLetterMsgs: []*producer.Message{deadLetterMsg("3")},
+		},
+		{
+			name:               "permanent error case",
+			handlerFailures:    3,
+			permanent:          true,
+			wantHandlerCalls:   1,
+			wantDeadLetterMsgs: []*producer.Message{deadLetterMsg("1")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			p := &deadLetterProducer{failures: tt.producerFailures}
+			s := &session{}
+			c := consumer.NewConsumer(
+				&consumerGroup{session: s, msgs: []*sarama.ConsumerMessage{msg}, cancel: cancel},
+				consumer.NewGroupHandler(retryPolicy, p, 1),
+			)
+
+			calls := 0
+			err := c.Consume(ctx, []string{"users"}, func(_ context.Context, _ *sarama.ConsumerMessage) error {
+				calls++
+				if calls <= tt.handlerFailures && tt.permanent {
+					return consumer.Permanent(handlerErr)
+				}
+
+				if calls <= tt.handlerFailures {
+					return handlerErr
+				}
+
+				return nil
+			})
+			require.ErrorIs(t, err, context.Canceled)
+
+			require.Equal(t, tt.wantHandlerCalls, calls)
+			require.Equal(t, tt.wantDeadLetterMsgs, p.sent)
+			require.Equal(t, []*sarama.ConsumerMessage{msg}, s.marked)
+		})
+	}
+}
+
+func TestGroupHandlerWorkers(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	keys := []string{"a", "b", "c"}
+	msgs := make([]*sarama.ConsumerMessage, 0, 12)
+	for offset := int64(0); offset < 12; offset++ {
+		msgs = append(msgs, &sarama.ConsumerMessage{
+			Topic:  "users",
+			Offset: offset,
+			Key:    []byte(keys[offset%int64(len(keys))]),
+		})
+	}
+
+	s := &session{}
+	c := consumer.NewConsumer(
+		&consumerGroup{session: s, msgs: msgs, cancel: cancel},
+		consumer.NewGroupHandler(consumer.RetryPolicy{Attempts: 1}, nil, 3),
+	)
+
+	var (
+		mu      sync.Mutex
+		handled = make(map[string][]int64)
+	)
+	err := c.Consume(ctx, []string{"users"}, func(_ context.Context, msg *sarama.ConsumerMessage) error {
+		// первое сообщение обрабатывается дольше остальных, чтобы последующие завершились раньше него
+		if msg.Offset == 0 {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		handled[string(msg.Key)] = append(handled[string(msg.Key)], msg.Offset)
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+
+	for i, key := range keys {
+		var want []int64
+		for offset := int64(i); offset < 12; offset += int64(len(keys)) {
+			want = append(want, offset)
+		}
+		require.Equal(t, want, handled[key])
+	}
+
+	require.NotEmpty(t, s.marked)
+	for i := 1; i < len(s.marked); i++ {
+		require.Greater(t, s.marked[i].Offset, s.marked[i-1].Offset)
+	}
+	require.Equal(t, msgs[len(msgs)-1], s.marked[len(s.marked)-1])
+}
+
+func TestToReplayMessage(t *testing.T) {
+	t.Parallel()
+
+	msg := &sarama.ConsumerMessage{
+		Topic: "users.dlq",
+		Key:   []byte("key"),
+		Value: []byte("value"),
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte("content-type"), Value: []byte("application/json")},
+			{Key: []byte(consumer.DeadLetterErrorHeader), Value: []byte("handler error")},
+			{Key: []byte(consumer.DeadLetterOriginalTopicHeader), Value: []byte("users")},
+		},
+	}
+
+	require.Equal(t, &producer.Message{
+		Topic:   "users",
+		Key:     []byte("key"),
+		Value:   []byte("value"),
+		Headers: []producer.Header{{Key: "content-type", Value: []byte("application/json")}},
+	}, consumer.ToReplayMessage(msg))
+}
@@ -0,0 +1,87 @@
+package consumer
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/IBM/sarama"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/ipv02/auth/internal/client/kafka/consumer"
+
+// headerCarrier читает контекст трейса из заголовков полученного сообщения
+type headerCarrier []*sarama.RecordHeader
+
+func (c headerCarrier) Get(key string) string {
+	for _, header := range c {
+		if string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+
+	return ""
+}
+
+// Set не используется: заголовки полученного сообщения только читаются
+func (c headerCarrier) Set(string, string) {}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for _, header := range c {
+		keys = append(keys, string(header.Key))
+	}
+
+	return keys
+}
+
+// startSpan начинает span обработки сообщения, который продолжает трейс отправителя из заголовков сообщения
+func startSpan(ctx context.Context, msg *sarama.ConsumerMessage) (context.Context, trace.Span) {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, headerCarrier(msg.Headers))
+
+	return otel.Tracer(tracerName).Start(ctx, msg.Topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypeDeliver,
+			semconv.MessagingDestinationName(msg.Topic),
+			semconv.MessagingDestinationPartitionID(strconv.FormatInt(int64(msg.Partition), 10)),
+			semconv.MessagingKafkaMessageOffset(int(msg.Offset)),
+		),
+	)
+}
+
+// startBatchSpan начинает span обработки пакета. У сообщений пакета разные трейсы отправителей,
+// поэтому они связываются со span ссылками, а не становятся его родителями
+func startBatchSpan(ctx context.Context, batch []*sarama.ConsumerMessage) (context.Context, trace.Span) {
+	links := make([]trace.Link, 0, len(batch))
+	for _, msg := range batch {
+		spanCtx := trace.SpanContextFromContext(otel.GetTextMapPropagator().Extract(context.Background(), headerCarrier(msg.Headers)))
+		if spanCtx.IsValid() {
+			links = append(links, trace.Link{SpanContext: spanCtx})
+		}
+	}
+
+	first := batch[0]
+
+	return otel.Tracer(tracerName).Start(ctx, first.Topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithLinks(links...),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypeDeliver,
+			semconv.MessagingDestinationName(first.Topic),
+			semconv.MessagingDestinationPartitionID(strconv.FormatInt(int64(first.Partition), 10)),
+			semconv.MessagingBatchMessageCount(len(batch)),
+		),
+	)
+}
+
+// recordError записывает ошибку обработки в span
+func recordError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
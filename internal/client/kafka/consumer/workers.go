@@ -0,0 +1,133 @@
+package consumer
+
+import (
+	"hash/fnv"
+	"log"
+	"sync"
+
+	"github.com/IBM/sarama"
+)
+
+// workerQueueSize размер очереди сообщений одного воркера
+const workerQueueSize = 16
+
+// consumeClaimConcurrently распределяет сообщения партиции между воркерами по ключу, поэтому сообщения
+// с одним ключом обрабатываются по порядку одним воркером. Смещение помечается только после обработки
+// всех предыдущих сообщений партиции, и необработанные сообщения будут получены повторно после перебалансировки
+func (c *GroupHandler) consumeClaimConcurrently(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	ctx := session.Context()
+	tracker := newOffsetTracker(session)
+
+	wg := sync.WaitGroup{}
+	queues := make([]chan *sarama.ConsumerMessage, c.workers)
+	for i := range queues {
+		queues[i] = make(chan *sarama.ConsumerMessage, workerQueueSize)
+
+		wg.Add(1)
+		go func(queue <-chan *sarama.ConsumerMessage) {
+			defer wg.Done()
+
+			for message := range queue {
+				// после завершения сессии оставшиеся сообщения только вычитываются из очереди
+				if ctx.Err() != nil {
+					continue
+				}
+
+				if c.handle(ctx, message) {
+					tracker.done(message)
+				}
+			}
+		}(queues[i])
+	}
+
+	defer func() {
+		for _, queue := range queues {
+			close(queue)
+		}
+		wg.Wait()
+	}()
+
+	for {
+		select {
+		case message, ok := <-claim.Messages():
+			if !ok {
+				log.Printf("message channel was closed\n")
+				return nil
+			}
+
+			log.Printf("message claimed from %s/%d at offset %d\n", message.Topic, message.Partition, message.Offset)
+
+			observeLag(claim, message)
+			tracker.add(message)
+
+			select {
+			case queues[c.worker(message)] <- message:
+			case <-ctx.Done():
+				log.Printf("session context done\n")
+				return nil
+			}
+
+		case <-ctx.Done():
+			log.Printf("session context done\n")
+			return nil
+		}
+	}
+}
+
+// worker возвращает номер воркера для сообщения. Сообщения без ключа распределяются по смещению
+func (c *GroupHandler) worker(msg *sarama.ConsumerMessage) int {
+	if len(msg.Key) == 0 {
+		return int(msg.Offset % int64(c.workers))
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write(msg.Key)
+
+	return int(h.Sum32() % uint32(c.workers))
+}
+
+// offsetTracker помечает смещение последнего сообщения, до которого обработаны все сообщения партиции
+type offsetTracker struct {
+	mu        sync.Mutex
+	session   sarama.ConsumerGroupSession
+	pending   []*sarama.ConsumerMessage
+	processed map[int64]struct{}
+}
+
+func newOffsetTracker(session sarama.ConsumerGroupSession) *offsetTracker {
+	return &offsetTracker{
+		session:   session,
+		processed: make(map[int64]struct{}),
+	}
+}
+
+// add добавляет сообщение в порядке получения из партиции
+func (t *offsetTracker) add(msg *sarama.ConsumerMessage) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.pending = append(t.pending, msg)
+}
+
+// done отмечает сообщение обработанным и помечает в сессии непрерывный обработанный префикс
+func (t *offsetTracker) done(msg *sarama.ConsumerMessage) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.processed[msg.Offset] = struct{}{}
+
+	var last *sarama.ConsumerMessage
+	for len(t.pending) > 0 {
+		if _, ok := t.processed[t.pending[0].Offset]; !ok {
+			break
+		}
+
+		last = t.pending[0]
+		delete(t.processed, last.Offset)
+		t.pending = t.pending[1:]
+	}
+
+	if last != nil {
+		t.session.MarkMessage(last, "")
+	}
+}
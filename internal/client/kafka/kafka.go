@@ -4,10 +4,23 @@ import (
 	"context"
 
 	"github.com/ipv02/auth/internal/client/kafka/consumer"
+	"github.com/ipv02/auth/internal/client/kafka/producer"
 )
 
 // Consumer определяет интерфейс для потребителя сообщений из очереди
 type Consumer interface {
-	Consume(ctx context.Context, topicName string, handler consumer.Handler) (err error)
+	Consume(ctx context.Context, topics []string, handler consumer.Handler) (err error)
+	Close() error
+}
+
+// BatchConsumer определяет интерфейс для потребителя, который обрабатывает сообщения пакетами
+type BatchConsumer interface {
+	Consume(ctx context.Context, topics []string, handler consumer.BatchHandler) (err error)
+	Close() error
+}
+
+// Producer определяет интерфейс для отправки сообщений в очередь
+type Producer interface {
+	SendMessage(ctx context.Context, msg *producer.Message) error
 	Close() error
 }
@@ -0,0 +1,90 @@
+package producer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/IBM/sarama"
+)
+
+type asyncProducer struct {
+	producer   sarama.AsyncProducer
+	onDelivery DeliveryHandler
+
+	wg sync.WaitGroup
+}
+
+// NewAsyncProducer создает producer, который отправляет сообщения в фоне.
+// Результат доставки каждого сообщения передается в onDelivery, поэтому
+// sarama конфиг должен возвращать и успехи, и ошибки
+func NewAsyncProducer(producer sarama.AsyncProducer, onDelivery DeliveryHandler) *asyncProducer {
+	if onDelivery == nil {
+		onDelivery = func(DeliveryReport) {}
+	}
+
+	p := &asyncProducer{
+		producer:   producer,
+		onDelivery: onDelivery,
+	}
+
+	p.wg.Add(2)
+	go p.handleSuccesses()
+	go p.handleErrors()
+
+	return p
+}
+
+// SendMessage ставит сообщение в очередь на отправку, не дожидаясь подтверждения брокера.
+// Контекст трейса из ctx передается в заголовках сообщения, span отправки завершается постановкой в очередь
+func (p *asyncProducer) SendMessage(ctx context.Context, msg *Message) error {
+	producerMsg := toProducerMessage(msg)
+	span := startSpan(ctx, producerMsg)
+
+	select {
+	case <-ctx.Done():
+		endSpan(span, ctx.Err())
+		return ctx.Err()
+	case p.producer.Input() <- producerMsg:
+		endSpan(span, nil)
+		return nil
+	}
+}
+
+// Close дожидается отправки сообщений из очереди и обработки всех отчетов о доставке
+func (p *asyncProducer) Close() error {
+	p.producer.AsyncClose()
+	p.wg.Wait()
+
+	return nil
+}
+
+func (p *asyncProducer) handleSuccesses() {
+	defer p.wg.Done()
+
+	for msg := range p.producer.Successes() {
+		p.onDelivery(DeliveryReport{
+			Message:   toMessage(msg),
+			Partition: msg.Partition,
+			Offset:    msg.Offset,
+		})
+	}
+}
+
+func (p *asyncProducer) handleErrors() {
+	defer p.wg.Done()
+
+	for producerErr := range p.producer.Errors() {
+		p.onDelivery(DeliveryReport{
+			Message:   toMessage(producerErr.Msg),
+			Partition: producerErr.Msg.Partition,
+			Offset:    producerErr.Msg.Offset,
+			Err:       producerErr.Err,
+		})
+	}
+}
+
+// toMessage возвращает исходное сообщение, сохраненное в метаданных sarama сообщения
+func toMessage(msg *sarama.ProducerMessage) *Message {
+	message, _ := msg.Metadata.(*Message)
+	return message
+}
@@ -0,0 +1,72 @@
+package producer
+
+import (
+	"log"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+// Header заголовок сообщения kafka
+type Header struct {
+	Key   string
+	Value []byte
+}
+
+// Message сообщение для отправки в kafka.
+// Сообщения с одинаковым Key попадают в одну партицию и сохраняют порядок
+type Message struct {
+	Topic     string
+	Key       []byte
+	Value     []byte
+	Headers   []Header
+	Timestamp time.Time
+}
+
+// DeliveryReport результат доставки сообщения, Err - ошибка, если брокер не подтвердил запись
+type DeliveryReport struct {
+	Message   *Message
+	Partition int32
+	Offset    int64
+	Err       error
+}
+
+// DeliveryHandler определяет тип функции, вызываемой после доставки каждого сообщения
+type DeliveryHandler func(report DeliveryReport)
+
+func toProducerMessage(msg *Message) *sarama.ProducerMessage {
+	headers := make([]sarama.RecordHeader, 0, len(msg.Headers))
+	for _, header := range msg.Headers {
+		headers = append(headers, sarama.RecordHeader{
+			Key:   []byte(header.Key),
+			Value: header.Value,
+		})
+	}
+
+	producerMsg := &sarama.ProducerMessage{
+		Topic:     msg.Topic,
+		Value:     sarama.ByteEncoder(msg.Value),
+		Headers:   headers,
+		Timestamp: msg.Timestamp,
+		Metadata:  msg,
+	}
+	if msg.Key != nil {
+		producerMsg.Key = sarama.ByteEncoder(msg.Key)
+	}
+
+	return producerMsg
+}
+
+// LogDeliveryErrors обработчик доставки, который логирует неотправленные сообщения
+func LogDeliveryErrors(report DeliveryReport) {
+	if report.Err == nil {
+		return
+	}
+
+	topic := ""
+	if report.Message != nil {
+		topic = report.Message.Topic
+	}
+
+	log.Printf("failed to deliver message to topic %s: %v\n", topic, report.Err)
+}
@@ -0,0 +1,51 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+)
+
+type syncProducer struct {
+	producer   sarama.SyncProducer
+	onDelivery DeliveryHandler
+}
+
+// NewSyncProducer создает producer, который дожидается подтверждения записи каждого сообщения.
+// onDelivery может быть nil
+func NewSyncProducer(producer sarama.SyncProducer, onDelivery DeliveryHandler) *syncProducer {
+	return &syncProducer{
+		producer:   producer,
+		onDelivery: onDelivery,
+	}
+}
+
+// SendMessage отправляет сообщение и возвращает ошибку, если брокер не подтвердил запись.
+// Контекст трейса из ctx передается в заголовках сообщения
+func (p *syncProducer) SendMessage(ctx context.Context, msg *Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	producerMsg := toProducerMessage(msg)
+	span := startSpan(ctx, producerMsg)
+
+	partition, offset, err := p.producer.SendMessage(producerMsg)
+	endSpan(span, err)
+
+	if p.onDelivery != nil {
+		p.onDelivery(DeliveryReport{
+			Message:   msg,
+			Partition: partition,
+			Offset:    offset,
+			Err:       err,
+		})
+	}
+
+	return err
+}
+
+// Close закрывает producer
+func (p *syncProducer) Close() error {
+	return p.producer.Close()
+}
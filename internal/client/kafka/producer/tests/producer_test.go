@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/auth/internal/client/kafka/producer"
+)
+
+func newMessage() *producer.Message {
+	return &producer.Message{
+		Topic:   "user-events",
+		Key:     []byte("10"),
+		Value:   []byte(`{"id":10}`),
+		Headers: []producer.Header{{Key: "event-type", Value: []byte("user.created")}},
+	}
+}
+
+// checkMessage проверяет, что ключ и заголовки переданы в sarama сообщение
+func checkMessage(msg *sarama.ProducerMessage) error {
+	key, err := msg.Key.Encode()
+	if err != nil {
+		return err
+	}
+	if string(key) != "10" {
+		return fmt.Errorf("unexpected key %q", key)
+	}
+
+	if len(msg.Headers) != 1 || string(msg.Headers[0].Key) != "event-type" || string(msg.Headers[0].Value) != "user.created" {
+		return fmt.Errorf("unexpected headers %v", msg.Headers)
+	}
+
+	return nil
+}
+
+func TestSyncProducer(t *testing.T) {
+	t.Parallel()
+
+	sendErr := fmt.Errorf("send error")
+
+	tests := []struct {
+		name  string
+		err   error
+		setup func(mock *mocks.SyncProducer)
+	}{
+		{
+			name: "success case",
+			setup: func(mock *mocks.SyncProducer) {
+				mock.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(checkMessage)
+			},
+		},
+		{
+			name: "send error case",
+			err:  sendErr,
+			setup: func(mock *mocks.SyncProducer) {
+				mock.ExpectSendMessageWithMessageCheckerFunctionAndFail(checkMessage, sendErr)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewSyncProducer(t, mocks.NewTestConfig())
+			tt.setup(mock)
+
+			var reports []producer.DeliveryReport
+			p := producer.NewSyncProducer(mock, func(report producer.DeliveryReport) {
+				reports = append(reports, report)
+			})
+
+			msg := newMessage()
+			err := p.SendMessage(context.Background(), msg)
+			require.Equal(t, tt.err, err)
+
+			require.Len(t, reports, 1)
+			require.Same(t, msg, reports[0].Message)
+			require.Equal(t, tt.err, reports[0].Err)
+
+			require.NoError(t, p.Close())
+		})
+	}
+}
+
+func TestAsyncProducer(t *testing.T) {
+	t.Parallel()
+
+	sendErr := fmt.Errorf("send error")
+
+	cfg := mocks.NewTestConfig()
+	cfg.Producer.Return.Successes = true
+
+	mock := mocks.NewAsyncProducer(t, cfg)
+	mock.ExpectInputWithMessageCheckerFunctionAndSucceed(checkMessage)
+	mock.ExpectInputWithMessageCheckerFunctionAndFail(checkMessage, sendErr)
+
+	var (
+		mu      sync.Mutex
+		reports = make(map[*producer.Message]error)
+	)
+	p := producer.NewAsyncProducer(mock, func(report producer.DeliveryReport) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		reports[report.Message] = report.Err
+	})
+
+	delivered, failed := newMessage(), newMessage()
+	require.NoError(t, p.SendMessage(context.Background(), delivered))
+	require.NoError(t, p.SendMessage(context.Background(), failed))
+
+	// Close дожидается обработки всех отчетов о доставке
+	require.NoError(t, p.Close())
+
+	require.Equal(t, map[*producer.Message]error{
+		delivered: nil,
+		failed:    sendErr,
+	}, reports)
+}
@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ipv02/auth/internal/client/kafka/producer"
+)
+
+func TestSyncProducerPropagatesTraceContext(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	traceID := trace.TraceID{0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19}
+	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     trace.SpanID{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), spanCtx)
+
+	mock := mocks.NewSyncProducer(t, mocks.NewTestConfig())
+	mock.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+		carrier := propagation.MapCarrier{}
+		for _, header := range msg.Headers {
+			carrier[string(header.Key)] = string(header.Value)
+		}
+
+		if carrier["event-type"] != "user.created" {
+			return fmt.Errorf("message headers were lost: %v", msg.Headers)
+		}
+
+		got := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), carrier))
+		if got.TraceID() != traceID {
+			return fmt.Errorf("unexpected trace id %s", got.TraceID())
+		}
+
+		return nil
+	})
+
+	p := producer.NewSyncProducer(mock, nil)
+
+	msg := newMessage()
+	require.NoError(t, p.SendMessage(ctx, msg))
+	require.Len(t, msg.Headers, 1, "source message must not be modified")
+
+	require.NoError(t, p.Close())
+}
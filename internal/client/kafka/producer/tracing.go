@@ -0,0 +1,75 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/ipv02/auth/internal/client/kafka/producer"
+
+// headerCarrier записывает контекст трейса в заголовки sarama сообщения
+type headerCarrier struct {
+	headers *[]sarama.RecordHeader
+}
+
+func (c headerCarrier) Get(key string) string {
+	for _, header := range *c.headers {
+		if string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+
+	return ""
+}
+
+// Set заменяет заголовок, поэтому повторно отправляемое сообщение несет контекст текущего трейса
+func (c headerCarrier) Set(key, value string) {
+	for i, header := range *c.headers {
+		if string(header.Key) == key {
+			(*c.headers)[i].Value = []byte(value)
+			return
+		}
+	}
+
+	*c.headers = append(*c.headers, sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(*c.headers))
+	for _, header := range *c.headers {
+		keys = append(keys, string(header.Key))
+	}
+
+	return keys
+}
+
+// startSpan начинает span отправки сообщения и передает его контекст в заголовках сообщения
+func startSpan(ctx context.Context, msg *sarama.ProducerMessage) trace.Span {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, msg.Topic+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypePublish,
+			semconv.MessagingDestinationName(msg.Topic),
+		),
+	)
+
+	otel.GetTextMapPropagator().Inject(ctx, headerCarrier{headers: &msg.Headers})
+
+	return span
+}
+
+// endSpan завершает span отправки сообщения и записывает в него ошибку
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,53 @@
+package retry
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+)
+
+// Policy политика повтора операции.
+// Attempts - число попыток, включая первую, задержка между попытками растет экспоненциально от InitialBackoff до MaxBackoff
+type Policy struct {
+	Attempts       int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Backoff возвращает задержку перед попыткой attempt+1
+func (p Policy) Backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+
+	return backoff
+}
+
+// JitteredBackoff возвращает задержку перед попыткой attempt+1 в диапазоне от половины до полной Backoff,
+// чтобы конкурирующие операции не повторялись одновременно
+func (p Policy) JitteredBackoff(attempt int) time.Duration {
+	backoff := p.Backoff(attempt)
+	if backoff <= 1 {
+		return backoff
+	}
+
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+// Sleep ждет d и возвращает false, если контекст завершился раньше
+func Sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
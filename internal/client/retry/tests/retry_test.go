@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/auth/internal/client/retry"
+)
+
+func TestPolicyBackoff(t *testing.T) {
+	t.Parallel()
+
+	policy := retry.Policy{
+		Attempts:       5,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+	}
+
+	require.Equal(t, 100*time.Millisecond, policy.Backoff(1))
+	require.Equal(t, 200*time.Millisecond, policy.Backoff(2))
+	require.Equal(t, 400*time.Millisecond, policy.Backoff(3))
+	require.Equal(t, 800*time.Millisecond, policy.Backoff(4))
+	require.Equal(t, time.Second, policy.Backoff(5))
+	require.Equal(t, time.Second, policy.Backoff(50))
+}
+
+func TestPolicyJitteredBackoff(t *testing.T) {
+	t.Parallel()
+
+	policy := retry.Policy{
+		Attempts:       5,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+	}
+
+	for attempt := 1; attempt <= 6; attempt++ {
+		backoff := policy.JitteredBackoff(attempt)
+		require.GreaterOrEqual(t, backoff, policy.Backoff(attempt)/2)
+		require.LessOrEqual(t, backoff, policy.Backoff(attempt))
+	}
+
+	require.Zero(t, retry.Policy{}.JitteredBackoff(1))
+}
+
+func TestSleep(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, retry.Sleep(context.Background(), time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.False(t, retry.Sleep(ctx, time.Hour))
+}
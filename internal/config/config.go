@@ -1,6 +1,7 @@
 package config
 
 import (
+	"log/slog"
 	"time"
 
 	"github.com/IBM/sarama"
@@ -32,9 +33,22 @@ type SwaggerConfig interface {
 	Address() string
 }
 
+// MetricsConfig представляет конфигурацию сервера метрик prometheus.
+type MetricsConfig interface {
+	Address() string
+}
+
 // PGConfig представляет конфигурацию для подключения к базе данных PostgreSQL.
 type PGConfig interface {
 	DSN() string
+	ReplicaDSNs() []string
+	ReplicaMaxLag() time.Duration
+	ReplicaCheckInterval() time.Duration
+	TxRetryAttempts() int
+	TxRetryInitialBackoff() time.Duration
+	TxRetryMaxBackoff() time.Duration
+	SlowQueryThreshold() time.Duration
+	LogLevel() slog.Level
 }
 
 // RedisConfig представляет конфигурацию для подключения к redis
@@ -50,9 +64,79 @@ type StorageConfig interface {
 	Mode() string
 }
 
+// UserCacheConfig представляет конфигурацию кеша пользователей в режиме хранилища cached.
+// MasterReadTTL - время после записи, в течение которого промах кеша читает пользователя с мастера
+type UserCacheConfig interface {
+	TTL() time.Duration
+	NegativeTTL() time.Duration
+	MasterReadTTL() time.Duration
+}
+
 // KafkaConsumerConfig представляет конфигурацию работы кафки
 type KafkaConsumerConfig interface {
 	Brokers() []string
 	GroupID() string
+	RetryAttempts() int
+	RetryInitialBackoff() time.Duration
+	RetryMaxBackoff() time.Duration
+	Topics() []string
+	InitialOffset() int64
+	RebalanceStrategy() sarama.BalanceStrategy
+	SessionTimeout() time.Duration
+	HeartbeatInterval() time.Duration
+	Workers() int
+	Mode() string
+	BatchSize() int
+	BatchTimeout() time.Duration
 	Config() *sarama.Config
 }
+
+// KafkaProducerConfig представляет конфигурацию отправки сообщений в кафку
+type KafkaProducerConfig interface {
+	Brokers() []string
+	Config() *sarama.Config
+}
+
+// PasswordHasherConfig представляет конфигурацию хеширования паролей
+type PasswordHasherConfig interface {
+	Algorithm() string
+}
+
+// JWTConfig представляет конфигурацию выпуска JWT токенов
+type JWTConfig interface {
+	AccessTokenSecretKey() []byte
+	RefreshTokenSecretKey() []byte
+	AccessTokenTTL() time.Duration
+	RefreshTokenTTL() time.Duration
+}
+
+// UserPurgerConfig конфиг очистки удаленных пользователей и ключей идемпотентности обработанных сообщений.
+// ProcessedMessageRetention должен быть больше срока, в течение которого сообщение может быть доставлено повторно
+type UserPurgerConfig interface {
+	Retention() time.Duration
+	Interval() time.Duration
+	BatchSize() uint64
+	ProcessedMessageRetention() time.Duration
+}
+
+// OutboxConfig конфиг публикации событий пользователей из outbox в kafka.
+// Без outbox события об изменении пользователей не публикуются
+type OutboxConfig interface {
+	Enabled() bool
+	Topic() string
+	PollInterval() time.Duration
+	BatchSize() uint64
+}
+
+// AccessConfig представляет конфигурацию проверки доступа
+type AccessConfig interface {
+	PolicyReloadInterval() time.Duration
+}
+
+// TracingConfig представляет конфигурацию трейсинга OpenTelemetry
+type TracingConfig interface {
+	Exporter() string
+	OTLPEndpoint() string
+	ServiceName() string
+	SampleRatio() float64
+}
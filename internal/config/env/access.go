@@ -0,0 +1,44 @@
+package env
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/config"
+)
+
+var _ config.AccessConfig = (*accessConfig)(nil)
+
+const accessPolicyReloadIntervalEnvName = "ACCESS_POLICY_RELOAD_INTERVAL_SEC"
+
+type accessConfig struct {
+	policyReloadInterval time.Duration
+}
+
+// NewAccessConfig создает новую конфигурацию проверки доступа
+func NewAccessConfig() (*accessConfig, error) {
+	intervalStr := os.Getenv(accessPolicyReloadIntervalEnvName)
+	if len(intervalStr) == 0 {
+		return nil, errors.New("access policy reload interval not found")
+	}
+
+	interval, err := strconv.ParseInt(intervalStr, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse access policy reload interval")
+	}
+
+	if interval <= 0 {
+		return nil, errors.New("access policy reload interval must be positive")
+	}
+
+	return &accessConfig{
+		policyReloadInterval: time.Duration(interval) * time.Second,
+	}, nil
+}
+
+func (cfg *accessConfig) PolicyReloadInterval() time.Duration {
+	return cfg.policyReloadInterval
+}
@@ -0,0 +1,89 @@
+package env
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/config"
+)
+
+var _ config.JWTConfig = (*jwtConfig)(nil)
+
+const (
+	jwtAccessTokenSecretKeyEnvName  = "JWT_ACCESS_TOKEN_SECRET_KEY"
+	jwtRefreshTokenSecretKeyEnvName = "JWT_REFRESH_TOKEN_SECRET_KEY"
+	jwtAccessTokenTTLEnvName        = "JWT_ACCESS_TOKEN_TTL_SEC"
+	jwtRefreshTokenTTLEnvName       = "JWT_REFRESH_TOKEN_TTL_SEC"
+)
+
+type jwtConfig struct {
+	accessTokenSecretKey  []byte
+	refreshTokenSecretKey []byte
+
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+}
+
+// NewJWTConfig создает новую конфигурацию для выпуска JWT токенов
+func NewJWTConfig() (*jwtConfig, error) {
+	accessTokenSecretKey := os.Getenv(jwtAccessTokenSecretKeyEnvName)
+	if len(accessTokenSecretKey) == 0 {
+		return nil, errors.New("jwt access token secret key not found")
+	}
+
+	refreshTokenSecretKey := os.Getenv(jwtRefreshTokenSecretKeyEnvName)
+	if len(refreshTokenSecretKey) == 0 {
+		return nil, errors.New("jwt refresh token secret key not found")
+	}
+
+	// с одинаковыми ключами токен одного типа проходил бы проверку подписи токена другого типа
+	if accessTokenSecretKey == refreshTokenSecretKey {
+		return nil, errors.New("jwt access and refresh token secret keys must differ")
+	}
+
+	accessTokenTTLStr := os.Getenv(jwtAccessTokenTTLEnvName)
+	if len(accessTokenTTLStr) == 0 {
+		return nil, errors.New("jwt access token ttl not found")
+	}
+
+	accessTokenTTL, err := strconv.ParseInt(accessTokenTTLStr, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse access token ttl")
+	}
+
+	refreshTokenTTLStr := os.Getenv(jwtRefreshTokenTTLEnvName)
+	if len(refreshTokenTTLStr) == 0 {
+		return nil, errors.New("jwt refresh token ttl not found")
+	}
+
+	refreshTokenTTL, err := strconv.ParseInt(refreshTokenTTLStr, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse refresh token ttl")
+	}
+
+	return &jwtConfig{
+		accessTokenSecretKey:  []byte(accessTokenSecretKey),
+		refreshTokenSecretKey: []byte(refreshTokenSecretKey),
+		accessTokenTTL:        time.Duration(accessTokenTTL) * time.Second,
+		refreshTokenTTL:       time.Duration(refreshTokenTTL) * time.Second,
+	}, nil
+}
+
+func (cfg *jwtConfig) AccessTokenSecretKey() []byte {
+	return cfg.accessTokenSecretKey
+}
+
+func (cfg *jwtConfig) RefreshTokenSecretKey() []byte {
+	return cfg.refreshTokenSecretKey
+}
+
+func (cfg *jwtConfig) AccessTokenTTL() time.Duration {
+	return cfg.accessTokenTTL
+}
+
+func (cfg *jwtConfig) RefreshTokenTTL() time.Duration {
+	return cfg.refreshTokenTTL
+}
@@ -1,21 +1,65 @@
 package env
 
 import (
-	"errors"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/pkg/errors"
 )
 
 const (
-	brokersEnvName = "KAFKA_BROKERS"
-	groupIDEnvName = "KAFKA_GROUP_ID"
+	brokersEnvName             = "KAFKA_BROKERS"
+	groupIDEnvName             = "KAFKA_GROUP_ID"
+	retryAttemptsEnvName       = "KAFKA_CONSUMER_RETRY_ATTEMPTS"
+	retryInitialBackoffEnvName = "KAFKA_CONSUMER_RETRY_INITIAL_BACKOFF_MS"
+	retryMaxBackoffEnvName     = "KAFKA_CONSUMER_RETRY_MAX_BACKOFF_MS"
+	topicsEnvName              = "KAFKA_CONSUMER_TOPICS"
+	initialOffsetEnvName       = "KAFKA_CONSUMER_INITIAL_OFFSET"
+	rebalanceStrategyEnvName   = "KAFKA_CONSUMER_REBALANCE_STRATEGY"
+	sessionTimeoutEnvName      = "KAFKA_CONSUMER_SESSION_TIMEOUT_SEC"
+	heartbeatIntervalEnvName   = "KAFKA_CONSUMER_HEARTBEAT_INTERVAL_SEC"
+	workersEnvName             = "KAFKA_CONSUMER_WORKERS"
+	modeEnvName                = "KAFKA_CONSUMER_MODE"
+	batchSizeEnvName           = "KAFKA_CONSUMER_BATCH_SIZE"
+	batchTimeoutEnvName        = "KAFKA_CONSUMER_BATCH_TIMEOUT_MS"
+)
+
+// Значения KAFKA_CONSUMER_MODE
+const (
+	consumerModeMessage = "message"
+	consumerModeBatch   = "batch"
+)
+
+// Значения KAFKA_CONSUMER_INITIAL_OFFSET
+const (
+	initialOffsetOldest = "oldest"
+	initialOffsetNewest = "newest"
+)
+
+// Значения KAFKA_CONSUMER_REBALANCE_STRATEGY
+const (
+	rebalanceStrategyRange      = "range"
+	rebalanceStrategyRoundRobin = "roundrobin"
+	rebalanceStrategySticky     = "sticky"
 )
 
 type kafkaConsumerConfig struct {
-	brokers []string
-	groupID string
+	brokers             []string
+	groupID             string
+	retryAttempts       int
+	retryInitialBackoff time.Duration
+	retryMaxBackoff     time.Duration
+	topics              []string
+	initialOffset       int64
+	rebalanceStrategy   sarama.BalanceStrategy
+	sessionTimeout      time.Duration
+	heartbeatInterval   time.Duration
+	workers             int
+	mode                string
+	batchSize           int
+	batchTimeout        time.Duration
 }
 
 // NewKafkaConsumerConfig создает конфигурацию для Kafka Consumer, используя переменные окружения
@@ -25,19 +69,136 @@ func NewKafkaConsumerConfig() (*kafkaConsumerConfig, error) {
 		return nil, errors.New("kafka brokers address not found")
 	}
 
-	brokers := strings.Split(brokersStr, ",")
+	brokers := splitList(brokersStr)
 
 	groupID := os.Getenv(groupIDEnvName)
 	if len(groupID) == 0 {
 		return nil, errors.New("kafka group id not found")
 	}
 
+	retryAttempts, err := positiveIntFromEnv(retryAttemptsEnvName, "kafka consumer retry attempts")
+	if err != nil {
+		return nil, err
+	}
+
+	retryInitialBackoff, err := positiveIntFromEnv(retryInitialBackoffEnvName, "kafka consumer retry initial backoff")
+	if err != nil {
+		return nil, err
+	}
+
+	retryMaxBackoff, err := positiveIntFromEnv(retryMaxBackoffEnvName, "kafka consumer retry max backoff")
+	if err != nil {
+		return nil, err
+	}
+
+	if retryMaxBackoff < retryInitialBackoff {
+		return nil, errors.New("kafka consumer retry max backoff is less than initial backoff")
+	}
+
+	topics := splitList(os.Getenv(topicsEnvName))
+	if len(topics) == 0 {
+		return nil, errors.New("kafka consumer topics not found")
+	}
+
+	initialOffset, err := initialOffsetFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	rebalanceStrategy, err := rebalanceStrategyFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	sessionTimeout, err := positiveIntFromEnv(sessionTimeoutEnvName, "kafka consumer session timeout")
+	if err != nil {
+		return nil, err
+	}
+
+	heartbeatInterval, err := positiveIntFromEnv(heartbeatIntervalEnvName, "kafka consumer heartbeat interval")
+	if err != nil {
+		return nil, err
+	}
+
+	if heartbeatInterval >= sessionTimeout {
+		return nil, errors.New("kafka consumer heartbeat interval must be less than session timeout")
+	}
+
+	workers, err := positiveIntFromEnv(workersEnvName, "kafka consumer workers")
+	if err != nil {
+		return nil, err
+	}
+
+	mode := os.Getenv(modeEnvName)
+	if mode != consumerModeMessage && mode != consumerModeBatch {
+		return nil, errors.Errorf("unknown kafka consumer mode %q", mode)
+	}
+
+	batchSize, err := positiveIntFromEnv(batchSizeEnvName, "kafka consumer batch size")
+	if err != nil {
+		return nil, err
+	}
+
+	batchTimeout, err := positiveIntFromEnv(batchTimeoutEnvName, "kafka consumer batch timeout")
+	if err != nil {
+		return nil, err
+	}
+
 	return &kafkaConsumerConfig{
-		brokers: brokers,
-		groupID: groupID,
+		brokers:             brokers,
+		groupID:             groupID,
+		retryAttempts:       int(retryAttempts),
+		retryInitialBackoff: time.Duration(retryInitialBackoff) * time.Millisecond,
+		retryMaxBackoff:     time.Duration(retryMaxBackoff) * time.Millisecond,
+		topics:              topics,
+		initialOffset:       initialOffset,
+		rebalanceStrategy:   rebalanceStrategy,
+		sessionTimeout:      time.Duration(sessionTimeout) * time.Second,
+		heartbeatInterval:   time.Duration(heartbeatInterval) * time.Second,
+		workers:             int(workers),
+		mode:                mode,
+		batchSize:           int(batchSize),
+		batchTimeout:        time.Duration(batchTimeout) * time.Millisecond,
 	}, nil
 }
 
+func initialOffsetFromEnv() (int64, error) {
+	switch value := os.Getenv(initialOffsetEnvName); value {
+	case initialOffsetOldest:
+		return sarama.OffsetOldest, nil
+	case initialOffsetNewest:
+		return sarama.OffsetNewest, nil
+	default:
+		return 0, errors.Errorf("unknown kafka consumer initial offset %q", value)
+	}
+}
+
+func rebalanceStrategyFromEnv() (sarama.BalanceStrategy, error) {
+	switch value := os.Getenv(rebalanceStrategyEnvName); value {
+	case rebalanceStrategyRange:
+		return sarama.NewBalanceStrategyRange(), nil
+	case rebalanceStrategyRoundRobin:
+		return sarama.NewBalanceStrategyRoundRobin(), nil
+	case rebalanceStrategySticky:
+		return sarama.NewBalanceStrategySticky(), nil
+	default:
+		return nil, errors.Errorf("unknown kafka consumer rebalance strategy %q", value)
+	}
+}
+
+// splitList разбивает список через запятую и отбрасывает пустые элементы
+func splitList(value string) []string {
+	var res []string
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) > 0 {
+			res = append(res, item)
+		}
+	}
+
+	return res
+}
+
 // Brokers возвращает список адресов брокеров Kafka из конфигурации
 func (cfg *kafkaConsumerConfig) Brokers() []string {
 	return cfg.brokers
@@ -48,12 +209,74 @@ func (cfg *kafkaConsumerConfig) GroupID() string {
 	return cfg.groupID
 }
 
+// RetryAttempts возвращает число попыток обработки сообщения перед отправкой в dead letter топик
+func (cfg *kafkaConsumerConfig) RetryAttempts() int {
+	return cfg.retryAttempts
+}
+
+// RetryInitialBackoff возвращает задержку перед второй попыткой обработки сообщения
+func (cfg *kafkaConsumerConfig) RetryInitialBackoff() time.Duration {
+	return cfg.retryInitialBackoff
+}
+
+// RetryMaxBackoff возвращает максимальную задержку между попытками обработки сообщения
+func (cfg *kafkaConsumerConfig) RetryMaxBackoff() time.Duration {
+	return cfg.retryMaxBackoff
+}
+
+// Topics возвращает топики, из которых читает consumer
+func (cfg *kafkaConsumerConfig) Topics() []string {
+	return cfg.topics
+}
+
+// InitialOffset возвращает смещение, с которого читается партиция без сохраненного смещения группы
+func (cfg *kafkaConsumerConfig) InitialOffset() int64 {
+	return cfg.initialOffset
+}
+
+// RebalanceStrategy возвращает стратегию распределения партиций между участниками группы
+func (cfg *kafkaConsumerConfig) RebalanceStrategy() sarama.BalanceStrategy {
+	return cfg.rebalanceStrategy
+}
+
+// SessionTimeout возвращает время, после которого участник без heartbeat исключается из группы
+func (cfg *kafkaConsumerConfig) SessionTimeout() time.Duration {
+	return cfg.sessionTimeout
+}
+
+// HeartbeatInterval возвращает интервал отправки heartbeat координатору группы
+func (cfg *kafkaConsumerConfig) HeartbeatInterval() time.Duration {
+	return cfg.heartbeatInterval
+}
+
+// Workers возвращает число воркеров, обрабатывающих сообщения одной партиции
+func (cfg *kafkaConsumerConfig) Workers() int {
+	return cfg.workers
+}
+
+// Mode возвращает режим обработки сообщений: message - по одному, batch - пакетами
+func (cfg *kafkaConsumerConfig) Mode() string {
+	return cfg.mode
+}
+
+// BatchSize возвращает максимальное число сообщений в пакете
+func (cfg *kafkaConsumerConfig) BatchSize() int {
+	return cfg.batchSize
+}
+
+// BatchTimeout возвращает время накопления пакета с получения его первого сообщения
+func (cfg *kafkaConsumerConfig) BatchTimeout() time.Duration {
+	return cfg.batchTimeout
+}
+
 // Config возвращает конфигурацию для sarama consumer
 func (cfg *kafkaConsumerConfig) Config() *sarama.Config {
 	config := sarama.NewConfig()
 	config.Version = sarama.V2_6_0_0
-	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRoundRobin()}
-	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{cfg.rebalanceStrategy}
+	config.Consumer.Group.Session.Timeout = cfg.sessionTimeout
+	config.Consumer.Group.Heartbeat.Interval = cfg.heartbeatInterval
+	config.Consumer.Offsets.Initial = cfg.initialOffset
 
 	return config
 }
@@ -0,0 +1,90 @@
+package env
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/config"
+)
+
+var _ config.KafkaProducerConfig = (*kafkaProducerConfig)(nil)
+
+const (
+	producerClientIDEnvName   = "KAFKA_PRODUCER_CLIENT_ID"
+	producerIdempotentEnvName = "KAFKA_PRODUCER_IDEMPOTENT"
+	producerRetryMaxEnvName   = "KAFKA_PRODUCER_RETRY_MAX"
+)
+
+type kafkaProducerConfig struct {
+	brokers    []string
+	clientID   string
+	idempotent bool
+	retryMax   int
+}
+
+// NewKafkaProducerConfig создает конфигурацию для Kafka Producer, используя переменные окружения.
+// Producer подключается к тем же брокерам, что и consumer
+func NewKafkaProducerConfig() (*kafkaProducerConfig, error) {
+	brokersStr := os.Getenv(brokersEnvName)
+	if len(brokersStr) == 0 {
+		return nil, errors.New("kafka brokers address not found")
+	}
+
+	brokers := strings.Split(brokersStr, ",")
+	for i := range brokers {
+		brokers[i] = strings.TrimSpace(brokers[i])
+	}
+
+	clientID := os.Getenv(producerClientIDEnvName)
+	if len(clientID) == 0 {
+		return nil, errors.New("kafka producer client id not found")
+	}
+
+	idempotent, err := strconv.ParseBool(os.Getenv(producerIdempotentEnvName))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse kafka producer idempotent")
+	}
+
+	retryMax, err := positiveIntFromEnv(producerRetryMaxEnvName, "kafka producer retry max")
+	if err != nil {
+		return nil, err
+	}
+
+	return &kafkaProducerConfig{
+		brokers:    brokers,
+		clientID:   clientID,
+		idempotent: idempotent,
+		retryMax:   int(retryMax),
+	}, nil
+}
+
+// Brokers возвращает список адресов брокеров Kafka из конфигурации
+func (cfg *kafkaProducerConfig) Brokers() []string {
+	return cfg.brokers
+}
+
+// Config возвращает конфигурацию для sarama producer.
+// Сообщение считается записанным после подтверждения всеми репликами,
+// успехи и ошибки возвращаются для отчетов о доставке.
+// Идемпотентный producer исключает дубли при повторных отправках и требует
+// не более одного запроса в полете на соединение
+func (cfg *kafkaProducerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V2_6_0_0
+	config.ClientID = cfg.clientID
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Retry.Max = cfg.retryMax
+	config.Producer.Return.Successes = true
+	config.Producer.Return.Errors = true
+
+	if cfg.idempotent {
+		config.Producer.Idempotent = true
+		config.Net.MaxOpenRequests = 1
+	}
+
+	return config
+}
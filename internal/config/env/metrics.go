@@ -0,0 +1,44 @@
+package env
+
+import (
+	"net"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/config"
+)
+
+var _ config.MetricsConfig = (*metricsConfig)(nil)
+
+const (
+	metricsHostEnvName = "METRICS_HOST"
+	metricsPortEnvName = "METRICS_PORT"
+)
+
+type metricsConfig struct {
+	host string
+	port string
+}
+
+// NewMetricsConfig создает новую конфигурацию для поднятия сервера метрик
+func NewMetricsConfig() (*metricsConfig, error) {
+	host := os.Getenv(metricsHostEnvName)
+	if len(host) == 0 {
+		return nil, errors.New("metrics host not found")
+	}
+
+	port := os.Getenv(metricsPortEnvName)
+	if len(port) == 0 {
+		return nil, errors.New("metrics port not found")
+	}
+
+	return &metricsConfig{
+		host: host,
+		port: port,
+	}, nil
+}
+
+func (cfg *metricsConfig) Address() string {
+	return net.JoinHostPort(cfg.host, cfg.port)
+}
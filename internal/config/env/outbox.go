@@ -0,0 +1,73 @@
+package env
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/config"
+)
+
+var _ config.OutboxConfig = (*outboxConfig)(nil)
+
+const (
+	outboxEnabledEnvName      = "OUTBOX_ENABLED"
+	outboxTopicEnvName        = "OUTBOX_TOPIC"
+	outboxPollIntervalEnvName = "OUTBOX_POLL_INTERVAL_SEC"
+	outboxBatchSizeEnvName    = "OUTBOX_BATCH_SIZE"
+)
+
+type outboxConfig struct {
+	enabled      bool
+	topic        string
+	pollInterval time.Duration
+	batchSize    uint64
+}
+
+// NewOutboxConfig создает новую конфигурацию публикации событий из outbox
+func NewOutboxConfig() (*outboxConfig, error) {
+	enabled, err := strconv.ParseBool(os.Getenv(outboxEnabledEnvName))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse outbox enabled")
+	}
+
+	topic := os.Getenv(outboxTopicEnvName)
+	if len(topic) == 0 {
+		return nil, errors.New("outbox topic not found")
+	}
+
+	pollInterval, err := positiveIntFromEnv(outboxPollIntervalEnvName, "outbox poll interval")
+	if err != nil {
+		return nil, err
+	}
+
+	batchSize, err := positiveIntFromEnv(outboxBatchSizeEnvName, "outbox batch size")
+	if err != nil {
+		return nil, err
+	}
+
+	return &outboxConfig{
+		enabled:      enabled,
+		topic:        topic,
+		pollInterval: time.Duration(pollInterval) * time.Second,
+		batchSize:    uint64(batchSize),
+	}, nil
+}
+
+func (cfg *outboxConfig) Enabled() bool {
+	return cfg.enabled
+}
+
+func (cfg *outboxConfig) Topic() string {
+	return cfg.topic
+}
+
+func (cfg *outboxConfig) PollInterval() time.Duration {
+	return cfg.pollInterval
+}
+
+func (cfg *outboxConfig) BatchSize() uint64 {
+	return cfg.batchSize
+}
@@ -0,0 +1,33 @@
+package env
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/config"
+)
+
+var _ config.PasswordHasherConfig = (*passwordHasherConfig)(nil)
+
+const passwordHasherEnvName = "PASSWORD_HASHER"
+
+type passwordHasherConfig struct {
+	algorithm string
+}
+
+// NewPasswordHasherConfig создает новую конфигурацию хеширования паролей
+func NewPasswordHasherConfig() (*passwordHasherConfig, error) {
+	algorithm := os.Getenv(passwordHasherEnvName)
+	if len(algorithm) == 0 {
+		return nil, errors.New("password hasher algorithm not found")
+	}
+
+	return &passwordHasherConfig{
+		algorithm: algorithm,
+	}, nil
+}
+
+func (cfg *passwordHasherConfig) Algorithm() string {
+	return cfg.algorithm
+}
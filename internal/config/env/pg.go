@@ -1,8 +1,12 @@
 package env
 
 import (
-	"errors"
+	"log/slog"
 	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"github.com/ipv02/auth/internal/config"
 )
@@ -10,25 +14,147 @@ import (
 var _ config.PGConfig = (*pgConfig)(nil)
 
 const (
-	dsnEnvName = "PG_DSN"
+	dsnEnvName                  = "PG_DSN"
+	replicaDSNsEnvName          = "PG_REPLICA_DSNS"
+	replicaMaxLagEnvName        = "PG_REPLICA_MAX_LAG_SEC"
+	replicaCheckIntervalEnvName = "PG_REPLICA_CHECK_INTERVAL_SEC"
+	txRetryAttemptsEnvName      = "PG_TX_RETRY_ATTEMPTS"
+	txRetryInitialBackoffEnv    = "PG_TX_RETRY_INITIAL_BACKOFF_MS"
+	txRetryMaxBackoffEnvName    = "PG_TX_RETRY_MAX_BACKOFF_MS"
+	slowQueryThresholdEnvName   = "PG_SLOW_QUERY_THRESHOLD_MS"
+	logLevelEnvName             = "PG_LOG_LEVEL"
+
+	// replicaDSNsSeparator разделитель DSN реплик, запятая может встречаться в key=value DSN
+	replicaDSNsSeparator = ";"
 )
 
 type pgConfig struct {
-	dsn string
+	dsn                   string
+	replicaDSNs           []string
+	replicaMaxLag         time.Duration
+	replicaCheckInterval  time.Duration
+	txRetryAttempts       int
+	txRetryInitialBackoff time.Duration
+	txRetryMaxBackoff     time.Duration
+	slowQueryThreshold    time.Duration
+	logLevel              slog.Level
 }
 
 // NewPGConfig создает новую конфигурацию для подключения к PostgreSQL.
+// Реплики необязательны, параметры их проверки читаются, только если реплики заданы
 func NewPGConfig() (*pgConfig, error) {
 	dsn := os.Getenv(dsnEnvName)
 	if len(dsn) == 0 {
 		return nil, errors.New("pg dsn not found")
 	}
 
-	return &pgConfig{
-		dsn: dsn,
-	}, nil
+	var replicaDSNs []string
+	for _, replicaDSN := range strings.Split(os.Getenv(replicaDSNsEnvName), replicaDSNsSeparator) {
+		replicaDSN = strings.TrimSpace(replicaDSN)
+		if len(replicaDSN) > 0 {
+			replicaDSNs = append(replicaDSNs, replicaDSN)
+		}
+	}
+
+	txRetryAttempts, err := positiveIntFromEnv(txRetryAttemptsEnvName, "pg transaction retry attempts")
+	if err != nil {
+		return nil, err
+	}
+
+	txRetryInitialBackoff, err := positiveIntFromEnv(txRetryInitialBackoffEnv, "pg transaction retry initial backoff")
+	if err != nil {
+		return nil, err
+	}
+
+	txRetryMaxBackoff, err := positiveIntFromEnv(txRetryMaxBackoffEnvName, "pg transaction retry max backoff")
+	if err != nil {
+		return nil, err
+	}
+
+	if txRetryMaxBackoff < txRetryInitialBackoff {
+		return nil, errors.New("pg transaction retry max backoff is less than initial backoff")
+	}
+
+	slowQueryThreshold, err := positiveIntFromEnv(slowQueryThresholdEnvName, "pg slow query threshold")
+	if err != nil {
+		return nil, err
+	}
+
+	var logLevel slog.Level
+	err = logLevel.UnmarshalText([]byte(os.Getenv(logLevelEnvName)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse pg log level")
+	}
+
+	cfg := &pgConfig{
+		dsn:                   dsn,
+		replicaDSNs:           replicaDSNs,
+		txRetryAttempts:       int(txRetryAttempts),
+		txRetryInitialBackoff: time.Duration(txRetryInitialBackoff) * time.Millisecond,
+		txRetryMaxBackoff:     time.Duration(txRetryMaxBackoff) * time.Millisecond,
+		slowQueryThreshold:    time.Duration(slowQueryThreshold) * time.Millisecond,
+		logLevel:              logLevel,
+	}
+	if len(replicaDSNs) == 0 {
+		return cfg, nil
+	}
+
+	replicaMaxLag, err := positiveIntFromEnv(replicaMaxLagEnvName, "pg replica max lag")
+	if err != nil {
+		return nil, err
+	}
+
+	replicaCheckInterval, err := positiveIntFromEnv(replicaCheckIntervalEnvName, "pg replica check interval")
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.replicaMaxLag = time.Duration(replicaMaxLag) * time.Second
+	cfg.replicaCheckInterval = time.Duration(replicaCheckInterval) * time.Second
+
+	return cfg, nil
 }
 
 func (cfg *pgConfig) DSN() string {
 	return cfg.dsn
 }
+
+// ReplicaDSNs возвращает DSN реплик для чтения, пустой список - чтение с мастера
+func (cfg *pgConfig) ReplicaDSNs() []string {
+	return cfg.replicaDSNs
+}
+
+// ReplicaMaxLag возвращает отставание, после которого реплика исключается из чтения
+func (cfg *pgConfig) ReplicaMaxLag() time.Duration {
+	return cfg.replicaMaxLag
+}
+
+// ReplicaCheckInterval возвращает интервал проверки доступности и отставания реплик
+func (cfg *pgConfig) ReplicaCheckInterval() time.Duration {
+	return cfg.replicaCheckInterval
+}
+
+// TxRetryAttempts возвращает число попыток транзакции, помеченной безопасной для повтора
+func (cfg *pgConfig) TxRetryAttempts() int {
+	return cfg.txRetryAttempts
+}
+
+// TxRetryInitialBackoff возвращает задержку перед второй попыткой транзакции
+func (cfg *pgConfig) TxRetryInitialBackoff() time.Duration {
+	return cfg.txRetryInitialBackoff
+}
+
+// TxRetryMaxBackoff возвращает максимальную задержку между попытками транзакции
+func (cfg *pgConfig) TxRetryMaxBackoff() time.Duration {
+	return cfg.txRetryMaxBackoff
+}
+
+// SlowQueryThreshold возвращает длительность, начиная с которой запрос логируется как медленный
+func (cfg *pgConfig) SlowQueryThreshold() time.Duration {
+	return cfg.slowQueryThreshold
+}
+
+// LogLevel возвращает уровень логов запросов: debug - все запросы, warn - медленные и ошибки, error - только ошибки
+func (cfg *pgConfig) LogLevel() slog.Level {
+	return cfg.logLevel
+}
@@ -0,0 +1,96 @@
+package env
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/config"
+)
+
+var _ config.TracingConfig = (*tracingConfig)(nil)
+
+const (
+	tracingExporterEnvName     = "TRACING_EXPORTER"
+	tracingOTLPEndpointEnvName = "TRACING_OTLP_ENDPOINT"
+	tracingServiceNameEnvName  = "TRACING_SERVICE_NAME"
+	tracingSampleRatioEnvName  = "TRACING_SAMPLE_RATIO"
+)
+
+// Значения TRACING_EXPORTER
+const (
+	tracingExporterOTLP   = "otlp"
+	tracingExporterStdout = "stdout"
+)
+
+type tracingConfig struct {
+	exporter     string
+	otlpEndpoint string
+	serviceName  string
+	sampleRatio  float64
+}
+
+// NewTracingConfig создает новую конфигурацию трейсинга.
+// Адрес коллектора читается, только если выбран экспортер otlp
+func NewTracingConfig() (*tracingConfig, error) {
+	exporter := os.Getenv(tracingExporterEnvName)
+	if exporter != tracingExporterOTLP && exporter != tracingExporterStdout {
+		return nil, errors.Errorf("unknown tracing exporter %q", exporter)
+	}
+
+	serviceName := os.Getenv(tracingServiceNameEnvName)
+	if len(serviceName) == 0 {
+		return nil, errors.New("tracing service name not found")
+	}
+
+	sampleRatioStr := os.Getenv(tracingSampleRatioEnvName)
+	if len(sampleRatioStr) == 0 {
+		return nil, errors.New("tracing sample ratio not found")
+	}
+
+	sampleRatio, err := strconv.ParseFloat(sampleRatioStr, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse tracing sample ratio")
+	}
+
+	if sampleRatio <= 0 || sampleRatio > 1 {
+		return nil, errors.New("tracing sample ratio must be in (0, 1]")
+	}
+
+	cfg := &tracingConfig{
+		exporter:    exporter,
+		serviceName: serviceName,
+		sampleRatio: sampleRatio,
+	}
+	if exporter != tracingExporterOTLP {
+		return cfg, nil
+	}
+
+	cfg.otlpEndpoint = os.Getenv(tracingOTLPEndpointEnvName)
+	if len(cfg.otlpEndpoint) == 0 {
+		return nil, errors.New("tracing otlp endpoint not found")
+	}
+
+	return cfg, nil
+}
+
+// Exporter возвращает экспортер трейсов: otlp или stdout
+func (cfg *tracingConfig) Exporter() string {
+	return cfg.exporter
+}
+
+// OTLPEndpoint возвращает адрес gRPC приемника OTLP коллектора
+func (cfg *tracingConfig) OTLPEndpoint() string {
+	return cfg.otlpEndpoint
+}
+
+// ServiceName возвращает имя сервиса в трейсах
+func (cfg *tracingConfig) ServiceName() string {
+	return cfg.serviceName
+}
+
+// SampleRatio возвращает долю запросов, для которых начинается новый трейс
+func (cfg *tracingConfig) SampleRatio() float64 {
+	return cfg.sampleRatio
+}
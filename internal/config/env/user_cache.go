@@ -0,0 +1,57 @@
+package env
+
+import (
+	"time"
+
+	"github.com/ipv02/auth/internal/config"
+)
+
+var _ config.UserCacheConfig = (*userCacheConfig)(nil)
+
+const (
+	userCacheTTLEnvName           = "USER_CACHE_TTL_SEC"
+	userCacheNegativeTTLEnvName   = "USER_CACHE_NEGATIVE_TTL_SEC"
+	userCacheMasterReadTTLEnvName = "USER_CACHE_MASTER_READ_TTL_SEC"
+)
+
+type userCacheConfig struct {
+	ttl           time.Duration
+	negativeTTL   time.Duration
+	masterReadTTL time.Duration
+}
+
+// NewUserCacheConfig создает новую конфигурацию кеша пользователей
+func NewUserCacheConfig() (*userCacheConfig, error) {
+	ttl, err := positiveIntFromEnv(userCacheTTLEnvName, "user cache ttl")
+	if err != nil {
+		return nil, err
+	}
+
+	negativeTTL, err := positiveIntFromEnv(userCacheNegativeTTLEnvName, "user cache negative ttl")
+	if err != nil {
+		return nil, err
+	}
+
+	masterReadTTL, err := positiveIntFromEnv(userCacheMasterReadTTLEnvName, "user cache master read ttl")
+	if err != nil {
+		return nil, err
+	}
+
+	return &userCacheConfig{
+		ttl:           time.Duration(ttl) * time.Second,
+		negativeTTL:   time.Duration(negativeTTL) * time.Second,
+		masterReadTTL: time.Duration(masterReadTTL) * time.Second,
+	}, nil
+}
+
+func (cfg *userCacheConfig) TTL() time.Duration {
+	return cfg.ttl
+}
+
+func (cfg *userCacheConfig) NegativeTTL() time.Duration {
+	return cfg.negativeTTL
+}
+
+func (cfg *userCacheConfig) MasterReadTTL() time.Duration {
+	return cfg.masterReadTTL
+}
@@ -0,0 +1,93 @@
+package env
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/config"
+)
+
+var _ config.UserPurgerConfig = (*userPurgerConfig)(nil)
+
+const (
+	userPurgeRetentionEnvName = "USER_PURGE_RETENTION_SEC"
+	userPurgeIntervalEnvName  = "USER_PURGE_INTERVAL_SEC"
+	userPurgeBatchSizeEnvName = "USER_PURGE_BATCH_SIZE"
+
+	processedMessageRetentionEnvName = "PROCESSED_MESSAGE_RETENTION_SEC"
+)
+
+type userPurgerConfig struct {
+	retention time.Duration
+	interval  time.Duration
+	batchSize uint64
+
+	processedMessageRetention time.Duration
+}
+
+// NewUserPurgerConfig создает новую конфигурацию очистки удаленных пользователей
+func NewUserPurgerConfig() (*userPurgerConfig, error) {
+	retention, err := positiveIntFromEnv(userPurgeRetentionEnvName, "user purge retention")
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := positiveIntFromEnv(userPurgeIntervalEnvName, "user purge interval")
+	if err != nil {
+		return nil, err
+	}
+
+	batchSize, err := positiveIntFromEnv(userPurgeBatchSizeEnvName, "user purge batch size")
+	if err != nil {
+		return nil, err
+	}
+
+	processedMessageRetention, err := positiveIntFromEnv(processedMessageRetentionEnvName, "processed message retention")
+	if err != nil {
+		return nil, err
+	}
+
+	return &userPurgerConfig{
+		retention:                 time.Duration(retention) * time.Second,
+		interval:                  time.Duration(interval) * time.Second,
+		batchSize:                 uint64(batchSize),
+		processedMessageRetention: time.Duration(processedMessageRetention) * time.Second,
+	}, nil
+}
+
+func (cfg *userPurgerConfig) Retention() time.Duration {
+	return cfg.retention
+}
+
+func (cfg *userPurgerConfig) Interval() time.Duration {
+	return cfg.interval
+}
+
+func (cfg *userPurgerConfig) BatchSize() uint64 {
+	return cfg.batchSize
+}
+
+func (cfg *userPurgerConfig) ProcessedMessageRetention() time.Duration {
+	return cfg.processedMessageRetention
+}
+
+func positiveIntFromEnv(envName, name string) (int64, error) {
+	valueStr := os.Getenv(envName)
+	if len(valueStr) == 0 {
+		return 0, errors.Errorf("%s not found", name)
+	}
+
+	value, err := strconv.ParseInt(valueStr, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse %s", name)
+	}
+
+	if value <= 0 {
+		return 0, errors.Errorf("%s must be positive", name)
+	}
+
+	return value, nil
+}
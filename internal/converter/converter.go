@@ -1,9 +1,13 @@
 package converter
 
 import (
+	"fmt"
+
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/utils"
+	"github.com/ipv02/auth/pkg/user_events_v1"
 	"github.com/ipv02/auth/pkg/user_v1"
 )
 
@@ -25,6 +29,7 @@ func ToUserFromService(user *model.UserGet) *user_v1.GetUserResponse {
 		Role:      user_v1.UserRole(user.UserRole),
 		CreatedAt: timestamppb.New(user.CreatedAt),
 		UpdatedAt: updatedAt,
+		Version:   user.Version,
 	}
 }
 
@@ -43,19 +48,110 @@ func ToUserCreateFromReq(user *user_v1.CreateUserRequest) *model.UserCreate {
 	}
 }
 
-// ToUserUpdateFromReq конвертер протомодели в модель бизнес-логики
-func ToUserUpdateFromReq(user *user_v1.UpdateUserRequest) *model.UserUpdate {
-	if user == nil {
+// ToCreateUserReqFromEvent конвертер события kafka в запрос создания пользователя,
+// чтобы событие проходило те же проверки, что и gRPC-запрос
+func ToCreateUserReqFromEvent(event *user_events_v1.UserCreateEvent) *user_v1.CreateUserRequest {
+	if event == nil {
 		return nil
 	}
 
-	name := user.Name.GetValue()
-	email := user.Email.GetValue()
+	return &user_v1.CreateUserRequest{
+		Name:            event.Name,
+		Email:           event.Email,
+		Password:        event.Password,
+		PasswordConfirm: event.PasswordConfirm,
+		Role:            user_v1.UserRole(event.Role),
+	}
+}
+
+// ToUserUpdateFromReq конвертер протомодели в модель бизнес-логики.
+// В модель попадают только поля из update_mask, неизвестные пути отклоняются
+func ToUserUpdateFromReq(user *user_v1.UpdateUserRequest) (*model.UserUpdate, error) {
+	if user == nil {
+		return nil, nil
+	}
+
+	userUpdate := &model.UserUpdate{
+		ID:      user.Id,
+		Version: user.Version,
+	}
+
+	for _, path := range user.GetUpdateMask().GetPaths() {
+		switch path {
+		case user_v1.UpdateUserPathName:
+			name := user.GetName().GetValue()
+			userUpdate.Name = &name
+		case user_v1.UpdateUserPathEmail:
+			email := user.GetEmail().GetValue()
+			userUpdate.Email = &email
+		case user_v1.UpdateUserPathRole:
+			role := int32(user.GetRole())
+			userUpdate.Role = &role
+		default:
+			return nil, model.NewInvalidArgumentError("unknown update_mask path", model.FieldViolation{
+				Field:       "update_mask",
+				Description: fmt.Sprintf("unknown path %q", path),
+			})
+		}
+	}
+
+	return userUpdate, nil
+}
+
+// ToUserListQueryFromReq конвертер протомодели запроса списка в модель бизнес-логики
+func ToUserListQueryFromReq(req *user_v1.ListUsersRequest) (*model.UserListQuery, error) {
+	if req == nil {
+		return nil, nil
+	}
+
+	cursor, err := utils.DecodeUserCursor(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := req.GetFilter()
+	query := &model.UserListQuery{
+		Limit:  uint64(req.GetPageSize()),
+		Cursor: cursor,
+		Filter: model.UserListFilter{
+			Role:  int32(filter.GetRole()),
+			Email: filter.GetEmail(),
+			Name:  filter.GetName(),
+		},
+		SortAsc: req.GetSort() == user_v1.SortOrder_CREATED_AT_ASC,
+	}
+
+	if filter.GetCreatedFrom() != nil {
+		createdFrom := filter.GetCreatedFrom().AsTime()
+		query.Filter.CreatedFrom = &createdFrom
+	}
 
-	return &model.UserUpdate{
-		ID:    user.Id,
-		Name:  &name,
-		Email: &email,
-		Role:  int32(user.Role),
+	if filter.GetCreatedTo() != nil {
+		createdTo := filter.GetCreatedTo().AsTime()
+		query.Filter.CreatedTo = &createdTo
 	}
+
+	return query, nil
+}
+
+// ToUserListFromService конвертер страницы пользователей в протомодель
+func ToUserListFromService(list *model.UserList) (*user_v1.ListUsersResponse, error) {
+	if list == nil {
+		return nil, nil
+	}
+
+	nextPageToken, err := utils.EncodeUserCursor(list.NextCursor)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*user_v1.GetUserResponse, 0, len(list.Users))
+	for _, user := range list.Users {
+		users = append(users, ToUserFromService(user))
+	}
+
+	return &user_v1.ListUsersResponse{
+		Users:         users,
+		NextPageToken: nextPageToken,
+	}, nil
 }
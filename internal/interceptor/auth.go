@@ -0,0 +1,138 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ipv02/auth/internal/config"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/utils"
+	"github.com/ipv02/auth/pkg/user_v1"
+)
+
+// Полные имена методов UserV1
+const (
+	createUserMethod  = "/user_v1.UserV1/CreateUser"
+	getUserMethod     = "/user_v1.UserV1/GetUser"
+	updateUserMethod  = "/user_v1.UserV1/UpdateUser"
+	deleteUserMethod  = "/user_v1.UserV1/DeleteUser"
+	listUsersMethod   = "/user_v1.UserV1/ListUsers"
+	restoreUserMethod = "/user_v1.UserV1/RestoreUser"
+)
+
+// authRule проверяет, что пользователю разрешен вызов метода с указанным запросом.
+// caller равен nil, если запрос пришел без токена
+type authRule struct {
+	public bool
+	check  func(caller *model.UserClaims, req interface{}) bool
+}
+
+// AuthInterceptor аутентифицирует пользователя по bearer токену и проверяет права на вызов методов UserV1.
+// Правила зависят от запроса и вызывающего, поэтому описаны здесь, а не в access_policies
+type AuthInterceptor struct {
+	jwtConfig config.JWTConfig
+	rules     map[string]authRule
+}
+
+// NewAuthInterceptor создает интерсептор аутентификации
+func NewAuthInterceptor(jwtConfig config.JWTConfig) *AuthInterceptor {
+	return &AuthInterceptor{
+		jwtConfig: jwtConfig,
+		rules: map[string]authRule{
+			createUserMethod:  {public: true, check: canCreateUser},
+			getUserMethod:     {check: canGetUser},
+			updateUserMethod:  {check: canUpdateUser},
+			deleteUserMethod:  {check: isAdminRule},
+			listUsersMethod:   {check: isAdminRule},
+			restoreUserMethod: {check: isAdminRule},
+		},
+	}
+}
+
+// Unary является интерсептором для gRPC-сервера.
+// Методы, для которых не описаны правила, пропускаются без проверки
+func (i *AuthInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	rule, ok := i.rules[info.FullMethod]
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	caller, err := i.authenticate(ctx)
+	if err != nil && !(rule.public && errors.Is(err, model.ErrorMissingToken)) {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if !rule.check(caller, req) {
+		return nil, status.Error(codes.PermissionDenied, model.ErrorAccessDenied.Error())
+	}
+
+	if caller != nil {
+		ctx = utils.ContextWithCaller(ctx, caller)
+	}
+
+	return handler(ctx, req)
+}
+
+func (i *AuthInterceptor) authenticate(ctx context.Context) (*model.UserClaims, error) {
+	accessToken, err := utils.BearerTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := utils.VerifyToken(accessToken, i.jwtConfig.AccessTokenSecretKey(), model.AccessTokenType)
+	if err != nil {
+		return nil, model.ErrorInvalidToken
+	}
+
+	return claims, nil
+}
+
+func isAdmin(caller *model.UserClaims) bool {
+	return caller != nil && caller.Role == int32(user_v1.UserRole_ADMIN)
+}
+
+func isAdminRule(caller *model.UserClaims, _ interface{}) bool {
+	return isAdmin(caller)
+}
+
+// canCreateUser регистрация открыта всем, но создать администратора может только администратор
+func canCreateUser(caller *model.UserClaims, req interface{}) bool {
+	r, ok := req.(*user_v1.CreateUserRequest)
+	if !ok {
+		return false
+	}
+
+	return r.GetRole() != user_v1.UserRole_ADMIN || isAdmin(caller)
+}
+
+// canGetUser пользователь может читать только себя, администратор - любого
+func canGetUser(caller *model.UserClaims, req interface{}) bool {
+	r, ok := req.(*user_v1.GetUserRequest)
+	if !ok {
+		return false
+	}
+
+	return isAdmin(caller) || (caller != nil && caller.UserID == r.GetId())
+}
+
+// canUpdateUser пользователь может обновлять только себя и не может менять себе роль
+func canUpdateUser(caller *model.UserClaims, req interface{}) bool {
+	r, ok := req.(*user_v1.UpdateUserRequest)
+	if !ok {
+		return false
+	}
+
+	if isAdmin(caller) {
+		return true
+	}
+
+	if caller == nil || caller.UserID != r.GetId() {
+		return false
+	}
+
+	return !r.UpdatesPath(user_v1.UpdateUserPathRole) || int32(r.GetRole()) == caller.Role
+}
@@ -0,0 +1,96 @@
+package interceptor
+
+import (
+	"context"
+	"log"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/ipv02/auth/internal/model"
+)
+
+var errorCodes = map[model.ErrorCode]codes.Code{
+	model.ErrorCodeNotFound:         codes.NotFound,
+	model.ErrorCodeAlreadyExists:    codes.AlreadyExists,
+	model.ErrorCodeInvalidArgument:  codes.InvalidArgument,
+	model.ErrorCodePermissionDenied: codes.PermissionDenied,
+	model.ErrorCodeConflict:         codes.Aborted,
+	// Предусловие проверяется под конкурентным изменением, поэтому это Aborted, а не FailedPrecondition.
+	// От конфликта ошибку отличает деталь PreconditionFailure
+	model.ErrorCodePreconditionFailed: codes.Aborted,
+}
+
+// preconditionFailureType тип нарушения в детали PreconditionFailure
+const preconditionFailureType = "VERSION"
+
+// ErrorInterceptor переводит доменные ошибки в gRPC статусы.
+// Ошибки без категории считаются внутренними, их текст клиенту не отдается
+func ErrorInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	res, err := handler(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return res, nil
+}
+
+func toStatusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	domainErr, ok := model.AsError(err)
+	if !ok || domainErr.Code == model.ErrorCodeInternal {
+		log.Printf("internal error: %v", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	st := status.New(errorCodes[domainErr.Code], domainErr.Message)
+
+	detail := statusDetail(domainErr)
+	if detail == nil {
+		return st.Err()
+	}
+
+	stWithDetails, detailsErr := st.WithDetails(detail)
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return stWithDetails.Err()
+}
+
+// statusDetail возвращает деталь gRPC статуса для доменной ошибки или nil, если детали нет
+func statusDetail(domainErr *model.Error) protoadapt.MessageV1 {
+	if domainErr.Code == model.ErrorCodePreconditionFailed {
+		return &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{
+				Type:        preconditionFailureType,
+				Description: domainErr.Message,
+			}},
+		}
+	}
+
+	if len(domainErr.Violations) == 0 {
+		return nil
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range domainErr.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+
+	return badRequest
+}
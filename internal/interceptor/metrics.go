@@ -0,0 +1,63 @@
+package interceptor
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/ipv02/auth/internal/metric"
+)
+
+// unknownRoute шаблон пути запроса, для которого шаблон маршрута не найден в контексте
+const unknownRoute = "unknown"
+
+// MetricsInterceptor учитывает число и длительность запросов по методу и коду ответа.
+// Должен быть первым в цепочке, чтобы видеть коды ответа после ErrorInterceptor
+func MetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+
+	res, err := handler(ctx, req)
+
+	metric.ObserveGRPCRequest(info.FullMethod, status.Code(err), time.Since(start))
+
+	return res, err
+}
+
+// MetricsMiddleware учитывает число и длительность HTTP запросов gateway.
+// Путь учитывается по шаблону маршрута, чтобы id из пути не попадали в метки
+func MetricsMiddleware(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		next(rec, r, pathParams)
+
+		route := unknownRoute
+		if pattern, ok := runtime.HTTPPattern(r.Context()); ok {
+			route = pattern.String()
+		}
+
+		metric.ObserveHTTPRequest(r.Method, route, rec.status, time.Since(start))
+	}
+}
+
+// statusRecorder запоминает код ответа, записанный обработчиком
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
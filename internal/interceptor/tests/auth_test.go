@@ -0,0 +1,231 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/ipv02/auth/internal/interceptor"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/utils"
+	"github.com/ipv02/auth/pkg/user_v1"
+)
+
+type jwtConfig struct{}
+
+func (jwtConfig) AccessTokenSecretKey() []byte   { return []byte("access") }
+func (jwtConfig) RefreshTokenSecretKey() []byte  { return []byte("refresh") }
+func (jwtConfig) AccessTokenTTL() time.Duration  { return time.Minute }
+func (jwtConfig) RefreshTokenTTL() time.Duration { return time.Hour }
+
+func tokenContext(t *testing.T, id int64, role user_v1.UserRole) context.Context {
+	token, err := utils.GenerateToken(model.UserInfo{ID: id, Role: int32(role)}, jwtConfig{}.AccessTokenSecretKey(), time.Minute)
+	require.NoError(t, err)
+
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+// refreshTokenContext подписывает refresh токен ключом access токенов, как при совпадающих ключах
+func refreshTokenContext(t *testing.T, id int64) context.Context {
+	token, err := utils.GenerateRefreshToken(
+		model.UserInfo{ID: id, Role: int32(user_v1.UserRole_USER)},
+		&model.RefreshToken{ID: "token", FamilyID: "family", ExpiresAt: time.Now().Add(time.Hour)},
+		jwtConfig{}.AccessTokenSecretKey(),
+	)
+	require.NoError(t, err)
+
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const (
+		userID  = int64(10)
+		adminID = int64(1)
+	)
+
+	var (
+		anonymousCtx = context.Background()
+		userCtx      = tokenContext(t, userID, user_v1.UserRole_USER)
+		adminCtx     = tokenContext(t, adminID, user_v1.UserRole_ADMIN)
+		invalidCtx   = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer invalid"))
+		refreshCtx   = refreshTokenContext(t, userID)
+
+		roleMask = &fieldmaskpb.FieldMask{Paths: []string{"role"}}
+	)
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		req    interface{}
+		code   codes.Code
+	}{
+		{
+			name:   "anonymous create user",
+			ctx:    anonymousCtx,
+			method: "/user_v1.UserV1/CreateUser",
+			req:    &user_v1.CreateUserRequest{Role: user_v1.UserRole_USER},
+			code:   codes.OK,
+		},
+		{
+			name:   "anonymous create admin",
+			ctx:    anonymousCtx,
+			method: "/user_v1.UserV1/CreateUser",
+			req:    &user_v1.CreateUserRequest{Role: user_v1.UserRole_ADMIN},
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "admin create admin",
+			ctx:    adminCtx,
+			method: "/user_v1.UserV1/CreateUser",
+			req:    &user_v1.CreateUserRequest{Role: user_v1.UserRole_ADMIN},
+			code:   codes.OK,
+		},
+		{
+			name:   "anonymous get user",
+			ctx:    anonymousCtx,
+			method: "/user_v1.UserV1/GetUser",
+			req:    &user_v1.GetUserRequest{Id: userID},
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "invalid token",
+			ctx:    invalidCtx,
+			method: "/user_v1.UserV1/GetUser",
+			req:    &user_v1.GetUserRequest{Id: userID},
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "refresh token",
+			ctx:    refreshCtx,
+			method: "/user_v1.UserV1/GetUser",
+			req:    &user_v1.GetUserRequest{Id: userID},
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "user get self",
+			ctx:    userCtx,
+			method: "/user_v1.UserV1/GetUser",
+			req:    &user_v1.GetUserRequest{Id: userID},
+			code:   codes.OK,
+		},
+		{
+			name:   "user get other",
+			ctx:    userCtx,
+			method: "/user_v1.UserV1/GetUser",
+			req:    &user_v1.GetUserRequest{Id: adminID},
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "user update self",
+			ctx:    userCtx,
+			method: "/user_v1.UserV1/UpdateUser",
+			req:    &user_v1.UpdateUserRequest{Id: userID, Role: user_v1.UserRole_USER, UpdateMask: roleMask},
+			code:   codes.OK,
+		},
+		{
+			name:   "user update self without role in mask",
+			ctx:    userCtx,
+			method: "/user_v1.UserV1/UpdateUser",
+			req:    &user_v1.UpdateUserRequest{Id: userID, Role: user_v1.UserRole_ADMIN, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}}},
+			code:   codes.OK,
+		},
+		{
+			name:   "user update own role",
+			ctx:    userCtx,
+			method: "/user_v1.UserV1/UpdateUser",
+			req:    &user_v1.UpdateUserRequest{Id: userID, Role: user_v1.UserRole_ADMIN, UpdateMask: roleMask},
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "admin update role",
+			ctx:    adminCtx,
+			method: "/user_v1.UserV1/UpdateUser",
+			req:    &user_v1.UpdateUserRequest{Id: userID, Role: user_v1.UserRole_ADMIN, UpdateMask: roleMask},
+			code:   codes.OK,
+		},
+		{
+			name:   "user delete",
+			ctx:    userCtx,
+			method: "/user_v1.UserV1/DeleteUser",
+			req:    &user_v1.DeleteUserRequest{Id: userID},
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "admin delete",
+			ctx:    adminCtx,
+			method: "/user_v1.UserV1/DeleteUser",
+			req:    &user_v1.DeleteUserRequest{Id: userID},
+			code:   codes.OK,
+		},
+		{
+			name:   "user list users",
+			ctx:    userCtx,
+			method: "/user_v1.UserV1/ListUsers",
+			req:    &user_v1.ListUsersRequest{},
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "admin list users",
+			ctx:    adminCtx,
+			method: "/user_v1.UserV1/ListUsers",
+			req:    &user_v1.ListUsersRequest{},
+			code:   codes.OK,
+		},
+		{
+			name:   "method without rules",
+			ctx:    anonymousCtx,
+			method: "/auth_v1.AuthV1/Login",
+			req:    nil,
+			code:   codes.OK,
+		},
+	}
+
+	authInterceptor := interceptor.NewAuthInterceptor(jwtConfig{})
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+				return struct{}{}, nil
+			}
+
+			_, err := authInterceptor.Unary(tt.ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			require.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestAuthInterceptorPutsCallerIntoContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := tokenContext(t, 42, user_v1.UserRole_USER)
+
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		caller, ok := utils.CallerFromContext(ctx)
+		require.True(t, ok)
+		require.Equal(t, int64(42), caller.UserID)
+		require.Equal(t, int32(user_v1.UserRole_USER), caller.Role)
+
+		return struct{}{}, nil
+	}
+
+	_, err := interceptor.NewAuthInterceptor(jwtConfig{}).Unary(
+		ctx,
+		&user_v1.GetUserRequest{Id: 42},
+		&grpc.UnaryServerInfo{FullMethod: "/user_v1.UserV1/GetUser"},
+		handler,
+	)
+	require.NoError(t, err)
+}
@@ -0,0 +1,189 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ipv02/auth/internal/interceptor"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/pkg/user_v1"
+)
+
+func TestErrorInterceptor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		err     error
+		code    codes.Code
+		message string
+	}{
+		{
+			name:    "not found",
+			err:     model.ErrorUserNotFound,
+			code:    codes.NotFound,
+			message: model.ErrorUserNotFound.Message,
+		},
+		{
+			name:    "wrapped already exists",
+			err:     errors.Wrap(model.ErrUserAlreadyExists, "failed to create user"),
+			code:    codes.AlreadyExists,
+			message: model.ErrUserAlreadyExists.Message,
+		},
+		{
+			name:    "conflict",
+			err:     model.NewConflictError("conflict"),
+			code:    codes.Aborted,
+			message: "conflict",
+		},
+		{
+			name:    "permission denied",
+			err:     model.ErrorAccessDenied,
+			code:    codes.PermissionDenied,
+			message: model.ErrorAccessDenied.Message,
+		},
+		{
+			name:    "internal error hides details",
+			err:     model.NewInternalError("failed to execute query", fmt.Errorf("connection refused")),
+			code:    codes.Internal,
+			message: "internal error",
+		},
+		{
+			name:    "untyped error",
+			err:     fmt.Errorf("some error"),
+			code:    codes.Internal,
+			message: "internal error",
+		},
+		{
+			name:    "status error passes through",
+			err:     status.Error(codes.Unauthenticated, "invalid token"),
+			code:    codes.Unauthenticated,
+			message: "invalid token",
+		},
+		{
+			name:    "context deadline",
+			err:     errors.Wrap(context.DeadlineExceeded, "failed to execute query"),
+			code:    codes.DeadlineExceeded,
+			message: "failed to execute query: context deadline exceeded",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+				return nil, tt.err
+			}
+
+			_, err := interceptor.ErrorInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, tt.code, st.Code())
+			require.Equal(t, tt.message, st.Message())
+		})
+	}
+}
+
+func TestErrorInterceptorBadRequestDetails(t *testing.T) {
+	t.Parallel()
+
+	chain := func(ctx context.Context, req interface{}) error {
+		_, err := interceptor.ErrorInterceptor(ctx, req, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor.ValidateInterceptor(ctx, req, &grpc.UnaryServerInfo{}, func(_ context.Context, _ interface{}) (interface{}, error) {
+				return nil, model.ErrorInvalidPageToken
+			})
+		})
+
+		return err
+	}
+
+	tests := []struct {
+		name  string
+		req   interface{}
+		field string
+	}{
+		{
+			name:  "proto validation",
+			req:   &user_v1.ListUsersRequest{PageSize: 1000},
+			field: "PageSize",
+		},
+		{
+			name:  "domain violation",
+			req:   &user_v1.ListUsersRequest{PageToken: "token"},
+			field: "page_token",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			st := status.Convert(chain(context.Background(), tt.req))
+			require.Equal(t, codes.InvalidArgument, st.Code())
+			require.Len(t, st.Details(), 1)
+
+			badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+			require.True(t, ok)
+			require.Len(t, badRequest.GetFieldViolations(), 1)
+			require.Equal(t, tt.field, badRequest.GetFieldViolations()[0].GetField())
+		})
+	}
+}
+
+func TestErrorInterceptorPreconditionFailureDetails(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		err          error
+		precondition bool
+	}{
+		{
+			name:         "version mismatch",
+			err:          errors.Wrap(model.ErrorUserVersionMismatch, "failed to update user"),
+			precondition: true,
+		},
+		{
+			name:         "conflict",
+			err:          model.NewConflictError("conflict"),
+			precondition: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+				return nil, tt.err
+			}
+
+			_, err := interceptor.ErrorInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+
+			st := status.Convert(err)
+			require.Equal(t, codes.Aborted, st.Code())
+
+			if !tt.precondition {
+				require.Empty(t, st.Details())
+				return
+			}
+
+			require.Len(t, st.Details(), 1)
+			_, ok := st.Details()[0].(*errdetails.PreconditionFailure)
+			require.True(t, ok)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ipv02/auth/internal/interceptor"
+)
+
+func TestMetricsInterceptor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		method string
+		err    error
+		code   codes.Code
+	}{
+		{
+			name:   "success",
+			method: "/metrics_test.Service/Success",
+			code:   codes.OK,
+		},
+		{
+			name:   "status error",
+			method: "/metrics_test.Service/NotFound",
+			err:    status.Error(codes.NotFound, "user not found"),
+			code:   codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := func(_ context.Context, req interface{}) (interface{}, error) {
+				return req, tt.err
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: tt.method}
+			for i := 0; i < 2; i++ {
+				_, err := interceptor.MetricsInterceptor(context.Background(), "req", info, handler)
+				require.Equal(t, tt.err, err)
+			}
+
+			require.Equal(t, float64(2), counterValue(t, "auth_grpc_requests_total", map[string]string{
+				"method": tt.method,
+				"code":   tt.code.String(),
+			}))
+		})
+	}
+}
+
+// counterValue возвращает значение счетчика с метками labels из реестра по умолчанию
+func counterValue(t *testing.T, name string, labels map[string]string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+		for _, m := range family.GetMetric() {
+			matched := 0
+			for _, label := range m.GetLabel() {
+				if value, ok := labels[label.GetName()]; ok && value == label.GetValue() {
+					matched++
+				}
+			}
+
+			if matched == len(labels) {
+				return m.GetCounter().GetValue()
+			}
+		}
+	}
+
+	return 0
+}
@@ -3,18 +3,37 @@ package interceptor
 import (
 	"context"
 
+	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+
+	"github.com/ipv02/auth/internal/model"
 )
 
 type validator interface {
 	Validate() error
 }
 
+// fieldError ошибка валидации protoc-gen-validate
+type fieldError interface {
+	Field() string
+	Reason() string
+}
+
 // ValidateInterceptor является интерсептором для gRPC-сервера
 func ValidateInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	if val, ok := req.(validator); ok {
 		if err := val.Validate(); err != nil {
-			return nil, err
+			var violations []model.FieldViolation
+
+			var fieldErr fieldError
+			if errors.As(err, &fieldErr) {
+				violations = append(violations, model.FieldViolation{
+					Field:       fieldErr.Field(),
+					Description: fieldErr.Reason(),
+				})
+			}
+
+			return nil, model.NewInvalidArgumentError(err.Error(), violations...)
 		}
 	}
 
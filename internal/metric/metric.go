@@ -0,0 +1,86 @@
+package metric
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc/codes"
+)
+
+// Namespace общий префикс метрик сервиса
+const Namespace = "auth"
+
+// Причины неудачного входа
+const (
+	LoginFailedInvalidCredentials = "invalid_credentials"
+	LoginFailedError              = "error"
+)
+
+var (
+	grpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Число gRPC запросов по методу и коду ответа",
+	}, []string{"method", "code"})
+
+	grpcRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Длительность gRPC запросов по методу и коду ответа",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Число HTTP запросов gateway по методу, шаблону пути и коду ответа",
+	}, []string{"method", "route", "code"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Длительность HTTP запросов gateway по методу, шаблону пути и коду ответа",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "code"})
+
+	usersCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "users_created_total",
+		Help:      "Число созданных пользователей",
+	})
+
+	loginsFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "logins_failed_total",
+		Help:      "Число неудачных попыток входа по причине",
+	}, []string{"reason"})
+)
+
+// ObserveGRPCRequest учитывает gRPC запрос
+func ObserveGRPCRequest(method string, code codes.Code, duration time.Duration) {
+	grpcRequests.WithLabelValues(method, code.String()).Inc()
+	grpcRequestDuration.WithLabelValues(method, code.String()).Observe(duration.Seconds())
+}
+
+// ObserveHTTPRequest учитывает HTTP запрос gateway. route - шаблон пути без значений параметров
+func ObserveHTTPRequest(method, route string, code int, duration time.Duration) {
+	statusCode := strconv.Itoa(code)
+	httpRequests.WithLabelValues(method, route, statusCode).Inc()
+	httpRequestDuration.WithLabelValues(method, route, statusCode).Observe(duration.Seconds())
+}
+
+// AddUsersCreated учитывает n созданных пользователей
+func AddUsersCreated(n int) {
+	usersCreated.Add(float64(n))
+}
+
+// IncLoginsFailed учитывает неудачную попытку входа
+func IncLoginsFailed(reason string) {
+	loginsFailed.WithLabelValues(reason).Inc()
+}
@@ -0,0 +1,7 @@
+package model
+
+// AccessPolicy правило доступа: роль, которой разрешен вызов эндпоинта
+type AccessPolicy struct {
+	EndpointAddress string
+	Role            int32
+}
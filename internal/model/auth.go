@@ -0,0 +1,53 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Типы JWT токенов, тип токена проверяется вместе с подписью
+const (
+	AccessTokenType  = "access"
+	RefreshTokenType = "refresh"
+)
+
+// UserClaims данные пользователя, которые хранятся в JWT токене
+type UserClaims struct {
+	jwt.RegisteredClaims
+	TokenType string `json:"token_type"`
+	UserID    int64  `json:"user_id"`
+	Role      int32  `json:"role"`
+	FamilyID  string `json:"family_id,omitempty"`
+}
+
+// UserInfo данные пользователя, на которые выписывается токен
+type UserInfo struct {
+	ID   int64
+	Role int32
+}
+
+// UserAuth данные пользователя, необходимые для аутентификации
+type UserAuth struct {
+	ID           int64
+	Role         int32
+	PasswordHash string
+}
+
+// TokenPair пара токенов доступа и обновления
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// RefreshToken выданный refresh токен. Все токены, полученные ротацией
+// от одного входа пользователя, принадлежат одному семейству
+type RefreshToken struct {
+	ID        string
+	FamilyID  string
+	UserID    int64
+	ExpiresAt time.Time
+	UsedAt    sql.NullTime
+	RevokedAt sql.NullTime
+}
@@ -2,5 +2,126 @@ package model
 
 import "github.com/pkg/errors"
 
+// ErrorCode категория доменной ошибки
+type ErrorCode int
+
+// Категории доменных ошибок
+const (
+	ErrorCodeInternal ErrorCode = iota
+	ErrorCodeNotFound
+	ErrorCodeAlreadyExists
+	ErrorCodeInvalidArgument
+	ErrorCodePermissionDenied
+	ErrorCodeConflict
+	ErrorCodePreconditionFailed
+)
+
+// FieldViolation описывает нарушение правила для поля запроса
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error доменная ошибка, которую возвращают репо и сервисный слои.
+// В gRPC статус ее переводит интерсептор по Code
+type Error struct {
+	Code       ErrorCode
+	Message    string
+	Violations []FieldViolation
+	err        error
+}
+
+// Error возвращает текст ошибки вместе с текстом исходной ошибки
+func (e *Error) Error() string {
+	if e.err != nil {
+		return e.Message + ": " + e.err.Error()
+	}
+
+	return e.Message
+}
+
+// Unwrap возвращает исходную ошибку
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// NewNotFoundError создает ошибку отсутствия сущности
+func NewNotFoundError(message string) *Error {
+	return &Error{Code: ErrorCodeNotFound, Message: message}
+}
+
+// NewAlreadyExistsError создает ошибку уже существующей сущности
+func NewAlreadyExistsError(message string) *Error {
+	return &Error{Code: ErrorCodeAlreadyExists, Message: message}
+}
+
+// NewInvalidArgumentError создает ошибку некорректного запроса с нарушениями по полям
+func NewInvalidArgumentError(message string, violations ...FieldViolation) *Error {
+	return &Error{Code: ErrorCodeInvalidArgument, Message: message, Violations: violations}
+}
+
+// NewPermissionDeniedError создает ошибку отсутствия прав
+func NewPermissionDeniedError(message string) *Error {
+	return &Error{Code: ErrorCodePermissionDenied, Message: message}
+}
+
+// NewConflictError создает ошибку конфликта с текущим состоянием сущности
+func NewConflictError(message string) *Error {
+	return &Error{Code: ErrorCodeConflict, Message: message}
+}
+
+// NewPreconditionFailedError создает ошибку невыполненного предусловия запроса, например несовпадения версии
+func NewPreconditionFailedError(message string) *Error {
+	return &Error{Code: ErrorCodePreconditionFailed, Message: message}
+}
+
+// NewInternalError создает внутреннюю ошибку, оборачивая исходную
+func NewInternalError(message string, err error) *Error {
+	return &Error{Code: ErrorCodeInternal, Message: message, err: err}
+}
+
+// AsError достает доменную ошибку из цепочки err
+func AsError(err error) (*Error, bool) {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr, true
+	}
+
+	return nil, false
+}
+
 // ErrorUserNotFound глобальная переменная хранящая ошибку с сообщением
-var ErrorUserNotFound = errors.New("user not found")
+var ErrorUserNotFound = NewNotFoundError("user not found")
+
+// ErrUserAlreadyExists ошибка создания пользователя с уже занятым email
+var ErrUserAlreadyExists = NewAlreadyExistsError("user with this email already exists")
+
+// ErrorUserVersionMismatch ошибка обновления пользователя, измененного с момента чтения
+var ErrorUserVersionMismatch = NewPreconditionFailedError("user was modified concurrently, version mismatch")
+
+// ErrorInvalidIfMatch ошибка некорректного заголовка If-Match
+var ErrorInvalidIfMatch = NewInvalidArgumentError("invalid If-Match header", FieldViolation{
+	Field:       "If-Match",
+	Description: "must be an ETag with a positive version",
+})
+
+// ErrorInvalidCredentials ошибка неверной пары email и пароль
+var ErrorInvalidCredentials = errors.New("invalid email or password")
+
+// ErrorInvalidToken ошибка невалидного или просроченного токена
+var ErrorInvalidToken = errors.New("invalid token")
+
+// ErrorRefreshTokenReused ошибка повторного использования refresh токена
+var ErrorRefreshTokenReused = errors.New("refresh token reuse detected")
+
+// ErrorMissingToken ошибка отсутствия токена в метаданных запроса
+var ErrorMissingToken = errors.New("authorization token is not provided")
+
+// ErrorAccessDenied ошибка отсутствия прав на вызов эндпоинта
+var ErrorAccessDenied = NewPermissionDeniedError("access denied")
+
+// ErrorInvalidPageToken ошибка некорректного курсора пагинации
+var ErrorInvalidPageToken = NewInvalidArgumentError("invalid page token", FieldViolation{
+	Field:       "page_token",
+	Description: "page token is malformed",
+})
@@ -0,0 +1,48 @@
+package model
+
+import "time"
+
+// Типы событий жизненного цикла пользователя
+const (
+	UserCreatedEventType  = "user.created"
+	UserUpdatedEventType  = "user.updated"
+	UserDeletedEventType  = "user.deleted"
+	UserRestoredEventType = "user.restored"
+)
+
+// OutboxEvent событие, сохраненное в одной транзакции с изменением пользователя
+// и ожидающее публикации в kafka.
+// AggregateID - id пользователя, используется как ключ сообщения
+type OutboxEvent struct {
+	ID          int64
+	AggregateID int64
+	EventType   string
+	Payload     []byte
+	CreatedAt   time.Time
+}
+
+// UserCreatedEvent данные события создания пользователя
+type UserCreatedEvent struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Role  int32  `json:"role"`
+}
+
+// UserUpdatedEvent данные события обновления пользователя, содержит только измененные поля
+type UserUpdatedEvent struct {
+	ID    int64   `json:"id"`
+	Name  *string `json:"name,omitempty"`
+	Email *string `json:"email,omitempty"`
+	Role  *int32  `json:"role,omitempty"`
+}
+
+// UserDeletedEvent данные события удаления пользователя
+type UserDeletedEvent struct {
+	ID int64 `json:"id"`
+}
+
+// UserRestoredEvent данные события восстановления удаленного пользователя
+type UserRestoredEvent struct {
+	ID int64 `json:"id"`
+}
@@ -5,7 +5,8 @@ import (
 	"time"
 )
 
-// UserCreate модель для конвертации из протомодели в модель бизнес-логики
+// UserCreate модель для конвертации из протомодели в модель бизнес-логики.
+// В репо слой Password передается уже в виде хеша, PasswordConfirm не сохраняется
 type UserCreate struct {
 	Name            string `json:"name"`
 	Email           string `json:"email"`
@@ -22,12 +23,45 @@ type UserGet struct {
 	UserRole  int32
 	CreatedAt time.Time
 	UpdatedAt sql.NullTime
+	Version   int64
 }
 
-// UserUpdate модель для конвертации из протомодели в модель бизнес-логики
+// UserUpdate модель для конвертации из протомодели в модель бизнес-логики.
+// Обновляются только поля, отличные от nil.
+// Version - ожидаемая версия записи, 0 - обновление без проверки версии
 type UserUpdate struct {
-	ID    int64
-	Name  *string
-	Email *string
-	Role  int32
+	ID      int64
+	Name    *string
+	Email   *string
+	Role    *int32
+	Version int64
+}
+
+// UserCursor курсор keyset-пагинации по (created_at, id)
+type UserCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        int64     `json:"id"`
+}
+
+// UserListFilter фильтры выборки пользователей, пустые поля не учитываются
+type UserListFilter struct {
+	Role        int32
+	Email       string
+	Name        string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+// UserListQuery параметры выборки страницы пользователей
+type UserListQuery struct {
+	Limit   uint64
+	Cursor  *UserCursor
+	Filter  UserListFilter
+	SortAsc bool
+}
+
+// UserList страница пользователей, NextCursor равен nil для последней страницы
+type UserList struct {
+	Users      []*UserGet
+	NextCursor *UserCursor
 }
@@ -0,0 +1,19 @@
+package converter
+
+import (
+	"github.com/ipv02/auth/internal/model"
+	modelRepo "github.com/ipv02/auth/internal/repository/access/pg/model"
+)
+
+// ToAccessPoliciesFromRepo конвертер правил доступа из репо-слоя в модели для сервисного слоя
+func ToAccessPoliciesFromRepo(policies []*modelRepo.AccessPolicy) []*model.AccessPolicy {
+	res := make([]*model.AccessPolicy, 0, len(policies))
+	for _, policy := range policies {
+		res = append(res, &model.AccessPolicy{
+			EndpointAddress: policy.EndpointAddress,
+			Role:            policy.Role,
+		})
+	}
+
+	return res
+}
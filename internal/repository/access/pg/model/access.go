@@ -0,0 +1,7 @@
+package model
+
+// AccessPolicy модель правила доступа для работы в репо слое
+type AccessPolicy struct {
+	EndpointAddress string `db:"endpoint_address"`
+	Role            int32  `db:"role"`
+}
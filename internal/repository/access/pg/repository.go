@@ -0,0 +1,56 @@
+package pg
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/client/db"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/repository"
+	"github.com/ipv02/auth/internal/repository/access/pg/converter"
+	modelRepo "github.com/ipv02/auth/internal/repository/access/pg/model"
+)
+
+const (
+	tableName = "access_policies"
+
+	endpointAddressColumn = "endpoint_address"
+	roleColumn            = "role"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository создает новый экземпляр AccessRepository с подключением к базе данных
+func NewRepository(db db.Client) repository.AccessRepository {
+	return &repo{db: db}
+}
+
+// GetAccessPolicies возвращает все правила доступа
+func (r *repo) GetAccessPolicies(ctx context.Context) ([]*model.AccessPolicy, error) {
+	builderSelect := sq.
+		Select(endpointAddressColumn, roleColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate query")
+	}
+
+	q := db.Query{
+		Name:     "access_repository.GetPolicies",
+		QueryRaw: query,
+	}
+
+	var policies []*modelRepo.AccessPolicy
+	err = r.db.DB().ScanAllContext(ctx, &policies, q, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute query")
+	}
+
+	return converter.ToAccessPoliciesFromRepo(policies), nil
+}
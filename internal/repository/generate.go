@@ -2,3 +2,7 @@ package repository
 
 //go:generate sh -c "rm -rf mocks && mkdir -p mocks"
 //go:generate minimock -i UserRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i RefreshTokenRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i AccessRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i OutboxRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i ProcessedMessageRepository -o ./mocks/ -s "_minimock.go"
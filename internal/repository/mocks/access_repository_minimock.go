@@ -0,0 +1,387 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/ipv02/auth/internal/repository.AccessRepository -o access_repository_minimock.go -n AccessRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/ipv02/auth/internal/model"
+)
+
+// AccessRepositoryMock implements mm_repository.AccessRepository
+type AccessRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetAccessPolicies          func(ctx context.Context) (apa1 []*model.AccessPolicy, err error)
+	funcGetAccessPoliciesOrigin    string
+	inspectFuncGetAccessPolicies   func(ctx context.Context)
+	afterGetAccessPoliciesCounter  uint64
+	beforeGetAccessPoliciesCounter uint64
+	GetAccessPoliciesMock          mAccessRepositoryMockGetAccessPolicies
+}
+
+// NewAccessRepositoryMock returns a mock for mm_repository.AccessRepository
+func NewAccessRepositoryMock(t minimock.Tester) *AccessRepositoryMock {
+	m := &AccessRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetAccessPoliciesMock = mAccessRepositoryMockGetAccessPolicies{mock: m}
+	m.GetAccessPoliciesMock.callArgs = []*AccessRepositoryMockGetAccessPoliciesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAccessRepositoryMockGetAccessPolicies struct {
+	optional           bool
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockGetAccessPoliciesExpectation
+	expectations       []*AccessRepositoryMockGetAccessPoliciesExpectation
+
+	callArgs []*AccessRepositoryMockGetAccessPoliciesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessRepositoryMockGetAccessPoliciesExpectation specifies expectation struct of the AccessRepository.GetAccessPolicies
+type AccessRepositoryMockGetAccessPoliciesExpectation struct {
+	mock               *AccessRepositoryMock
+	params             *AccessRepositoryMockGetAccessPoliciesParams
+	paramPtrs          *AccessRepositoryMockGetAccessPoliciesParamPtrs
+	expectationOrigins AccessRepositoryMockGetAccessPoliciesExpectationOrigins
+	results            *AccessRepositoryMockGetAccessPoliciesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessRepositoryMockGetAccessPoliciesParams contains parameters of the AccessRepository.GetAccessPolicies
+type AccessRepositoryMockGetAccessPoliciesParams struct {
+	ctx context.Context
+}
+
+// AccessRepositoryMockGetAccessPoliciesParamPtrs contains pointers to parameters of the AccessRepository.GetAccessPolicies
+type AccessRepositoryMockGetAccessPoliciesParamPtrs struct {
+	ctx *context.Context
+}
+
+// AccessRepositoryMockGetAccessPoliciesResults contains results of the AccessRepository.GetAccessPolicies
+type AccessRepositoryMockGetAccessPoliciesResults struct {
+	apa1 []*model.AccessPolicy
+	err  error
+}
+
+// AccessRepositoryMockGetAccessPoliciesOrigins contains origins of expectations of the AccessRepository.GetAccessPolicies
+type AccessRepositoryMockGetAccessPoliciesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAccessPolicies *mAccessRepositoryMockGetAccessPolicies) Optional() *mAccessRepositoryMockGetAccessPolicies {
+	mmGetAccessPolicies.optional = true
+	return mmGetAccessPolicies
+}
+
+// Expect sets up expected params for AccessRepository.GetAccessPolicies
+func (mmGetAccessPolicies *mAccessRepositoryMockGetAccessPolicies) Expect(ctx context.Context) *mAccessRepositoryMockGetAccessPolicies {
+	if mmGetAccessPolicies.mock.funcGetAccessPolicies != nil {
+		mmGetAccessPolicies.mock.t.Fatalf("AccessRepositoryMock.GetAccessPolicies mock is already set by Set")
+	}
+
+	if mmGetAccessPolicies.defaultExpectation == nil {
+		mmGetAccessPolicies.defaultExpectation = &AccessRepositoryMockGetAccessPoliciesExpectation{}
+	}
+
+	if mmGetAccessPolicies.defaultExpectation.paramPtrs != nil {
+		mmGetAccessPolicies.mock.t.Fatalf("AccessRepositoryMock.GetAccessPolicies mock is already set by ExpectParams functions")
+	}
+
+	mmGetAccessPolicies.defaultExpectation.params = &AccessRepositoryMockGetAccessPoliciesParams{ctx}
+	mmGetAccessPolicies.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAccessPolicies.expectations {
+		if minimock.Equal(e.params, mmGetAccessPolicies.defaultExpectation.params) {
+			mmGetAccessPolicies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAccessPolicies.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAccessPolicies
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.GetAccessPolicies
+func (mmGetAccessPolicies *mAccessRepositoryMockGetAccessPolicies) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockGetAccessPolicies {
+	if mmGetAccessPolicies.mock.funcGetAccessPolicies != nil {
+		mmGetAccessPolicies.mock.t.Fatalf("AccessRepositoryMock.GetAccessPolicies mock is already set by Set")
+	}
+
+	if mmGetAccessPolicies.defaultExpectation == nil {
+		mmGetAccessPolicies.defaultExpectation = &AccessRepositoryMockGetAccessPoliciesExpectation{}
+	}
+
+	if mmGetAccessPolicies.defaultExpectation.params != nil {
+		mmGetAccessPolicies.mock.t.Fatalf("AccessRepositoryMock.GetAccessPolicies mock is already set by Expect")
+	}
+
+	if mmGetAccessPolicies.defaultExpectation.paramPtrs == nil {
+		mmGetAccessPolicies.defaultExpectation.paramPtrs = &AccessRepositoryMockGetAccessPoliciesParamPtrs{}
+	}
+	mmGetAccessPolicies.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAccessPolicies.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAccessPolicies
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.GetAccessPolicies
+func (mmGetAccessPolicies *mAccessRepositoryMockGetAccessPolicies) Inspect(f func(ctx context.Context)) *mAccessRepositoryMockGetAccessPolicies {
+	if mmGetAccessPolicies.mock.inspectFuncGetAccessPolicies != nil {
+		mmGetAccessPolicies.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.GetAccessPolicies")
+	}
+
+	mmGetAccessPolicies.mock.inspectFuncGetAccessPolicies = f
+
+	return mmGetAccessPolicies
+}
+
+// Return sets up results that will be returned by AccessRepository.GetAccessPolicies
+func (mmGetAccessPolicies *mAccessRepositoryMockGetAccessPolicies) Return(apa1 []*model.AccessPolicy, err error) *AccessRepositoryMock {
+	if mmGetAccessPolicies.mock.funcGetAccessPolicies != nil {
+		mmGetAccessPolicies.mock.t.Fatalf("AccessRepositoryMock.GetAccessPolicies mock is already set by Set")
+	}
+
+	if mmGetAccessPolicies.defaultExpectation == nil {
+		mmGetAccessPolicies.defaultExpectation = &AccessRepositoryMockGetAccessPoliciesExpectation{mock: mmGetAccessPolicies.mock}
+	}
+	mmGetAccessPolicies.defaultExpectation.results = &AccessRepositoryMockGetAccessPoliciesResults{apa1, err}
+	mmGetAccessPolicies.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAccessPolicies.mock
+}
+
+// Set uses given function f to mock the AccessRepository.GetAccessPolicies method
+func (mmGetAccessPolicies *mAccessRepositoryMockGetAccessPolicies) Set(f func(ctx context.Context) (apa1 []*model.AccessPolicy, err error)) *AccessRepositoryMock {
+	if mmGetAccessPolicies.defaultExpectation != nil {
+		mmGetAccessPolicies.mock.t.Fatalf("Default expectation is already set for the AccessRepository.GetAccessPolicies method")
+	}
+
+	if len(mmGetAccessPolicies.expectations) > 0 {
+		mmGetAccessPolicies.mock.t.Fatalf("Some expectations are already set for the AccessRepository.GetAccessPolicies method")
+	}
+
+	mmGetAccessPolicies.mock.funcGetAccessPolicies = f
+	mmGetAccessPolicies.mock.funcGetAccessPoliciesOrigin = minimock.CallerInfo(1)
+	return mmGetAccessPolicies.mock
+}
+
+// When sets expectation for the AccessRepository.GetAccessPolicies which will trigger the result defined by the following
+// Then helper
+func (mmGetAccessPolicies *mAccessRepositoryMockGetAccessPolicies) When(ctx context.Context) *AccessRepositoryMockGetAccessPoliciesExpectation {
+	if mmGetAccessPolicies.mock.funcGetAccessPolicies != nil {
+		mmGetAccessPolicies.mock.t.Fatalf("AccessRepositoryMock.GetAccessPolicies mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockGetAccessPoliciesExpectation{
+		mock:               mmGetAccessPolicies.mock,
+		params:             &AccessRepositoryMockGetAccessPoliciesParams{ctx},
+		expectationOrigins: AccessRepositoryMockGetAccessPoliciesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAccessPolicies.expectations = append(mmGetAccessPolicies.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.GetAccessPolicies return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockGetAccessPoliciesExpectation) Then(apa1 []*model.AccessPolicy, err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockGetAccessPoliciesResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessRepository.GetAccessPolicies should be invoked
+func (mmGetAccessPolicies *mAccessRepositoryMockGetAccessPolicies) Times(n uint64) *mAccessRepositoryMockGetAccessPolicies {
+	if n == 0 {
+		mmGetAccessPolicies.mock.t.Fatalf("Times of AccessRepositoryMock.GetAccessPolicies mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAccessPolicies.expectedInvocations, n)
+	mmGetAccessPolicies.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAccessPolicies
+}
+
+func (mmGetAccessPolicies *mAccessRepositoryMockGetAccessPolicies) invocationsDone() bool {
+	if len(mmGetAccessPolicies.expectations) == 0 && mmGetAccessPolicies.defaultExpectation == nil && mmGetAccessPolicies.mock.funcGetAccessPolicies == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAccessPolicies.mock.afterGetAccessPoliciesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAccessPolicies.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAccessPolicies implements mm_repository.AccessRepository
+func (mmGetAccessPolicies *AccessRepositoryMock) GetAccessPolicies(ctx context.Context) (apa1 []*model.AccessPolicy, err error) {
+	mm_atomic.AddUint64(&mmGetAccessPolicies.beforeGetAccessPoliciesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAccessPolicies.afterGetAccessPoliciesCounter, 1)
+
+	mmGetAccessPolicies.t.Helper()
+
+	if mmGetAccessPolicies.inspectFuncGetAccessPolicies != nil {
+		mmGetAccessPolicies.inspectFuncGetAccessPolicies(ctx)
+	}
+
+	mm_params := AccessRepositoryMockGetAccessPoliciesParams{ctx}
+
+	// Record call args
+	mmGetAccessPolicies.GetAccessPoliciesMock.mutex.Lock()
+	mmGetAccessPolicies.GetAccessPoliciesMock.callArgs = append(mmGetAccessPolicies.GetAccessPoliciesMock.callArgs, &mm_params)
+	mmGetAccessPolicies.GetAccessPoliciesMock.mutex.Unlock()
+
+	for _, e := range mmGetAccessPolicies.GetAccessPoliciesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmGetAccessPolicies.GetAccessPoliciesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAccessPolicies.GetAccessPoliciesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAccessPolicies.GetAccessPoliciesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAccessPolicies.GetAccessPoliciesMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockGetAccessPoliciesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAccessPolicies.t.Errorf("AccessRepositoryMock.GetAccessPolicies got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAccessPolicies.GetAccessPoliciesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAccessPolicies.t.Errorf("AccessRepositoryMock.GetAccessPolicies got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAccessPolicies.GetAccessPoliciesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAccessPolicies.GetAccessPoliciesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAccessPolicies.t.Fatal("No results are set for the AccessRepositoryMock.GetAccessPolicies")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmGetAccessPolicies.funcGetAccessPolicies != nil {
+		return mmGetAccessPolicies.funcGetAccessPolicies(ctx)
+	}
+	mmGetAccessPolicies.t.Fatalf("Unexpected call to AccessRepositoryMock.GetAccessPolicies. %v", ctx)
+	return
+}
+
+// GetAccessPoliciesAfterCounter returns a count of finished AccessRepositoryMock.GetAccessPolicies invocations
+func (mmGetAccessPolicies *AccessRepositoryMock) GetAccessPoliciesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAccessPolicies.afterGetAccessPoliciesCounter)
+}
+
+// GetAccessPoliciesBeforeCounter returns a count of AccessRepositoryMock.GetAccessPolicies invocations
+func (mmGetAccessPolicies *AccessRepositoryMock) GetAccessPoliciesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAccessPolicies.beforeGetAccessPoliciesCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.GetAccessPolicies.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAccessPolicies *mAccessRepositoryMockGetAccessPolicies) Calls() []*AccessRepositoryMockGetAccessPoliciesParams {
+	mmGetAccessPolicies.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockGetAccessPoliciesParams, len(mmGetAccessPolicies.callArgs))
+	copy(argCopy, mmGetAccessPolicies.callArgs)
+
+	mmGetAccessPolicies.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAccessPoliciesDone returns true if the count of the GetAccessPolicies invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockGetAccessPoliciesDone() bool {
+	if m.GetAccessPoliciesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAccessPoliciesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAccessPoliciesMock.invocationsDone()
+}
+
+// MinimockGetAccessPoliciesInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockGetAccessPoliciesInspect() {
+	for _, e := range m.GetAccessPoliciesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetAccessPolicies at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAccessPoliciesCounter := mm_atomic.LoadUint64(&m.afterGetAccessPoliciesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAccessPoliciesMock.defaultExpectation != nil && afterGetAccessPoliciesCounter < 1 {
+		if m.GetAccessPoliciesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetAccessPolicies at\n%s", m.GetAccessPoliciesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetAccessPolicies at\n%s with params: %#v", m.GetAccessPoliciesMock.defaultExpectation.expectationOrigins.origin, *m.GetAccessPoliciesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAccessPolicies != nil && afterGetAccessPoliciesCounter < 1 {
+		m.t.Errorf("Expected call to AccessRepositoryMock.GetAccessPolicies at\n%s", m.funcGetAccessPoliciesOrigin)
+	}
+
+	if !m.GetAccessPoliciesMock.invocationsDone() && afterGetAccessPoliciesCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessRepositoryMock.GetAccessPolicies at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAccessPoliciesMock.expectedInvocations), m.GetAccessPoliciesMock.expectedInvocationsOrigin, afterGetAccessPoliciesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AccessRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetAccessPoliciesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AccessRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AccessRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetAccessPoliciesDone()
+}
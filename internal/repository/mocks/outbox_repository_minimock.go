@@ -0,0 +1,1483 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/ipv02/auth/internal/repository.OutboxRepository -o outbox_repository_minimock.go -n OutboxRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/ipv02/auth/internal/model"
+)
+
+// OutboxRepositoryMock implements mm_repository.OutboxRepository
+type OutboxRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddEvent          func(ctx context.Context, event *model.OutboxEvent) (err error)
+	funcAddEventOrigin    string
+	inspectFuncAddEvent   func(ctx context.Context, event *model.OutboxEvent)
+	afterAddEventCounter  uint64
+	beforeAddEventCounter uint64
+	AddEventMock          mOutboxRepositoryMockAddEvent
+
+	funcAddEvents          func(ctx context.Context, events []*model.OutboxEvent) (err error)
+	funcAddEventsOrigin    string
+	inspectFuncAddEvents   func(ctx context.Context, events []*model.OutboxEvent)
+	afterAddEventsCounter  uint64
+	beforeAddEventsCounter uint64
+	AddEventsMock          mOutboxRepositoryMockAddEvents
+
+	funcDeleteEvents          func(ctx context.Context, ids []int64) (err error)
+	funcDeleteEventsOrigin    string
+	inspectFuncDeleteEvents   func(ctx context.Context, ids []int64)
+	afterDeleteEventsCounter  uint64
+	beforeDeleteEventsCounter uint64
+	DeleteEventsMock          mOutboxRepositoryMockDeleteEvents
+
+	funcGetPendingEvents          func(ctx context.Context, limit uint64) (opa1 []*model.OutboxEvent, err error)
+	funcGetPendingEventsOrigin    string
+	inspectFuncGetPendingEvents   func(ctx context.Context, limit uint64)
+	afterGetPendingEventsCounter  uint64
+	beforeGetPendingEventsCounter uint64
+	GetPendingEventsMock          mOutboxRepositoryMockGetPendingEvents
+}
+
+// NewOutboxRepositoryMock returns a mock for mm_repository.OutboxRepository
+func NewOutboxRepositoryMock(t minimock.Tester) *OutboxRepositoryMock {
+	m := &OutboxRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddEventMock = mOutboxRepositoryMockAddEvent{mock: m}
+	m.AddEventMock.callArgs = []*OutboxRepositoryMockAddEventParams{}
+
+	m.AddEventsMock = mOutboxRepositoryMockAddEvents{mock: m}
+	m.AddEventsMock.callArgs = []*OutboxRepositoryMockAddEventsParams{}
+
+	m.DeleteEventsMock = mOutboxRepositoryMockDeleteEvents{mock: m}
+	m.DeleteEventsMock.callArgs = []*OutboxRepositoryMockDeleteEventsParams{}
+
+	m.GetPendingEventsMock = mOutboxRepositoryMockGetPendingEvents{mock: m}
+	m.GetPendingEventsMock.callArgs = []*OutboxRepositoryMockGetPendingEventsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOutboxRepositoryMockAddEvent struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockAddEventExpectation
+	expectations       []*OutboxRepositoryMockAddEventExpectation
+
+	callArgs []*OutboxRepositoryMockAddEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockAddEventExpectation specifies expectation struct of the OutboxRepository.AddEvent
+type OutboxRepositoryMockAddEventExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockAddEventParams
+	paramPtrs          *OutboxRepositoryMockAddEventParamPtrs
+	expectationOrigins OutboxRepositoryMockAddEventExpectationOrigins
+	results            *OutboxRepositoryMockAddEventResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockAddEventParams contains parameters of the OutboxRepository.AddEvent
+type OutboxRepositoryMockAddEventParams struct {
+	ctx   context.Context
+	event *model.OutboxEvent
+}
+
+// OutboxRepositoryMockAddEventParamPtrs contains pointers to parameters of the OutboxRepository.AddEvent
+type OutboxRepositoryMockAddEventParamPtrs struct {
+	ctx   *context.Context
+	event **model.OutboxEvent
+}
+
+// OutboxRepositoryMockAddEventResults contains results of the OutboxRepository.AddEvent
+type OutboxRepositoryMockAddEventResults struct {
+	err error
+}
+
+// OutboxRepositoryMockAddEventOrigins contains origins of expectations of the OutboxRepository.AddEvent
+type OutboxRepositoryMockAddEventExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEvent string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) Optional() *mOutboxRepositoryMockAddEvent {
+	mmAddEvent.optional = true
+	return mmAddEvent
+}
+
+// Expect sets up expected params for OutboxRepository.AddEvent
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) Expect(ctx context.Context, event *model.OutboxEvent) *mOutboxRepositoryMockAddEvent {
+	if mmAddEvent.mock.funcAddEvent != nil {
+		mmAddEvent.mock.t.Fatalf("OutboxRepositoryMock.AddEvent mock is already set by Set")
+	}
+
+	if mmAddEvent.defaultExpectation == nil {
+		mmAddEvent.defaultExpectation = &OutboxRepositoryMockAddEventExpectation{}
+	}
+
+	if mmAddEvent.defaultExpectation.paramPtrs != nil {
+		mmAddEvent.mock.t.Fatalf("OutboxRepositoryMock.AddEvent mock is already set by ExpectParams functions")
+	}
+
+	mmAddEvent.defaultExpectation.params = &OutboxRepositoryMockAddEventParams{ctx, event}
+	mmAddEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddEvent.expectations {
+		if minimock.Equal(e.params, mmAddEvent.defaultExpectation.params) {
+			mmAddEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmAddEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.AddEvent
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockAddEvent {
+	if mmAddEvent.mock.funcAddEvent != nil {
+		mmAddEvent.mock.t.Fatalf("OutboxRepositoryMock.AddEvent mock is already set by Set")
+	}
+
+	if mmAddEvent.defaultExpectation == nil {
+		mmAddEvent.defaultExpectation = &OutboxRepositoryMockAddEventExpectation{}
+	}
+
+	if mmAddEvent.defaultExpectation.params != nil {
+		mmAddEvent.mock.t.Fatalf("OutboxRepositoryMock.AddEvent mock is already set by Expect")
+	}
+
+	if mmAddEvent.defaultExpectation.paramPtrs == nil {
+		mmAddEvent.defaultExpectation.paramPtrs = &OutboxRepositoryMockAddEventParamPtrs{}
+	}
+	mmAddEvent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddEvent
+}
+
+// ExpectEventParam2 sets up expected param event for OutboxRepository.AddEvent
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) ExpectEventParam2(event *model.OutboxEvent) *mOutboxRepositoryMockAddEvent {
+	if mmAddEvent.mock.funcAddEvent != nil {
+		mmAddEvent.mock.t.Fatalf("OutboxRepositoryMock.AddEvent mock is already set by Set")
+	}
+
+	if mmAddEvent.defaultExpectation == nil {
+		mmAddEvent.defaultExpectation = &OutboxRepositoryMockAddEventExpectation{}
+	}
+
+	if mmAddEvent.defaultExpectation.params != nil {
+		mmAddEvent.mock.t.Fatalf("OutboxRepositoryMock.AddEvent mock is already set by Expect")
+	}
+
+	if mmAddEvent.defaultExpectation.paramPtrs == nil {
+		mmAddEvent.defaultExpectation.paramPtrs = &OutboxRepositoryMockAddEventParamPtrs{}
+	}
+	mmAddEvent.defaultExpectation.paramPtrs.event = &event
+	mmAddEvent.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
+
+	return mmAddEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.AddEvent
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) Inspect(f func(ctx context.Context, event *model.OutboxEvent)) *mOutboxRepositoryMockAddEvent {
+	if mmAddEvent.mock.inspectFuncAddEvent != nil {
+		mmAddEvent.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.AddEvent")
+	}
+
+	mmAddEvent.mock.inspectFuncAddEvent = f
+
+	return mmAddEvent
+}
+
+// Return sets up results that will be returned by OutboxRepository.AddEvent
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) Return(err error) *OutboxRepositoryMock {
+	if mmAddEvent.mock.funcAddEvent != nil {
+		mmAddEvent.mock.t.Fatalf("OutboxRepositoryMock.AddEvent mock is already set by Set")
+	}
+
+	if mmAddEvent.defaultExpectation == nil {
+		mmAddEvent.defaultExpectation = &OutboxRepositoryMockAddEventExpectation{mock: mmAddEvent.mock}
+	}
+	mmAddEvent.defaultExpectation.results = &OutboxRepositoryMockAddEventResults{err}
+	mmAddEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddEvent.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.AddEvent method
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) Set(f func(ctx context.Context, event *model.OutboxEvent) (err error)) *OutboxRepositoryMock {
+	if mmAddEvent.defaultExpectation != nil {
+		mmAddEvent.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.AddEvent method")
+	}
+
+	if len(mmAddEvent.expectations) > 0 {
+		mmAddEvent.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.AddEvent method")
+	}
+
+	mmAddEvent.mock.funcAddEvent = f
+	mmAddEvent.mock.funcAddEventOrigin = minimock.CallerInfo(1)
+	return mmAddEvent.mock
+}
+
+// When sets expectation for the OutboxRepository.AddEvent which will trigger the result defined by the following
+// Then helper
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) When(ctx context.Context, event *model.OutboxEvent) *OutboxRepositoryMockAddEventExpectation {
+	if mmAddEvent.mock.funcAddEvent != nil {
+		mmAddEvent.mock.t.Fatalf("OutboxRepositoryMock.AddEvent mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockAddEventExpectation{
+		mock:               mmAddEvent.mock,
+		params:             &OutboxRepositoryMockAddEventParams{ctx, event},
+		expectationOrigins: OutboxRepositoryMockAddEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddEvent.expectations = append(mmAddEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.AddEvent return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockAddEventExpectation) Then(err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockAddEventResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.AddEvent should be invoked
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) Times(n uint64) *mOutboxRepositoryMockAddEvent {
+	if n == 0 {
+		mmAddEvent.mock.t.Fatalf("Times of OutboxRepositoryMock.AddEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddEvent.expectedInvocations, n)
+	mmAddEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddEvent
+}
+
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) invocationsDone() bool {
+	if len(mmAddEvent.expectations) == 0 && mmAddEvent.defaultExpectation == nil && mmAddEvent.mock.funcAddEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddEvent.mock.afterAddEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddEvent implements mm_repository.OutboxRepository
+func (mmAddEvent *OutboxRepositoryMock) AddEvent(ctx context.Context, event *model.OutboxEvent) (err error) {
+	mm_atomic.AddUint64(&mmAddEvent.beforeAddEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddEvent.afterAddEventCounter, 1)
+
+	mmAddEvent.t.Helper()
+
+	if mmAddEvent.inspectFuncAddEvent != nil {
+		mmAddEvent.inspectFuncAddEvent(ctx, event)
+	}
+
+	mm_params := OutboxRepositoryMockAddEventParams{ctx, event}
+
+	// Record call args
+	mmAddEvent.AddEventMock.mutex.Lock()
+	mmAddEvent.AddEventMock.callArgs = append(mmAddEvent.AddEventMock.callArgs, &mm_params)
+	mmAddEvent.AddEventMock.mutex.Unlock()
+
+	for _, e := range mmAddEvent.AddEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddEvent.AddEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddEvent.AddEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddEvent.AddEventMock.defaultExpectation.params
+		mm_want_ptrs := mmAddEvent.AddEventMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockAddEventParams{ctx, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddEvent.t.Errorf("OutboxRepositoryMock.AddEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddEvent.AddEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmAddEvent.t.Errorf("OutboxRepositoryMock.AddEvent got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddEvent.AddEventMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddEvent.t.Errorf("OutboxRepositoryMock.AddEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddEvent.AddEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddEvent.AddEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddEvent.t.Fatal("No results are set for the OutboxRepositoryMock.AddEvent")
+		}
+		return (*mm_results).err
+	}
+	if mmAddEvent.funcAddEvent != nil {
+		return mmAddEvent.funcAddEvent(ctx, event)
+	}
+	mmAddEvent.t.Fatalf("Unexpected call to OutboxRepositoryMock.AddEvent. %v %v", ctx, event)
+	return
+}
+
+// AddEventAfterCounter returns a count of finished OutboxRepositoryMock.AddEvent invocations
+func (mmAddEvent *OutboxRepositoryMock) AddEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddEvent.afterAddEventCounter)
+}
+
+// AddEventBeforeCounter returns a count of OutboxRepositoryMock.AddEvent invocations
+func (mmAddEvent *OutboxRepositoryMock) AddEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddEvent.beforeAddEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.AddEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddEvent *mOutboxRepositoryMockAddEvent) Calls() []*OutboxRepositoryMockAddEventParams {
+	mmAddEvent.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockAddEventParams, len(mmAddEvent.callArgs))
+	copy(argCopy, mmAddEvent.callArgs)
+
+	mmAddEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddEventDone returns true if the count of the AddEvent invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockAddEventDone() bool {
+	if m.AddEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddEventMock.invocationsDone()
+}
+
+// MinimockAddEventInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockAddEventInspect() {
+	for _, e := range m.AddEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.AddEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddEventCounter := mm_atomic.LoadUint64(&m.afterAddEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddEventMock.defaultExpectation != nil && afterAddEventCounter < 1 {
+		if m.AddEventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.AddEvent at\n%s", m.AddEventMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.AddEvent at\n%s with params: %#v", m.AddEventMock.defaultExpectation.expectationOrigins.origin, *m.AddEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddEvent != nil && afterAddEventCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.AddEvent at\n%s", m.funcAddEventOrigin)
+	}
+
+	if !m.AddEventMock.invocationsDone() && afterAddEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.AddEvent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddEventMock.expectedInvocations), m.AddEventMock.expectedInvocationsOrigin, afterAddEventCounter)
+	}
+}
+
+type mOutboxRepositoryMockAddEvents struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockAddEventsExpectation
+	expectations       []*OutboxRepositoryMockAddEventsExpectation
+
+	callArgs []*OutboxRepositoryMockAddEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockAddEventsExpectation specifies expectation struct of the OutboxRepository.AddEvents
+type OutboxRepositoryMockAddEventsExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockAddEventsParams
+	paramPtrs          *OutboxRepositoryMockAddEventsParamPtrs
+	expectationOrigins OutboxRepositoryMockAddEventsExpectationOrigins
+	results            *OutboxRepositoryMockAddEventsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockAddEventsParams contains parameters of the OutboxRepository.AddEvents
+type OutboxRepositoryMockAddEventsParams struct {
+	ctx    context.Context
+	events []*model.OutboxEvent
+}
+
+// OutboxRepositoryMockAddEventsParamPtrs contains pointers to parameters of the OutboxRepository.AddEvents
+type OutboxRepositoryMockAddEventsParamPtrs struct {
+	ctx    *context.Context
+	events *[]*model.OutboxEvent
+}
+
+// OutboxRepositoryMockAddEventsResults contains results of the OutboxRepository.AddEvents
+type OutboxRepositoryMockAddEventsResults struct {
+	err error
+}
+
+// OutboxRepositoryMockAddEventsOrigins contains origins of expectations of the OutboxRepository.AddEvents
+type OutboxRepositoryMockAddEventsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originEvents string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) Optional() *mOutboxRepositoryMockAddEvents {
+	mmAddEvents.optional = true
+	return mmAddEvents
+}
+
+// Expect sets up expected params for OutboxRepository.AddEvents
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) Expect(ctx context.Context, events []*model.OutboxEvent) *mOutboxRepositoryMockAddEvents {
+	if mmAddEvents.mock.funcAddEvents != nil {
+		mmAddEvents.mock.t.Fatalf("OutboxRepositoryMock.AddEvents mock is already set by Set")
+	}
+
+	if mmAddEvents.defaultExpectation == nil {
+		mmAddEvents.defaultExpectation = &OutboxRepositoryMockAddEventsExpectation{}
+	}
+
+	if mmAddEvents.defaultExpectation.paramPtrs != nil {
+		mmAddEvents.mock.t.Fatalf("OutboxRepositoryMock.AddEvents mock is already set by ExpectParams functions")
+	}
+
+	mmAddEvents.defaultExpectation.params = &OutboxRepositoryMockAddEventsParams{ctx, events}
+	mmAddEvents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddEvents.expectations {
+		if minimock.Equal(e.params, mmAddEvents.defaultExpectation.params) {
+			mmAddEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmAddEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.AddEvents
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockAddEvents {
+	if mmAddEvents.mock.funcAddEvents != nil {
+		mmAddEvents.mock.t.Fatalf("OutboxRepositoryMock.AddEvents mock is already set by Set")
+	}
+
+	if mmAddEvents.defaultExpectation == nil {
+		mmAddEvents.defaultExpectation = &OutboxRepositoryMockAddEventsExpectation{}
+	}
+
+	if mmAddEvents.defaultExpectation.params != nil {
+		mmAddEvents.mock.t.Fatalf("OutboxRepositoryMock.AddEvents mock is already set by Expect")
+	}
+
+	if mmAddEvents.defaultExpectation.paramPtrs == nil {
+		mmAddEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockAddEventsParamPtrs{}
+	}
+	mmAddEvents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddEvents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddEvents
+}
+
+// ExpectEventsParam2 sets up expected param events for OutboxRepository.AddEvents
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) ExpectEventsParam2(events []*model.OutboxEvent) *mOutboxRepositoryMockAddEvents {
+	if mmAddEvents.mock.funcAddEvents != nil {
+		mmAddEvents.mock.t.Fatalf("OutboxRepositoryMock.AddEvents mock is already set by Set")
+	}
+
+	if mmAddEvents.defaultExpectation == nil {
+		mmAddEvents.defaultExpectation = &OutboxRepositoryMockAddEventsExpectation{}
+	}
+
+	if mmAddEvents.defaultExpectation.params != nil {
+		mmAddEvents.mock.t.Fatalf("OutboxRepositoryMock.AddEvents mock is already set by Expect")
+	}
+
+	if mmAddEvents.defaultExpectation.paramPtrs == nil {
+		mmAddEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockAddEventsParamPtrs{}
+	}
+	mmAddEvents.defaultExpectation.paramPtrs.events = &events
+	mmAddEvents.defaultExpectation.expectationOrigins.originEvents = minimock.CallerInfo(1)
+
+	return mmAddEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.AddEvents
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) Inspect(f func(ctx context.Context, events []*model.OutboxEvent)) *mOutboxRepositoryMockAddEvents {
+	if mmAddEvents.mock.inspectFuncAddEvents != nil {
+		mmAddEvents.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.AddEvents")
+	}
+
+	mmAddEvents.mock.inspectFuncAddEvents = f
+
+	return mmAddEvents
+}
+
+// Return sets up results that will be returned by OutboxRepository.AddEvents
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) Return(err error) *OutboxRepositoryMock {
+	if mmAddEvents.mock.funcAddEvents != nil {
+		mmAddEvents.mock.t.Fatalf("OutboxRepositoryMock.AddEvents mock is already set by Set")
+	}
+
+	if mmAddEvents.defaultExpectation == nil {
+		mmAddEvents.defaultExpectation = &OutboxRepositoryMockAddEventsExpectation{mock: mmAddEvents.mock}
+	}
+	mmAddEvents.defaultExpectation.results = &OutboxRepositoryMockAddEventsResults{err}
+	mmAddEvents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddEvents.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.AddEvents method
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) Set(f func(ctx context.Context, events []*model.OutboxEvent) (err error)) *OutboxRepositoryMock {
+	if mmAddEvents.defaultExpectation != nil {
+		mmAddEvents.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.AddEvents method")
+	}
+
+	if len(mmAddEvents.expectations) > 0 {
+		mmAddEvents.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.AddEvents method")
+	}
+
+	mmAddEvents.mock.funcAddEvents = f
+	mmAddEvents.mock.funcAddEventsOrigin = minimock.CallerInfo(1)
+	return mmAddEvents.mock
+}
+
+// When sets expectation for the OutboxRepository.AddEvents which will trigger the result defined by the following
+// Then helper
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) When(ctx context.Context, events []*model.OutboxEvent) *OutboxRepositoryMockAddEventsExpectation {
+	if mmAddEvents.mock.funcAddEvents != nil {
+		mmAddEvents.mock.t.Fatalf("OutboxRepositoryMock.AddEvents mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockAddEventsExpectation{
+		mock:               mmAddEvents.mock,
+		params:             &OutboxRepositoryMockAddEventsParams{ctx, events},
+		expectationOrigins: OutboxRepositoryMockAddEventsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddEvents.expectations = append(mmAddEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.AddEvents return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockAddEventsExpectation) Then(err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockAddEventsResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.AddEvents should be invoked
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) Times(n uint64) *mOutboxRepositoryMockAddEvents {
+	if n == 0 {
+		mmAddEvents.mock.t.Fatalf("Times of OutboxRepositoryMock.AddEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddEvents.expectedInvocations, n)
+	mmAddEvents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddEvents
+}
+
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) invocationsDone() bool {
+	if len(mmAddEvents.expectations) == 0 && mmAddEvents.defaultExpectation == nil && mmAddEvents.mock.funcAddEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddEvents.mock.afterAddEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddEvents implements mm_repository.OutboxRepository
+func (mmAddEvents *OutboxRepositoryMock) AddEvents(ctx context.Context, events []*model.OutboxEvent) (err error) {
+	mm_atomic.AddUint64(&mmAddEvents.beforeAddEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddEvents.afterAddEventsCounter, 1)
+
+	mmAddEvents.t.Helper()
+
+	if mmAddEvents.inspectFuncAddEvents != nil {
+		mmAddEvents.inspectFuncAddEvents(ctx, events)
+	}
+
+	mm_params := OutboxRepositoryMockAddEventsParams{ctx, events}
+
+	// Record call args
+	mmAddEvents.AddEventsMock.mutex.Lock()
+	mmAddEvents.AddEventsMock.callArgs = append(mmAddEvents.AddEventsMock.callArgs, &mm_params)
+	mmAddEvents.AddEventsMock.mutex.Unlock()
+
+	for _, e := range mmAddEvents.AddEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddEvents.AddEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddEvents.AddEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddEvents.AddEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmAddEvents.AddEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockAddEventsParams{ctx, events}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddEvents.t.Errorf("OutboxRepositoryMock.AddEvents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddEvents.AddEventsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.events != nil && !minimock.Equal(*mm_want_ptrs.events, mm_got.events) {
+				mmAddEvents.t.Errorf("OutboxRepositoryMock.AddEvents got unexpected parameter events, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddEvents.AddEventsMock.defaultExpectation.expectationOrigins.originEvents, *mm_want_ptrs.events, mm_got.events, minimock.Diff(*mm_want_ptrs.events, mm_got.events))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddEvents.t.Errorf("OutboxRepositoryMock.AddEvents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddEvents.AddEventsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddEvents.AddEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddEvents.t.Fatal("No results are set for the OutboxRepositoryMock.AddEvents")
+		}
+		return (*mm_results).err
+	}
+	if mmAddEvents.funcAddEvents != nil {
+		return mmAddEvents.funcAddEvents(ctx, events)
+	}
+	mmAddEvents.t.Fatalf("Unexpected call to OutboxRepositoryMock.AddEvents. %v %v", ctx, events)
+	return
+}
+
+// AddEventsAfterCounter returns a count of finished OutboxRepositoryMock.AddEvents invocations
+func (mmAddEvents *OutboxRepositoryMock) AddEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddEvents.afterAddEventsCounter)
+}
+
+// AddEventsBeforeCounter returns a count of OutboxRepositoryMock.AddEvents invocations
+func (mmAddEvents *OutboxRepositoryMock) AddEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddEvents.beforeAddEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.AddEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddEvents *mOutboxRepositoryMockAddEvents) Calls() []*OutboxRepositoryMockAddEventsParams {
+	mmAddEvents.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockAddEventsParams, len(mmAddEvents.callArgs))
+	copy(argCopy, mmAddEvents.callArgs)
+
+	mmAddEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddEventsDone returns true if the count of the AddEvents invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockAddEventsDone() bool {
+	if m.AddEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddEventsMock.invocationsDone()
+}
+
+// MinimockAddEventsInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockAddEventsInspect() {
+	for _, e := range m.AddEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.AddEvents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddEventsCounter := mm_atomic.LoadUint64(&m.afterAddEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddEventsMock.defaultExpectation != nil && afterAddEventsCounter < 1 {
+		if m.AddEventsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.AddEvents at\n%s", m.AddEventsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.AddEvents at\n%s with params: %#v", m.AddEventsMock.defaultExpectation.expectationOrigins.origin, *m.AddEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddEvents != nil && afterAddEventsCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.AddEvents at\n%s", m.funcAddEventsOrigin)
+	}
+
+	if !m.AddEventsMock.invocationsDone() && afterAddEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.AddEvents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddEventsMock.expectedInvocations), m.AddEventsMock.expectedInvocationsOrigin, afterAddEventsCounter)
+	}
+}
+
+type mOutboxRepositoryMockDeleteEvents struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockDeleteEventsExpectation
+	expectations       []*OutboxRepositoryMockDeleteEventsExpectation
+
+	callArgs []*OutboxRepositoryMockDeleteEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockDeleteEventsExpectation specifies expectation struct of the OutboxRepository.DeleteEvents
+type OutboxRepositoryMockDeleteEventsExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockDeleteEventsParams
+	paramPtrs          *OutboxRepositoryMockDeleteEventsParamPtrs
+	expectationOrigins OutboxRepositoryMockDeleteEventsExpectationOrigins
+	results            *OutboxRepositoryMockDeleteEventsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockDeleteEventsParams contains parameters of the OutboxRepository.DeleteEvents
+type OutboxRepositoryMockDeleteEventsParams struct {
+	ctx context.Context
+	ids []int64
+}
+
+// OutboxRepositoryMockDeleteEventsParamPtrs contains pointers to parameters of the OutboxRepository.DeleteEvents
+type OutboxRepositoryMockDeleteEventsParamPtrs struct {
+	ctx *context.Context
+	ids *[]int64
+}
+
+// OutboxRepositoryMockDeleteEventsResults contains results of the OutboxRepository.DeleteEvents
+type OutboxRepositoryMockDeleteEventsResults struct {
+	err error
+}
+
+// OutboxRepositoryMockDeleteEventsOrigins contains origins of expectations of the OutboxRepository.DeleteEvents
+type OutboxRepositoryMockDeleteEventsExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIds string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteEvents *mOutboxRepositoryMockDeleteEvents) Optional() *mOutboxRepositoryMockDeleteEvents {
+	mmDeleteEvents.optional = true
+	return mmDeleteEvents
+}
+
+// Expect sets up expected params for OutboxRepository.DeleteEvents
+func (mmDeleteEvents *mOutboxRepositoryMockDeleteEvents) Expect(ctx context.Context, ids []int64) *mOutboxRepositoryMockDeleteEvents {
+	if mmDeleteEvents.mock.funcDeleteEvents != nil {
+		mmDeleteEvents.mock.t.Fatalf("OutboxRepositoryMock.DeleteEvents mock is already set by Set")
+	}
+
+	if mmDeleteEvents.defaultExpectation == nil {
+		mmDeleteEvents.defaultExpectation = &OutboxRepositoryMockDeleteEventsExpectation{}
+	}
+
+	if mmDeleteEvents.defaultExpectation.paramPtrs != nil {
+		mmDeleteEvents.mock.t.Fatalf("OutboxRepositoryMock.DeleteEvents mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteEvents.defaultExpectation.params = &OutboxRepositoryMockDeleteEventsParams{ctx, ids}
+	mmDeleteEvents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteEvents.expectations {
+		if minimock.Equal(e.params, mmDeleteEvents.defaultExpectation.params) {
+			mmDeleteEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.DeleteEvents
+func (mmDeleteEvents *mOutboxRepositoryMockDeleteEvents) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockDeleteEvents {
+	if mmDeleteEvents.mock.funcDeleteEvents != nil {
+		mmDeleteEvents.mock.t.Fatalf("OutboxRepositoryMock.DeleteEvents mock is already set by Set")
+	}
+
+	if mmDeleteEvents.defaultExpectation == nil {
+		mmDeleteEvents.defaultExpectation = &OutboxRepositoryMockDeleteEventsExpectation{}
+	}
+
+	if mmDeleteEvents.defaultExpectation.params != nil {
+		mmDeleteEvents.mock.t.Fatalf("OutboxRepositoryMock.DeleteEvents mock is already set by Expect")
+	}
+
+	if mmDeleteEvents.defaultExpectation.paramPtrs == nil {
+		mmDeleteEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockDeleteEventsParamPtrs{}
+	}
+	mmDeleteEvents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteEvents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteEvents
+}
+
+// ExpectIdsParam2 sets up expected param ids for OutboxRepository.DeleteEvents
+func (mmDeleteEvents *mOutboxRepositoryMockDeleteEvents) ExpectIdsParam2(ids []int64) *mOutboxRepositoryMockDeleteEvents {
+	if mmDeleteEvents.mock.funcDeleteEvents != nil {
+		mmDeleteEvents.mock.t.Fatalf("OutboxRepositoryMock.DeleteEvents mock is already set by Set")
+	}
+
+	if mmDeleteEvents.defaultExpectation == nil {
+		mmDeleteEvents.defaultExpectation = &OutboxRepositoryMockDeleteEventsExpectation{}
+	}
+
+	if mmDeleteEvents.defaultExpectation.params != nil {
+		mmDeleteEvents.mock.t.Fatalf("OutboxRepositoryMock.DeleteEvents mock is already set by Expect")
+	}
+
+	if mmDeleteEvents.defaultExpectation.paramPtrs == nil {
+		mmDeleteEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockDeleteEventsParamPtrs{}
+	}
+	mmDeleteEvents.defaultExpectation.paramPtrs.ids = &ids
+	mmDeleteEvents.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmDeleteEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.DeleteEvents
+func (mmDeleteEvents *mOutboxRepositoryMockDeleteEvents) Inspect(f func(ctx context.Context, ids []int64)) *mOutboxRepositoryMockDeleteEvents {
+	if mmDeleteEvents.mock.inspectFuncDeleteEvents != nil {
+		mmDeleteEvents.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.DeleteEvents")
+	}
+
+	mmDeleteEvents.mock.inspectFuncDeleteEvents = f
+
+	return mmDeleteEvents
+}
+
+// Return sets up results that will be returned by OutboxRepository.DeleteEvents
+func (mmDeleteEvents *mOutboxRepositoryMockDeleteEvents) Return(err error) *OutboxRepositoryMock {
+	if mmDeleteEvents.mock.funcDeleteEvents != nil {
+		mmDeleteEvents.mock.t.Fatalf("OutboxRepositoryMock.DeleteEvents mock is already set by Set")
+	}
+
+	if mmDeleteEvents.defaultExpectation == nil {
+		mmDeleteEvents.defaultExpectation = &OutboxRepositoryMockDeleteEventsExpectation{mock: mmDeleteEvents.mock}
+	}
+	mmDeleteEvents.defaultExpectation.results = &OutboxRepositoryMockDeleteEventsResults{err}
+	mmDeleteEvents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteEvents.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.DeleteEvents method
+func (mmDeleteEvents *mOutboxRepositoryMockDeleteEvents) Set(f func(ctx context.Context, ids []int64) (err error)) *OutboxRepositoryMock {
+	if mmDeleteEvents.defaultExpectation != nil {
+		mmDeleteEvents.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.DeleteEvents method")
+	}
+
+	if len(mmDeleteEvents.expectations) > 0 {
+		mmDeleteEvents.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.DeleteEvents method")
+	}
+
+	mmDeleteEvents.mock.funcDeleteEvents = f
+	mmDeleteEvents.mock.funcDeleteEventsOrigin = minimock.CallerInfo(1)
+	return mmDeleteEvents.mock
+}
+
+// When sets expectation for the OutboxRepository.DeleteEvents which will trigger the result defined by the following
+// Then helper
+func (mmDeleteEvents *mOutboxRepositoryMockDeleteEvents) When(ctx context.Context, ids []int64) *OutboxRepositoryMockDeleteEventsExpectation {
+	if mmDeleteEvents.mock.funcDeleteEvents != nil {
+		mmDeleteEvents.mock.t.Fatalf("OutboxRepositoryMock.DeleteEvents mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockDeleteEventsExpectation{
+		mock:               mmDeleteEvents.mock,
+		params:             &OutboxRepositoryMockDeleteEventsParams{ctx, ids},
+		expectationOrigins: OutboxRepositoryMockDeleteEventsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteEvents.expectations = append(mmDeleteEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.DeleteEvents return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockDeleteEventsExpectation) Then(err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockDeleteEventsResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.DeleteEvents should be invoked
+func (mmDeleteEvents *mOutboxRepositoryMockDeleteEvents) Times(n uint64) *mOutboxRepositoryMockDeleteEvents {
+	if n == 0 {
+		mmDeleteEvents.mock.t.Fatalf("Times of OutboxRepositoryMock.DeleteEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteEvents.expectedInvocations, n)
+	mmDeleteEvents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteEvents
+}
+
+func (mmDeleteEvents *mOutboxRepositoryMockDeleteEvents) invocationsDone() bool {
+	if len(mmDeleteEvents.expectations) == 0 && mmDeleteEvents.defaultExpectation == nil && mmDeleteEvents.mock.funcDeleteEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteEvents.mock.afterDeleteEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteEvents implements mm_repository.OutboxRepository
+func (mmDeleteEvents *OutboxRepositoryMock) DeleteEvents(ctx context.Context, ids []int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteEvents.beforeDeleteEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteEvents.afterDeleteEventsCounter, 1)
+
+	mmDeleteEvents.t.Helper()
+
+	if mmDeleteEvents.inspectFuncDeleteEvents != nil {
+		mmDeleteEvents.inspectFuncDeleteEvents(ctx, ids)
+	}
+
+	mm_params := OutboxRepositoryMockDeleteEventsParams{ctx, ids}
+
+	// Record call args
+	mmDeleteEvents.DeleteEventsMock.mutex.Lock()
+	mmDeleteEvents.DeleteEventsMock.callArgs = append(mmDeleteEvents.DeleteEventsMock.callArgs, &mm_params)
+	mmDeleteEvents.DeleteEventsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteEvents.DeleteEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteEvents.DeleteEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteEvents.DeleteEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteEvents.DeleteEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteEvents.DeleteEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockDeleteEventsParams{ctx, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteEvents.t.Errorf("OutboxRepositoryMock.DeleteEvents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteEvents.DeleteEventsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmDeleteEvents.t.Errorf("OutboxRepositoryMock.DeleteEvents got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteEvents.DeleteEventsMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteEvents.t.Errorf("OutboxRepositoryMock.DeleteEvents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteEvents.DeleteEventsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteEvents.DeleteEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteEvents.t.Fatal("No results are set for the OutboxRepositoryMock.DeleteEvents")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteEvents.funcDeleteEvents != nil {
+		return mmDeleteEvents.funcDeleteEvents(ctx, ids)
+	}
+	mmDeleteEvents.t.Fatalf("Unexpected call to OutboxRepositoryMock.DeleteEvents. %v %v", ctx, ids)
+	return
+}
+
+// DeleteEventsAfterCounter returns a count of finished OutboxRepositoryMock.DeleteEvents invocations
+func (mmDeleteEvents *OutboxRepositoryMock) DeleteEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteEvents.afterDeleteEventsCounter)
+}
+
+// DeleteEventsBeforeCounter returns a count of OutboxRepositoryMock.DeleteEvents invocations
+func (mmDeleteEvents *OutboxRepositoryMock) DeleteEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteEvents.beforeDeleteEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.DeleteEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteEvents *mOutboxRepositoryMockDeleteEvents) Calls() []*OutboxRepositoryMockDeleteEventsParams {
+	mmDeleteEvents.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockDeleteEventsParams, len(mmDeleteEvents.callArgs))
+	copy(argCopy, mmDeleteEvents.callArgs)
+
+	mmDeleteEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteEventsDone returns true if the count of the DeleteEvents invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockDeleteEventsDone() bool {
+	if m.DeleteEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteEventsMock.invocationsDone()
+}
+
+// MinimockDeleteEventsInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockDeleteEventsInspect() {
+	for _, e := range m.DeleteEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.DeleteEvents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteEventsCounter := mm_atomic.LoadUint64(&m.afterDeleteEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteEventsMock.defaultExpectation != nil && afterDeleteEventsCounter < 1 {
+		if m.DeleteEventsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.DeleteEvents at\n%s", m.DeleteEventsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.DeleteEvents at\n%s with params: %#v", m.DeleteEventsMock.defaultExpectation.expectationOrigins.origin, *m.DeleteEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteEvents != nil && afterDeleteEventsCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.DeleteEvents at\n%s", m.funcDeleteEventsOrigin)
+	}
+
+	if !m.DeleteEventsMock.invocationsDone() && afterDeleteEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.DeleteEvents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteEventsMock.expectedInvocations), m.DeleteEventsMock.expectedInvocationsOrigin, afterDeleteEventsCounter)
+	}
+}
+
+type mOutboxRepositoryMockGetPendingEvents struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockGetPendingEventsExpectation
+	expectations       []*OutboxRepositoryMockGetPendingEventsExpectation
+
+	callArgs []*OutboxRepositoryMockGetPendingEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockGetPendingEventsExpectation specifies expectation struct of the OutboxRepository.GetPendingEvents
+type OutboxRepositoryMockGetPendingEventsExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockGetPendingEventsParams
+	paramPtrs          *OutboxRepositoryMockGetPendingEventsParamPtrs
+	expectationOrigins OutboxRepositoryMockGetPendingEventsExpectationOrigins
+	results            *OutboxRepositoryMockGetPendingEventsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockGetPendingEventsParams contains parameters of the OutboxRepository.GetPendingEvents
+type OutboxRepositoryMockGetPendingEventsParams struct {
+	ctx   context.Context
+	limit uint64
+}
+
+// OutboxRepositoryMockGetPendingEventsParamPtrs contains pointers to parameters of the OutboxRepository.GetPendingEvents
+type OutboxRepositoryMockGetPendingEventsParamPtrs struct {
+	ctx   *context.Context
+	limit *uint64
+}
+
+// OutboxRepositoryMockGetPendingEventsResults contains results of the OutboxRepository.GetPendingEvents
+type OutboxRepositoryMockGetPendingEventsResults struct {
+	opa1 []*model.OutboxEvent
+	err  error
+}
+
+// OutboxRepositoryMockGetPendingEventsOrigins contains origins of expectations of the OutboxRepository.GetPendingEvents
+type OutboxRepositoryMockGetPendingEventsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) Optional() *mOutboxRepositoryMockGetPendingEvents {
+	mmGetPendingEvents.optional = true
+	return mmGetPendingEvents
+}
+
+// Expect sets up expected params for OutboxRepository.GetPendingEvents
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) Expect(ctx context.Context, limit uint64) *mOutboxRepositoryMockGetPendingEvents {
+	if mmGetPendingEvents.mock.funcGetPendingEvents != nil {
+		mmGetPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.GetPendingEvents mock is already set by Set")
+	}
+
+	if mmGetPendingEvents.defaultExpectation == nil {
+		mmGetPendingEvents.defaultExpectation = &OutboxRepositoryMockGetPendingEventsExpectation{}
+	}
+
+	if mmGetPendingEvents.defaultExpectation.paramPtrs != nil {
+		mmGetPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.GetPendingEvents mock is already set by ExpectParams functions")
+	}
+
+	mmGetPendingEvents.defaultExpectation.params = &OutboxRepositoryMockGetPendingEventsParams{ctx, limit}
+	mmGetPendingEvents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPendingEvents.expectations {
+		if minimock.Equal(e.params, mmGetPendingEvents.defaultExpectation.params) {
+			mmGetPendingEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPendingEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPendingEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.GetPendingEvents
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockGetPendingEvents {
+	if mmGetPendingEvents.mock.funcGetPendingEvents != nil {
+		mmGetPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.GetPendingEvents mock is already set by Set")
+	}
+
+	if mmGetPendingEvents.defaultExpectation == nil {
+		mmGetPendingEvents.defaultExpectation = &OutboxRepositoryMockGetPendingEventsExpectation{}
+	}
+
+	if mmGetPendingEvents.defaultExpectation.params != nil {
+		mmGetPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.GetPendingEvents mock is already set by Expect")
+	}
+
+	if mmGetPendingEvents.defaultExpectation.paramPtrs == nil {
+		mmGetPendingEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockGetPendingEventsParamPtrs{}
+	}
+	mmGetPendingEvents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPendingEvents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPendingEvents
+}
+
+// ExpectLimitParam2 sets up expected param limit for OutboxRepository.GetPendingEvents
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) ExpectLimitParam2(limit uint64) *mOutboxRepositoryMockGetPendingEvents {
+	if mmGetPendingEvents.mock.funcGetPendingEvents != nil {
+		mmGetPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.GetPendingEvents mock is already set by Set")
+	}
+
+	if mmGetPendingEvents.defaultExpectation == nil {
+		mmGetPendingEvents.defaultExpectation = &OutboxRepositoryMockGetPendingEventsExpectation{}
+	}
+
+	if mmGetPendingEvents.defaultExpectation.params != nil {
+		mmGetPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.GetPendingEvents mock is already set by Expect")
+	}
+
+	if mmGetPendingEvents.defaultExpectation.paramPtrs == nil {
+		mmGetPendingEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockGetPendingEventsParamPtrs{}
+	}
+	mmGetPendingEvents.defaultExpectation.paramPtrs.limit = &limit
+	mmGetPendingEvents.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetPendingEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.GetPendingEvents
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) Inspect(f func(ctx context.Context, limit uint64)) *mOutboxRepositoryMockGetPendingEvents {
+	if mmGetPendingEvents.mock.inspectFuncGetPendingEvents != nil {
+		mmGetPendingEvents.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.GetPendingEvents")
+	}
+
+	mmGetPendingEvents.mock.inspectFuncGetPendingEvents = f
+
+	return mmGetPendingEvents
+}
+
+// Return sets up results that will be returned by OutboxRepository.GetPendingEvents
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) Return(opa1 []*model.OutboxEvent, err error) *OutboxRepositoryMock {
+	if mmGetPendingEvents.mock.funcGetPendingEvents != nil {
+		mmGetPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.GetPendingEvents mock is already set by Set")
+	}
+
+	if mmGetPendingEvents.defaultExpectation == nil {
+		mmGetPendingEvents.defaultExpectation = &OutboxRepositoryMockGetPendingEventsExpectation{mock: mmGetPendingEvents.mock}
+	}
+	mmGetPendingEvents.defaultExpectation.results = &OutboxRepositoryMockGetPendingEventsResults{opa1, err}
+	mmGetPendingEvents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPendingEvents.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.GetPendingEvents method
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) Set(f func(ctx context.Context, limit uint64) (opa1 []*model.OutboxEvent, err error)) *OutboxRepositoryMock {
+	if mmGetPendingEvents.defaultExpectation != nil {
+		mmGetPendingEvents.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.GetPendingEvents method")
+	}
+
+	if len(mmGetPendingEvents.expectations) > 0 {
+		mmGetPendingEvents.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.GetPendingEvents method")
+	}
+
+	mmGetPendingEvents.mock.funcGetPendingEvents = f
+	mmGetPendingEvents.mock.funcGetPendingEventsOrigin = minimock.CallerInfo(1)
+	return mmGetPendingEvents.mock
+}
+
+// When sets expectation for the OutboxRepository.GetPendingEvents which will trigger the result defined by the following
+// Then helper
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) When(ctx context.Context, limit uint64) *OutboxRepositoryMockGetPendingEventsExpectation {
+	if mmGetPendingEvents.mock.funcGetPendingEvents != nil {
+		mmGetPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.GetPendingEvents mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockGetPendingEventsExpectation{
+		mock:               mmGetPendingEvents.mock,
+		params:             &OutboxRepositoryMockGetPendingEventsParams{ctx, limit},
+		expectationOrigins: OutboxRepositoryMockGetPendingEventsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPendingEvents.expectations = append(mmGetPendingEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.GetPendingEvents return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockGetPendingEventsExpectation) Then(opa1 []*model.OutboxEvent, err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockGetPendingEventsResults{opa1, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.GetPendingEvents should be invoked
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) Times(n uint64) *mOutboxRepositoryMockGetPendingEvents {
+	if n == 0 {
+		mmGetPendingEvents.mock.t.Fatalf("Times of OutboxRepositoryMock.GetPendingEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPendingEvents.expectedInvocations, n)
+	mmGetPendingEvents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPendingEvents
+}
+
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) invocationsDone() bool {
+	if len(mmGetPendingEvents.expectations) == 0 && mmGetPendingEvents.defaultExpectation == nil && mmGetPendingEvents.mock.funcGetPendingEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPendingEvents.mock.afterGetPendingEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPendingEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPendingEvents implements mm_repository.OutboxRepository
+func (mmGetPendingEvents *OutboxRepositoryMock) GetPendingEvents(ctx context.Context, limit uint64) (opa1 []*model.OutboxEvent, err error) {
+	mm_atomic.AddUint64(&mmGetPendingEvents.beforeGetPendingEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPendingEvents.afterGetPendingEventsCounter, 1)
+
+	mmGetPendingEvents.t.Helper()
+
+	if mmGetPendingEvents.inspectFuncGetPendingEvents != nil {
+		mmGetPendingEvents.inspectFuncGetPendingEvents(ctx, limit)
+	}
+
+	mm_params := OutboxRepositoryMockGetPendingEventsParams{ctx, limit}
+
+	// Record call args
+	mmGetPendingEvents.GetPendingEventsMock.mutex.Lock()
+	mmGetPendingEvents.GetPendingEventsMock.callArgs = append(mmGetPendingEvents.GetPendingEventsMock.callArgs, &mm_params)
+	mmGetPendingEvents.GetPendingEventsMock.mutex.Unlock()
+
+	for _, e := range mmGetPendingEvents.GetPendingEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.opa1, e.results.err
+		}
+	}
+
+	if mmGetPendingEvents.GetPendingEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPendingEvents.GetPendingEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPendingEvents.GetPendingEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPendingEvents.GetPendingEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockGetPendingEventsParams{ctx, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPendingEvents.t.Errorf("OutboxRepositoryMock.GetPendingEvents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPendingEvents.GetPendingEventsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetPendingEvents.t.Errorf("OutboxRepositoryMock.GetPendingEvents got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPendingEvents.GetPendingEventsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPendingEvents.t.Errorf("OutboxRepositoryMock.GetPendingEvents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPendingEvents.GetPendingEventsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPendingEvents.GetPendingEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPendingEvents.t.Fatal("No results are set for the OutboxRepositoryMock.GetPendingEvents")
+		}
+		return (*mm_results).opa1, (*mm_results).err
+	}
+	if mmGetPendingEvents.funcGetPendingEvents != nil {
+		return mmGetPendingEvents.funcGetPendingEvents(ctx, limit)
+	}
+	mmGetPendingEvents.t.Fatalf("Unexpected call to OutboxRepositoryMock.GetPendingEvents. %v %v", ctx, limit)
+	return
+}
+
+// GetPendingEventsAfterCounter returns a count of finished OutboxRepositoryMock.GetPendingEvents invocations
+func (mmGetPendingEvents *OutboxRepositoryMock) GetPendingEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPendingEvents.afterGetPendingEventsCounter)
+}
+
+// GetPendingEventsBeforeCounter returns a count of OutboxRepositoryMock.GetPendingEvents invocations
+func (mmGetPendingEvents *OutboxRepositoryMock) GetPendingEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPendingEvents.beforeGetPendingEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.GetPendingEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPendingEvents *mOutboxRepositoryMockGetPendingEvents) Calls() []*OutboxRepositoryMockGetPendingEventsParams {
+	mmGetPendingEvents.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockGetPendingEventsParams, len(mmGetPendingEvents.callArgs))
+	copy(argCopy, mmGetPendingEvents.callArgs)
+
+	mmGetPendingEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPendingEventsDone returns true if the count of the GetPendingEvents invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockGetPendingEventsDone() bool {
+	if m.GetPendingEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPendingEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPendingEventsMock.invocationsDone()
+}
+
+// MinimockGetPendingEventsInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockGetPendingEventsInspect() {
+	for _, e := range m.GetPendingEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.GetPendingEvents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPendingEventsCounter := mm_atomic.LoadUint64(&m.afterGetPendingEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPendingEventsMock.defaultExpectation != nil && afterGetPendingEventsCounter < 1 {
+		if m.GetPendingEventsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.GetPendingEvents at\n%s", m.GetPendingEventsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.GetPendingEvents at\n%s with params: %#v", m.GetPendingEventsMock.defaultExpectation.expectationOrigins.origin, *m.GetPendingEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPendingEvents != nil && afterGetPendingEventsCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.GetPendingEvents at\n%s", m.funcGetPendingEventsOrigin)
+	}
+
+	if !m.GetPendingEventsMock.invocationsDone() && afterGetPendingEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.GetPendingEvents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPendingEventsMock.expectedInvocations), m.GetPendingEventsMock.expectedInvocationsOrigin, afterGetPendingEventsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OutboxRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddEventInspect()
+
+			m.MinimockAddEventsInspect()
+
+			m.MinimockDeleteEventsInspect()
+
+			m.MinimockGetPendingEventsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OutboxRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OutboxRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddEventDone() &&
+		m.MinimockAddEventsDone() &&
+		m.MinimockDeleteEventsDone() &&
+		m.MinimockGetPendingEventsDone()
+}
@@ -0,0 +1,1161 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/ipv02/auth/internal/repository.ProcessedMessageRepository -o processed_message_repository_minimock.go -n ProcessedMessageRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ProcessedMessageRepositoryMock implements mm_repository.ProcessedMessageRepository
+type ProcessedMessageRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcMarkProcessed          func(ctx context.Context, key string) (b1 bool, err error)
+	funcMarkProcessedOrigin    string
+	inspectFuncMarkProcessed   func(ctx context.Context, key string)
+	afterMarkProcessedCounter  uint64
+	beforeMarkProcessedCounter uint64
+	MarkProcessedMock          mProcessedMessageRepositoryMockMarkProcessed
+
+	funcMarkProcessedBatch          func(ctx context.Context, keys []string) (sa1 []string, err error)
+	funcMarkProcessedBatchOrigin    string
+	inspectFuncMarkProcessedBatch   func(ctx context.Context, keys []string)
+	afterMarkProcessedBatchCounter  uint64
+	beforeMarkProcessedBatchCounter uint64
+	MarkProcessedBatchMock          mProcessedMessageRepositoryMockMarkProcessedBatch
+
+	funcPurgeProcessedMessages          func(ctx context.Context, processedBefore time.Time, limit uint64) (i1 int64, err error)
+	funcPurgeProcessedMessagesOrigin    string
+	inspectFuncPurgeProcessedMessages   func(ctx context.Context, processedBefore time.Time, limit uint64)
+	afterPurgeProcessedMessagesCounter  uint64
+	beforePurgeProcessedMessagesCounter uint64
+	PurgeProcessedMessagesMock          mProcessedMessageRepositoryMockPurgeProcessedMessages
+}
+
+// NewProcessedMessageRepositoryMock returns a mock for mm_repository.ProcessedMessageRepository
+func NewProcessedMessageRepositoryMock(t minimock.Tester) *ProcessedMessageRepositoryMock {
+	m := &ProcessedMessageRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.MarkProcessedMock = mProcessedMessageRepositoryMockMarkProcessed{mock: m}
+	m.MarkProcessedMock.callArgs = []*ProcessedMessageRepositoryMockMarkProcessedParams{}
+
+	m.MarkProcessedBatchMock = mProcessedMessageRepositoryMockMarkProcessedBatch{mock: m}
+	m.MarkProcessedBatchMock.callArgs = []*ProcessedMessageRepositoryMockMarkProcessedBatchParams{}
+
+	m.PurgeProcessedMessagesMock = mProcessedMessageRepositoryMockPurgeProcessedMessages{mock: m}
+	m.PurgeProcessedMessagesMock.callArgs = []*ProcessedMessageRepositoryMockPurgeProcessedMessagesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mProcessedMessageRepositoryMockMarkProcessed struct {
+	optional           bool
+	mock               *ProcessedMessageRepositoryMock
+	defaultExpectation *ProcessedMessageRepositoryMockMarkProcessedExpectation
+	expectations       []*ProcessedMessageRepositoryMockMarkProcessedExpectation
+
+	callArgs []*ProcessedMessageRepositoryMockMarkProcessedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProcessedMessageRepositoryMockMarkProcessedExpectation specifies expectation struct of the ProcessedMessageRepository.MarkProcessed
+type ProcessedMessageRepositoryMockMarkProcessedExpectation struct {
+	mock               *ProcessedMessageRepositoryMock
+	params             *ProcessedMessageRepositoryMockMarkProcessedParams
+	paramPtrs          *ProcessedMessageRepositoryMockMarkProcessedParamPtrs
+	expectationOrigins ProcessedMessageRepositoryMockMarkProcessedExpectationOrigins
+	results            *ProcessedMessageRepositoryMockMarkProcessedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProcessedMessageRepositoryMockMarkProcessedParams contains parameters of the ProcessedMessageRepository.MarkProcessed
+type ProcessedMessageRepositoryMockMarkProcessedParams struct {
+	ctx context.Context
+	key string
+}
+
+// ProcessedMessageRepositoryMockMarkProcessedParamPtrs contains pointers to parameters of the ProcessedMessageRepository.MarkProcessed
+type ProcessedMessageRepositoryMockMarkProcessedParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// ProcessedMessageRepositoryMockMarkProcessedResults contains results of the ProcessedMessageRepository.MarkProcessed
+type ProcessedMessageRepositoryMockMarkProcessedResults struct {
+	b1  bool
+	err error
+}
+
+// ProcessedMessageRepositoryMockMarkProcessedOrigins contains origins of expectations of the ProcessedMessageRepository.MarkProcessed
+type ProcessedMessageRepositoryMockMarkProcessedExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkProcessed *mProcessedMessageRepositoryMockMarkProcessed) Optional() *mProcessedMessageRepositoryMockMarkProcessed {
+	mmMarkProcessed.optional = true
+	return mmMarkProcessed
+}
+
+// Expect sets up expected params for ProcessedMessageRepository.MarkProcessed
+func (mmMarkProcessed *mProcessedMessageRepositoryMockMarkProcessed) Expect(ctx context.Context, key string) *mProcessedMessageRepositoryMockMarkProcessed {
+	if mmMarkProcessed.mock.funcMarkProcessed != nil {
+		mmMarkProcessed.mock.t.Fatalf("ProcessedMessageRepositoryMock.MarkProcessed mock is already set by Set")
+	}
+
+	if mmMarkProcessed.defaultExpectation == nil {
+		mmMarkProcessed.defaultExpectation = &ProcessedMessageRepositoryMockMarkProcessedExpectation{}
+	}
+
+	if mmMarkProcessed.defaultExpectation.paramPtrs != nil {
+		mmMarkProcessed.mock.t.Fatalf("ProcessedMessageRepositoryMock.MarkProcessed mock is already set by ExpectParams functions")
+	}
+
+	mmMarkProcessed.defaultExpectation.params = &ProcessedMessageRepositoryMockMarkProcessedParams{ctx, key}
+	mmMarkProcessed.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkProcessed.expectations {
+		if minimock.Equal(e.params, mmMarkProcessed.defaultExpectation.params) {
+			mmMarkProcessed.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkProcessed.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkProcessed
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProcessedMessageRepository.MarkProcessed
+func (mmMarkProcessed *mProcessedMessageRepositoryMockMarkProcessed) ExpectCtxParam1(ctx context.Context) *mProcessedMessageRepositoryMockMarkProcessed {
+	if mmMarkProcessed.mock.funcMarkProcessed != nil {
+		mmMarkProcessed.mock.t.Fatalf("ProcessedMessageRepositoryMock.MarkProcessed mock is already set by Set")
+	}
+
+	if mmMarkProcessed.defaultExpectation == nil {
+		mmMarkProcessed.defaultExpectation = &ProcessedMessageRepositoryMockMarkProcessedExpectation{}
+	}
+
+	if mmMarkProcessed.defaultExpectation.params != nil {
+		mmMarkProcessed.mock.t.Fatalf("ProcessedMessageRepositoryMock.MarkProcessed mock is already set by Expect")
+	}
+
+	if mmMarkProcessed.defaultExpectation.paramPtrs == nil {
+		mmMarkProcessed.defaultExpectation.paramPtrs = &ProcessedMessageRepositoryMockMarkProcessedParamPtrs{}
+	}
+	mmMarkProcessed.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkProcessed.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkProcessed
+}
+
+// ExpectKeyParam2 sets up expected param key for ProcessedMessageRepository.MarkProcessed
+func (mmMarkProcessed *mProcessedMessageRepositoryMockMarkProcessed) ExpectKeyParam2(key string) *mProcessedMessageRepositoryMockMarkProcessed {
+	if mmMarkProcessed.mock.funcMarkProcessed != nil {
+		mmMarkProcessed.mock.t.Fatalf("ProcessedMessageRepositoryMock.MarkProcessed mock is already set by Set")
+	}
+
+	if mmMarkProcessed.defaultExpectation == nil {
+		mmMarkProcessed.defaultExpectation = &ProcessedMessageRepositoryMockMarkProcessedExpectation{}
+	}
+
+	if mmMarkProcessed.defaultExpectation.params != nil {
+		mmMarkProcessed.mock.t.Fatalf("ProcessedMessageRepositoryMock.MarkProcessed mock is already set by Expect")
+	}
+
+	if mmMarkProcessed.defaultExpectation.paramPtrs == nil {
+		mmMarkProcessed.defaultExpectation.paramPtrs = &ProcessedMessageRepositoryMockMarkProcessedParamPtrs{}
+	}
+	mmMarkProcessed.defaultExpectation.paramPtrs.key = &key
+	mmMarkProcessed.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmMarkProcessed
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProcessedMessageRepository.MarkProcessed
+func (mmMarkProcessed *mProcessedMessageRepositoryMockMarkProcessed) Inspect(f func(ctx context.Context, key string)) *mProcessedMessageRepositoryMockMarkProcessed {
+	if mmMarkProcessed.mock.inspectFuncMarkProcessed != nil {
+		mmMarkProcessed.mock.t.Fatalf("Inspect function is already set for ProcessedMessageRepositoryMock.MarkProcessed")
+	}
+
+	mmMarkProcessed.mock.inspectFuncMarkProcessed = f
+
+	return mmMarkProcessed
+}
+
+// Return sets up results that will be returned by ProcessedMessageRepository.MarkProcessed
+func (mmMarkProcessed *mProcessedMessageRepositoryMockMarkProcessed) Return(b1 bool, err error) *ProcessedMessageRepositoryMock {
+	if mmMarkProcessed.mock.funcMarkProcessed != nil {
+		mmMarkProcessed.mock.t.Fatalf("ProcessedMessageRepositoryMock.MarkProcessed mock is already set by Set")
+	}
+
+	if mmMarkProcessed.defaultExpectation == nil {
+		mmMarkProcessed.defaultExpectation = &ProcessedMessageRepositoryMockMarkProcessedExpectation{mock: mmMarkProcessed.mock}
+	}
+	mmMarkProcessed.defaultExpectation.results = &ProcessedMessageRepositoryMockMarkProcessedResults{b1, err}
+	mmMarkProcessed.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkProcessed.mock
+}
+
+// Set uses given function f to mock the ProcessedMessageRepository.MarkProcessed method
+func (mmMarkProcessed *mProcessedMessageRepositoryMockMarkProcessed) Set(f func(ctx context.Context, key string) (b1 bool, err error)) *ProcessedMessageRepositoryMock {
+	if mmMarkProcessed.defaultExpectation != nil {
+		mmMarkProcessed.mock.t.Fatalf("Default expectation is already set for the ProcessedMessageRepository.MarkProcessed method")
+	}
+
+	if len(mmMarkProcessed.expectations) > 0 {
+		mmMarkProcessed.mock.t.Fatalf("Some expectations are already set for the ProcessedMessageRepository.MarkProcessed method")
+	}
+
+	mmMarkProcessed.mock.funcMarkProcessed = f
+	mmMarkProcessed.mock.funcMarkProcessedOrigin = minimock.CallerInfo(1)
+	return mmMarkProcessed.mock
+}
+
+// When sets expectation for the ProcessedMessageRepository.MarkProcessed which will trigger the result defined by the following
+// Then helper
+func (mmMarkProcessed *mProcessedMessageRepositoryMockMarkProcessed) When(ctx context.Context, key string) *ProcessedMessageRepositoryMockMarkProcessedExpectation {
+	if mmMarkProcessed.mock.funcMarkProcessed != nil {
+		mmMarkProcessed.mock.t.Fatalf("ProcessedMessageRepositoryMock.MarkProcessed mock is already set by Set")
+	}
+
+	expectation := &ProcessedMessageRepositoryMockMarkProcessedExpectation{
+		mock:               mmMarkProcessed.mock,
+		params:             &ProcessedMessageRepositoryMockMarkProcessedParams{ctx, key},
+		expectationOrigins: ProcessedMessageRepositoryMockMarkProcessedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkProcessed.expectations = append(mmMarkProcessed.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProcessedMessageRepository.MarkProcessed return parameters for the expectation previously defined by the When method
+func (e *ProcessedMessageRepositoryMockMarkProcessedExpectation) Then(b1 bool, err error) *ProcessedMessageRepositoryMock {
+	e.results = &ProcessedMessageRepositoryMockMarkProcessedResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ProcessedMessageRepository.MarkProcessed should be invoked
+func (mmMarkProcessed *mProcessedMessageRepositoryMockMarkProcessed) Times(n uint64) *mProcessedMessageRepositoryMockMarkProcessed {
+	if n == 0 {
+		mmMarkProcessed.mock.t.Fatalf("Times of ProcessedMessageRepositoryMock.MarkProcessed mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkProcessed.expectedInvocations, n)
+	mmMarkProcessed.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkProcessed
+}
+
+func (mmMarkProcessed *mProcessedMessageRepositoryMockMarkProcessed) invocationsDone() bool {
+	if len(mmMarkProcessed.expectations) == 0 && mmMarkProcessed.defaultExpectation == nil && mmMarkProcessed.mock.funcMarkProcessed == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkProcessed.mock.afterMarkProcessedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkProcessed.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkProcessed implements mm_repository.ProcessedMessageRepository
+func (mmMarkProcessed *ProcessedMessageRepositoryMock) MarkProcessed(ctx context.Context, key string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmMarkProcessed.beforeMarkProcessedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkProcessed.afterMarkProcessedCounter, 1)
+
+	mmMarkProcessed.t.Helper()
+
+	if mmMarkProcessed.inspectFuncMarkProcessed != nil {
+		mmMarkProcessed.inspectFuncMarkProcessed(ctx, key)
+	}
+
+	mm_params := ProcessedMessageRepositoryMockMarkProcessedParams{ctx, key}
+
+	// Record call args
+	mmMarkProcessed.MarkProcessedMock.mutex.Lock()
+	mmMarkProcessed.MarkProcessedMock.callArgs = append(mmMarkProcessed.MarkProcessedMock.callArgs, &mm_params)
+	mmMarkProcessed.MarkProcessedMock.mutex.Unlock()
+
+	for _, e := range mmMarkProcessed.MarkProcessedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmMarkProcessed.MarkProcessedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkProcessed.MarkProcessedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkProcessed.MarkProcessedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkProcessed.MarkProcessedMock.defaultExpectation.paramPtrs
+
+		mm_got := ProcessedMessageRepositoryMockMarkProcessedParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkProcessed.t.Errorf("ProcessedMessageRepositoryMock.MarkProcessed got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkProcessed.MarkProcessedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmMarkProcessed.t.Errorf("ProcessedMessageRepositoryMock.MarkProcessed got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkProcessed.MarkProcessedMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkProcessed.t.Errorf("ProcessedMessageRepositoryMock.MarkProcessed got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkProcessed.MarkProcessedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkProcessed.MarkProcessedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkProcessed.t.Fatal("No results are set for the ProcessedMessageRepositoryMock.MarkProcessed")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmMarkProcessed.funcMarkProcessed != nil {
+		return mmMarkProcessed.funcMarkProcessed(ctx, key)
+	}
+	mmMarkProcessed.t.Fatalf("Unexpected call to ProcessedMessageRepositoryMock.MarkProcessed. %v %v", ctx, key)
+	return
+}
+
+// MarkProcessedAfterCounter returns a count of finished ProcessedMessageRepositoryMock.MarkProcessed invocations
+func (mmMarkProcessed *ProcessedMessageRepositoryMock) MarkProcessedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkProcessed.afterMarkProcessedCounter)
+}
+
+// MarkProcessedBeforeCounter returns a count of ProcessedMessageRepositoryMock.MarkProcessed invocations
+func (mmMarkProcessed *ProcessedMessageRepositoryMock) MarkProcessedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkProcessed.beforeMarkProcessedCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProcessedMessageRepositoryMock.MarkProcessed.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkProcessed *mProcessedMessageRepositoryMockMarkProcessed) Calls() []*ProcessedMessageRepositoryMockMarkProcessedParams {
+	mmMarkProcessed.mutex.RLock()
+
+	argCopy := make([]*ProcessedMessageRepositoryMockMarkProcessedParams, len(mmMarkProcessed.callArgs))
+	copy(argCopy, mmMarkProcessed.callArgs)
+
+	mmMarkProcessed.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkProcessedDone returns true if the count of the MarkProcessed invocations corresponds
+// the number of defined expectations
+func (m *ProcessedMessageRepositoryMock) MinimockMarkProcessedDone() bool {
+	if m.MarkProcessedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkProcessedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkProcessedMock.invocationsDone()
+}
+
+// MinimockMarkProcessedInspect logs each unmet expectation
+func (m *ProcessedMessageRepositoryMock) MinimockMarkProcessedInspect() {
+	for _, e := range m.MarkProcessedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProcessedMessageRepositoryMock.MarkProcessed at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkProcessedCounter := mm_atomic.LoadUint64(&m.afterMarkProcessedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkProcessedMock.defaultExpectation != nil && afterMarkProcessedCounter < 1 {
+		if m.MarkProcessedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProcessedMessageRepositoryMock.MarkProcessed at\n%s", m.MarkProcessedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProcessedMessageRepositoryMock.MarkProcessed at\n%s with params: %#v", m.MarkProcessedMock.defaultExpectation.expectationOrigins.origin, *m.MarkProcessedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkProcessed != nil && afterMarkProcessedCounter < 1 {
+		m.t.Errorf("Expected call to ProcessedMessageRepositoryMock.MarkProcessed at\n%s", m.funcMarkProcessedOrigin)
+	}
+
+	if !m.MarkProcessedMock.invocationsDone() && afterMarkProcessedCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProcessedMessageRepositoryMock.MarkProcessed at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkProcessedMock.expectedInvocations), m.MarkProcessedMock.expectedInvocationsOrigin, afterMarkProcessedCounter)
+	}
+}
+
+type mProcessedMessageRepositoryMockMarkProcessedBatch struct {
+	optional           bool
+	mock               *ProcessedMessageRepositoryMock
+	defaultExpectation *ProcessedMessageRepositoryMockMarkProcessedBatchExpectation
+	expectations       []*ProcessedMessageRepositoryMockMarkProcessedBatchExpectation
+
+	callArgs []*ProcessedMessageRepositoryMockMarkProcessedBatchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProcessedMessageRepositoryMockMarkProcessedBatchExpectation specifies expectation struct of the ProcessedMessageRepository.MarkProcessedBatch
+type ProcessedMessageRepositoryMockMarkProcessedBatchExpectation struct {
+	mock               *ProcessedMessageRepositoryMock
+	params             *ProcessedMessageRepositoryMockMarkProcessedBatchParams
+	paramPtrs          *ProcessedMessageRepositoryMockMarkProcessedBatchParamPtrs
+	expectationOrigins ProcessedMessageRepositoryMockMarkProcessedBatchExpectationOrigins
+	results            *ProcessedMessageRepositoryMockMarkProcessedBatchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProcessedMessageRepositoryMockMarkProcessedBatchParams contains parameters of the ProcessedMessageRepository.MarkProcessedBatch
+type ProcessedMessageRepositoryMockMarkProcessedBatchParams struct {
+	ctx  context.Context
+	keys []string
+}
+
+// ProcessedMessageRepositoryMockMarkProcessedBatchParamPtrs contains pointers to parameters of the ProcessedMessageRepository.MarkProcessedBatch
+type ProcessedMessageRepositoryMockMarkProcessedBatchParamPtrs struct {
+	ctx  *context.Context
+	keys *[]string
+}
+
+// ProcessedMessageRepositoryMockMarkProcessedBatchResults contains results of the ProcessedMessageRepository.MarkProcessedBatch
+type ProcessedMessageRepositoryMockMarkProcessedBatchResults struct {
+	sa1 []string
+	err error
+}
+
+// ProcessedMessageRepositoryMockMarkProcessedBatchOrigins contains origins of expectations of the ProcessedMessageRepository.MarkProcessedBatch
+type ProcessedMessageRepositoryMockMarkProcessedBatchExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originKeys string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkProcessedBatch *mProcessedMessageRepositoryMockMarkProcessedBatch) Optional() *mProcessedMessageRepositoryMockMarkProcessedBatch {
+	mmMarkProcessedBatch.optional = true
+	return mmMarkProcessedBatch
+}
+
+// Expect sets up expected params for ProcessedMessageRepository.MarkProcessedBatch
+func (mmMarkProcessedBatch *mProcessedMessageRepositoryMockMarkProcessedBatch) Expect(ctx context.Context, keys []string) *mProcessedMessageRepositoryMockMarkProcessedBatch {
+	if mmMarkProcessedBatch.mock.funcMarkProcessedBatch != nil {
+		mmMarkProcessedBatch.mock.t.Fatalf("ProcessedMessageRepositoryMock.MarkProcessedBatch mock is already set by Set")
+	}
+
+	if mmMarkProcessedBatch.defaultExpectation == nil {
+		mmMarkProcessedBatch.defaultExpectation = &ProcessedMessageRepositoryMockMarkProcessedBatchExpectation{}
+	}
+
+	if mmMarkProcessedBatch.defaultExpectation.paramPtrs != nil {
+		mmMarkProcessedBatch.mock.t.Fatalf("ProcessedMessageRepositoryMock.MarkProcessedBatch mock is already set by ExpectParams functions")
+	}
+
+	mmMarkProcessedBatch.defaultExpectation.params = &ProcessedMessageRepositoryMockMarkProcessedBatchParams{ctx, keys}
+	mmMarkProcessedBatch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkProcessedBatch.expectations {
+		if minimock.Equal(e.params, mmMarkProcessedBatch.defaultExpectation.params) {
+			mmMarkProcessedBatch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkProcessedBatch.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkProcessedBatch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProcessedMessageRepository.MarkProcessedBatch
+func (mmMarkProcessedBatch *mProcessedMessageRepositoryMockMarkProcessedBatch) ExpectCtxParam1(ctx context.Context) *mProcessedMessageRepositoryMockMarkProcessedBatch {
+	if mmMarkProcessedBatch.mock.funcMarkProcessedBatch != nil {
+		mmMarkProcessedBatch.mock.t.Fatalf("ProcessedMessageRepositoryMock.MarkProcessedBatch mock is already set by Set")
+	}
+
+	if mmMarkProcessedBatch.defaultExpectation == nil {
+		mmMarkProcessedBatch.defaultExpectation = &ProcessedMessageRepositoryMockMarkProcessedBatchExpectation{}
+	}
+
+	if mmMarkProcessedBatch.defaultExpectation.params != nil {
+		mmMarkProcessedBatch.mock.t.Fatalf("ProcessedMessageRepositoryMock.MarkProcessedBatch mock is already set by Expect")
+	}
+
+	if mmMarkProcessedBatch.defaultExpectation.paramPtrs == nil {
+		mmMarkProcessedBatch.defaultExpectation.paramPtrs = &ProcessedMessageRepositoryMockMarkProcessedBatchParamPtrs{}
+	}
+	mmMarkProcessedBatch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkProcessedBatch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkProcessedBatch
+}
+
+// ExpectKeysParam2 sets up expected param keys for ProcessedMessageRepository.MarkProcessedBatch
+func (mmMarkProcessedBatch *mProcessedMessageRepositoryMockMarkProcessedBatch) ExpectKeysParam2(keys []string) *mProcessedMessageRepositoryMockMarkProcessedBatch {
+	if mmMarkProcessedBatch.mock.funcMarkProcessedBatch != nil {
+		mmMarkProcessedBatch.mock.t.Fatalf("ProcessedMessageRepositoryMock.MarkProcessedBatch mock is already set by Set")
+	}
+
+	if mmMarkProcessedBatch.defaultExpectation == nil {
+		mmMarkProcessedBatch.defaultExpectation = &ProcessedMessageRepositoryMockMarkProcessedBatchExpectation{}
+	}
+
+	if mmMarkProcessedBatch.defaultExpectation.params != nil {
+		mmMarkProcessedBatch.mock.t.Fatalf("ProcessedMessageRepositoryMock.MarkProcessedBatch mock is already set by Expect")
+	}
+
+	if mmMarkProcessedBatch.defaultExpectation.paramPtrs == nil {
+		mmMarkProcessedBatch.defaultExpectation.paramPtrs = &ProcessedMessageRepositoryMockMarkProcessedBatchParamPtrs{}
+	}
+	mmMarkProcessedBatch.defaultExpectation.paramPtrs.keys = &keys
+	mmMarkProcessedBatch.defaultExpectation.expectationOrigins.originKeys = minimock.CallerInfo(1)
+
+	return mmMarkProcessedBatch
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProcessedMessageRepository.MarkProcessedBatch
+func (mmMarkProcessedBatch *mProcessedMessageRepositoryMockMarkProcessedBatch) Inspect(f func(ctx context.Context, keys []string)) *mProcessedMessageRepositoryMockMarkProcessedBatch {
+	if mmMarkProcessedBatch.mock.inspectFuncMarkProcessedBatch != nil {
+		mmMarkProcessedBatch.mock.t.Fatalf("Inspect function is already set for ProcessedMessageRepositoryMock.MarkProcessedBatch")
+	}
+
+	mmMarkProcessedBatch.mock.inspectFuncMarkProcessedBatch = f
+
+	return mmMarkProcessedBatch
+}
+
+// Return sets up results that will be returned by ProcessedMessageRepository.MarkProcessedBatch
+func (mmMarkProcessedBatch *mProcessedMessageRepositoryMockMarkProcessedBatch) Return(sa1 []string, err error) *ProcessedMessageRepositoryMock {
+	if mmMarkProcessedBatch.mock.funcMarkProcessedBatch != nil {
+		mmMarkProcessedBatch.mock.t.Fatalf("ProcessedMessageRepositoryMock.MarkProcessedBatch mock is already set by Set")
+	}
+
+	if mmMarkProcessedBatch.defaultExpectation == nil {
+		mmMarkProcessedBatch.defaultExpectation = &ProcessedMessageRepositoryMockMarkProcessedBatchExpectation{mock: mmMarkProcessedBatch.mock}
+	}
+	mmMarkProcessedBatch.defaultExpectation.results = &ProcessedMessageRepositoryMockMarkProcessedBatchResults{sa1, err}
+	mmMarkProcessedBatch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkProcessedBatch.mock
+}
+
+// Set uses given function f to mock the ProcessedMessageRepository.MarkProcessedBatch method
+func (mmMarkProcessedBatch *mProcessedMessageRepositoryMockMarkProcessedBatch) Set(f func(ctx context.Context, keys []string) (sa1 []string, err error)) *ProcessedMessageRepositoryMock {
+	if mmMarkProcessedBatch.defaultExpectation != nil {
+		mmMarkProcessedBatch.mock.t.Fatalf("Default expectation is already set for the ProcessedMessageRepository.MarkProcessedBatch method")
+	}
+
+	if len(mmMarkProcessedBatch.expectations) > 0 {
+		mmMarkProcessedBatch.mock.t.Fatalf("Some expectations are already set for the ProcessedMessageRepository.MarkProcessedBatch method")
+	}
+
+	mmMarkProcessedBatch.mock.funcMarkProcessedBatch = f
+	mmMarkProcessedBatch.mock.funcMarkProcessedBatchOrigin = minimock.CallerInfo(1)
+	return mmMarkProcessedBatch.mock
+}
+
+// When sets expectation for the ProcessedMessageRepository.MarkProcessedBatch which will trigger the result defined by the following
+// Then helper
+func (mmMarkProcessedBatch *mProcessedMessageRepositoryMockMarkProcessedBatch) When(ctx context.Context, keys []string) *ProcessedMessageRepositoryMockMarkProcessedBatchExpectation {
+	if mmMarkProcessedBatch.mock.funcMarkProcessedBatch != nil {
+		mmMarkProcessedBatch.mock.t.Fatalf("ProcessedMessageRepositoryMock.MarkProcessedBatch mock is already set by Set")
+	}
+
+	expectation := &ProcessedMessageRepositoryMockMarkProcessedBatchExpectation{
+		mock:               mmMarkProcessedBatch.mock,
+		params:             &ProcessedMessageRepositoryMockMarkProcessedBatchParams{ctx, keys},
+		expectationOrigins: ProcessedMessageRepositoryMockMarkProcessedBatchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkProcessedBatch.expectations = append(mmMarkProcessedBatch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProcessedMessageRepository.MarkProcessedBatch return parameters for the expectation previously defined by the When method
+func (e *ProcessedMessageRepositoryMockMarkProcessedBatchExpectation) Then(sa1 []string, err error) *ProcessedMessageRepositoryMock {
+	e.results = &ProcessedMessageRepositoryMockMarkProcessedBatchResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times ProcessedMessageRepository.MarkProcessedBatch should be invoked
+func (mmMarkProcessedBatch *mProcessedMessageRepositoryMockMarkProcessedBatch) Times(n uint64) *mProcessedMessageRepositoryMockMarkProcessedBatch {
+	if n == 0 {
+		mmMarkProcessedBatch.mock.t.Fatalf("Times of ProcessedMessageRepositoryMock.MarkProcessedBatch mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkProcessedBatch.expectedInvocations, n)
+	mmMarkProcessedBatch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkProcessedBatch
+}
+
+func (mmMarkProcessedBatch *mProcessedMessageRepositoryMockMarkProcessedBatch) invocationsDone() bool {
+	if len(mmMarkProcessedBatch.expectations) == 0 && mmMarkProcessedBatch.defaultExpectation == nil && mmMarkProcessedBatch.mock.funcMarkProcessedBatch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkProcessedBatch.mock.afterMarkProcessedBatchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkProcessedBatch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkProcessedBatch implements mm_repository.ProcessedMessageRepository
+func (mmMarkProcessedBatch *ProcessedMessageRepositoryMock) MarkProcessedBatch(ctx context.Context, keys []string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmMarkProcessedBatch.beforeMarkProcessedBatchCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkProcessedBatch.afterMarkProcessedBatchCounter, 1)
+
+	mmMarkProcessedBatch.t.Helper()
+
+	if mmMarkProcessedBatch.inspectFuncMarkProcessedBatch != nil {
+		mmMarkProcessedBatch.inspectFuncMarkProcessedBatch(ctx, keys)
+	}
+
+	mm_params := ProcessedMessageRepositoryMockMarkProcessedBatchParams{ctx, keys}
+
+	// Record call args
+	mmMarkProcessedBatch.MarkProcessedBatchMock.mutex.Lock()
+	mmMarkProcessedBatch.MarkProcessedBatchMock.callArgs = append(mmMarkProcessedBatch.MarkProcessedBatchMock.callArgs, &mm_params)
+	mmMarkProcessedBatch.MarkProcessedBatchMock.mutex.Unlock()
+
+	for _, e := range mmMarkProcessedBatch.MarkProcessedBatchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmMarkProcessedBatch.MarkProcessedBatchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkProcessedBatch.MarkProcessedBatchMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkProcessedBatch.MarkProcessedBatchMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkProcessedBatch.MarkProcessedBatchMock.defaultExpectation.paramPtrs
+
+		mm_got := ProcessedMessageRepositoryMockMarkProcessedBatchParams{ctx, keys}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkProcessedBatch.t.Errorf("ProcessedMessageRepositoryMock.MarkProcessedBatch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkProcessedBatch.MarkProcessedBatchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.keys != nil && !minimock.Equal(*mm_want_ptrs.keys, mm_got.keys) {
+				mmMarkProcessedBatch.t.Errorf("ProcessedMessageRepositoryMock.MarkProcessedBatch got unexpected parameter keys, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkProcessedBatch.MarkProcessedBatchMock.defaultExpectation.expectationOrigins.originKeys, *mm_want_ptrs.keys, mm_got.keys, minimock.Diff(*mm_want_ptrs.keys, mm_got.keys))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkProcessedBatch.t.Errorf("ProcessedMessageRepositoryMock.MarkProcessedBatch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkProcessedBatch.MarkProcessedBatchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkProcessedBatch.MarkProcessedBatchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkProcessedBatch.t.Fatal("No results are set for the ProcessedMessageRepositoryMock.MarkProcessedBatch")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmMarkProcessedBatch.funcMarkProcessedBatch != nil {
+		return mmMarkProcessedBatch.funcMarkProcessedBatch(ctx, keys)
+	}
+	mmMarkProcessedBatch.t.Fatalf("Unexpected call to ProcessedMessageRepositoryMock.MarkProcessedBatch. %v %v", ctx, keys)
+	return
+}
+
+// MarkProcessedBatchAfterCounter returns a count of finished ProcessedMessageRepositoryMock.MarkProcessedBatch invocations
+func (mmMarkProcessedBatch *ProcessedMessageRepositoryMock) MarkProcessedBatchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkProcessedBatch.afterMarkProcessedBatchCounter)
+}
+
+// MarkProcessedBatchBeforeCounter returns a count of ProcessedMessageRepositoryMock.MarkProcessedBatch invocations
+func (mmMarkProcessedBatch *ProcessedMessageRepositoryMock) MarkProcessedBatchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkProcessedBatch.beforeMarkProcessedBatchCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProcessedMessageRepositoryMock.MarkProcessedBatch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkProcessedBatch *mProcessedMessageRepositoryMockMarkProcessedBatch) Calls() []*ProcessedMessageRepositoryMockMarkProcessedBatchParams {
+	mmMarkProcessedBatch.mutex.RLock()
+
+	argCopy := make([]*ProcessedMessageRepositoryMockMarkProcessedBatchParams, len(mmMarkProcessedBatch.callArgs))
+	copy(argCopy, mmMarkProcessedBatch.callArgs)
+
+	mmMarkProcessedBatch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkProcessedBatchDone returns true if the count of the MarkProcessedBatch invocations corresponds
+// the number of defined expectations
+func (m *ProcessedMessageRepositoryMock) MinimockMarkProcessedBatchDone() bool {
+	if m.MarkProcessedBatchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkProcessedBatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkProcessedBatchMock.invocationsDone()
+}
+
+// MinimockMarkProcessedBatchInspect logs each unmet expectation
+func (m *ProcessedMessageRepositoryMock) MinimockMarkProcessedBatchInspect() {
+	for _, e := range m.MarkProcessedBatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProcessedMessageRepositoryMock.MarkProcessedBatch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkProcessedBatchCounter := mm_atomic.LoadUint64(&m.afterMarkProcessedBatchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkProcessedBatchMock.defaultExpectation != nil && afterMarkProcessedBatchCounter < 1 {
+		if m.MarkProcessedBatchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProcessedMessageRepositoryMock.MarkProcessedBatch at\n%s", m.MarkProcessedBatchMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProcessedMessageRepositoryMock.MarkProcessedBatch at\n%s with params: %#v", m.MarkProcessedBatchMock.defaultExpectation.expectationOrigins.origin, *m.MarkProcessedBatchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkProcessedBatch != nil && afterMarkProcessedBatchCounter < 1 {
+		m.t.Errorf("Expected call to ProcessedMessageRepositoryMock.MarkProcessedBatch at\n%s", m.funcMarkProcessedBatchOrigin)
+	}
+
+	if !m.MarkProcessedBatchMock.invocationsDone() && afterMarkProcessedBatchCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProcessedMessageRepositoryMock.MarkProcessedBatch at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkProcessedBatchMock.expectedInvocations), m.MarkProcessedBatchMock.expectedInvocationsOrigin, afterMarkProcessedBatchCounter)
+	}
+}
+
+type mProcessedMessageRepositoryMockPurgeProcessedMessages struct {
+	optional           bool
+	mock               *ProcessedMessageRepositoryMock
+	defaultExpectation *ProcessedMessageRepositoryMockPurgeProcessedMessagesExpectation
+	expectations       []*ProcessedMessageRepositoryMockPurgeProcessedMessagesExpectation
+
+	callArgs []*ProcessedMessageRepositoryMockPurgeProcessedMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProcessedMessageRepositoryMockPurgeProcessedMessagesExpectation specifies expectation struct of the ProcessedMessageRepository.PurgeProcessedMessages
+type ProcessedMessageRepositoryMockPurgeProcessedMessagesExpectation struct {
+	mock               *ProcessedMessageRepositoryMock
+	params             *ProcessedMessageRepositoryMockPurgeProcessedMessagesParams
+	paramPtrs          *ProcessedMessageRepositoryMockPurgeProcessedMessagesParamPtrs
+	expectationOrigins ProcessedMessageRepositoryMockPurgeProcessedMessagesExpectationOrigins
+	results            *ProcessedMessageRepositoryMockPurgeProcessedMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProcessedMessageRepositoryMockPurgeProcessedMessagesParams contains parameters of the ProcessedMessageRepository.PurgeProcessedMessages
+type ProcessedMessageRepositoryMockPurgeProcessedMessagesParams struct {
+	ctx             context.Context
+	processedBefore time.Time
+	limit           uint64
+}
+
+// ProcessedMessageRepositoryMockPurgeProcessedMessagesParamPtrs contains pointers to parameters of the ProcessedMessageRepository.PurgeProcessedMessages
+type ProcessedMessageRepositoryMockPurgeProcessedMessagesParamPtrs struct {
+	ctx             *context.Context
+	processedBefore *time.Time
+	limit           *uint64
+}
+
+// ProcessedMessageRepositoryMockPurgeProcessedMessagesResults contains results of the ProcessedMessageRepository.PurgeProcessedMessages
+type ProcessedMessageRepositoryMockPurgeProcessedMessagesResults struct {
+	i1  int64
+	err error
+}
+
+// ProcessedMessageRepositoryMockPurgeProcessedMessagesOrigins contains origins of expectations of the ProcessedMessageRepository.PurgeProcessedMessages
+type ProcessedMessageRepositoryMockPurgeProcessedMessagesExpectationOrigins struct {
+	origin                string
+	originCtx             string
+	originProcessedBefore string
+	originLimit           string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeProcessedMessages *mProcessedMessageRepositoryMockPurgeProcessedMessages) Optional() *mProcessedMessageRepositoryMockPurgeProcessedMessages {
+	mmPurgeProcessedMessages.optional = true
+	return mmPurgeProcessedMessages
+}
+
+// Expect sets up expected params for ProcessedMessageRepository.PurgeProcessedMessages
+func (mmPurgeProcessedMessages *mProcessedMessageRepositoryMockPurgeProcessedMessages) Expect(ctx context.Context, processedBefore time.Time, limit uint64) *mProcessedMessageRepositoryMockPurgeProcessedMessages {
+	if mmPurgeProcessedMessages.mock.funcPurgeProcessedMessages != nil {
+		mmPurgeProcessedMessages.mock.t.Fatalf("ProcessedMessageRepositoryMock.PurgeProcessedMessages mock is already set by Set")
+	}
+
+	if mmPurgeProcessedMessages.defaultExpectation == nil {
+		mmPurgeProcessedMessages.defaultExpectation = &ProcessedMessageRepositoryMockPurgeProcessedMessagesExpectation{}
+	}
+
+	if mmPurgeProcessedMessages.defaultExpectation.paramPtrs != nil {
+		mmPurgeProcessedMessages.mock.t.Fatalf("ProcessedMessageRepositoryMock.PurgeProcessedMessages mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeProcessedMessages.defaultExpectation.params = &ProcessedMessageRepositoryMockPurgeProcessedMessagesParams{ctx, processedBefore, limit}
+	mmPurgeProcessedMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeProcessedMessages.expectations {
+		if minimock.Equal(e.params, mmPurgeProcessedMessages.defaultExpectation.params) {
+			mmPurgeProcessedMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeProcessedMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeProcessedMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProcessedMessageRepository.PurgeProcessedMessages
+func (mmPurgeProcessedMessages *mProcessedMessageRepositoryMockPurgeProcessedMessages) ExpectCtxParam1(ctx context.Context) *mProcessedMessageRepositoryMockPurgeProcessedMessages {
+	if mmPurgeProcessedMessages.mock.funcPurgeProcessedMessages != nil {
+		mmPurgeProcessedMessages.mock.t.Fatalf("ProcessedMessageRepositoryMock.PurgeProcessedMessages mock is already set by Set")
+	}
+
+	if mmPurgeProcessedMessages.defaultExpectation == nil {
+		mmPurgeProcessedMessages.defaultExpectation = &ProcessedMessageRepositoryMockPurgeProcessedMessagesExpectation{}
+	}
+
+	if mmPurgeProcessedMessages.defaultExpectation.params != nil {
+		mmPurgeProcessedMessages.mock.t.Fatalf("ProcessedMessageRepositoryMock.PurgeProcessedMessages mock is already set by Expect")
+	}
+
+	if mmPurgeProcessedMessages.defaultExpectation.paramPtrs == nil {
+		mmPurgeProcessedMessages.defaultExpectation.paramPtrs = &ProcessedMessageRepositoryMockPurgeProcessedMessagesParamPtrs{}
+	}
+	mmPurgeProcessedMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeProcessedMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeProcessedMessages
+}
+
+// ExpectProcessedBeforeParam2 sets up expected param processedBefore for ProcessedMessageRepository.PurgeProcessedMessages
+func (mmPurgeProcessedMessages *mProcessedMessageRepositoryMockPurgeProcessedMessages) ExpectProcessedBeforeParam2(processedBefore time.Time) *mProcessedMessageRepositoryMockPurgeProcessedMessages {
+	if mmPurgeProcessedMessages.mock.funcPurgeProcessedMessages != nil {
+		mmPurgeProcessedMessages.mock.t.Fatalf("ProcessedMessageRepositoryMock.PurgeProcessedMessages mock is already set by Set")
+	}
+
+	if mmPurgeProcessedMessages.defaultExpectation == nil {
+		mmPurgeProcessedMessages.defaultExpectation = &ProcessedMessageRepositoryMockPurgeProcessedMessagesExpectation{}
+	}
+
+	if mmPurgeProcessedMessages.defaultExpectation.params != nil {
+		mmPurgeProcessedMessages.mock.t.Fatalf("ProcessedMessageRepositoryMock.PurgeProcessedMessages mock is already set by Expect")
+	}
+
+	if mmPurgeProcessedMessages.defaultExpectation.paramPtrs == nil {
+		mmPurgeProcessedMessages.defaultExpectation.paramPtrs = &ProcessedMessageRepositoryMockPurgeProcessedMessagesParamPtrs{}
+	}
+	mmPurgeProcessedMessages.defaultExpectation.paramPtrs.processedBefore = &processedBefore
+	mmPurgeProcessedMessages.defaultExpectation.expectationOrigins.originProcessedBefore = minimock.CallerInfo(1)
+
+	return mmPurgeProcessedMessages
+}
+
+// ExpectLimitParam3 sets up expected param limit for ProcessedMessageRepository.PurgeProcessedMessages
+func (mmPurgeProcessedMessages *mProcessedMessageRepositoryMockPurgeProcessedMessages) ExpectLimitParam3(limit uint64) *mProcessedMessageRepositoryMockPurgeProcessedMessages {
+	if mmPurgeProcessedMessages.mock.funcPurgeProcessedMessages != nil {
+		mmPurgeProcessedMessages.mock.t.Fatalf("ProcessedMessageRepositoryMock.PurgeProcessedMessages mock is already set by Set")
+	}
+
+	if mmPurgeProcessedMessages.defaultExpectation == nil {
+		mmPurgeProcessedMessages.defaultExpectation = &ProcessedMessageRepositoryMockPurgeProcessedMessagesExpectation{}
+	}
+
+	if mmPurgeProcessedMessages.defaultExpectation.params != nil {
+		mmPurgeProcessedMessages.mock.t.Fatalf("ProcessedMessageRepositoryMock.PurgeProcessedMessages mock is already set by Expect")
+	}
+
+	if mmPurgeProcessedMessages.defaultExpectation.paramPtrs == nil {
+		mmPurgeProcessedMessages.defaultExpectation.paramPtrs = &ProcessedMessageRepositoryMockPurgeProcessedMessagesParamPtrs{}
+	}
+	mmPurgeProcessedMessages.defaultExpectation.paramPtrs.limit = &limit
+	mmPurgeProcessedMessages.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmPurgeProcessedMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProcessedMessageRepository.PurgeProcessedMessages
+func (mmPurgeProcessedMessages *mProcessedMessageRepositoryMockPurgeProcessedMessages) Inspect(f func(ctx context.Context, processedBefore time.Time, limit uint64)) *mProcessedMessageRepositoryMockPurgeProcessedMessages {
+	if mmPurgeProcessedMessages.mock.inspectFuncPurgeProcessedMessages != nil {
+		mmPurgeProcessedMessages.mock.t.Fatalf("Inspect function is already set for ProcessedMessageRepositoryMock.PurgeProcessedMessages")
+	}
+
+	mmPurgeProcessedMessages.mock.inspectFuncPurgeProcessedMessages = f
+
+	return mmPurgeProcessedMessages
+}
+
+// Return sets up results that will be returned by ProcessedMessageRepository.PurgeProcessedMessages
+func (mmPurgeProcessedMessages *mProcessedMessageRepositoryMockPurgeProcessedMessages) Return(i1 int64, err error) *ProcessedMessageRepositoryMock {
+	if mmPurgeProcessedMessages.mock.funcPurgeProcessedMessages != nil {
+		mmPurgeProcessedMessages.mock.t.Fatalf("ProcessedMessageRepositoryMock.PurgeProcessedMessages mock is already set by Set")
+	}
+
+	if mmPurgeProcessedMessages.defaultExpectation == nil {
+		mmPurgeProcessedMessages.defaultExpectation = &ProcessedMessageRepositoryMockPurgeProcessedMessagesExpectation{mock: mmPurgeProcessedMessages.mock}
+	}
+	mmPurgeProcessedMessages.defaultExpectation.results = &ProcessedMessageRepositoryMockPurgeProcessedMessagesResults{i1, err}
+	mmPurgeProcessedMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeProcessedMessages.mock
+}
+
+// Set uses given function f to mock the ProcessedMessageRepository.PurgeProcessedMessages method
+func (mmPurgeProcessedMessages *mProcessedMessageRepositoryMockPurgeProcessedMessages) Set(f func(ctx context.Context, processedBefore time.Time, limit uint64) (i1 int64, err error)) *ProcessedMessageRepositoryMock {
+	if mmPurgeProcessedMessages.defaultExpectation != nil {
+		mmPurgeProcessedMessages.mock.t.Fatalf("Default expectation is already set for the ProcessedMessageRepository.PurgeProcessedMessages method")
+	}
+
+	if len(mmPurgeProcessedMessages.expectations) > 0 {
+		mmPurgeProcessedMessages.mock.t.Fatalf("Some expectations are already set for the ProcessedMessageRepository.PurgeProcessedMessages method")
+	}
+
+	mmPurgeProcessedMessages.mock.funcPurgeProcessedMessages = f
+	mmPurgeProcessedMessages.mock.funcPurgeProcessedMessagesOrigin = minimock.CallerInfo(1)
+	return mmPurgeProcessedMessages.mock
+}
+
+// When sets expectation for the ProcessedMessageRepository.PurgeProcessedMessages which will trigger the result defined by the following
+// Then helper
+func (mmPurgeProcessedMessages *mProcessedMessageRepositoryMockPurgeProcessedMessages) When(ctx context.Context, processedBefore time.Time, limit uint64) *ProcessedMessageRepositoryMockPurgeProcessedMessagesExpectation {
+	if mmPurgeProcessedMessages.mock.funcPurgeProcessedMessages != nil {
+		mmPurgeProcessedMessages.mock.t.Fatalf("ProcessedMessageRepositoryMock.PurgeProcessedMessages mock is already set by Set")
+	}
+
+	expectation := &ProcessedMessageRepositoryMockPurgeProcessedMessagesExpectation{
+		mock:               mmPurgeProcessedMessages.mock,
+		params:             &ProcessedMessageRepositoryMockPurgeProcessedMessagesParams{ctx, processedBefore, limit},
+		expectationOrigins: ProcessedMessageRepositoryMockPurgeProcessedMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeProcessedMessages.expectations = append(mmPurgeProcessedMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProcessedMessageRepository.PurgeProcessedMessages return parameters for the expectation previously defined by the When method
+func (e *ProcessedMessageRepositoryMockPurgeProcessedMessagesExpectation) Then(i1 int64, err error) *ProcessedMessageRepositoryMock {
+	e.results = &ProcessedMessageRepositoryMockPurgeProcessedMessagesResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ProcessedMessageRepository.PurgeProcessedMessages should be invoked
+func (mmPurgeProcessedMessages *mProcessedMessageRepositoryMockPurgeProcessedMessages) Times(n uint64) *mProcessedMessageRepositoryMockPurgeProcessedMessages {
+	if n == 0 {
+		mmPurgeProcessedMessages.mock.t.Fatalf("Times of ProcessedMessageRepositoryMock.PurgeProcessedMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeProcessedMessages.expectedInvocations, n)
+	mmPurgeProcessedMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeProcessedMessages
+}
+
+func (mmPurgeProcessedMessages *mProcessedMessageRepositoryMockPurgeProcessedMessages) invocationsDone() bool {
+	if len(mmPurgeProcessedMessages.expectations) == 0 && mmPurgeProcessedMessages.defaultExpectation == nil && mmPurgeProcessedMessages.mock.funcPurgeProcessedMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeProcessedMessages.mock.afterPurgeProcessedMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeProcessedMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeProcessedMessages implements mm_repository.ProcessedMessageRepository
+func (mmPurgeProcessedMessages *ProcessedMessageRepositoryMock) PurgeProcessedMessages(ctx context.Context, processedBefore time.Time, limit uint64) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurgeProcessedMessages.beforePurgeProcessedMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeProcessedMessages.afterPurgeProcessedMessagesCounter, 1)
+
+	mmPurgeProcessedMessages.t.Helper()
+
+	if mmPurgeProcessedMessages.inspectFuncPurgeProcessedMessages != nil {
+		mmPurgeProcessedMessages.inspectFuncPurgeProcessedMessages(ctx, processedBefore, limit)
+	}
+
+	mm_params := ProcessedMessageRepositoryMockPurgeProcessedMessagesParams{ctx, processedBefore, limit}
+
+	// Record call args
+	mmPurgeProcessedMessages.PurgeProcessedMessagesMock.mutex.Lock()
+	mmPurgeProcessedMessages.PurgeProcessedMessagesMock.callArgs = append(mmPurgeProcessedMessages.PurgeProcessedMessagesMock.callArgs, &mm_params)
+	mmPurgeProcessedMessages.PurgeProcessedMessagesMock.mutex.Unlock()
+
+	for _, e := range mmPurgeProcessedMessages.PurgeProcessedMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeProcessedMessages.PurgeProcessedMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeProcessedMessages.PurgeProcessedMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeProcessedMessages.PurgeProcessedMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeProcessedMessages.PurgeProcessedMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ProcessedMessageRepositoryMockPurgeProcessedMessagesParams{ctx, processedBefore, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeProcessedMessages.t.Errorf("ProcessedMessageRepositoryMock.PurgeProcessedMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeProcessedMessages.PurgeProcessedMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.processedBefore != nil && !minimock.Equal(*mm_want_ptrs.processedBefore, mm_got.processedBefore) {
+				mmPurgeProcessedMessages.t.Errorf("ProcessedMessageRepositoryMock.PurgeProcessedMessages got unexpected parameter processedBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeProcessedMessages.PurgeProcessedMessagesMock.defaultExpectation.expectationOrigins.originProcessedBefore, *mm_want_ptrs.processedBefore, mm_got.processedBefore, minimock.Diff(*mm_want_ptrs.processedBefore, mm_got.processedBefore))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmPurgeProcessedMessages.t.Errorf("ProcessedMessageRepositoryMock.PurgeProcessedMessages got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeProcessedMessages.PurgeProcessedMessagesMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeProcessedMessages.t.Errorf("ProcessedMessageRepositoryMock.PurgeProcessedMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeProcessedMessages.PurgeProcessedMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeProcessedMessages.PurgeProcessedMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeProcessedMessages.t.Fatal("No results are set for the ProcessedMessageRepositoryMock.PurgeProcessedMessages")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeProcessedMessages.funcPurgeProcessedMessages != nil {
+		return mmPurgeProcessedMessages.funcPurgeProcessedMessages(ctx, processedBefore, limit)
+	}
+	mmPurgeProcessedMessages.t.Fatalf("Unexpected call to ProcessedMessageRepositoryMock.PurgeProcessedMessages. %v %v %v", ctx, processedBefore, limit)
+	return
+}
+
+// PurgeProcessedMessagesAfterCounter returns a count of finished ProcessedMessageRepositoryMock.PurgeProcessedMessages invocations
+func (mmPurgeProcessedMessages *ProcessedMessageRepositoryMock) PurgeProcessedMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeProcessedMessages.afterPurgeProcessedMessagesCounter)
+}
+
+// PurgeProcessedMessagesBeforeCounter returns a count of ProcessedMessageRepositoryMock.PurgeProcessedMessages invocations
+func (mmPurgeProcessedMessages *ProcessedMessageRepositoryMock) PurgeProcessedMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeProcessedMessages.beforePurgeProcessedMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProcessedMessageRepositoryMock.PurgeProcessedMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeProcessedMessages *mProcessedMessageRepositoryMockPurgeProcessedMessages) Calls() []*ProcessedMessageRepositoryMockPurgeProcessedMessagesParams {
+	mmPurgeProcessedMessages.mutex.RLock()
+
+	argCopy := make([]*ProcessedMessageRepositoryMockPurgeProcessedMessagesParams, len(mmPurgeProcessedMessages.callArgs))
+	copy(argCopy, mmPurgeProcessedMessages.callArgs)
+
+	mmPurgeProcessedMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeProcessedMessagesDone returns true if the count of the PurgeProcessedMessages invocations corresponds
+// the number of defined expectations
+func (m *ProcessedMessageRepositoryMock) MinimockPurgeProcessedMessagesDone() bool {
+	if m.PurgeProcessedMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeProcessedMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeProcessedMessagesMock.invocationsDone()
+}
+
+// MinimockPurgeProcessedMessagesInspect logs each unmet expectation
+func (m *ProcessedMessageRepositoryMock) MinimockPurgeProcessedMessagesInspect() {
+	for _, e := range m.PurgeProcessedMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProcessedMessageRepositoryMock.PurgeProcessedMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeProcessedMessagesCounter := mm_atomic.LoadUint64(&m.afterPurgeProcessedMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeProcessedMessagesMock.defaultExpectation != nil && afterPurgeProcessedMessagesCounter < 1 {
+		if m.PurgeProcessedMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProcessedMessageRepositoryMock.PurgeProcessedMessages at\n%s", m.PurgeProcessedMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProcessedMessageRepositoryMock.PurgeProcessedMessages at\n%s with params: %#v", m.PurgeProcessedMessagesMock.defaultExpectation.expectationOrigins.origin, *m.PurgeProcessedMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeProcessedMessages != nil && afterPurgeProcessedMessagesCounter < 1 {
+		m.t.Errorf("Expected call to ProcessedMessageRepositoryMock.PurgeProcessedMessages at\n%s", m.funcPurgeProcessedMessagesOrigin)
+	}
+
+	if !m.PurgeProcessedMessagesMock.invocationsDone() && afterPurgeProcessedMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProcessedMessageRepositoryMock.PurgeProcessedMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeProcessedMessagesMock.expectedInvocations), m.PurgeProcessedMessagesMock.expectedInvocationsOrigin, afterPurgeProcessedMessagesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ProcessedMessageRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockMarkProcessedInspect()
+
+			m.MinimockMarkProcessedBatchInspect()
+
+			m.MinimockPurgeProcessedMessagesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ProcessedMessageRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ProcessedMessageRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockMarkProcessedDone() &&
+		m.MinimockMarkProcessedBatchDone() &&
+		m.MinimockPurgeProcessedMessagesDone()
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -26,6 +27,13 @@ type UserRepositoryMock struct {
 	beforeCreateUserCounter uint64
 	CreateUserMock          mUserRepositoryMockCreateUser
 
+	funcCreateUsers          func(ctx context.Context, users []*model.UserCreate) (ia1 []int64, err error)
+	funcCreateUsersOrigin    string
+	inspectFuncCreateUsers   func(ctx context.Context, users []*model.UserCreate)
+	afterCreateUsersCounter  uint64
+	beforeCreateUsersCounter uint64
+	CreateUsersMock          mUserRepositoryMockCreateUsers
+
 	funcDeleteUser          func(ctx context.Context, id int64) (err error)
 	funcDeleteUserOrigin    string
 	inspectFuncDeleteUser   func(ctx context.Context, id int64)
@@ -40,12 +48,47 @@ type UserRepositoryMock struct {
 	beforeGetUserCounter uint64
 	GetUserMock          mUserRepositoryMockGetUser
 
+	funcGetUserAuthByEmail          func(ctx context.Context, email string) (up1 *model.UserAuth, err error)
+	funcGetUserAuthByEmailOrigin    string
+	inspectFuncGetUserAuthByEmail   func(ctx context.Context, email string)
+	afterGetUserAuthByEmailCounter  uint64
+	beforeGetUserAuthByEmailCounter uint64
+	GetUserAuthByEmailMock          mUserRepositoryMockGetUserAuthByEmail
+
+	funcListUsers          func(ctx context.Context, query *model.UserListQuery) (upa1 []*model.UserGet, err error)
+	funcListUsersOrigin    string
+	inspectFuncListUsers   func(ctx context.Context, query *model.UserListQuery)
+	afterListUsersCounter  uint64
+	beforeListUsersCounter uint64
+	ListUsersMock          mUserRepositoryMockListUsers
+
+	funcPurgeDeletedUsers          func(ctx context.Context, deletedBefore time.Time, limit uint64) (i1 int64, err error)
+	funcPurgeDeletedUsersOrigin    string
+	inspectFuncPurgeDeletedUsers   func(ctx context.Context, deletedBefore time.Time, limit uint64)
+	afterPurgeDeletedUsersCounter  uint64
+	beforePurgeDeletedUsersCounter uint64
+	PurgeDeletedUsersMock          mUserRepositoryMockPurgeDeletedUsers
+
+	funcRestoreUser          func(ctx context.Context, id int64) (err error)
+	funcRestoreUserOrigin    string
+	inspectFuncRestoreUser   func(ctx context.Context, id int64)
+	afterRestoreUserCounter  uint64
+	beforeRestoreUserCounter uint64
+	RestoreUserMock          mUserRepositoryMockRestoreUser
+
 	funcUpdateUser          func(ctx context.Context, user *model.UserUpdate) (err error)
 	funcUpdateUserOrigin    string
 	inspectFuncUpdateUser   func(ctx context.Context, user *model.UserUpdate)
 	afterUpdateUserCounter  uint64
 	beforeUpdateUserCounter uint64
 	UpdateUserMock          mUserRepositoryMockUpdateUser
+
+	funcUpdateUserPassword          func(ctx context.Context, id int64, passwordHash string) (err error)
+	funcUpdateUserPasswordOrigin    string
+	inspectFuncUpdateUserPassword   func(ctx context.Context, id int64, passwordHash string)
+	afterUpdateUserPasswordCounter  uint64
+	beforeUpdateUserPasswordCounter uint64
+	UpdateUserPasswordMock          mUserRepositoryMockUpdateUserPassword
 }
 
 // NewUserRepositoryMock returns a mock for mm_repository.UserRepository
@@ -59,15 +102,33 @@ func NewUserRepositoryMock(t minimock.Tester) *UserRepositoryMock {
 	m.CreateUserMock = mUserRepositoryMockCreateUser{mock: m}
 	m.CreateUserMock.callArgs = []*UserRepositoryMockCreateUserParams{}
 
+	m.CreateUsersMock = mUserRepositoryMockCreateUsers{mock: m}
+	m.CreateUsersMock.callArgs = []*UserRepositoryMockCreateUsersParams{}
+
 	m.DeleteUserMock = mUserRepositoryMockDeleteUser{mock: m}
 	m.DeleteUserMock.callArgs = []*UserRepositoryMockDeleteUserParams{}
 
 	m.GetUserMock = mUserRepositoryMockGetUser{mock: m}
 	m.GetUserMock.callArgs = []*UserRepositoryMockGetUserParams{}
 
+	m.GetUserAuthByEmailMock = mUserRepositoryMockGetUserAuthByEmail{mock: m}
+	m.GetUserAuthByEmailMock.callArgs = []*UserRepositoryMockGetUserAuthByEmailParams{}
+
+	m.ListUsersMock = mUserRepositoryMockListUsers{mock: m}
+	m.ListUsersMock.callArgs = []*UserRepositoryMockListUsersParams{}
+
+	m.PurgeDeletedUsersMock = mUserRepositoryMockPurgeDeletedUsers{mock: m}
+	m.PurgeDeletedUsersMock.callArgs = []*UserRepositoryMockPurgeDeletedUsersParams{}
+
+	m.RestoreUserMock = mUserRepositoryMockRestoreUser{mock: m}
+	m.RestoreUserMock.callArgs = []*UserRepositoryMockRestoreUserParams{}
+
 	m.UpdateUserMock = mUserRepositoryMockUpdateUser{mock: m}
 	m.UpdateUserMock.callArgs = []*UserRepositoryMockUpdateUserParams{}
 
+	m.UpdateUserPasswordMock = mUserRepositoryMockUpdateUserPassword{mock: m}
+	m.UpdateUserPasswordMock.callArgs = []*UserRepositoryMockUpdateUserPasswordParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -416,6 +477,349 @@ func (m *UserRepositoryMock) MinimockCreateUserInspect() {
 	}
 }
 
+type mUserRepositoryMockCreateUsers struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockCreateUsersExpectation
+	expectations       []*UserRepositoryMockCreateUsersExpectation
+
+	callArgs []*UserRepositoryMockCreateUsersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockCreateUsersExpectation specifies expectation struct of the UserRepository.CreateUsers
+type UserRepositoryMockCreateUsersExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockCreateUsersParams
+	paramPtrs          *UserRepositoryMockCreateUsersParamPtrs
+	expectationOrigins UserRepositoryMockCreateUsersExpectationOrigins
+	results            *UserRepositoryMockCreateUsersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockCreateUsersParams contains parameters of the UserRepository.CreateUsers
+type UserRepositoryMockCreateUsersParams struct {
+	ctx   context.Context
+	users []*model.UserCreate
+}
+
+// UserRepositoryMockCreateUsersParamPtrs contains pointers to parameters of the UserRepository.CreateUsers
+type UserRepositoryMockCreateUsersParamPtrs struct {
+	ctx   *context.Context
+	users *[]*model.UserCreate
+}
+
+// UserRepositoryMockCreateUsersResults contains results of the UserRepository.CreateUsers
+type UserRepositoryMockCreateUsersResults struct {
+	ia1 []int64
+	err error
+}
+
+// UserRepositoryMockCreateUsersOrigins contains origins of expectations of the UserRepository.CreateUsers
+type UserRepositoryMockCreateUsersExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originUsers string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateUsers *mUserRepositoryMockCreateUsers) Optional() *mUserRepositoryMockCreateUsers {
+	mmCreateUsers.optional = true
+	return mmCreateUsers
+}
+
+// Expect sets up expected params for UserRepository.CreateUsers
+func (mmCreateUsers *mUserRepositoryMockCreateUsers) Expect(ctx context.Context, users []*model.UserCreate) *mUserRepositoryMockCreateUsers {
+	if mmCreateUsers.mock.funcCreateUsers != nil {
+		mmCreateUsers.mock.t.Fatalf("UserRepositoryMock.CreateUsers mock is already set by Set")
+	}
+
+	if mmCreateUsers.defaultExpectation == nil {
+		mmCreateUsers.defaultExpectation = &UserRepositoryMockCreateUsersExpectation{}
+	}
+
+	if mmCreateUsers.defaultExpectation.paramPtrs != nil {
+		mmCreateUsers.mock.t.Fatalf("UserRepositoryMock.CreateUsers mock is already set by ExpectParams functions")
+	}
+
+	mmCreateUsers.defaultExpectation.params = &UserRepositoryMockCreateUsersParams{ctx, users}
+	mmCreateUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateUsers.expectations {
+		if minimock.Equal(e.params, mmCreateUsers.defaultExpectation.params) {
+			mmCreateUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateUsers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.CreateUsers
+func (mmCreateUsers *mUserRepositoryMockCreateUsers) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockCreateUsers {
+	if mmCreateUsers.mock.funcCreateUsers != nil {
+		mmCreateUsers.mock.t.Fatalf("UserRepositoryMock.CreateUsers mock is already set by Set")
+	}
+
+	if mmCreateUsers.defaultExpectation == nil {
+		mmCreateUsers.defaultExpectation = &UserRepositoryMockCreateUsersExpectation{}
+	}
+
+	if mmCreateUsers.defaultExpectation.params != nil {
+		mmCreateUsers.mock.t.Fatalf("UserRepositoryMock.CreateUsers mock is already set by Expect")
+	}
+
+	if mmCreateUsers.defaultExpectation.paramPtrs == nil {
+		mmCreateUsers.defaultExpectation.paramPtrs = &UserRepositoryMockCreateUsersParamPtrs{}
+	}
+	mmCreateUsers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateUsers
+}
+
+// ExpectUsersParam2 sets up expected param users for UserRepository.CreateUsers
+func (mmCreateUsers *mUserRepositoryMockCreateUsers) ExpectUsersParam2(users []*model.UserCreate) *mUserRepositoryMockCreateUsers {
+	if mmCreateUsers.mock.funcCreateUsers != nil {
+		mmCreateUsers.mock.t.Fatalf("UserRepositoryMock.CreateUsers mock is already set by Set")
+	}
+
+	if mmCreateUsers.defaultExpectation == nil {
+		mmCreateUsers.defaultExpectation = &UserRepositoryMockCreateUsersExpectation{}
+	}
+
+	if mmCreateUsers.defaultExpectation.params != nil {
+		mmCreateUsers.mock.t.Fatalf("UserRepositoryMock.CreateUsers mock is already set by Expect")
+	}
+
+	if mmCreateUsers.defaultExpectation.paramPtrs == nil {
+		mmCreateUsers.defaultExpectation.paramPtrs = &UserRepositoryMockCreateUsersParamPtrs{}
+	}
+	mmCreateUsers.defaultExpectation.paramPtrs.users = &users
+	mmCreateUsers.defaultExpectation.expectationOrigins.originUsers = minimock.CallerInfo(1)
+
+	return mmCreateUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.CreateUsers
+func (mmCreateUsers *mUserRepositoryMockCreateUsers) Inspect(f func(ctx context.Context, users []*model.UserCreate)) *mUserRepositoryMockCreateUsers {
+	if mmCreateUsers.mock.inspectFuncCreateUsers != nil {
+		mmCreateUsers.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.CreateUsers")
+	}
+
+	mmCreateUsers.mock.inspectFuncCreateUsers = f
+
+	return mmCreateUsers
+}
+
+// Return sets up results that will be returned by UserRepository.CreateUsers
+func (mmCreateUsers *mUserRepositoryMockCreateUsers) Return(ia1 []int64, err error) *UserRepositoryMock {
+	if mmCreateUsers.mock.funcCreateUsers != nil {
+		mmCreateUsers.mock.t.Fatalf("UserRepositoryMock.CreateUsers mock is already set by Set")
+	}
+
+	if mmCreateUsers.defaultExpectation == nil {
+		mmCreateUsers.defaultExpectation = &UserRepositoryMockCreateUsersExpectation{mock: mmCreateUsers.mock}
+	}
+	mmCreateUsers.defaultExpectation.results = &UserRepositoryMockCreateUsersResults{ia1, err}
+	mmCreateUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateUsers.mock
+}
+
+// Set uses given function f to mock the UserRepository.CreateUsers method
+func (mmCreateUsers *mUserRepositoryMockCreateUsers) Set(f func(ctx context.Context, users []*model.UserCreate) (ia1 []int64, err error)) *UserRepositoryMock {
+	if mmCreateUsers.defaultExpectation != nil {
+		mmCreateUsers.mock.t.Fatalf("Default expectation is already set for the UserRepository.CreateUsers method")
+	}
+
+	if len(mmCreateUsers.expectations) > 0 {
+		mmCreateUsers.mock.t.Fatalf("Some expectations are already set for the UserRepository.CreateUsers method")
+	}
+
+	mmCreateUsers.mock.funcCreateUsers = f
+	mmCreateUsers.mock.funcCreateUsersOrigin = minimock.CallerInfo(1)
+	return mmCreateUsers.mock
+}
+
+// When sets expectation for the UserRepository.CreateUsers which will trigger the result defined by the following
+// Then helper
+func (mmCreateUsers *mUserRepositoryMockCreateUsers) When(ctx context.Context, users []*model.UserCreate) *UserRepositoryMockCreateUsersExpectation {
+	if mmCreateUsers.mock.funcCreateUsers != nil {
+		mmCreateUsers.mock.t.Fatalf("UserRepositoryMock.CreateUsers mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockCreateUsersExpectation{
+		mock:               mmCreateUsers.mock,
+		params:             &UserRepositoryMockCreateUsersParams{ctx, users},
+		expectationOrigins: UserRepositoryMockCreateUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateUsers.expectations = append(mmCreateUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.CreateUsers return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockCreateUsersExpectation) Then(ia1 []int64, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockCreateUsersResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.CreateUsers should be invoked
+func (mmCreateUsers *mUserRepositoryMockCreateUsers) Times(n uint64) *mUserRepositoryMockCreateUsers {
+	if n == 0 {
+		mmCreateUsers.mock.t.Fatalf("Times of UserRepositoryMock.CreateUsers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateUsers.expectedInvocations, n)
+	mmCreateUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateUsers
+}
+
+func (mmCreateUsers *mUserRepositoryMockCreateUsers) invocationsDone() bool {
+	if len(mmCreateUsers.expectations) == 0 && mmCreateUsers.defaultExpectation == nil && mmCreateUsers.mock.funcCreateUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateUsers.mock.afterCreateUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateUsers implements mm_repository.UserRepository
+func (mmCreateUsers *UserRepositoryMock) CreateUsers(ctx context.Context, users []*model.UserCreate) (ia1 []int64, err error) {
+	mm_atomic.AddUint64(&mmCreateUsers.beforeCreateUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateUsers.afterCreateUsersCounter, 1)
+
+	mmCreateUsers.t.Helper()
+
+	if mmCreateUsers.inspectFuncCreateUsers != nil {
+		mmCreateUsers.inspectFuncCreateUsers(ctx, users)
+	}
+
+	mm_params := UserRepositoryMockCreateUsersParams{ctx, users}
+
+	// Record call args
+	mmCreateUsers.CreateUsersMock.mutex.Lock()
+	mmCreateUsers.CreateUsersMock.callArgs = append(mmCreateUsers.CreateUsersMock.callArgs, &mm_params)
+	mmCreateUsers.CreateUsersMock.mutex.Unlock()
+
+	for _, e := range mmCreateUsers.CreateUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmCreateUsers.CreateUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateUsers.CreateUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateUsers.CreateUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateUsers.CreateUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockCreateUsersParams{ctx, users}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateUsers.t.Errorf("UserRepositoryMock.CreateUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUsers.CreateUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.users != nil && !minimock.Equal(*mm_want_ptrs.users, mm_got.users) {
+				mmCreateUsers.t.Errorf("UserRepositoryMock.CreateUsers got unexpected parameter users, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUsers.CreateUsersMock.defaultExpectation.expectationOrigins.originUsers, *mm_want_ptrs.users, mm_got.users, minimock.Diff(*mm_want_ptrs.users, mm_got.users))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateUsers.t.Errorf("UserRepositoryMock.CreateUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateUsers.CreateUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateUsers.CreateUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateUsers.t.Fatal("No results are set for the UserRepositoryMock.CreateUsers")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmCreateUsers.funcCreateUsers != nil {
+		return mmCreateUsers.funcCreateUsers(ctx, users)
+	}
+	mmCreateUsers.t.Fatalf("Unexpected call to UserRepositoryMock.CreateUsers. %v %v", ctx, users)
+	return
+}
+
+// CreateUsersAfterCounter returns a count of finished UserRepositoryMock.CreateUsers invocations
+func (mmCreateUsers *UserRepositoryMock) CreateUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUsers.afterCreateUsersCounter)
+}
+
+// CreateUsersBeforeCounter returns a count of UserRepositoryMock.CreateUsers invocations
+func (mmCreateUsers *UserRepositoryMock) CreateUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUsers.beforeCreateUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.CreateUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateUsers *mUserRepositoryMockCreateUsers) Calls() []*UserRepositoryMockCreateUsersParams {
+	mmCreateUsers.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockCreateUsersParams, len(mmCreateUsers.callArgs))
+	copy(argCopy, mmCreateUsers.callArgs)
+
+	mmCreateUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateUsersDone returns true if the count of the CreateUsers invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockCreateUsersDone() bool {
+	if m.CreateUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateUsersMock.invocationsDone()
+}
+
+// MinimockCreateUsersInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockCreateUsersInspect() {
+	for _, e := range m.CreateUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.CreateUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateUsersCounter := mm_atomic.LoadUint64(&m.afterCreateUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateUsersMock.defaultExpectation != nil && afterCreateUsersCounter < 1 {
+		if m.CreateUsersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.CreateUsers at\n%s", m.CreateUsersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.CreateUsers at\n%s with params: %#v", m.CreateUsersMock.defaultExpectation.expectationOrigins.origin, *m.CreateUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateUsers != nil && afterCreateUsersCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.CreateUsers at\n%s", m.funcCreateUsersOrigin)
+	}
+
+	if !m.CreateUsersMock.invocationsDone() && afterCreateUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.CreateUsers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateUsersMock.expectedInvocations), m.CreateUsersMock.expectedInvocationsOrigin, afterCreateUsersCounter)
+	}
+}
+
 type mUserRepositoryMockDeleteUser struct {
 	optional           bool
 	mock               *UserRepositoryMock
@@ -1101,52 +1505,53 @@ func (m *UserRepositoryMock) MinimockGetUserInspect() {
 	}
 }
 
-type mUserRepositoryMockUpdateUser struct {
+type mUserRepositoryMockGetUserAuthByEmail struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockUpdateUserExpectation
-	expectations       []*UserRepositoryMockUpdateUserExpectation
+	defaultExpectation *UserRepositoryMockGetUserAuthByEmailExpectation
+	expectations       []*UserRepositoryMockGetUserAuthByEmailExpectation
 
-	callArgs []*UserRepositoryMockUpdateUserParams
+	callArgs []*UserRepositoryMockGetUserAuthByEmailParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockUpdateUserExpectation specifies expectation struct of the UserRepository.UpdateUser
-type UserRepositoryMockUpdateUserExpectation struct {
+// UserRepositoryMockGetUserAuthByEmailExpectation specifies expectation struct of the UserRepository.GetUserAuthByEmail
+type UserRepositoryMockGetUserAuthByEmailExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockUpdateUserParams
-	paramPtrs          *UserRepositoryMockUpdateUserParamPtrs
-	expectationOrigins UserRepositoryMockUpdateUserExpectationOrigins
-	results            *UserRepositoryMockUpdateUserResults
+	params             *UserRepositoryMockGetUserAuthByEmailParams
+	paramPtrs          *UserRepositoryMockGetUserAuthByEmailParamPtrs
+	expectationOrigins UserRepositoryMockGetUserAuthByEmailExpectationOrigins
+	results            *UserRepositoryMockGetUserAuthByEmailResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockUpdateUserParams contains parameters of the UserRepository.UpdateUser
-type UserRepositoryMockUpdateUserParams struct {
-	ctx  context.Context
-	user *model.UserUpdate
+// UserRepositoryMockGetUserAuthByEmailParams contains parameters of the UserRepository.GetUserAuthByEmail
+type UserRepositoryMockGetUserAuthByEmailParams struct {
+	ctx   context.Context
+	email string
 }
 
-// UserRepositoryMockUpdateUserParamPtrs contains pointers to parameters of the UserRepository.UpdateUser
-type UserRepositoryMockUpdateUserParamPtrs struct {
-	ctx  *context.Context
-	user **model.UserUpdate
+// UserRepositoryMockGetUserAuthByEmailParamPtrs contains pointers to parameters of the UserRepository.GetUserAuthByEmail
+type UserRepositoryMockGetUserAuthByEmailParamPtrs struct {
+	ctx   *context.Context
+	email *string
 }
 
-// UserRepositoryMockUpdateUserResults contains results of the UserRepository.UpdateUser
-type UserRepositoryMockUpdateUserResults struct {
+// UserRepositoryMockGetUserAuthByEmailResults contains results of the UserRepository.GetUserAuthByEmail
+type UserRepositoryMockGetUserAuthByEmailResults struct {
+	up1 *model.UserAuth
 	err error
 }
 
-// UserRepositoryMockUpdateUserOrigins contains origins of expectations of the UserRepository.UpdateUser
-type UserRepositoryMockUpdateUserExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originUser string
+// UserRepositoryMockGetUserAuthByEmailOrigins contains origins of expectations of the UserRepository.GetUserAuthByEmail
+type UserRepositoryMockGetUserAuthByEmailExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1154,103 +1559,1504 @@ type UserRepositoryMockUpdateUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) Optional() *mUserRepositoryMockUpdateUser {
-	mmUpdateUser.optional = true
-	return mmUpdateUser
+func (mmGetUserAuthByEmail *mUserRepositoryMockGetUserAuthByEmail) Optional() *mUserRepositoryMockGetUserAuthByEmail {
+	mmGetUserAuthByEmail.optional = true
+	return mmGetUserAuthByEmail
 }
 
-// Expect sets up expected params for UserRepository.UpdateUser
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) Expect(ctx context.Context, user *model.UserUpdate) *mUserRepositoryMockUpdateUser {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
+// Expect sets up expected params for UserRepository.GetUserAuthByEmail
+func (mmGetUserAuthByEmail *mUserRepositoryMockGetUserAuthByEmail) Expect(ctx context.Context, email string) *mUserRepositoryMockGetUserAuthByEmail {
+	if mmGetUserAuthByEmail.mock.funcGetUserAuthByEmail != nil {
+		mmGetUserAuthByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserAuthByEmail mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{}
+	if mmGetUserAuthByEmail.defaultExpectation == nil {
+		mmGetUserAuthByEmail.defaultExpectation = &UserRepositoryMockGetUserAuthByEmailExpectation{}
 	}
 
-	if mmUpdateUser.defaultExpectation.paramPtrs != nil {
-		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by ExpectParams functions")
+	if mmGetUserAuthByEmail.defaultExpectation.paramPtrs != nil {
+		mmGetUserAuthByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserAuthByEmail mock is already set by ExpectParams functions")
 	}
 
-	mmUpdateUser.defaultExpectation.params = &UserRepositoryMockUpdateUserParams{ctx, user}
-	mmUpdateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmUpdateUser.expectations {
-		if minimock.Equal(e.params, mmUpdateUser.defaultExpectation.params) {
-			mmUpdateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateUser.defaultExpectation.params)
+	mmGetUserAuthByEmail.defaultExpectation.params = &UserRepositoryMockGetUserAuthByEmailParams{ctx, email}
+	mmGetUserAuthByEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserAuthByEmail.expectations {
+		if minimock.Equal(e.params, mmGetUserAuthByEmail.defaultExpectation.params) {
+			mmGetUserAuthByEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserAuthByEmail.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdateUser
+	return mmGetUserAuthByEmail
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.UpdateUser
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUpdateUser {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetUserAuthByEmail
+func (mmGetUserAuthByEmail *mUserRepositoryMockGetUserAuthByEmail) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetUserAuthByEmail {
+	if mmGetUserAuthByEmail.mock.funcGetUserAuthByEmail != nil {
+		mmGetUserAuthByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserAuthByEmail mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{}
+	if mmGetUserAuthByEmail.defaultExpectation == nil {
+		mmGetUserAuthByEmail.defaultExpectation = &UserRepositoryMockGetUserAuthByEmailExpectation{}
 	}
 
-	if mmUpdateUser.defaultExpectation.params != nil {
-		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Expect")
+	if mmGetUserAuthByEmail.defaultExpectation.params != nil {
+		mmGetUserAuthByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserAuthByEmail mock is already set by Expect")
 	}
 
-	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
-		mmUpdateUser.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateUserParamPtrs{}
+	if mmGetUserAuthByEmail.defaultExpectation.paramPtrs == nil {
+		mmGetUserAuthByEmail.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserAuthByEmailParamPtrs{}
 	}
-	mmUpdateUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmUpdateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmGetUserAuthByEmail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserAuthByEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmUpdateUser
+	return mmGetUserAuthByEmail
 }
 
-// ExpectUserParam2 sets up expected param user for UserRepository.UpdateUser
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) ExpectUserParam2(user *model.UserUpdate) *mUserRepositoryMockUpdateUser {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
+// ExpectEmailParam2 sets up expected param email for UserRepository.GetUserAuthByEmail
+func (mmGetUserAuthByEmail *mUserRepositoryMockGetUserAuthByEmail) ExpectEmailParam2(email string) *mUserRepositoryMockGetUserAuthByEmail {
+	if mmGetUserAuthByEmail.mock.funcGetUserAuthByEmail != nil {
+		mmGetUserAuthByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserAuthByEmail mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{}
+	if mmGetUserAuthByEmail.defaultExpectation == nil {
+		mmGetUserAuthByEmail.defaultExpectation = &UserRepositoryMockGetUserAuthByEmailExpectation{}
 	}
 
-	if mmUpdateUser.defaultExpectation.params != nil {
-		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Expect")
+	if mmGetUserAuthByEmail.defaultExpectation.params != nil {
+		mmGetUserAuthByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserAuthByEmail mock is already set by Expect")
 	}
 
-	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
-		mmUpdateUser.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateUserParamPtrs{}
+	if mmGetUserAuthByEmail.defaultExpectation.paramPtrs == nil {
+		mmGetUserAuthByEmail.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserAuthByEmailParamPtrs{}
 	}
-	mmUpdateUser.defaultExpectation.paramPtrs.user = &user
-	mmUpdateUser.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+	mmGetUserAuthByEmail.defaultExpectation.paramPtrs.email = &email
+	mmGetUserAuthByEmail.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
 
-	return mmUpdateUser
+	return mmGetUserAuthByEmail
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.UpdateUser
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) Inspect(f func(ctx context.Context, user *model.UserUpdate)) *mUserRepositoryMockUpdateUser {
-	if mmUpdateUser.mock.inspectFuncUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.UpdateUser")
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetUserAuthByEmail
+func (mmGetUserAuthByEmail *mUserRepositoryMockGetUserAuthByEmail) Inspect(f func(ctx context.Context, email string)) *mUserRepositoryMockGetUserAuthByEmail {
+	if mmGetUserAuthByEmail.mock.inspectFuncGetUserAuthByEmail != nil {
+		mmGetUserAuthByEmail.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetUserAuthByEmail")
 	}
 
-	mmUpdateUser.mock.inspectFuncUpdateUser = f
+	mmGetUserAuthByEmail.mock.inspectFuncGetUserAuthByEmail = f
 
-	return mmUpdateUser
+	return mmGetUserAuthByEmail
 }
 
-// Return sets up results that will be returned by UserRepository.UpdateUser
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) Return(err error) *UserRepositoryMock {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
+// Return sets up results that will be returned by UserRepository.GetUserAuthByEmail
+func (mmGetUserAuthByEmail *mUserRepositoryMockGetUserAuthByEmail) Return(up1 *model.UserAuth, err error) *UserRepositoryMock {
+	if mmGetUserAuthByEmail.mock.funcGetUserAuthByEmail != nil {
+		mmGetUserAuthByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserAuthByEmail mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{mock: mmUpdateUser.mock}
+	if mmGetUserAuthByEmail.defaultExpectation == nil {
+		mmGetUserAuthByEmail.defaultExpectation = &UserRepositoryMockGetUserAuthByEmailExpectation{mock: mmGetUserAuthByEmail.mock}
 	}
-	mmUpdateUser.defaultExpectation.results = &UserRepositoryMockUpdateUserResults{err}
+	mmGetUserAuthByEmail.defaultExpectation.results = &UserRepositoryMockGetUserAuthByEmailResults{up1, err}
+	mmGetUserAuthByEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserAuthByEmail.mock
+}
+
+// Set uses given function f to mock the UserRepository.GetUserAuthByEmail method
+func (mmGetUserAuthByEmail *mUserRepositoryMockGetUserAuthByEmail) Set(f func(ctx context.Context, email string) (up1 *model.UserAuth, err error)) *UserRepositoryMock {
+	if mmGetUserAuthByEmail.defaultExpectation != nil {
+		mmGetUserAuthByEmail.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetUserAuthByEmail method")
+	}
+
+	if len(mmGetUserAuthByEmail.expectations) > 0 {
+		mmGetUserAuthByEmail.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetUserAuthByEmail method")
+	}
+
+	mmGetUserAuthByEmail.mock.funcGetUserAuthByEmail = f
+	mmGetUserAuthByEmail.mock.funcGetUserAuthByEmailOrigin = minimock.CallerInfo(1)
+	return mmGetUserAuthByEmail.mock
+}
+
+// When sets expectation for the UserRepository.GetUserAuthByEmail which will trigger the result defined by the following
+// Then helper
+func (mmGetUserAuthByEmail *mUserRepositoryMockGetUserAuthByEmail) When(ctx context.Context, email string) *UserRepositoryMockGetUserAuthByEmailExpectation {
+	if mmGetUserAuthByEmail.mock.funcGetUserAuthByEmail != nil {
+		mmGetUserAuthByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserAuthByEmail mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetUserAuthByEmailExpectation{
+		mock:               mmGetUserAuthByEmail.mock,
+		params:             &UserRepositoryMockGetUserAuthByEmailParams{ctx, email},
+		expectationOrigins: UserRepositoryMockGetUserAuthByEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserAuthByEmail.expectations = append(mmGetUserAuthByEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.GetUserAuthByEmail return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetUserAuthByEmailExpectation) Then(up1 *model.UserAuth, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetUserAuthByEmailResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.GetUserAuthByEmail should be invoked
+func (mmGetUserAuthByEmail *mUserRepositoryMockGetUserAuthByEmail) Times(n uint64) *mUserRepositoryMockGetUserAuthByEmail {
+	if n == 0 {
+		mmGetUserAuthByEmail.mock.t.Fatalf("Times of UserRepositoryMock.GetUserAuthByEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserAuthByEmail.expectedInvocations, n)
+	mmGetUserAuthByEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserAuthByEmail
+}
+
+func (mmGetUserAuthByEmail *mUserRepositoryMockGetUserAuthByEmail) invocationsDone() bool {
+	if len(mmGetUserAuthByEmail.expectations) == 0 && mmGetUserAuthByEmail.defaultExpectation == nil && mmGetUserAuthByEmail.mock.funcGetUserAuthByEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserAuthByEmail.mock.afterGetUserAuthByEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserAuthByEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserAuthByEmail implements mm_repository.UserRepository
+func (mmGetUserAuthByEmail *UserRepositoryMock) GetUserAuthByEmail(ctx context.Context, email string) (up1 *model.UserAuth, err error) {
+	mm_atomic.AddUint64(&mmGetUserAuthByEmail.beforeGetUserAuthByEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserAuthByEmail.afterGetUserAuthByEmailCounter, 1)
+
+	mmGetUserAuthByEmail.t.Helper()
+
+	if mmGetUserAuthByEmail.inspectFuncGetUserAuthByEmail != nil {
+		mmGetUserAuthByEmail.inspectFuncGetUserAuthByEmail(ctx, email)
+	}
+
+	mm_params := UserRepositoryMockGetUserAuthByEmailParams{ctx, email}
+
+	// Record call args
+	mmGetUserAuthByEmail.GetUserAuthByEmailMock.mutex.Lock()
+	mmGetUserAuthByEmail.GetUserAuthByEmailMock.callArgs = append(mmGetUserAuthByEmail.GetUserAuthByEmailMock.callArgs, &mm_params)
+	mmGetUserAuthByEmail.GetUserAuthByEmailMock.mutex.Unlock()
+
+	for _, e := range mmGetUserAuthByEmail.GetUserAuthByEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetUserAuthByEmail.GetUserAuthByEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserAuthByEmail.GetUserAuthByEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserAuthByEmail.GetUserAuthByEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserAuthByEmail.GetUserAuthByEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockGetUserAuthByEmailParams{ctx, email}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserAuthByEmail.t.Errorf("UserRepositoryMock.GetUserAuthByEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserAuthByEmail.GetUserAuthByEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmGetUserAuthByEmail.t.Errorf("UserRepositoryMock.GetUserAuthByEmail got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserAuthByEmail.GetUserAuthByEmailMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserAuthByEmail.t.Errorf("UserRepositoryMock.GetUserAuthByEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserAuthByEmail.GetUserAuthByEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserAuthByEmail.GetUserAuthByEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserAuthByEmail.t.Fatal("No results are set for the UserRepositoryMock.GetUserAuthByEmail")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetUserAuthByEmail.funcGetUserAuthByEmail != nil {
+		return mmGetUserAuthByEmail.funcGetUserAuthByEmail(ctx, email)
+	}
+	mmGetUserAuthByEmail.t.Fatalf("Unexpected call to UserRepositoryMock.GetUserAuthByEmail. %v %v", ctx, email)
+	return
+}
+
+// GetUserAuthByEmailAfterCounter returns a count of finished UserRepositoryMock.GetUserAuthByEmail invocations
+func (mmGetUserAuthByEmail *UserRepositoryMock) GetUserAuthByEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserAuthByEmail.afterGetUserAuthByEmailCounter)
+}
+
+// GetUserAuthByEmailBeforeCounter returns a count of UserRepositoryMock.GetUserAuthByEmail invocations
+func (mmGetUserAuthByEmail *UserRepositoryMock) GetUserAuthByEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserAuthByEmail.beforeGetUserAuthByEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetUserAuthByEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserAuthByEmail *mUserRepositoryMockGetUserAuthByEmail) Calls() []*UserRepositoryMockGetUserAuthByEmailParams {
+	mmGetUserAuthByEmail.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockGetUserAuthByEmailParams, len(mmGetUserAuthByEmail.callArgs))
+	copy(argCopy, mmGetUserAuthByEmail.callArgs)
+
+	mmGetUserAuthByEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserAuthByEmailDone returns true if the count of the GetUserAuthByEmail invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockGetUserAuthByEmailDone() bool {
+	if m.GetUserAuthByEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserAuthByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserAuthByEmailMock.invocationsDone()
+}
+
+// MinimockGetUserAuthByEmailInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetUserAuthByEmailInspect() {
+	for _, e := range m.GetUserAuthByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUserAuthByEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserAuthByEmailCounter := mm_atomic.LoadUint64(&m.afterGetUserAuthByEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserAuthByEmailMock.defaultExpectation != nil && afterGetUserAuthByEmailCounter < 1 {
+		if m.GetUserAuthByEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUserAuthByEmail at\n%s", m.GetUserAuthByEmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUserAuthByEmail at\n%s with params: %#v", m.GetUserAuthByEmailMock.defaultExpectation.expectationOrigins.origin, *m.GetUserAuthByEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserAuthByEmail != nil && afterGetUserAuthByEmailCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.GetUserAuthByEmail at\n%s", m.funcGetUserAuthByEmailOrigin)
+	}
+
+	if !m.GetUserAuthByEmailMock.invocationsDone() && afterGetUserAuthByEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetUserAuthByEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserAuthByEmailMock.expectedInvocations), m.GetUserAuthByEmailMock.expectedInvocationsOrigin, afterGetUserAuthByEmailCounter)
+	}
+}
+
+type mUserRepositoryMockListUsers struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockListUsersExpectation
+	expectations       []*UserRepositoryMockListUsersExpectation
+
+	callArgs []*UserRepositoryMockListUsersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockListUsersExpectation specifies expectation struct of the UserRepository.ListUsers
+type UserRepositoryMockListUsersExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockListUsersParams
+	paramPtrs          *UserRepositoryMockListUsersParamPtrs
+	expectationOrigins UserRepositoryMockListUsersExpectationOrigins
+	results            *UserRepositoryMockListUsersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockListUsersParams contains parameters of the UserRepository.ListUsers
+type UserRepositoryMockListUsersParams struct {
+	ctx   context.Context
+	query *model.UserListQuery
+}
+
+// UserRepositoryMockListUsersParamPtrs contains pointers to parameters of the UserRepository.ListUsers
+type UserRepositoryMockListUsersParamPtrs struct {
+	ctx   *context.Context
+	query **model.UserListQuery
+}
+
+// UserRepositoryMockListUsersResults contains results of the UserRepository.ListUsers
+type UserRepositoryMockListUsersResults struct {
+	upa1 []*model.UserGet
+	err  error
+}
+
+// UserRepositoryMockListUsersOrigins contains origins of expectations of the UserRepository.ListUsers
+type UserRepositoryMockListUsersExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originQuery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListUsers *mUserRepositoryMockListUsers) Optional() *mUserRepositoryMockListUsers {
+	mmListUsers.optional = true
+	return mmListUsers
+}
+
+// Expect sets up expected params for UserRepository.ListUsers
+func (mmListUsers *mUserRepositoryMockListUsers) Expect(ctx context.Context, query *model.UserListQuery) *mUserRepositoryMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserRepositoryMockListUsersExpectation{}
+	}
+
+	if mmListUsers.defaultExpectation.paramPtrs != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by ExpectParams functions")
+	}
+
+	mmListUsers.defaultExpectation.params = &UserRepositoryMockListUsersParams{ctx, query}
+	mmListUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListUsers.expectations {
+		if minimock.Equal(e.params, mmListUsers.defaultExpectation.params) {
+			mmListUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmListUsers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.ListUsers
+func (mmListUsers *mUserRepositoryMockListUsers) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserRepositoryMockListUsersExpectation{}
+	}
+
+	if mmListUsers.defaultExpectation.params != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Expect")
+	}
+
+	if mmListUsers.defaultExpectation.paramPtrs == nil {
+		mmListUsers.defaultExpectation.paramPtrs = &UserRepositoryMockListUsersParamPtrs{}
+	}
+	mmListUsers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListUsers
+}
+
+// ExpectQueryParam2 sets up expected param query for UserRepository.ListUsers
+func (mmListUsers *mUserRepositoryMockListUsers) ExpectQueryParam2(query *model.UserListQuery) *mUserRepositoryMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserRepositoryMockListUsersExpectation{}
+	}
+
+	if mmListUsers.defaultExpectation.params != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Expect")
+	}
+
+	if mmListUsers.defaultExpectation.paramPtrs == nil {
+		mmListUsers.defaultExpectation.paramPtrs = &UserRepositoryMockListUsersParamPtrs{}
+	}
+	mmListUsers.defaultExpectation.paramPtrs.query = &query
+	mmListUsers.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmListUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.ListUsers
+func (mmListUsers *mUserRepositoryMockListUsers) Inspect(f func(ctx context.Context, query *model.UserListQuery)) *mUserRepositoryMockListUsers {
+	if mmListUsers.mock.inspectFuncListUsers != nil {
+		mmListUsers.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.ListUsers")
+	}
+
+	mmListUsers.mock.inspectFuncListUsers = f
+
+	return mmListUsers
+}
+
+// Return sets up results that will be returned by UserRepository.ListUsers
+func (mmListUsers *mUserRepositoryMockListUsers) Return(upa1 []*model.UserGet, err error) *UserRepositoryMock {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserRepositoryMockListUsersExpectation{mock: mmListUsers.mock}
+	}
+	mmListUsers.defaultExpectation.results = &UserRepositoryMockListUsersResults{upa1, err}
+	mmListUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListUsers.mock
+}
+
+// Set uses given function f to mock the UserRepository.ListUsers method
+func (mmListUsers *mUserRepositoryMockListUsers) Set(f func(ctx context.Context, query *model.UserListQuery) (upa1 []*model.UserGet, err error)) *UserRepositoryMock {
+	if mmListUsers.defaultExpectation != nil {
+		mmListUsers.mock.t.Fatalf("Default expectation is already set for the UserRepository.ListUsers method")
+	}
+
+	if len(mmListUsers.expectations) > 0 {
+		mmListUsers.mock.t.Fatalf("Some expectations are already set for the UserRepository.ListUsers method")
+	}
+
+	mmListUsers.mock.funcListUsers = f
+	mmListUsers.mock.funcListUsersOrigin = minimock.CallerInfo(1)
+	return mmListUsers.mock
+}
+
+// When sets expectation for the UserRepository.ListUsers which will trigger the result defined by the following
+// Then helper
+func (mmListUsers *mUserRepositoryMockListUsers) When(ctx context.Context, query *model.UserListQuery) *UserRepositoryMockListUsersExpectation {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockListUsersExpectation{
+		mock:               mmListUsers.mock,
+		params:             &UserRepositoryMockListUsersParams{ctx, query},
+		expectationOrigins: UserRepositoryMockListUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListUsers.expectations = append(mmListUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.ListUsers return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockListUsersExpectation) Then(upa1 []*model.UserGet, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockListUsersResults{upa1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.ListUsers should be invoked
+func (mmListUsers *mUserRepositoryMockListUsers) Times(n uint64) *mUserRepositoryMockListUsers {
+	if n == 0 {
+		mmListUsers.mock.t.Fatalf("Times of UserRepositoryMock.ListUsers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListUsers.expectedInvocations, n)
+	mmListUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListUsers
+}
+
+func (mmListUsers *mUserRepositoryMockListUsers) invocationsDone() bool {
+	if len(mmListUsers.expectations) == 0 && mmListUsers.defaultExpectation == nil && mmListUsers.mock.funcListUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListUsers.mock.afterListUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListUsers implements mm_repository.UserRepository
+func (mmListUsers *UserRepositoryMock) ListUsers(ctx context.Context, query *model.UserListQuery) (upa1 []*model.UserGet, err error) {
+	mm_atomic.AddUint64(&mmListUsers.beforeListUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListUsers.afterListUsersCounter, 1)
+
+	mmListUsers.t.Helper()
+
+	if mmListUsers.inspectFuncListUsers != nil {
+		mmListUsers.inspectFuncListUsers(ctx, query)
+	}
+
+	mm_params := UserRepositoryMockListUsersParams{ctx, query}
+
+	// Record call args
+	mmListUsers.ListUsersMock.mutex.Lock()
+	mmListUsers.ListUsersMock.callArgs = append(mmListUsers.ListUsersMock.callArgs, &mm_params)
+	mmListUsers.ListUsersMock.mutex.Unlock()
+
+	for _, e := range mmListUsers.ListUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.upa1, e.results.err
+		}
+	}
+
+	if mmListUsers.ListUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListUsers.ListUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListUsers.ListUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmListUsers.ListUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockListUsersParams{ctx, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListUsers.t.Errorf("UserRepositoryMock.ListUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUsers.ListUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmListUsers.t.Errorf("UserRepositoryMock.ListUsers got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUsers.ListUsersMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListUsers.t.Errorf("UserRepositoryMock.ListUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListUsers.ListUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListUsers.ListUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListUsers.t.Fatal("No results are set for the UserRepositoryMock.ListUsers")
+		}
+		return (*mm_results).upa1, (*mm_results).err
+	}
+	if mmListUsers.funcListUsers != nil {
+		return mmListUsers.funcListUsers(ctx, query)
+	}
+	mmListUsers.t.Fatalf("Unexpected call to UserRepositoryMock.ListUsers. %v %v", ctx, query)
+	return
+}
+
+// ListUsersAfterCounter returns a count of finished UserRepositoryMock.ListUsers invocations
+func (mmListUsers *UserRepositoryMock) ListUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUsers.afterListUsersCounter)
+}
+
+// ListUsersBeforeCounter returns a count of UserRepositoryMock.ListUsers invocations
+func (mmListUsers *UserRepositoryMock) ListUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUsers.beforeListUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.ListUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListUsers *mUserRepositoryMockListUsers) Calls() []*UserRepositoryMockListUsersParams {
+	mmListUsers.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockListUsersParams, len(mmListUsers.callArgs))
+	copy(argCopy, mmListUsers.callArgs)
+
+	mmListUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListUsersDone returns true if the count of the ListUsers invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockListUsersDone() bool {
+	if m.ListUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListUsersMock.invocationsDone()
+}
+
+// MinimockListUsersInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockListUsersInspect() {
+	for _, e := range m.ListUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.ListUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListUsersCounter := mm_atomic.LoadUint64(&m.afterListUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUsersMock.defaultExpectation != nil && afterListUsersCounter < 1 {
+		if m.ListUsersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.ListUsers at\n%s", m.ListUsersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.ListUsers at\n%s with params: %#v", m.ListUsersMock.defaultExpectation.expectationOrigins.origin, *m.ListUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUsers != nil && afterListUsersCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.ListUsers at\n%s", m.funcListUsersOrigin)
+	}
+
+	if !m.ListUsersMock.invocationsDone() && afterListUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.ListUsers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListUsersMock.expectedInvocations), m.ListUsersMock.expectedInvocationsOrigin, afterListUsersCounter)
+	}
+}
+
+type mUserRepositoryMockPurgeDeletedUsers struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockPurgeDeletedUsersExpectation
+	expectations       []*UserRepositoryMockPurgeDeletedUsersExpectation
+
+	callArgs []*UserRepositoryMockPurgeDeletedUsersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockPurgeDeletedUsersExpectation specifies expectation struct of the UserRepository.PurgeDeletedUsers
+type UserRepositoryMockPurgeDeletedUsersExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockPurgeDeletedUsersParams
+	paramPtrs          *UserRepositoryMockPurgeDeletedUsersParamPtrs
+	expectationOrigins UserRepositoryMockPurgeDeletedUsersExpectationOrigins
+	results            *UserRepositoryMockPurgeDeletedUsersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockPurgeDeletedUsersParams contains parameters of the UserRepository.PurgeDeletedUsers
+type UserRepositoryMockPurgeDeletedUsersParams struct {
+	ctx           context.Context
+	deletedBefore time.Time
+	limit         uint64
+}
+
+// UserRepositoryMockPurgeDeletedUsersParamPtrs contains pointers to parameters of the UserRepository.PurgeDeletedUsers
+type UserRepositoryMockPurgeDeletedUsersParamPtrs struct {
+	ctx           *context.Context
+	deletedBefore *time.Time
+	limit         *uint64
+}
+
+// UserRepositoryMockPurgeDeletedUsersResults contains results of the UserRepository.PurgeDeletedUsers
+type UserRepositoryMockPurgeDeletedUsersResults struct {
+	i1  int64
+	err error
+}
+
+// UserRepositoryMockPurgeDeletedUsersOrigins contains origins of expectations of the UserRepository.PurgeDeletedUsers
+type UserRepositoryMockPurgeDeletedUsersExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originDeletedBefore string
+	originLimit         string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeDeletedUsers *mUserRepositoryMockPurgeDeletedUsers) Optional() *mUserRepositoryMockPurgeDeletedUsers {
+	mmPurgeDeletedUsers.optional = true
+	return mmPurgeDeletedUsers
+}
+
+// Expect sets up expected params for UserRepository.PurgeDeletedUsers
+func (mmPurgeDeletedUsers *mUserRepositoryMockPurgeDeletedUsers) Expect(ctx context.Context, deletedBefore time.Time, limit uint64) *mUserRepositoryMockPurgeDeletedUsers {
+	if mmPurgeDeletedUsers.mock.funcPurgeDeletedUsers != nil {
+		mmPurgeDeletedUsers.mock.t.Fatalf("UserRepositoryMock.PurgeDeletedUsers mock is already set by Set")
+	}
+
+	if mmPurgeDeletedUsers.defaultExpectation == nil {
+		mmPurgeDeletedUsers.defaultExpectation = &UserRepositoryMockPurgeDeletedUsersExpectation{}
+	}
+
+	if mmPurgeDeletedUsers.defaultExpectation.paramPtrs != nil {
+		mmPurgeDeletedUsers.mock.t.Fatalf("UserRepositoryMock.PurgeDeletedUsers mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeDeletedUsers.defaultExpectation.params = &UserRepositoryMockPurgeDeletedUsersParams{ctx, deletedBefore, limit}
+	mmPurgeDeletedUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeDeletedUsers.expectations {
+		if minimock.Equal(e.params, mmPurgeDeletedUsers.defaultExpectation.params) {
+			mmPurgeDeletedUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeDeletedUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeDeletedUsers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.PurgeDeletedUsers
+func (mmPurgeDeletedUsers *mUserRepositoryMockPurgeDeletedUsers) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockPurgeDeletedUsers {
+	if mmPurgeDeletedUsers.mock.funcPurgeDeletedUsers != nil {
+		mmPurgeDeletedUsers.mock.t.Fatalf("UserRepositoryMock.PurgeDeletedUsers mock is already set by Set")
+	}
+
+	if mmPurgeDeletedUsers.defaultExpectation == nil {
+		mmPurgeDeletedUsers.defaultExpectation = &UserRepositoryMockPurgeDeletedUsersExpectation{}
+	}
+
+	if mmPurgeDeletedUsers.defaultExpectation.params != nil {
+		mmPurgeDeletedUsers.mock.t.Fatalf("UserRepositoryMock.PurgeDeletedUsers mock is already set by Expect")
+	}
+
+	if mmPurgeDeletedUsers.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeletedUsers.defaultExpectation.paramPtrs = &UserRepositoryMockPurgeDeletedUsersParamPtrs{}
+	}
+	mmPurgeDeletedUsers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeDeletedUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeDeletedUsers
+}
+
+// ExpectDeletedBeforeParam2 sets up expected param deletedBefore for UserRepository.PurgeDeletedUsers
+func (mmPurgeDeletedUsers *mUserRepositoryMockPurgeDeletedUsers) ExpectDeletedBeforeParam2(deletedBefore time.Time) *mUserRepositoryMockPurgeDeletedUsers {
+	if mmPurgeDeletedUsers.mock.funcPurgeDeletedUsers != nil {
+		mmPurgeDeletedUsers.mock.t.Fatalf("UserRepositoryMock.PurgeDeletedUsers mock is already set by Set")
+	}
+
+	if mmPurgeDeletedUsers.defaultExpectation == nil {
+		mmPurgeDeletedUsers.defaultExpectation = &UserRepositoryMockPurgeDeletedUsersExpectation{}
+	}
+
+	if mmPurgeDeletedUsers.defaultExpectation.params != nil {
+		mmPurgeDeletedUsers.mock.t.Fatalf("UserRepositoryMock.PurgeDeletedUsers mock is already set by Expect")
+	}
+
+	if mmPurgeDeletedUsers.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeletedUsers.defaultExpectation.paramPtrs = &UserRepositoryMockPurgeDeletedUsersParamPtrs{}
+	}
+	mmPurgeDeletedUsers.defaultExpectation.paramPtrs.deletedBefore = &deletedBefore
+	mmPurgeDeletedUsers.defaultExpectation.expectationOrigins.originDeletedBefore = minimock.CallerInfo(1)
+
+	return mmPurgeDeletedUsers
+}
+
+// ExpectLimitParam3 sets up expected param limit for UserRepository.PurgeDeletedUsers
+func (mmPurgeDeletedUsers *mUserRepositoryMockPurgeDeletedUsers) ExpectLimitParam3(limit uint64) *mUserRepositoryMockPurgeDeletedUsers {
+	if mmPurgeDeletedUsers.mock.funcPurgeDeletedUsers != nil {
+		mmPurgeDeletedUsers.mock.t.Fatalf("UserRepositoryMock.PurgeDeletedUsers mock is already set by Set")
+	}
+
+	if mmPurgeDeletedUsers.defaultExpectation == nil {
+		mmPurgeDeletedUsers.defaultExpectation = &UserRepositoryMockPurgeDeletedUsersExpectation{}
+	}
+
+	if mmPurgeDeletedUsers.defaultExpectation.params != nil {
+		mmPurgeDeletedUsers.mock.t.Fatalf("UserRepositoryMock.PurgeDeletedUsers mock is already set by Expect")
+	}
+
+	if mmPurgeDeletedUsers.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeletedUsers.defaultExpectation.paramPtrs = &UserRepositoryMockPurgeDeletedUsersParamPtrs{}
+	}
+	mmPurgeDeletedUsers.defaultExpectation.paramPtrs.limit = &limit
+	mmPurgeDeletedUsers.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmPurgeDeletedUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.PurgeDeletedUsers
+func (mmPurgeDeletedUsers *mUserRepositoryMockPurgeDeletedUsers) Inspect(f func(ctx context.Context, deletedBefore time.Time, limit uint64)) *mUserRepositoryMockPurgeDeletedUsers {
+	if mmPurgeDeletedUsers.mock.inspectFuncPurgeDeletedUsers != nil {
+		mmPurgeDeletedUsers.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.PurgeDeletedUsers")
+	}
+
+	mmPurgeDeletedUsers.mock.inspectFuncPurgeDeletedUsers = f
+
+	return mmPurgeDeletedUsers
+}
+
+// Return sets up results that will be returned by UserRepository.PurgeDeletedUsers
+func (mmPurgeDeletedUsers *mUserRepositoryMockPurgeDeletedUsers) Return(i1 int64, err error) *UserRepositoryMock {
+	if mmPurgeDeletedUsers.mock.funcPurgeDeletedUsers != nil {
+		mmPurgeDeletedUsers.mock.t.Fatalf("UserRepositoryMock.PurgeDeletedUsers mock is already set by Set")
+	}
+
+	if mmPurgeDeletedUsers.defaultExpectation == nil {
+		mmPurgeDeletedUsers.defaultExpectation = &UserRepositoryMockPurgeDeletedUsersExpectation{mock: mmPurgeDeletedUsers.mock}
+	}
+	mmPurgeDeletedUsers.defaultExpectation.results = &UserRepositoryMockPurgeDeletedUsersResults{i1, err}
+	mmPurgeDeletedUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedUsers.mock
+}
+
+// Set uses given function f to mock the UserRepository.PurgeDeletedUsers method
+func (mmPurgeDeletedUsers *mUserRepositoryMockPurgeDeletedUsers) Set(f func(ctx context.Context, deletedBefore time.Time, limit uint64) (i1 int64, err error)) *UserRepositoryMock {
+	if mmPurgeDeletedUsers.defaultExpectation != nil {
+		mmPurgeDeletedUsers.mock.t.Fatalf("Default expectation is already set for the UserRepository.PurgeDeletedUsers method")
+	}
+
+	if len(mmPurgeDeletedUsers.expectations) > 0 {
+		mmPurgeDeletedUsers.mock.t.Fatalf("Some expectations are already set for the UserRepository.PurgeDeletedUsers method")
+	}
+
+	mmPurgeDeletedUsers.mock.funcPurgeDeletedUsers = f
+	mmPurgeDeletedUsers.mock.funcPurgeDeletedUsersOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedUsers.mock
+}
+
+// When sets expectation for the UserRepository.PurgeDeletedUsers which will trigger the result defined by the following
+// Then helper
+func (mmPurgeDeletedUsers *mUserRepositoryMockPurgeDeletedUsers) When(ctx context.Context, deletedBefore time.Time, limit uint64) *UserRepositoryMockPurgeDeletedUsersExpectation {
+	if mmPurgeDeletedUsers.mock.funcPurgeDeletedUsers != nil {
+		mmPurgeDeletedUsers.mock.t.Fatalf("UserRepositoryMock.PurgeDeletedUsers mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockPurgeDeletedUsersExpectation{
+		mock:               mmPurgeDeletedUsers.mock,
+		params:             &UserRepositoryMockPurgeDeletedUsersParams{ctx, deletedBefore, limit},
+		expectationOrigins: UserRepositoryMockPurgeDeletedUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeDeletedUsers.expectations = append(mmPurgeDeletedUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.PurgeDeletedUsers return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockPurgeDeletedUsersExpectation) Then(i1 int64, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockPurgeDeletedUsersResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.PurgeDeletedUsers should be invoked
+func (mmPurgeDeletedUsers *mUserRepositoryMockPurgeDeletedUsers) Times(n uint64) *mUserRepositoryMockPurgeDeletedUsers {
+	if n == 0 {
+		mmPurgeDeletedUsers.mock.t.Fatalf("Times of UserRepositoryMock.PurgeDeletedUsers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeDeletedUsers.expectedInvocations, n)
+	mmPurgeDeletedUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedUsers
+}
+
+func (mmPurgeDeletedUsers *mUserRepositoryMockPurgeDeletedUsers) invocationsDone() bool {
+	if len(mmPurgeDeletedUsers.expectations) == 0 && mmPurgeDeletedUsers.defaultExpectation == nil && mmPurgeDeletedUsers.mock.funcPurgeDeletedUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeDeletedUsers.mock.afterPurgeDeletedUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeDeletedUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeDeletedUsers implements mm_repository.UserRepository
+func (mmPurgeDeletedUsers *UserRepositoryMock) PurgeDeletedUsers(ctx context.Context, deletedBefore time.Time, limit uint64) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurgeDeletedUsers.beforePurgeDeletedUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeDeletedUsers.afterPurgeDeletedUsersCounter, 1)
+
+	mmPurgeDeletedUsers.t.Helper()
+
+	if mmPurgeDeletedUsers.inspectFuncPurgeDeletedUsers != nil {
+		mmPurgeDeletedUsers.inspectFuncPurgeDeletedUsers(ctx, deletedBefore, limit)
+	}
+
+	mm_params := UserRepositoryMockPurgeDeletedUsersParams{ctx, deletedBefore, limit}
+
+	// Record call args
+	mmPurgeDeletedUsers.PurgeDeletedUsersMock.mutex.Lock()
+	mmPurgeDeletedUsers.PurgeDeletedUsersMock.callArgs = append(mmPurgeDeletedUsers.PurgeDeletedUsersMock.callArgs, &mm_params)
+	mmPurgeDeletedUsers.PurgeDeletedUsersMock.mutex.Unlock()
+
+	for _, e := range mmPurgeDeletedUsers.PurgeDeletedUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeDeletedUsers.PurgeDeletedUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeDeletedUsers.PurgeDeletedUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeDeletedUsers.PurgeDeletedUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeDeletedUsers.PurgeDeletedUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockPurgeDeletedUsersParams{ctx, deletedBefore, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeDeletedUsers.t.Errorf("UserRepositoryMock.PurgeDeletedUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeletedUsers.PurgeDeletedUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.deletedBefore != nil && !minimock.Equal(*mm_want_ptrs.deletedBefore, mm_got.deletedBefore) {
+				mmPurgeDeletedUsers.t.Errorf("UserRepositoryMock.PurgeDeletedUsers got unexpected parameter deletedBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeletedUsers.PurgeDeletedUsersMock.defaultExpectation.expectationOrigins.originDeletedBefore, *mm_want_ptrs.deletedBefore, mm_got.deletedBefore, minimock.Diff(*mm_want_ptrs.deletedBefore, mm_got.deletedBefore))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmPurgeDeletedUsers.t.Errorf("UserRepositoryMock.PurgeDeletedUsers got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeletedUsers.PurgeDeletedUsersMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeDeletedUsers.t.Errorf("UserRepositoryMock.PurgeDeletedUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeDeletedUsers.PurgeDeletedUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeDeletedUsers.PurgeDeletedUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeDeletedUsers.t.Fatal("No results are set for the UserRepositoryMock.PurgeDeletedUsers")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeDeletedUsers.funcPurgeDeletedUsers != nil {
+		return mmPurgeDeletedUsers.funcPurgeDeletedUsers(ctx, deletedBefore, limit)
+	}
+	mmPurgeDeletedUsers.t.Fatalf("Unexpected call to UserRepositoryMock.PurgeDeletedUsers. %v %v %v", ctx, deletedBefore, limit)
+	return
+}
+
+// PurgeDeletedUsersAfterCounter returns a count of finished UserRepositoryMock.PurgeDeletedUsers invocations
+func (mmPurgeDeletedUsers *UserRepositoryMock) PurgeDeletedUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeletedUsers.afterPurgeDeletedUsersCounter)
+}
+
+// PurgeDeletedUsersBeforeCounter returns a count of UserRepositoryMock.PurgeDeletedUsers invocations
+func (mmPurgeDeletedUsers *UserRepositoryMock) PurgeDeletedUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeletedUsers.beforePurgeDeletedUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.PurgeDeletedUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeDeletedUsers *mUserRepositoryMockPurgeDeletedUsers) Calls() []*UserRepositoryMockPurgeDeletedUsersParams {
+	mmPurgeDeletedUsers.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockPurgeDeletedUsersParams, len(mmPurgeDeletedUsers.callArgs))
+	copy(argCopy, mmPurgeDeletedUsers.callArgs)
+
+	mmPurgeDeletedUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeDeletedUsersDone returns true if the count of the PurgeDeletedUsers invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockPurgeDeletedUsersDone() bool {
+	if m.PurgeDeletedUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeDeletedUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeDeletedUsersMock.invocationsDone()
+}
+
+// MinimockPurgeDeletedUsersInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockPurgeDeletedUsersInspect() {
+	for _, e := range m.PurgeDeletedUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.PurgeDeletedUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeDeletedUsersCounter := mm_atomic.LoadUint64(&m.afterPurgeDeletedUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeDeletedUsersMock.defaultExpectation != nil && afterPurgeDeletedUsersCounter < 1 {
+		if m.PurgeDeletedUsersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.PurgeDeletedUsers at\n%s", m.PurgeDeletedUsersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.PurgeDeletedUsers at\n%s with params: %#v", m.PurgeDeletedUsersMock.defaultExpectation.expectationOrigins.origin, *m.PurgeDeletedUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeDeletedUsers != nil && afterPurgeDeletedUsersCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.PurgeDeletedUsers at\n%s", m.funcPurgeDeletedUsersOrigin)
+	}
+
+	if !m.PurgeDeletedUsersMock.invocationsDone() && afterPurgeDeletedUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.PurgeDeletedUsers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeDeletedUsersMock.expectedInvocations), m.PurgeDeletedUsersMock.expectedInvocationsOrigin, afterPurgeDeletedUsersCounter)
+	}
+}
+
+type mUserRepositoryMockRestoreUser struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockRestoreUserExpectation
+	expectations       []*UserRepositoryMockRestoreUserExpectation
+
+	callArgs []*UserRepositoryMockRestoreUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockRestoreUserExpectation specifies expectation struct of the UserRepository.RestoreUser
+type UserRepositoryMockRestoreUserExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockRestoreUserParams
+	paramPtrs          *UserRepositoryMockRestoreUserParamPtrs
+	expectationOrigins UserRepositoryMockRestoreUserExpectationOrigins
+	results            *UserRepositoryMockRestoreUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockRestoreUserParams contains parameters of the UserRepository.RestoreUser
+type UserRepositoryMockRestoreUserParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// UserRepositoryMockRestoreUserParamPtrs contains pointers to parameters of the UserRepository.RestoreUser
+type UserRepositoryMockRestoreUserParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// UserRepositoryMockRestoreUserResults contains results of the UserRepository.RestoreUser
+type UserRepositoryMockRestoreUserResults struct {
+	err error
+}
+
+// UserRepositoryMockRestoreUserOrigins contains origins of expectations of the UserRepository.RestoreUser
+type UserRepositoryMockRestoreUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRestoreUser *mUserRepositoryMockRestoreUser) Optional() *mUserRepositoryMockRestoreUser {
+	mmRestoreUser.optional = true
+	return mmRestoreUser
+}
+
+// Expect sets up expected params for UserRepository.RestoreUser
+func (mmRestoreUser *mUserRepositoryMockRestoreUser) Expect(ctx context.Context, id int64) *mUserRepositoryMockRestoreUser {
+	if mmRestoreUser.mock.funcRestoreUser != nil {
+		mmRestoreUser.mock.t.Fatalf("UserRepositoryMock.RestoreUser mock is already set by Set")
+	}
+
+	if mmRestoreUser.defaultExpectation == nil {
+		mmRestoreUser.defaultExpectation = &UserRepositoryMockRestoreUserExpectation{}
+	}
+
+	if mmRestoreUser.defaultExpectation.paramPtrs != nil {
+		mmRestoreUser.mock.t.Fatalf("UserRepositoryMock.RestoreUser mock is already set by ExpectParams functions")
+	}
+
+	mmRestoreUser.defaultExpectation.params = &UserRepositoryMockRestoreUserParams{ctx, id}
+	mmRestoreUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRestoreUser.expectations {
+		if minimock.Equal(e.params, mmRestoreUser.defaultExpectation.params) {
+			mmRestoreUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestoreUser.defaultExpectation.params)
+		}
+	}
+
+	return mmRestoreUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.RestoreUser
+func (mmRestoreUser *mUserRepositoryMockRestoreUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockRestoreUser {
+	if mmRestoreUser.mock.funcRestoreUser != nil {
+		mmRestoreUser.mock.t.Fatalf("UserRepositoryMock.RestoreUser mock is already set by Set")
+	}
+
+	if mmRestoreUser.defaultExpectation == nil {
+		mmRestoreUser.defaultExpectation = &UserRepositoryMockRestoreUserExpectation{}
+	}
+
+	if mmRestoreUser.defaultExpectation.params != nil {
+		mmRestoreUser.mock.t.Fatalf("UserRepositoryMock.RestoreUser mock is already set by Expect")
+	}
+
+	if mmRestoreUser.defaultExpectation.paramPtrs == nil {
+		mmRestoreUser.defaultExpectation.paramPtrs = &UserRepositoryMockRestoreUserParamPtrs{}
+	}
+	mmRestoreUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRestoreUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRestoreUser
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.RestoreUser
+func (mmRestoreUser *mUserRepositoryMockRestoreUser) ExpectIdParam2(id int64) *mUserRepositoryMockRestoreUser {
+	if mmRestoreUser.mock.funcRestoreUser != nil {
+		mmRestoreUser.mock.t.Fatalf("UserRepositoryMock.RestoreUser mock is already set by Set")
+	}
+
+	if mmRestoreUser.defaultExpectation == nil {
+		mmRestoreUser.defaultExpectation = &UserRepositoryMockRestoreUserExpectation{}
+	}
+
+	if mmRestoreUser.defaultExpectation.params != nil {
+		mmRestoreUser.mock.t.Fatalf("UserRepositoryMock.RestoreUser mock is already set by Expect")
+	}
+
+	if mmRestoreUser.defaultExpectation.paramPtrs == nil {
+		mmRestoreUser.defaultExpectation.paramPtrs = &UserRepositoryMockRestoreUserParamPtrs{}
+	}
+	mmRestoreUser.defaultExpectation.paramPtrs.id = &id
+	mmRestoreUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRestoreUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.RestoreUser
+func (mmRestoreUser *mUserRepositoryMockRestoreUser) Inspect(f func(ctx context.Context, id int64)) *mUserRepositoryMockRestoreUser {
+	if mmRestoreUser.mock.inspectFuncRestoreUser != nil {
+		mmRestoreUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.RestoreUser")
+	}
+
+	mmRestoreUser.mock.inspectFuncRestoreUser = f
+
+	return mmRestoreUser
+}
+
+// Return sets up results that will be returned by UserRepository.RestoreUser
+func (mmRestoreUser *mUserRepositoryMockRestoreUser) Return(err error) *UserRepositoryMock {
+	if mmRestoreUser.mock.funcRestoreUser != nil {
+		mmRestoreUser.mock.t.Fatalf("UserRepositoryMock.RestoreUser mock is already set by Set")
+	}
+
+	if mmRestoreUser.defaultExpectation == nil {
+		mmRestoreUser.defaultExpectation = &UserRepositoryMockRestoreUserExpectation{mock: mmRestoreUser.mock}
+	}
+	mmRestoreUser.defaultExpectation.results = &UserRepositoryMockRestoreUserResults{err}
+	mmRestoreUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRestoreUser.mock
+}
+
+// Set uses given function f to mock the UserRepository.RestoreUser method
+func (mmRestoreUser *mUserRepositoryMockRestoreUser) Set(f func(ctx context.Context, id int64) (err error)) *UserRepositoryMock {
+	if mmRestoreUser.defaultExpectation != nil {
+		mmRestoreUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.RestoreUser method")
+	}
+
+	if len(mmRestoreUser.expectations) > 0 {
+		mmRestoreUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.RestoreUser method")
+	}
+
+	mmRestoreUser.mock.funcRestoreUser = f
+	mmRestoreUser.mock.funcRestoreUserOrigin = minimock.CallerInfo(1)
+	return mmRestoreUser.mock
+}
+
+// When sets expectation for the UserRepository.RestoreUser which will trigger the result defined by the following
+// Then helper
+func (mmRestoreUser *mUserRepositoryMockRestoreUser) When(ctx context.Context, id int64) *UserRepositoryMockRestoreUserExpectation {
+	if mmRestoreUser.mock.funcRestoreUser != nil {
+		mmRestoreUser.mock.t.Fatalf("UserRepositoryMock.RestoreUser mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockRestoreUserExpectation{
+		mock:               mmRestoreUser.mock,
+		params:             &UserRepositoryMockRestoreUserParams{ctx, id},
+		expectationOrigins: UserRepositoryMockRestoreUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRestoreUser.expectations = append(mmRestoreUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.RestoreUser return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockRestoreUserExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockRestoreUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.RestoreUser should be invoked
+func (mmRestoreUser *mUserRepositoryMockRestoreUser) Times(n uint64) *mUserRepositoryMockRestoreUser {
+	if n == 0 {
+		mmRestoreUser.mock.t.Fatalf("Times of UserRepositoryMock.RestoreUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRestoreUser.expectedInvocations, n)
+	mmRestoreUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRestoreUser
+}
+
+func (mmRestoreUser *mUserRepositoryMockRestoreUser) invocationsDone() bool {
+	if len(mmRestoreUser.expectations) == 0 && mmRestoreUser.defaultExpectation == nil && mmRestoreUser.mock.funcRestoreUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRestoreUser.mock.afterRestoreUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRestoreUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RestoreUser implements mm_repository.UserRepository
+func (mmRestoreUser *UserRepositoryMock) RestoreUser(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmRestoreUser.beforeRestoreUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestoreUser.afterRestoreUserCounter, 1)
+
+	mmRestoreUser.t.Helper()
+
+	if mmRestoreUser.inspectFuncRestoreUser != nil {
+		mmRestoreUser.inspectFuncRestoreUser(ctx, id)
+	}
+
+	mm_params := UserRepositoryMockRestoreUserParams{ctx, id}
+
+	// Record call args
+	mmRestoreUser.RestoreUserMock.mutex.Lock()
+	mmRestoreUser.RestoreUserMock.callArgs = append(mmRestoreUser.RestoreUserMock.callArgs, &mm_params)
+	mmRestoreUser.RestoreUserMock.mutex.Unlock()
+
+	for _, e := range mmRestoreUser.RestoreUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRestoreUser.RestoreUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestoreUser.RestoreUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestoreUser.RestoreUserMock.defaultExpectation.params
+		mm_want_ptrs := mmRestoreUser.RestoreUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockRestoreUserParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRestoreUser.t.Errorf("UserRepositoryMock.RestoreUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreUser.RestoreUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRestoreUser.t.Errorf("UserRepositoryMock.RestoreUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreUser.RestoreUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRestoreUser.t.Errorf("UserRepositoryMock.RestoreUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRestoreUser.RestoreUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRestoreUser.RestoreUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRestoreUser.t.Fatal("No results are set for the UserRepositoryMock.RestoreUser")
+		}
+		return (*mm_results).err
+	}
+	if mmRestoreUser.funcRestoreUser != nil {
+		return mmRestoreUser.funcRestoreUser(ctx, id)
+	}
+	mmRestoreUser.t.Fatalf("Unexpected call to UserRepositoryMock.RestoreUser. %v %v", ctx, id)
+	return
+}
+
+// RestoreUserAfterCounter returns a count of finished UserRepositoryMock.RestoreUser invocations
+func (mmRestoreUser *UserRepositoryMock) RestoreUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreUser.afterRestoreUserCounter)
+}
+
+// RestoreUserBeforeCounter returns a count of UserRepositoryMock.RestoreUser invocations
+func (mmRestoreUser *UserRepositoryMock) RestoreUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreUser.beforeRestoreUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.RestoreUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRestoreUser *mUserRepositoryMockRestoreUser) Calls() []*UserRepositoryMockRestoreUserParams {
+	mmRestoreUser.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockRestoreUserParams, len(mmRestoreUser.callArgs))
+	copy(argCopy, mmRestoreUser.callArgs)
+
+	mmRestoreUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRestoreUserDone returns true if the count of the RestoreUser invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockRestoreUserDone() bool {
+	if m.RestoreUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RestoreUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RestoreUserMock.invocationsDone()
+}
+
+// MinimockRestoreUserInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockRestoreUserInspect() {
+	for _, e := range m.RestoreUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.RestoreUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRestoreUserCounter := mm_atomic.LoadUint64(&m.afterRestoreUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreUserMock.defaultExpectation != nil && afterRestoreUserCounter < 1 {
+		if m.RestoreUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.RestoreUser at\n%s", m.RestoreUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.RestoreUser at\n%s with params: %#v", m.RestoreUserMock.defaultExpectation.expectationOrigins.origin, *m.RestoreUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestoreUser != nil && afterRestoreUserCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.RestoreUser at\n%s", m.funcRestoreUserOrigin)
+	}
+
+	if !m.RestoreUserMock.invocationsDone() && afterRestoreUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.RestoreUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RestoreUserMock.expectedInvocations), m.RestoreUserMock.expectedInvocationsOrigin, afterRestoreUserCounter)
+	}
+}
+
+type mUserRepositoryMockUpdateUser struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockUpdateUserExpectation
+	expectations       []*UserRepositoryMockUpdateUserExpectation
+
+	callArgs []*UserRepositoryMockUpdateUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockUpdateUserExpectation specifies expectation struct of the UserRepository.UpdateUser
+type UserRepositoryMockUpdateUserExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockUpdateUserParams
+	paramPtrs          *UserRepositoryMockUpdateUserParamPtrs
+	expectationOrigins UserRepositoryMockUpdateUserExpectationOrigins
+	results            *UserRepositoryMockUpdateUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockUpdateUserParams contains parameters of the UserRepository.UpdateUser
+type UserRepositoryMockUpdateUserParams struct {
+	ctx  context.Context
+	user *model.UserUpdate
+}
+
+// UserRepositoryMockUpdateUserParamPtrs contains pointers to parameters of the UserRepository.UpdateUser
+type UserRepositoryMockUpdateUserParamPtrs struct {
+	ctx  *context.Context
+	user **model.UserUpdate
+}
+
+// UserRepositoryMockUpdateUserResults contains results of the UserRepository.UpdateUser
+type UserRepositoryMockUpdateUserResults struct {
+	err error
+}
+
+// UserRepositoryMockUpdateUserOrigins contains origins of expectations of the UserRepository.UpdateUser
+type UserRepositoryMockUpdateUserExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originUser string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) Optional() *mUserRepositoryMockUpdateUser {
+	mmUpdateUser.optional = true
+	return mmUpdateUser
+}
+
+// Expect sets up expected params for UserRepository.UpdateUser
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) Expect(ctx context.Context, user *model.UserUpdate) *mUserRepositoryMockUpdateUser {
+	if mmUpdateUser.mock.funcUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
+	}
+
+	if mmUpdateUser.defaultExpectation == nil {
+		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{}
+	}
+
+	if mmUpdateUser.defaultExpectation.paramPtrs != nil {
+		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateUser.defaultExpectation.params = &UserRepositoryMockUpdateUserParams{ctx, user}
+	mmUpdateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateUser.expectations {
+		if minimock.Equal(e.params, mmUpdateUser.defaultExpectation.params) {
+			mmUpdateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateUser.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.UpdateUser
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUpdateUser {
+	if mmUpdateUser.mock.funcUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
+	}
+
+	if mmUpdateUser.defaultExpectation == nil {
+		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{}
+	}
+
+	if mmUpdateUser.defaultExpectation.params != nil {
+		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Expect")
+	}
+
+	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
+		mmUpdateUser.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateUserParamPtrs{}
+	}
+	mmUpdateUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateUser
+}
+
+// ExpectUserParam2 sets up expected param user for UserRepository.UpdateUser
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) ExpectUserParam2(user *model.UserUpdate) *mUserRepositoryMockUpdateUser {
+	if mmUpdateUser.mock.funcUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
+	}
+
+	if mmUpdateUser.defaultExpectation == nil {
+		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{}
+	}
+
+	if mmUpdateUser.defaultExpectation.params != nil {
+		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Expect")
+	}
+
+	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
+		mmUpdateUser.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateUserParamPtrs{}
+	}
+	mmUpdateUser.defaultExpectation.paramPtrs.user = &user
+	mmUpdateUser.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+
+	return mmUpdateUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.UpdateUser
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) Inspect(f func(ctx context.Context, user *model.UserUpdate)) *mUserRepositoryMockUpdateUser {
+	if mmUpdateUser.mock.inspectFuncUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.UpdateUser")
+	}
+
+	mmUpdateUser.mock.inspectFuncUpdateUser = f
+
+	return mmUpdateUser
+}
+
+// Return sets up results that will be returned by UserRepository.UpdateUser
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) Return(err error) *UserRepositoryMock {
+	if mmUpdateUser.mock.funcUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
+	}
+
+	if mmUpdateUser.defaultExpectation == nil {
+		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{mock: mmUpdateUser.mock}
+	}
+	mmUpdateUser.defaultExpectation.results = &UserRepositoryMockUpdateUserResults{err}
 	mmUpdateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmUpdateUser.mock
 }
@@ -1443,17 +3249,402 @@ func (m *UserRepositoryMock) MinimockUpdateUserInspect() {
 	}
 }
 
+type mUserRepositoryMockUpdateUserPassword struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockUpdateUserPasswordExpectation
+	expectations       []*UserRepositoryMockUpdateUserPasswordExpectation
+
+	callArgs []*UserRepositoryMockUpdateUserPasswordParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockUpdateUserPasswordExpectation specifies expectation struct of the UserRepository.UpdateUserPassword
+type UserRepositoryMockUpdateUserPasswordExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockUpdateUserPasswordParams
+	paramPtrs          *UserRepositoryMockUpdateUserPasswordParamPtrs
+	expectationOrigins UserRepositoryMockUpdateUserPasswordExpectationOrigins
+	results            *UserRepositoryMockUpdateUserPasswordResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockUpdateUserPasswordParams contains parameters of the UserRepository.UpdateUserPassword
+type UserRepositoryMockUpdateUserPasswordParams struct {
+	ctx          context.Context
+	id           int64
+	passwordHash string
+}
+
+// UserRepositoryMockUpdateUserPasswordParamPtrs contains pointers to parameters of the UserRepository.UpdateUserPassword
+type UserRepositoryMockUpdateUserPasswordParamPtrs struct {
+	ctx          *context.Context
+	id           *int64
+	passwordHash *string
+}
+
+// UserRepositoryMockUpdateUserPasswordResults contains results of the UserRepository.UpdateUserPassword
+type UserRepositoryMockUpdateUserPasswordResults struct {
+	err error
+}
+
+// UserRepositoryMockUpdateUserPasswordOrigins contains origins of expectations of the UserRepository.UpdateUserPassword
+type UserRepositoryMockUpdateUserPasswordExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originId           string
+	originPasswordHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) Optional() *mUserRepositoryMockUpdateUserPassword {
+	mmUpdateUserPassword.optional = true
+	return mmUpdateUserPassword
+}
+
+// Expect sets up expected params for UserRepository.UpdateUserPassword
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) Expect(ctx context.Context, id int64, passwordHash string) *mUserRepositoryMockUpdateUserPassword {
+	if mmUpdateUserPassword.mock.funcUpdateUserPassword != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by Set")
+	}
+
+	if mmUpdateUserPassword.defaultExpectation == nil {
+		mmUpdateUserPassword.defaultExpectation = &UserRepositoryMockUpdateUserPasswordExpectation{}
+	}
+
+	if mmUpdateUserPassword.defaultExpectation.paramPtrs != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateUserPassword.defaultExpectation.params = &UserRepositoryMockUpdateUserPasswordParams{ctx, id, passwordHash}
+	mmUpdateUserPassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateUserPassword.expectations {
+		if minimock.Equal(e.params, mmUpdateUserPassword.defaultExpectation.params) {
+			mmUpdateUserPassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateUserPassword.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateUserPassword
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.UpdateUserPassword
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUpdateUserPassword {
+	if mmUpdateUserPassword.mock.funcUpdateUserPassword != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by Set")
+	}
+
+	if mmUpdateUserPassword.defaultExpectation == nil {
+		mmUpdateUserPassword.defaultExpectation = &UserRepositoryMockUpdateUserPasswordExpectation{}
+	}
+
+	if mmUpdateUserPassword.defaultExpectation.params != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by Expect")
+	}
+
+	if mmUpdateUserPassword.defaultExpectation.paramPtrs == nil {
+		mmUpdateUserPassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateUserPasswordParamPtrs{}
+	}
+	mmUpdateUserPassword.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateUserPassword.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateUserPassword
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.UpdateUserPassword
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) ExpectIdParam2(id int64) *mUserRepositoryMockUpdateUserPassword {
+	if mmUpdateUserPassword.mock.funcUpdateUserPassword != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by Set")
+	}
+
+	if mmUpdateUserPassword.defaultExpectation == nil {
+		mmUpdateUserPassword.defaultExpectation = &UserRepositoryMockUpdateUserPasswordExpectation{}
+	}
+
+	if mmUpdateUserPassword.defaultExpectation.params != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by Expect")
+	}
+
+	if mmUpdateUserPassword.defaultExpectation.paramPtrs == nil {
+		mmUpdateUserPassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateUserPasswordParamPtrs{}
+	}
+	mmUpdateUserPassword.defaultExpectation.paramPtrs.id = &id
+	mmUpdateUserPassword.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdateUserPassword
+}
+
+// ExpectPasswordHashParam3 sets up expected param passwordHash for UserRepository.UpdateUserPassword
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) ExpectPasswordHashParam3(passwordHash string) *mUserRepositoryMockUpdateUserPassword {
+	if mmUpdateUserPassword.mock.funcUpdateUserPassword != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by Set")
+	}
+
+	if mmUpdateUserPassword.defaultExpectation == nil {
+		mmUpdateUserPassword.defaultExpectation = &UserRepositoryMockUpdateUserPasswordExpectation{}
+	}
+
+	if mmUpdateUserPassword.defaultExpectation.params != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by Expect")
+	}
+
+	if mmUpdateUserPassword.defaultExpectation.paramPtrs == nil {
+		mmUpdateUserPassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateUserPasswordParamPtrs{}
+	}
+	mmUpdateUserPassword.defaultExpectation.paramPtrs.passwordHash = &passwordHash
+	mmUpdateUserPassword.defaultExpectation.expectationOrigins.originPasswordHash = minimock.CallerInfo(1)
+
+	return mmUpdateUserPassword
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.UpdateUserPassword
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) Inspect(f func(ctx context.Context, id int64, passwordHash string)) *mUserRepositoryMockUpdateUserPassword {
+	if mmUpdateUserPassword.mock.inspectFuncUpdateUserPassword != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.UpdateUserPassword")
+	}
+
+	mmUpdateUserPassword.mock.inspectFuncUpdateUserPassword = f
+
+	return mmUpdateUserPassword
+}
+
+// Return sets up results that will be returned by UserRepository.UpdateUserPassword
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) Return(err error) *UserRepositoryMock {
+	if mmUpdateUserPassword.mock.funcUpdateUserPassword != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by Set")
+	}
+
+	if mmUpdateUserPassword.defaultExpectation == nil {
+		mmUpdateUserPassword.defaultExpectation = &UserRepositoryMockUpdateUserPasswordExpectation{mock: mmUpdateUserPassword.mock}
+	}
+	mmUpdateUserPassword.defaultExpectation.results = &UserRepositoryMockUpdateUserPasswordResults{err}
+	mmUpdateUserPassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateUserPassword.mock
+}
+
+// Set uses given function f to mock the UserRepository.UpdateUserPassword method
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) Set(f func(ctx context.Context, id int64, passwordHash string) (err error)) *UserRepositoryMock {
+	if mmUpdateUserPassword.defaultExpectation != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("Default expectation is already set for the UserRepository.UpdateUserPassword method")
+	}
+
+	if len(mmUpdateUserPassword.expectations) > 0 {
+		mmUpdateUserPassword.mock.t.Fatalf("Some expectations are already set for the UserRepository.UpdateUserPassword method")
+	}
+
+	mmUpdateUserPassword.mock.funcUpdateUserPassword = f
+	mmUpdateUserPassword.mock.funcUpdateUserPasswordOrigin = minimock.CallerInfo(1)
+	return mmUpdateUserPassword.mock
+}
+
+// When sets expectation for the UserRepository.UpdateUserPassword which will trigger the result defined by the following
+// Then helper
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) When(ctx context.Context, id int64, passwordHash string) *UserRepositoryMockUpdateUserPasswordExpectation {
+	if mmUpdateUserPassword.mock.funcUpdateUserPassword != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockUpdateUserPasswordExpectation{
+		mock:               mmUpdateUserPassword.mock,
+		params:             &UserRepositoryMockUpdateUserPasswordParams{ctx, id, passwordHash},
+		expectationOrigins: UserRepositoryMockUpdateUserPasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateUserPassword.expectations = append(mmUpdateUserPassword.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.UpdateUserPassword return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockUpdateUserPasswordExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockUpdateUserPasswordResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.UpdateUserPassword should be invoked
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) Times(n uint64) *mUserRepositoryMockUpdateUserPassword {
+	if n == 0 {
+		mmUpdateUserPassword.mock.t.Fatalf("Times of UserRepositoryMock.UpdateUserPassword mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateUserPassword.expectedInvocations, n)
+	mmUpdateUserPassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateUserPassword
+}
+
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) invocationsDone() bool {
+	if len(mmUpdateUserPassword.expectations) == 0 && mmUpdateUserPassword.defaultExpectation == nil && mmUpdateUserPassword.mock.funcUpdateUserPassword == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateUserPassword.mock.afterUpdateUserPasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateUserPassword.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateUserPassword implements mm_repository.UserRepository
+func (mmUpdateUserPassword *UserRepositoryMock) UpdateUserPassword(ctx context.Context, id int64, passwordHash string) (err error) {
+	mm_atomic.AddUint64(&mmUpdateUserPassword.beforeUpdateUserPasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateUserPassword.afterUpdateUserPasswordCounter, 1)
+
+	mmUpdateUserPassword.t.Helper()
+
+	if mmUpdateUserPassword.inspectFuncUpdateUserPassword != nil {
+		mmUpdateUserPassword.inspectFuncUpdateUserPassword(ctx, id, passwordHash)
+	}
+
+	mm_params := UserRepositoryMockUpdateUserPasswordParams{ctx, id, passwordHash}
+
+	// Record call args
+	mmUpdateUserPassword.UpdateUserPasswordMock.mutex.Lock()
+	mmUpdateUserPassword.UpdateUserPasswordMock.callArgs = append(mmUpdateUserPassword.UpdateUserPasswordMock.callArgs, &mm_params)
+	mmUpdateUserPassword.UpdateUserPasswordMock.mutex.Unlock()
+
+	for _, e := range mmUpdateUserPassword.UpdateUserPasswordMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateUserPassword.UpdateUserPasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateUserPassword.UpdateUserPasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateUserPassword.UpdateUserPasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateUserPassword.UpdateUserPasswordMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockUpdateUserPasswordParams{ctx, id, passwordHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateUserPassword.t.Errorf("UserRepositoryMock.UpdateUserPassword got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateUserPassword.UpdateUserPasswordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdateUserPassword.t.Errorf("UserRepositoryMock.UpdateUserPassword got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateUserPassword.UpdateUserPasswordMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.passwordHash != nil && !minimock.Equal(*mm_want_ptrs.passwordHash, mm_got.passwordHash) {
+				mmUpdateUserPassword.t.Errorf("UserRepositoryMock.UpdateUserPassword got unexpected parameter passwordHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateUserPassword.UpdateUserPasswordMock.defaultExpectation.expectationOrigins.originPasswordHash, *mm_want_ptrs.passwordHash, mm_got.passwordHash, minimock.Diff(*mm_want_ptrs.passwordHash, mm_got.passwordHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateUserPassword.t.Errorf("UserRepositoryMock.UpdateUserPassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateUserPassword.UpdateUserPasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateUserPassword.UpdateUserPasswordMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateUserPassword.t.Fatal("No results are set for the UserRepositoryMock.UpdateUserPassword")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateUserPassword.funcUpdateUserPassword != nil {
+		return mmUpdateUserPassword.funcUpdateUserPassword(ctx, id, passwordHash)
+	}
+	mmUpdateUserPassword.t.Fatalf("Unexpected call to UserRepositoryMock.UpdateUserPassword. %v %v %v", ctx, id, passwordHash)
+	return
+}
+
+// UpdateUserPasswordAfterCounter returns a count of finished UserRepositoryMock.UpdateUserPassword invocations
+func (mmUpdateUserPassword *UserRepositoryMock) UpdateUserPasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateUserPassword.afterUpdateUserPasswordCounter)
+}
+
+// UpdateUserPasswordBeforeCounter returns a count of UserRepositoryMock.UpdateUserPassword invocations
+func (mmUpdateUserPassword *UserRepositoryMock) UpdateUserPasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateUserPassword.beforeUpdateUserPasswordCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.UpdateUserPassword.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) Calls() []*UserRepositoryMockUpdateUserPasswordParams {
+	mmUpdateUserPassword.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockUpdateUserPasswordParams, len(mmUpdateUserPassword.callArgs))
+	copy(argCopy, mmUpdateUserPassword.callArgs)
+
+	mmUpdateUserPassword.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateUserPasswordDone returns true if the count of the UpdateUserPassword invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockUpdateUserPasswordDone() bool {
+	if m.UpdateUserPasswordMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateUserPasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateUserPasswordMock.invocationsDone()
+}
+
+// MinimockUpdateUserPasswordInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockUpdateUserPasswordInspect() {
+	for _, e := range m.UpdateUserPasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdateUserPassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateUserPasswordCounter := mm_atomic.LoadUint64(&m.afterUpdateUserPasswordCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateUserPasswordMock.defaultExpectation != nil && afterUpdateUserPasswordCounter < 1 {
+		if m.UpdateUserPasswordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdateUserPassword at\n%s", m.UpdateUserPasswordMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdateUserPassword at\n%s with params: %#v", m.UpdateUserPasswordMock.defaultExpectation.expectationOrigins.origin, *m.UpdateUserPasswordMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateUserPassword != nil && afterUpdateUserPasswordCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.UpdateUserPassword at\n%s", m.funcUpdateUserPasswordOrigin)
+	}
+
+	if !m.UpdateUserPasswordMock.invocationsDone() && afterUpdateUserPasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.UpdateUserPassword at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateUserPasswordMock.expectedInvocations), m.UpdateUserPasswordMock.expectedInvocationsOrigin, afterUpdateUserPasswordCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *UserRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
 			m.MinimockCreateUserInspect()
 
+			m.MinimockCreateUsersInspect()
+
 			m.MinimockDeleteUserInspect()
 
 			m.MinimockGetUserInspect()
 
+			m.MinimockGetUserAuthByEmailInspect()
+
+			m.MinimockListUsersInspect()
+
+			m.MinimockPurgeDeletedUsersInspect()
+
+			m.MinimockRestoreUserInspect()
+
 			m.MinimockUpdateUserInspect()
+
+			m.MinimockUpdateUserPasswordInspect()
 		}
 	})
 }
@@ -1478,7 +3669,13 @@ func (m *UserRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockCreateUserDone() &&
+		m.MinimockCreateUsersDone() &&
 		m.MinimockDeleteUserDone() &&
 		m.MinimockGetUserDone() &&
-		m.MinimockUpdateUserDone()
+		m.MinimockGetUserAuthByEmailDone() &&
+		m.MinimockListUsersDone() &&
+		m.MinimockPurgeDeletedUsersDone() &&
+		m.MinimockRestoreUserDone() &&
+		m.MinimockUpdateUserDone() &&
+		m.MinimockUpdateUserPasswordDone()
 }
@@ -0,0 +1,22 @@
+package converter
+
+import (
+	"github.com/ipv02/auth/internal/model"
+	modelRepo "github.com/ipv02/auth/internal/repository/outbox/pg/model"
+)
+
+// ToOutboxEventsFromRepo конвертер событий outbox из репо-слоя в модели для сервисного слоя
+func ToOutboxEventsFromRepo(events []*modelRepo.OutboxEvent) []*model.OutboxEvent {
+	res := make([]*model.OutboxEvent, 0, len(events))
+	for _, event := range events {
+		res = append(res, &model.OutboxEvent{
+			ID:          event.ID,
+			AggregateID: event.AggregateID,
+			EventType:   event.EventType,
+			Payload:     event.Payload,
+			CreatedAt:   event.CreatedAt,
+		})
+	}
+
+	return res
+}
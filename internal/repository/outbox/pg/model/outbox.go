@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// OutboxEvent модель события outbox для работы в репо слое
+type OutboxEvent struct {
+	ID          int64     `db:"id"`
+	AggregateID int64     `db:"aggregate_id"`
+	EventType   string    `db:"event_type"`
+	Payload     []byte    `db:"payload"`
+	CreatedAt   time.Time `db:"created_at"`
+}
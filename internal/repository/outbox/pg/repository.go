@@ -0,0 +1,147 @@
+package pg
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/client/db"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/repository"
+	"github.com/ipv02/auth/internal/repository/outbox/pg/converter"
+	modelRepo "github.com/ipv02/auth/internal/repository/outbox/pg/model"
+)
+
+const (
+	tableName = "outbox"
+
+	idColumn          = "id"
+	aggregateIDColumn = "aggregate_id"
+	eventTypeColumn   = "event_type"
+	payloadColumn     = "payload"
+	createdAtColumn   = "created_at"
+
+	// addEventsChunkSize число строк в одном insert, ограничивает число параметров запроса
+	addEventsChunkSize = 1000
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository создает новый экземпляр OutboxRepository с подключением к базе данных
+func NewRepository(db db.Client) repository.OutboxRepository {
+	return &repo{db: db}
+}
+
+// AddEvent сохраняет событие в outbox, вызывается в транзакции изменения пользователя
+func (r *repo) AddEvent(ctx context.Context, event *model.OutboxEvent) error {
+	builderInsert := sq.Insert(tableName).
+		Columns(aggregateIDColumn, eventTypeColumn, payloadColumn).
+		Values(event.AggregateID, event.EventType, event.Payload).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "failed to generate query")
+	}
+
+	q := db.Query{
+		Name:     "outbox_repository.AddEvent",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to execute query")
+	}
+
+	return nil
+}
+
+// AddEvents сохраняет события в outbox многострочными insert, вызывается в транзакции изменения пользователей
+func (r *repo) AddEvents(ctx context.Context, events []*model.OutboxEvent) error {
+	for start := 0; start < len(events); start += addEventsChunkSize {
+		end := min(start+addEventsChunkSize, len(events))
+
+		builderInsert := sq.Insert(tableName).
+			Columns(aggregateIDColumn, eventTypeColumn, payloadColumn).
+			PlaceholderFormat(sq.Dollar)
+		for _, event := range events[start:end] {
+			builderInsert = builderInsert.Values(event.AggregateID, event.EventType, event.Payload)
+		}
+
+		query, args, err := builderInsert.ToSql()
+		if err != nil {
+			return errors.Wrap(err, "failed to generate query")
+		}
+
+		q := db.Query{
+			Name:     "outbox_repository.AddEvents",
+			QueryRaw: query,
+		}
+
+		_, err = r.db.DB().ExecContext(ctx, q, args...)
+		if err != nil {
+			return errors.Wrap(err, "failed to execute query")
+		}
+	}
+
+	return nil
+}
+
+// GetPendingEvents возвращает не более limit самых старых событий и блокирует их до конца транзакции.
+// Заблокированные другим relay события пропускаются
+func (r *repo) GetPendingEvents(ctx context.Context, limit uint64) ([]*model.OutboxEvent, error) {
+	builderSelect := sq.
+		Select(idColumn, aggregateIDColumn, eventTypeColumn, payloadColumn, createdAtColumn).
+		From(tableName).
+		OrderBy(idColumn).
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate query")
+	}
+
+	q := db.Query{
+		Name:     "outbox_repository.GetPendingEvents",
+		QueryRaw: query,
+	}
+
+	var events []*modelRepo.OutboxEvent
+	err = r.db.DB().ScanAllContext(ctx, &events, q, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute query")
+	}
+
+	return converter.ToOutboxEventsFromRepo(events), nil
+}
+
+// DeleteEvents удаляет отправленные события
+func (r *repo) DeleteEvents(ctx context.Context, ids []int64) error {
+	builderDelete := sq.
+		Delete(tableName).
+		Where(sq.Eq{idColumn: ids}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "failed to generate query")
+	}
+
+	q := db.Query{
+		Name:     "outbox_repository.DeleteEvents",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to execute query")
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package pg
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/client/db"
+	"github.com/ipv02/auth/internal/repository"
+)
+
+const (
+	tableName = "processed_messages"
+
+	idempotencyKeyColumn = "idempotency_key"
+	processedAtColumn    = "processed_at"
+
+	// markProcessedChunkSize число строк в одном insert, ограничивает число параметров запроса
+	markProcessedChunkSize = 5000
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository создает новый экземпляр ProcessedMessageRepository с подключением к базе данных
+func NewRepository(db db.Client) repository.ProcessedMessageRepository {
+	return &repo{db: db}
+}
+
+// MarkProcessed сохраняет ключ идемпотентности сообщения и возвращает false, если он уже был сохранен.
+// Вызывается в транзакции обработки сообщения, поэтому ключ сохраняется только вместе с ее результатом
+func (r *repo) MarkProcessed(ctx context.Context, key string) (bool, error) {
+	builderInsert := sq.Insert(tableName).
+		Columns(idempotencyKeyColumn).
+		Values(key).
+		Suffix("ON CONFLICT (" + idempotencyKeyColumn + ") DO NOTHING").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to generate query")
+	}
+
+	q := db.Query{
+		Name:     "processed_message_repository.MarkProcessed",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to execute query")
+	}
+
+	return res.RowsAffected() == 1, nil
+}
+
+// MarkProcessedBatch сохраняет ключи идемпотентности пакета сообщений и возвращает ключи, которых еще не было.
+// Повторяющиеся в пакете ключи возвращаются один раз
+func (r *repo) MarkProcessedBatch(ctx context.Context, keys []string) ([]string, error) {
+	marked := make([]string, 0, len(keys))
+	for start := 0; start < len(keys); start += markProcessedChunkSize {
+		end := min(start+markProcessedChunkSize, len(keys))
+
+		builderInsert := sq.Insert(tableName).
+			Columns(idempotencyKeyColumn).
+			Suffix("ON CONFLICT (" + idempotencyKeyColumn + ") DO NOTHING RETURNING " + idempotencyKeyColumn).
+			PlaceholderFormat(sq.Dollar)
+		for _, key := range keys[start:end] {
+			builderInsert = builderInsert.Values(key)
+		}
+
+		query, args, err := builderInsert.ToSql()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate query")
+		}
+
+		q := db.Query{
+			Name:     "processed_message_repository.MarkProcessedBatch",
+			QueryRaw: query,
+		}
+
+		var chunkMarked []string
+		err = r.db.DB().ScanAllContext(ctx, &chunkMarked, q, args...)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to execute query")
+		}
+
+		marked = append(marked, chunkMarked...)
+	}
+
+	return marked, nil
+}
+
+// PurgeProcessedMessages удаляет не более limit ключей идемпотентности,
+// сохраненных раньше processedBefore, и возвращает их количество
+func (r *repo) PurgeProcessedMessages(ctx context.Context, processedBefore time.Time, limit uint64) (int64, error) {
+	builderSelect := sq.
+		Select(idempotencyKeyColumn).
+		From(tableName).
+		Where(sq.Lt{processedAtColumn: processedBefore}).
+		OrderBy(processedAtColumn).
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	builderDelete := sq.
+		Delete(tableName).
+		Where(builderSelect.Prefix(idempotencyKeyColumn + " IN (").Suffix(")")).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to generate query")
+	}
+
+	q := db.Query{
+		Name:     "processed_message_repository.Purge",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to execute query")
+	}
+
+	return res.RowsAffected(), nil
+}
@@ -0,0 +1,22 @@
+package converter
+
+import (
+	"github.com/ipv02/auth/internal/model"
+	modelRepo "github.com/ipv02/auth/internal/repository/refresh_token/pg/model"
+)
+
+// ToRefreshTokenFromRepo конвертер модели refresh токена из репо-слоя в модель для сервисного слоя
+func ToRefreshTokenFromRepo(token *modelRepo.RefreshToken) *model.RefreshToken {
+	if token == nil {
+		return nil
+	}
+
+	return &model.RefreshToken{
+		ID:        token.ID,
+		FamilyID:  token.FamilyID,
+		UserID:    token.UserID,
+		ExpiresAt: token.ExpiresAt,
+		UsedAt:    token.UsedAt,
+		RevokedAt: token.RevokedAt,
+	}
+}
@@ -0,0 +1,16 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// RefreshToken модель refresh токена для работы в репо слое
+type RefreshToken struct {
+	ID        string       `db:"id"`
+	FamilyID  string       `db:"family_id"`
+	UserID    int64        `db:"user_id"`
+	ExpiresAt time.Time    `db:"expires_at"`
+	UsedAt    sql.NullTime `db:"used_at"`
+	RevokedAt sql.NullTime `db:"revoked_at"`
+}
@@ -0,0 +1,145 @@
+package pg
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/client/db"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/repository"
+	"github.com/ipv02/auth/internal/repository/refresh_token/pg/converter"
+	modelRepo "github.com/ipv02/auth/internal/repository/refresh_token/pg/model"
+)
+
+const (
+	tableName = "refresh_tokens"
+
+	idColumn        = "id"
+	familyIDColumn  = "family_id"
+	userIDColumn    = "user_id"
+	expiresAtColumn = "expires_at"
+	usedAtColumn    = "used_at"
+	revokedAtColumn = "revoked_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository создает новый экземпляр RefreshTokenRepository с подключением к базе данных
+func NewRepository(db db.Client) repository.RefreshTokenRepository {
+	return &repo{db: db}
+}
+
+// CreateRefreshToken сохраняет выданный refresh токен
+func (r *repo) CreateRefreshToken(ctx context.Context, token *model.RefreshToken) error {
+	builderInsert := sq.Insert(tableName).
+		Columns(idColumn, familyIDColumn, userIDColumn, expiresAtColumn).
+		Values(token.ID, token.FamilyID, token.UserID, token.ExpiresAt).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "failed to generate query")
+	}
+
+	q := db.Query{
+		Name:     "refresh_token_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to execute query")
+	}
+
+	return nil
+}
+
+// GetRefreshToken возвращает refresh токен по идентификатору и блокирует строку до конца транзакции
+func (r *repo) GetRefreshToken(ctx context.Context, id string) (*model.RefreshToken, error) {
+	builderSelect := sq.
+		Select(idColumn, familyIDColumn, userIDColumn, expiresAtColumn, usedAtColumn, revokedAtColumn).
+		From(tableName).
+		Where(sq.Eq{idColumn: id}).
+		PlaceholderFormat(sq.Dollar).
+		Suffix("FOR UPDATE")
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate query")
+	}
+
+	q := db.Query{
+		Name:     "refresh_token_repository.Get",
+		QueryRaw: query,
+	}
+
+	var token modelRepo.RefreshToken
+	err = r.db.DB().ScanOneContext(ctx, &token, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrorInvalidToken
+		}
+
+		return nil, errors.Wrap(err, "failed to execute query")
+	}
+
+	return converter.ToRefreshTokenFromRepo(&token), nil
+}
+
+// MarkRefreshTokenUsed помечает refresh токен использованным
+func (r *repo) MarkRefreshTokenUsed(ctx context.Context, id string) error {
+	builderUpdate := sq.
+		Update(tableName).
+		Set(usedAtColumn, time.Now()).
+		Where(sq.Eq{idColumn: id}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "failed to generate query")
+	}
+
+	q := db.Query{
+		Name:     "refresh_token_repository.MarkUsed",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to execute query")
+	}
+
+	return nil
+}
+
+// RevokeRefreshTokenFamily отзывает все refresh токены семейства
+func (r *repo) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	builderUpdate := sq.
+		Update(tableName).
+		Set(revokedAtColumn, time.Now()).
+		Where(sq.Eq{familyIDColumn: familyID, revokedAtColumn: nil}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "failed to generate query")
+	}
+
+	q := db.Query{
+		Name:     "refresh_token_repository.RevokeFamily",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to execute query")
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/ipv02/auth/internal/model"
 )
@@ -9,7 +10,41 @@ import (
 // UserRepository интерфейс описывающий репо слой
 type UserRepository interface {
 	CreateUser(ctx context.Context, user *model.UserCreate) (int64, error)
+	CreateUsers(ctx context.Context, users []*model.UserCreate) ([]int64, error)
 	GetUser(ctx context.Context, id int64) (*model.UserGet, error)
 	UpdateUser(ctx context.Context, user *model.UserUpdate) error
 	DeleteUser(ctx context.Context, id int64) error
+	GetUserAuthByEmail(ctx context.Context, email string) (*model.UserAuth, error)
+	UpdateUserPassword(ctx context.Context, id int64, passwordHash string) error
+	ListUsers(ctx context.Context, query *model.UserListQuery) ([]*model.UserGet, error)
+	RestoreUser(ctx context.Context, id int64) error
+	PurgeDeletedUsers(ctx context.Context, deletedBefore time.Time, limit uint64) (int64, error)
+}
+
+// RefreshTokenRepository интерфейс описывающий репо слой refresh токенов
+type RefreshTokenRepository interface {
+	CreateRefreshToken(ctx context.Context, token *model.RefreshToken) error
+	GetRefreshToken(ctx context.Context, id string) (*model.RefreshToken, error)
+	MarkRefreshTokenUsed(ctx context.Context, id string) error
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string) error
+}
+
+// AccessRepository интерфейс описывающий репо слой правил доступа
+type AccessRepository interface {
+	GetAccessPolicies(ctx context.Context) ([]*model.AccessPolicy, error)
+}
+
+// OutboxRepository интерфейс описывающий репо слой outbox событий
+type OutboxRepository interface {
+	AddEvent(ctx context.Context, event *model.OutboxEvent) error
+	AddEvents(ctx context.Context, events []*model.OutboxEvent) error
+	GetPendingEvents(ctx context.Context, limit uint64) ([]*model.OutboxEvent, error)
+	DeleteEvents(ctx context.Context, ids []int64) error
+}
+
+// ProcessedMessageRepository интерфейс описывающий репо слой обработанных сообщений kafka
+type ProcessedMessageRepository interface {
+	MarkProcessed(ctx context.Context, key string) (bool, error)
+	MarkProcessedBatch(ctx context.Context, keys []string) ([]string, error)
+	PurgeProcessedMessages(ctx context.Context, processedBefore time.Time, limit uint64) (int64, error)
 }
@@ -0,0 +1,242 @@
+package cached
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strconv"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/ipv02/auth/internal/client/cache"
+	"github.com/ipv02/auth/internal/client/db"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/repository"
+)
+
+const (
+	userCacheKeyPrefix = "user:cache:"
+
+	// notFoundValue значение кеша для несуществующего пользователя
+	notFoundValue = "null"
+	// writtenValue значение кеша для недавно измененного пользователя, которого нужно читать с мастера
+	writtenValue = "written"
+)
+
+// cacheState состояние записи кеша пользователя
+type cacheState int
+
+const (
+	cacheMiss cacheState = iota
+	cacheHit
+	cacheWritten
+)
+
+// repo кеширует GetUser основного репозитория в redis, остальные методы вызываются напрямую.
+// После коммита записи в основной репозиторий кеш пользователя на masterReadTTL заменяется меткой,
+// и промахи в это время читают пользователя с мастера, а не с отстающей реплики.
+// Устаревшее значение может прожить не дольше ttl, только если чтение, начатое до записи,
+// сохранит результат в кеш после нее
+type repo struct {
+	repository.UserRepository
+
+	cl            cache.RedisClient
+	ttl           time.Duration
+	negativeTTL   time.Duration
+	masterReadTTL time.Duration
+
+	group singleflight.Group
+}
+
+// NewRepository создает репозиторий с кешем поверх основного репозитория
+func NewRepository(
+	userRepository repository.UserRepository,
+	cl cache.RedisClient,
+	ttl time.Duration,
+	negativeTTL time.Duration,
+	masterReadTTL time.Duration,
+) repository.UserRepository {
+	return &repo{
+		UserRepository: userRepository,
+		cl:             cl,
+		ttl:            ttl,
+		negativeTTL:    negativeTTL,
+		masterReadTTL:  masterReadTTL,
+	}
+}
+
+// GetUser возвращает пользователя из кеша, а при промахе читает его из основного репозитория.
+// Одновременные промахи по одному id выполняют один запрос, отсутствие пользователя тоже кешируется.
+// Запрос выполняется без отмены, потому что его результат получают все ожидающие вызовы.
+// В транзакции пользователь читается из основного репозитория без кеша, чтобы не разделить
+// с другими вызовами и не закешировать незакоммиченные данные
+func (r *repo) GetUser(ctx context.Context, id int64) (*model.UserGet, error) {
+	if db.InTransaction(ctx) {
+		return r.UserRepository.GetUser(ctx, id)
+	}
+
+	user, state, err := r.getCached(ctx, id)
+	if err != nil {
+		log.Printf("failed to get user %d from cache: %v\n", id, err)
+	}
+	if state == cacheHit {
+		if user == nil {
+			return nil, model.ErrorUserNotFound
+		}
+
+		return user, nil
+	}
+
+	key := cacheKey(id)
+	if state == cacheWritten {
+		key += ":master"
+	}
+
+	res, err, _ := r.group.Do(key, func() (interface{}, error) {
+		ctx := context.WithoutCancel(ctx)
+		if state == cacheWritten {
+			ctx = db.ContextWithMasterRead(ctx)
+		}
+
+		user, err := r.UserRepository.GetUser(ctx, id)
+		if errors.Is(err, model.ErrorUserNotFound) {
+			r.setCached(ctx, id, nil, r.negativeTTL)
+			return nil, err
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		r.setCached(ctx, id, user, r.ttl)
+		return user, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// результат разделяется между всеми ожидавшими вызовами, поэтому каждому отдается копия
+	userCopy := *res.(*model.UserGet)
+	return &userCopy, nil
+}
+
+// CreateUser сбрасывает кеш отсутствия пользователя с выданным id
+func (r *repo) CreateUser(ctx context.Context, user *model.UserCreate) (int64, error) {
+	id, err := r.UserRepository.CreateUser(ctx, user)
+	if err != nil {
+		return 0, err
+	}
+
+	r.invalidate(ctx, id)
+
+	return id, nil
+}
+
+// CreateUsers сбрасывает кеш отсутствия пользователей с выданными id
+func (r *repo) CreateUsers(ctx context.Context, users []*model.UserCreate) ([]int64, error) {
+	ids, err := r.UserRepository.CreateUsers(ctx, users)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		r.invalidate(ctx, id)
+	}
+
+	return ids, nil
+}
+
+func (r *repo) UpdateUser(ctx context.Context, user *model.UserUpdate) error {
+	err := r.UserRepository.UpdateUser(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	r.invalidate(ctx, user.ID)
+
+	return nil
+}
+
+func (r *repo) DeleteUser(ctx context.Context, id int64) error {
+	err := r.UserRepository.DeleteUser(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	r.invalidate(ctx, id)
+
+	return nil
+}
+
+func (r *repo) RestoreUser(ctx context.Context, id int64) error {
+	err := r.UserRepository.RestoreUser(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	r.invalidate(ctx, id)
+
+	return nil
+}
+
+// getCached возвращает пользователя из кеша и состояние записи, nil пользователь при попадании - кешированное отсутствие
+func (r *repo) getCached(ctx context.Context, id int64) (*model.UserGet, cacheState, error) {
+	data, err := redigo.Bytes(r.cl.Get(ctx, cacheKey(id)))
+	if err != nil {
+		if errors.Is(err, redigo.ErrNil) {
+			return nil, cacheMiss, nil
+		}
+
+		return nil, cacheMiss, err
+	}
+
+	switch string(data) {
+	case notFoundValue:
+		return nil, cacheHit, nil
+	case writtenValue:
+		return nil, cacheWritten, nil
+	}
+
+	user := &model.UserGet{}
+	err = json.Unmarshal(data, user)
+	if err != nil {
+		return nil, cacheMiss, errors.Wrap(err, "failed to unmarshal cached user")
+	}
+
+	return user, cacheHit, nil
+}
+
+// setCached сохраняет пользователя в кеш, ошибки кеша не прерывают запрос и только логируются
+func (r *repo) setCached(ctx context.Context, id int64, user *model.UserGet, ttl time.Duration) {
+	value := []byte(notFoundValue)
+	if user != nil {
+		var err error
+		value, err = json.Marshal(user)
+		if err != nil {
+			log.Printf("failed to marshal user %d for cache: %v\n", id, err)
+			return
+		}
+	}
+
+	err := r.cl.SetEx(ctx, cacheKey(id), value, ttl)
+	if err != nil {
+		log.Printf("failed to set user %d to cache: %v\n", id, err)
+	}
+}
+
+// invalidate после коммита транзакции заменяет кеш пользователя меткой недавней записи,
+// чтобы до коммита и сразу после него не закешировать старые данные с реплики
+func (r *repo) invalidate(ctx context.Context, id int64) {
+	db.AfterCommit(ctx, func(ctx context.Context) {
+		err := r.cl.SetEx(context.WithoutCancel(ctx), cacheKey(id), []byte(writtenValue), r.masterReadTTL)
+		if err != nil {
+			log.Printf("failed to invalidate user %d in cache: %v\n", id, err)
+		}
+	})
+}
+
+func cacheKey(id int64) string {
+	return userCacheKeyPrefix + strconv.FormatInt(id, 10)
+}
@@ -0,0 +1,200 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/auth/internal/client/cache"
+	cacheMocks "github.com/ipv02/auth/internal/client/cache/mocks"
+	"github.com/ipv02/auth/internal/client/db"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/repository"
+	repoMocks "github.com/ipv02/auth/internal/repository/mocks"
+	"github.com/ipv02/auth/internal/repository/user/cached"
+)
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type redisClientMockFunc func(mc *minimock.Controller) cache.RedisClient
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id            = gofakeit.Int64()
+		key           = "user:cache:" + strconv.FormatInt(id, 10)
+		ttl           = time.Minute
+		negativeTTL   = time.Second
+		masterReadTTL = 5 * time.Second
+
+		repoErr  = fmt.Errorf("repo error")
+		cacheErr = fmt.Errorf("cache error")
+
+		user = &model.UserGet{
+			ID:        id,
+			Name:      gofakeit.Name(),
+			Email:     gofakeit.Email(),
+			UserRole:  gofakeit.Int32(),
+			CreatedAt: gofakeit.Date(),
+			UpdatedAt: sql.NullTime{
+				Time:  gofakeit.Date(),
+				Valid: true,
+			},
+			Version: gofakeit.Int64(),
+		}
+	)
+
+	cachedUser, err := json.Marshal(user)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		inTx               bool
+		want               *model.UserGet
+		err                error
+		userRepositoryMock userRepositoryMockFunc
+		redisClientMock    redisClientMockFunc
+	}{
+		{
+			name: "cache hit case",
+			want: user,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+			redisClientMock: func(mc *minimock.Controller) cache.RedisClient {
+				mock := cacheMocks.NewRedisClientMock(mc)
+				mock.GetMock.Expect(ctx, key).Return(cachedUser, nil)
+				return mock
+			},
+		},
+		{
+			name: "cached not found case",
+			err:  model.ErrorUserNotFound,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+			redisClientMock: func(mc *minimock.Controller) cache.RedisClient {
+				mock := cacheMocks.NewRedisClientMock(mc)
+				mock.GetMock.Expect(ctx, key).Return([]byte("null"), nil)
+				return mock
+			},
+		},
+		{
+			name: "cache miss case",
+			want: user,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(minimock.AnyContext, id).Return(user, nil)
+				return mock
+			},
+			redisClientMock: func(mc *minimock.Controller) cache.RedisClient {
+				mock := cacheMocks.NewRedisClientMock(mc)
+				mock.GetMock.Expect(ctx, key).Return(nil, nil)
+				mock.SetExMock.Expect(minimock.AnyContext, key, cachedUser, ttl).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "cache miss after write case",
+			want: user,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Inspect(func(ctx context.Context, _ int64) {
+					require.True(t, db.MasterReadFromContext(ctx))
+				}).Return(user, nil)
+				return mock
+			},
+			redisClientMock: func(mc *minimock.Controller) cache.RedisClient {
+				mock := cacheMocks.NewRedisClientMock(mc)
+				mock.GetMock.Expect(ctx, key).Return([]byte("written"), nil)
+				mock.SetExMock.Expect(minimock.AnyContext, key, cachedUser, ttl).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "not found case",
+			err:  model.ErrorUserNotFound,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(minimock.AnyContext, id).Return(nil, model.ErrorUserNotFound)
+				return mock
+			},
+			redisClientMock: func(mc *minimock.Controller) cache.RedisClient {
+				mock := cacheMocks.NewRedisClientMock(mc)
+				mock.GetMock.Expect(ctx, key).Return(nil, nil)
+				mock.SetExMock.Expect(minimock.AnyContext, key, []byte("null"), negativeTTL).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "cache error case",
+			want: user,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(minimock.AnyContext, id).Return(user, nil)
+				return mock
+			},
+			redisClientMock: func(mc *minimock.Controller) cache.RedisClient {
+				mock := cacheMocks.NewRedisClientMock(mc)
+				mock.GetMock.Expect(ctx, key).Return(nil, cacheErr)
+				mock.SetExMock.Expect(minimock.AnyContext, key, cachedUser, ttl).Return(cacheErr)
+				return mock
+			},
+		},
+		{
+			name: "in transaction case",
+			inTx: true,
+			want: user,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(minimock.AnyContext, id).Return(user, nil)
+				return mock
+			},
+			redisClientMock: func(mc *minimock.Controller) cache.RedisClient {
+				return cacheMocks.NewRedisClientMock(mc)
+			},
+		},
+		{
+			name: "repo error case",
+			err:  repoErr,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(minimock.AnyContext, id).Return(nil, repoErr)
+				return mock
+			},
+			redisClientMock: func(mc *minimock.Controller) cache.RedisClient {
+				mock := cacheMocks.NewRedisClientMock(mc)
+				mock.GetMock.Expect(ctx, key).Return(nil, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := cached.NewRepository(tt.userRepositoryMock(mc), tt.redisClientMock(mc), ttl, negativeTTL, masterReadTTL)
+
+			ctx := ctx
+			if tt.inTx {
+				ctx, _ = db.ContextWithAfterCommit(ctx)
+			}
+
+			res, err := repo.GetUser(ctx, id)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	cacheMocks "github.com/ipv02/auth/internal/client/cache/mocks"
+	"github.com/ipv02/auth/internal/client/db"
+	"github.com/ipv02/auth/internal/model"
+	repoMocks "github.com/ipv02/auth/internal/repository/mocks"
+	"github.com/ipv02/auth/internal/repository/user/cached"
+)
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id            = gofakeit.Int64()
+		key           = "user:cache:" + strconv.FormatInt(id, 10)
+		masterReadTTL = 5 * time.Second
+
+		repoErr = fmt.Errorf("repo error")
+
+		user = &model.UserUpdate{ID: id}
+	)
+
+	tests := []struct {
+		name       string
+		inTx       bool
+		err        error
+		invalidate bool
+	}{
+		{
+			name:       "without transaction case",
+			invalidate: true,
+		},
+		{
+			name:       "in transaction case",
+			inTx:       true,
+			invalidate: true,
+		},
+		{
+			name: "repo error case",
+			err:  repoErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			userRepositoryMock := repoMocks.NewUserRepositoryMock(mc)
+			userRepositoryMock.UpdateUserMock.Expect(minimock.AnyContext, user).Return(tt.err)
+
+			redisClientMock := cacheMocks.NewRedisClientMock(mc)
+			if tt.invalidate {
+				redisClientMock.SetExMock.Expect(minimock.AnyContext, key, []byte("written"), masterReadTTL).Return(nil)
+			}
+
+			repo := cached.NewRepository(userRepositoryMock, redisClientMock, time.Minute, time.Second, masterReadTTL)
+
+			ctx := context.Background()
+			runAfterCommit := func(context.Context) {}
+			if tt.inTx {
+				ctx, runAfterCommit = db.ContextWithAfterCommit(ctx)
+			}
+
+			err := repo.UpdateUser(ctx, user)
+			require.Equal(t, tt.err, err)
+
+			if tt.inTx {
+				require.Zero(t, redisClientMock.SetExAfterCounter())
+			}
+
+			runAfterCommit(context.Background())
+		})
+	}
+}
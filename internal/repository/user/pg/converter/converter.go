@@ -21,5 +21,29 @@ func ToUserFromRepo(user *modelRepo.User) *model.UserGet {
 		UserRole:  user.UserRole,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
+		Version:   user.Version,
 	}
 }
+
+// ToUserAuthFromRepo конвертер модели данных для аутентификации из репо-слоя в модель для сервисного слоя
+func ToUserAuthFromRepo(user *modelRepo.UserAuth) *model.UserAuth {
+	if user == nil {
+		return nil
+	}
+
+	return &model.UserAuth{
+		ID:           user.ID,
+		Role:         user.UserRole,
+		PasswordHash: user.Password,
+	}
+}
+
+// ToUsersFromRepo конвертер списка моделей из репо-слоя в модели для сервисного слоя
+func ToUsersFromRepo(users []*modelRepo.User) []*model.UserGet {
+	res := make([]*model.UserGet, 0, len(users))
+	for _, user := range users {
+		res = append(res, ToUserFromRepo(user))
+	}
+
+	return res
+}
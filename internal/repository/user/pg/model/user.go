@@ -13,4 +13,12 @@ type User struct {
 	UserRole  int32        `db:"role"`
 	CreatedAt time.Time    `db:"created_at"`
 	UpdatedAt sql.NullTime `db:"updated_at"`
+	Version   int64        `db:"version"`
+}
+
+// UserAuth модель данных для аутентификации в репо слое
+type UserAuth struct {
+	ID       int64  `db:"id"`
+	UserRole int32  `db:"role"`
+	Password string `db:"password"`
 }
@@ -2,11 +2,13 @@ package pg
 
 import (
 	"context"
-	"log"
 	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
 
 	"github.com/ipv02/auth/internal/client/db"
 	"github.com/ipv02/auth/internal/model"
@@ -18,14 +20,21 @@ import (
 const (
 	tableName = "auth"
 
-	idColumn              = "id"
-	nameColumn            = "name"
-	emailColumn           = "email"
-	passwordColumn        = "password"
-	passwordConfirmColumn = "password_confirm"
-	roleColumn            = "role"
-	createdAtColumn       = "created_at"
-	updatedAtColumn       = "updated_at"
+	idColumn        = "id"
+	nameColumn      = "name"
+	emailColumn     = "email"
+	passwordColumn  = "password"
+	roleColumn      = "role"
+	createdAtColumn = "created_at"
+	updatedAtColumn = "updated_at"
+	deletedAtColumn = "deleted_at"
+	versionColumn   = "version"
+
+	// uniqueViolationCode код ошибки postgres нарушения уникального индекса
+	uniqueViolationCode = "23505"
+
+	// createUsersChunkSize число строк в одном insert, ограничивает число параметров запроса
+	createUsersChunkSize = 1000
 )
 
 type repo struct {
@@ -40,15 +49,14 @@ func NewRepository(db db.Client) repository.UserRepository {
 // CreateUser выполняет создание нового пользователя в базе данных
 func (r *repo) CreateUser(ctx context.Context, user *model.UserCreate) (int64, error) {
 	builderInsert := sq.Insert(tableName).
-		Columns(nameColumn, emailColumn, passwordColumn, passwordConfirmColumn, roleColumn).
-		Values(user.Name, user.Email, user.Password, user.PasswordConfirm, user.Role).
+		Columns(nameColumn, emailColumn, passwordColumn, roleColumn).
+		Values(user.Name, user.Email, user.Password, user.Role).
 		PlaceholderFormat(sq.Dollar).
 		Suffix("RETURNING id")
 
 	query, args, err := builderInsert.ToSql()
 	if err != nil {
-		log.Fatalf("failed to generate query: %v", err)
-		return 0, err
+		return 0, errors.Wrap(err, "failed to generate query")
 	}
 
 	q := db.Query{
@@ -59,27 +67,70 @@ func (r *repo) CreateUser(ctx context.Context, user *model.UserCreate) (int64, e
 	var userID int64
 	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&userID)
 
+	if isUniqueViolation(err) {
+		return 0, model.ErrUserAlreadyExists
+	}
 	if err != nil {
-		log.Fatalf("failed to execute query: %v", err)
-		return 0, err
+		return 0, errors.Wrap(err, "failed to execute query")
 	}
 
 	return userID, nil
 }
 
-// GetUser функция берет пользоваиеля из базы данных
+// CreateUsers добавляет пользователей многострочными insert и возвращает id в порядке users.
+// Вызывается в транзакции, чтобы при нарушении уникальности email не сохранилась часть пользователей
+func (r *repo) CreateUsers(ctx context.Context, users []*model.UserCreate) ([]int64, error) {
+	ids := make([]int64, 0, len(users))
+	for start := 0; start < len(users); start += createUsersChunkSize {
+		end := min(start+createUsersChunkSize, len(users))
+
+		builderInsert := sq.Insert(tableName).
+			Columns(nameColumn, emailColumn, passwordColumn, roleColumn).
+			PlaceholderFormat(sq.Dollar).
+			Suffix("RETURNING id")
+		for _, user := range users[start:end] {
+			builderInsert = builderInsert.Values(user.Name, user.Email, user.Password, user.Role)
+		}
+
+		query, args, err := builderInsert.ToSql()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate query")
+		}
+
+		q := db.Query{
+			Name:     "user_repository.CreateUsers",
+			QueryRaw: query,
+		}
+
+		// postgres возвращает строки многострочного insert в порядке values
+		var chunkIDs []int64
+		err = r.db.DB().ScanAllContext(ctx, &chunkIDs, q, args...)
+		if isUniqueViolation(err) {
+			return nil, model.ErrUserAlreadyExists
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to execute query")
+		}
+
+		ids = append(ids, chunkIDs...)
+	}
+
+	return ids, nil
+}
+
+// GetUser функция берет пользоваиеля из базы данных.
+// Чтение идет с реплики, если она настроена, поэтому результат может отставать от записи не больше допустимого лага
 func (r *repo) GetUser(ctx context.Context, id int64) (*model.UserGet, error) {
 	builderSelect := sq.
-		Select(idColumn, nameColumn, emailColumn, roleColumn, createdAtColumn, updatedAtColumn).
+		Select(idColumn, nameColumn, emailColumn, roleColumn, createdAtColumn, updatedAtColumn, versionColumn).
 		From(tableName).
-		Where(sq.Eq{idColumn: id}).
+		Where(sq.Eq{idColumn: id, deletedAtColumn: nil}).
 		PlaceholderFormat(sq.Dollar).
 		Limit(1)
 
 	query, args, err := builderSelect.ToSql()
 	if err != nil {
-		log.Fatalf("failed to generate query: %v", err)
-		return nil, err
+		return nil, errors.Wrap(err, "failed to generate query")
 	}
 
 	q := db.Query{
@@ -88,38 +139,49 @@ func (r *repo) GetUser(ctx context.Context, id int64) (*model.UserGet, error) {
 	}
 
 	var user modelRepo.User
-	err = r.db.DB().ScanOneContext(ctx, &user, q, args...)
+	err = r.db.Replica().ScanOneContext(ctx, &user, q, args...)
 	if err != nil {
-		log.Fatalf("failed to execute query: %v", err)
-		return nil, err
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrorUserNotFound
+		}
+
+		return nil, errors.Wrap(err, "failed to execute query")
 	}
 
 	return converter.ToUserFromRepo(&user), nil
 }
 
-// UpdateUser выполняет обновление данных пользователя в базе
+// UpdateUser выполняет обновление данных пользователя в базе и увеличивает версию записи.
+// Если передана ожидаемая версия, запись обновляется только при ее совпадении
 func (r *repo) UpdateUser(ctx context.Context, user *model.UserUpdate) error {
 	builderUpdate := sq.
 		Update(tableName).
-		Set(roleColumn, user.Role).
 		Set(updatedAtColumn, time.Now()).
-		Where(sq.Eq{idColumn: user.ID}).
+		Set(versionColumn, sq.Expr(versionColumn+" + 1")).
+		Where(sq.Eq{idColumn: user.ID, deletedAtColumn: nil}).
 		PlaceholderFormat(sq.Dollar)
 
+	if user.Version != 0 {
+		builderUpdate = builderUpdate.Where(sq.Eq{versionColumn: user.Version})
+	}
+
 	if user.Name != nil {
 		trimmedName := strings.TrimSpace(*user.Name)
-		builderUpdate.Set(nameColumn, trimmedName)
+		builderUpdate = builderUpdate.Set(nameColumn, trimmedName)
 	}
 
 	if user.Email != nil {
 		trimmedEmail := strings.TrimSpace(*user.Email)
-		builderUpdate.Set(emailColumn, trimmedEmail)
+		builderUpdate = builderUpdate.Set(emailColumn, trimmedEmail)
+	}
+
+	if user.Role != nil {
+		builderUpdate = builderUpdate.Set(roleColumn, *user.Role)
 	}
 
 	query, args, err := builderUpdate.ToSql()
 	if err != nil {
-		log.Fatalf("failed to generate query: %v", err)
-		return err
+		return errors.Wrap(err, "failed to generate query")
 	}
 
 	q := db.Query{
@@ -127,38 +189,260 @@ func (r *repo) UpdateUser(ctx context.Context, user *model.UserUpdate) error {
 		QueryRaw: query,
 	}
 
-	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if isUniqueViolation(err) {
+		return model.ErrUserAlreadyExists
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to execute query")
+	}
+
+	if res.RowsAffected() > 0 {
+		return nil
+	}
+
+	if user.Version == 0 {
+		return model.ErrorUserNotFound
+	}
+
+	// Запись не обновилась либо из-за отсутствия пользователя, либо из-за несовпадения версии
+	_, err = r.GetUser(ctx, user.ID)
 	if err != nil {
-		log.Fatalf("failed to execute query: %v", err)
 		return err
 	}
 
-	return err
+	return model.ErrorUserVersionMismatch
 }
 
-// DeleteUser удаляет пользователя из базы данных
+// DeleteUser помечает пользователя удаленным. Строка физически удаляется
+// после истечения срока хранения, см. PurgeDeletedUsers
 func (r *repo) DeleteUser(ctx context.Context, id int64) error {
+	builderUpdate := sq.
+		Update(tableName).
+		Set(deletedAtColumn, time.Now()).
+		Where(sq.Eq{idColumn: id, deletedAtColumn: nil}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "failed to generate query")
+	}
+
+	q := db.Query{
+		Name:     "user_repository.Delete",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to execute query")
+	}
+
+	if res.RowsAffected() == 0 {
+		return model.ErrorUserNotFound
+	}
+
+	return nil
+}
+
+// GetUserAuthByEmail возвращает данные пользователя для аутентификации по email
+func (r *repo) GetUserAuthByEmail(ctx context.Context, email string) (*model.UserAuth, error) {
+	builderSelect := sq.
+		Select(idColumn, roleColumn, passwordColumn).
+		From(tableName).
+		Where(sq.Expr("lower("+emailColumn+") = lower(?)", email)).
+		Where(sq.Eq{deletedAtColumn: nil}).
+		PlaceholderFormat(sq.Dollar).
+		Limit(1)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate query")
+	}
+
+	q := db.Query{
+		Name:     "user_repository.GetAuthByEmail",
+		QueryRaw: query,
+	}
+
+	var user modelRepo.UserAuth
+	err = r.db.DB().ScanOneContext(ctx, &user, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrorUserNotFound
+		}
+
+		return nil, errors.Wrap(err, "failed to execute query")
+	}
+
+	return converter.ToUserAuthFromRepo(&user), nil
+}
+
+// UpdateUserPassword сохраняет новый хеш пароля пользователя
+func (r *repo) UpdateUserPassword(ctx context.Context, id int64, passwordHash string) error {
+	builderUpdate := sq.
+		Update(tableName).
+		Set(passwordColumn, passwordHash).
+		Set(updatedAtColumn, time.Now()).
+		Where(sq.Eq{idColumn: id, deletedAtColumn: nil}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "failed to generate query")
+	}
+
+	q := db.Query{
+		Name:     "user_repository.UpdatePassword",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to execute query")
+	}
+
+	return nil
+}
+
+// ListUsers возвращает страницу пользователей, отсортированную по (created_at, id).
+// Следующая страница выбирается по курсору без OFFSET, чтение идет с реплики, если она настроена
+func (r *repo) ListUsers(ctx context.Context, query *model.UserListQuery) ([]*model.UserGet, error) {
+	builderSelect := sq.
+		Select(idColumn, nameColumn, emailColumn, roleColumn, createdAtColumn, updatedAtColumn, versionColumn).
+		From(tableName).
+		Where(sq.Eq{deletedAtColumn: nil}).
+		PlaceholderFormat(sq.Dollar).
+		Limit(query.Limit)
+
+	filter := query.Filter
+	if filter.Role != 0 {
+		builderSelect = builderSelect.Where(sq.Eq{roleColumn: filter.Role})
+	}
+	if filter.Email != "" {
+		builderSelect = builderSelect.Where(sq.ILike{emailColumn: containsPattern(filter.Email)})
+	}
+	if filter.Name != "" {
+		builderSelect = builderSelect.Where(sq.ILike{nameColumn: containsPattern(filter.Name)})
+	}
+	if filter.CreatedFrom != nil {
+		builderSelect = builderSelect.Where(sq.GtOrEq{createdAtColumn: *filter.CreatedFrom})
+	}
+	if filter.CreatedTo != nil {
+		builderSelect = builderSelect.Where(sq.Lt{createdAtColumn: *filter.CreatedTo})
+	}
+
+	order := "DESC"
+	cursorOp := "<"
+	if query.SortAsc {
+		order = "ASC"
+		cursorOp = ">"
+	}
+
+	if query.Cursor != nil {
+		builderSelect = builderSelect.Where(
+			sq.Expr("("+createdAtColumn+", "+idColumn+") "+cursorOp+" (?, ?)", query.Cursor.CreatedAt, query.Cursor.ID),
+		)
+	}
+
+	builderSelect = builderSelect.OrderBy(createdAtColumn+" "+order, idColumn+" "+order)
+
+	sqlQuery, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate query")
+	}
+
+	q := db.Query{
+		Name:     "user_repository.List",
+		QueryRaw: sqlQuery,
+	}
+
+	var users []*modelRepo.User
+	err = r.db.Replica().ScanAllContext(ctx, &users, q, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute query")
+	}
+
+	return converter.ToUsersFromRepo(users), nil
+}
+
+// RestoreUser снимает пометку об удалении с пользователя
+func (r *repo) RestoreUser(ctx context.Context, id int64) error {
+	builderUpdate := sq.
+		Update(tableName).
+		Set(deletedAtColumn, nil).
+		Set(updatedAtColumn, time.Now()).
+		Where(sq.Eq{idColumn: id}).
+		Where(sq.NotEq{deletedAtColumn: nil}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "failed to generate query")
+	}
+
+	q := db.Query{
+		Name:     "user_repository.Restore",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if isUniqueViolation(err) {
+		return model.ErrUserAlreadyExists
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to execute query")
+	}
+
+	if res.RowsAffected() == 0 {
+		return model.ErrorUserNotFound
+	}
+
+	return nil
+}
+
+// PurgeDeletedUsers физически удаляет не более limit пользователей,
+// помеченных удаленными раньше deletedBefore, и возвращает их количество
+func (r *repo) PurgeDeletedUsers(ctx context.Context, deletedBefore time.Time, limit uint64) (int64, error) {
+	builderSelect := sq.
+		Select(idColumn).
+		From(tableName).
+		Where(sq.Lt{deletedAtColumn: deletedBefore}).
+		OrderBy(deletedAtColumn).
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
 	builderDelete := sq.
 		Delete(tableName).
-		Where(sq.Eq{idColumn: id}).
+		Where(builderSelect.Prefix(idColumn + " IN (").Suffix(")")).
 		PlaceholderFormat(sq.Dollar)
 
 	query, args, err := builderDelete.ToSql()
 	if err != nil {
-		log.Fatalf("failed to generate query: %v", err)
-		return err
+		return 0, errors.Wrap(err, "failed to generate query")
 	}
 
 	q := db.Query{
-		Name:     "user_repository.Delete",
+		Name:     "user_repository.PurgeDeleted",
 		QueryRaw: query,
 	}
 
-	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
 	if err != nil {
-		log.Fatalf("failed to execute query: %v", err)
-		return err
+		return 0, errors.Wrap(err, "failed to execute query")
 	}
 
-	return err
+	return res.RowsAffected(), nil
+}
+
+// isUniqueViolation проверяет, что запрос нарушил уникальный индекс
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
+
+// containsPattern строит шаблон ILIKE для поиска по подстроке, экранируя спецсимволы
+func containsPattern(substr string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return "%" + replacer.Replace(substr) + "%"
 }
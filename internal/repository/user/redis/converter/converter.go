@@ -31,5 +31,19 @@ func ToUserFromRepo(user *modelRepo.User) *model.UserGet {
 		UserRole:  user.UserRole,
 		CreatedAt: time.Unix(0, user.CreatedAtNs),
 		UpdatedAt: updateAt,
+		Version:   user.Version,
+	}
+}
+
+// ToUserAuthFromRepo конвертер модели данных для аутентификации из репо-слоя в модель для сервисного слоя
+func ToUserAuthFromRepo(id int64, user *modelRepo.UserAuth) *model.UserAuth {
+	if user == nil {
+		return nil
+	}
+
+	return &model.UserAuth{
+		ID:           id,
+		Role:         user.UserRole,
+		PasswordHash: user.Password,
 	}
 }
@@ -8,21 +8,12 @@ type User struct {
 	UserRole    int32  `redis:"role"`
 	CreatedAtNs int64  `redis:"created_at"`
 	UpdatedAtNs *int64 `redis:"updated_at"`
+	DeletedAtNs int64  `redis:"deleted_at"`
+	Version     int64  `redis:"version"`
 }
 
-// UserCreate модель для работы c redis
-type UserCreate struct {
-	Name            string `redis:"name"`
-	Email           string `redis:"email"`
-	Password        string `redis:"password"`
-	PasswordConfirm string `redis:"password_confirm"`
-	Role            int32  `redis:"role"`
-}
-
-// UserUpdate модель для для работы c redis
-type UserUpdate struct {
-	ID    int64   `redis:"id"`
-	Name  *string `redis:"name"`
-	Email *string `redis:"email"`
-	Role  int32   `redis:"role"`
+// UserAuth модель данных для аутентификации для работы c redis
+type UserAuth struct {
+	UserRole int32  `redis:"role"`
+	Password string `redis:"password"`
 }
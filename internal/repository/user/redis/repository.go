@@ -2,9 +2,14 @@ package redis
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	redigo "github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
 	"github.com/ipv02/auth/internal/client/cache"
 	"github.com/ipv02/auth/internal/model"
 	"github.com/ipv02/auth/internal/repository"
@@ -12,6 +17,26 @@ import (
 	modelRepo "github.com/ipv02/auth/internal/repository/user/redis/model"
 )
 
+const (
+	// userKeyPrefix хеши пользователей по id
+	userKeyPrefix = "user:"
+	// emailKeyPrefix индекс email без учета регистра в id владельца
+	emailKeyPrefix = "user:email:"
+	// idSeqKey счетчик для выделения id пользователей
+	idSeqKey = "user:id_seq"
+
+	// createdAtIndexKey упорядоченное множество с одинаковым score, элементы которого
+	// имеют вид "<created_at ns>:<id>" с ведущими нулями, поэтому лексикографический
+	// порядок совпадает с порядком по (created_at, id)
+	createdAtIndexKey = "user:index:created_at"
+	// roleIndexKeyPrefix множества id пользователей по ролям
+	roleIndexKeyPrefix = "user:index:role:"
+	// deletedAtIndexKey упорядоченное множество id удаленных пользователей со временем удаления в score
+	deletedAtIndexKey = "user:index:deleted_at"
+
+	listChunkSize = 100
+)
+
 type repo struct {
 	cl cache.RedisClient
 }
@@ -22,18 +47,12 @@ func NewRepository(cl cache.RedisClient) repository.UserRepository {
 }
 
 func (r *repo) CreateUser(ctx context.Context, user *model.UserCreate) (int64, error) {
-	id := int64(1)
-
-	userCreate := modelRepo.UserCreate{
-		Name:            user.Name,
-		Email:           user.Email,
-		Password:        user.Password,
-		PasswordConfirm: user.PasswordConfirm,
-		Role:            user.Role,
-	}
+	createdAt := time.Now()
 
-	idStr := strconv.FormatInt(id, 10)
-	err := r.cl.HashSet(ctx, idStr, userCreate)
+	id, err := r.eval(ctx, createUserScript,
+		[]interface{}{emailKey(user.Email), idSeqKey, createdAtIndexKey, roleIndexKey(user.Role)},
+		user.Name, user.Email, user.Password, user.Role, createdAt.UnixNano(), createdAtIndexPrefix(createdAt), userKeyPrefix,
+	)
 	if err != nil {
 		return 0, err
 	}
@@ -41,9 +60,84 @@ func (r *repo) CreateUser(ctx context.Context, user *model.UserCreate) (int64, e
 	return id, nil
 }
 
+// CreateUsers создает пользователей по одному, redis не поддерживает транзакции вызывающего кода,
+// поэтому при ошибке уже созданные пользователи сохраняются
+func (r *repo) CreateUsers(ctx context.Context, users []*model.UserCreate) ([]int64, error) {
+	ids := make([]int64, 0, len(users))
+	for _, user := range users {
+		id, err := r.CreateUser(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
 func (r *repo) GetUser(ctx context.Context, id int64) (*model.UserGet, error) {
-	idStr := strconv.FormatInt(id, 10)
-	values, err := r.cl.HGetAll(ctx, idStr)
+	user, err := r.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.DeletedAtNs != 0 {
+		return nil, model.ErrorUserNotFound
+	}
+
+	return converter.ToUserFromRepo(user), nil
+}
+
+func (r *repo) UpdateUser(ctx context.Context, user *model.UserUpdate) error {
+	var (
+		nameSet, emailSet, roleSet int
+		name, email, newEmailKey   string
+		role                       int32
+	)
+	if user.Name != nil {
+		nameSet, name = 1, *user.Name
+	}
+	if user.Email != nil {
+		emailSet, email, newEmailKey = 1, *user.Email, emailKey(*user.Email)
+	}
+	if user.Role != nil {
+		roleSet, role = 1, *user.Role
+	}
+
+	_, err := r.eval(ctx, updateUserScript,
+		[]interface{}{userKey(user.ID)},
+		user.Version, time.Now().UnixNano(),
+		nameSet, name,
+		emailSet, email, newEmailKey,
+		roleSet, role, roleIndexKeyPrefix,
+	)
+
+	return err
+}
+
+// DeleteUser помечает пользователя удаленным и освобождает его email.
+// Индексы по дате создания и роли очищаются при физическом удалении в PurgeDeletedUsers
+func (r *repo) DeleteUser(ctx context.Context, id int64) error {
+	_, err := r.eval(ctx, deleteUserScript,
+		[]interface{}{userKey(id), deletedAtIndexKey},
+		time.Now().UnixNano(),
+	)
+
+	return err
+}
+
+func (r *repo) GetUserAuthByEmail(ctx context.Context, email string) (*model.UserAuth, error) {
+	id, err := redigo.Int64(r.cl.Get(ctx, emailKey(email)))
+	if err != nil {
+		if errors.Is(err, redigo.ErrNil) {
+			return nil, model.ErrorUserNotFound
+		}
+
+		return nil, err
+	}
+
+	values, err := r.cl.HGetAll(ctx, userKey(id))
 	if err != nil {
 		return nil, err
 	}
@@ -52,33 +146,243 @@ func (r *repo) GetUser(ctx context.Context, id int64) (*model.UserGet, error) {
 		return nil, model.ErrorUserNotFound
 	}
 
-	var user modelRepo.User
+	var user modelRepo.UserAuth
 	err = redigo.ScanStruct(values, &user)
 	if err != nil {
 		return nil, err
 	}
 
-	return converter.ToUserFromRepo(&user), nil
+	return converter.ToUserAuthFromRepo(id, &user), nil
 }
 
-func (r *repo) UpdateUser(ctx context.Context, user *model.UserUpdate) error {
-	idStr := strconv.FormatInt(user.ID, 10)
+func (r *repo) UpdateUserPassword(ctx context.Context, id int64, passwordHash string) error {
+	_, err := r.eval(ctx, updatePasswordScript,
+		[]interface{}{userKey(id)},
+		passwordHash, time.Now().UnixNano(),
+	)
+
+	return err
+}
+
+// RestoreUser снимает пометку об удалении, если email пользователя еще свободен
+func (r *repo) RestoreUser(ctx context.Context, id int64) error {
+	_, err := r.eval(ctx, restoreUserScript, []interface{}{userKey(id), deletedAtIndexKey})
+
+	return err
+}
+
+// PurgeDeletedUsers физически удаляет не более limit пользователей,
+// помеченных удаленными раньше deletedBefore, вместе с их индексами
+func (r *repo) PurgeDeletedUsers(ctx context.Context, deletedBefore time.Time, limit uint64) (int64, error) {
+	members, err := r.cl.ZRangeByScore(ctx, deletedAtIndexKey, "-inf", "("+strconv.FormatInt(deletedBefore.UnixNano(), 10), int64(limit))
+	if err != nil {
+		return 0, err
+	}
+
+	var purged int64
+	for _, member := range members {
+		id, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			return purged, errors.Wrapf(err, "invalid deleted_at index member %q", member)
+		}
+
+		removed, err := r.eval(ctx, purgeUserScript,
+			[]interface{}{userKey(id), deletedAtIndexKey, createdAtIndexKey},
+			id, roleIndexKeyPrefix, deletedBefore.UnixNano(),
+		)
+		if err != nil {
+			return purged, err
+		}
+
+		// пользователь был восстановлен или удален заново после выборки из индекса
+		if removed == 0 {
+			continue
+		}
+
+		purged++
+	}
+
+	return purged, nil
+}
+
+// ListUsers обходит индекс по (created_at, id) порциями, начиная с курсора,
+// и фильтрует пользователей, пока не наберет страницу
+func (r *repo) ListUsers(ctx context.Context, query *model.UserListQuery) ([]*model.UserGet, error) {
+	filter := query.Filter
 
-	userUpdate := modelRepo.UserUpdate{
-		Name:  user.Name,
-		Email: user.Email,
-		Role:  user.Role,
+	var roleIDs map[string]struct{}
+	if filter.Role != 0 {
+		members, err := r.cl.SMembers(ctx, roleIndexKey(filter.Role))
+		if err != nil {
+			return nil, err
+		}
+
+		roleIDs = make(map[string]struct{}, len(members))
+		for _, member := range members {
+			roleIDs[member] = struct{}{}
+		}
 	}
 
-	err := r.cl.HashSet(ctx, idStr, userUpdate)
+	lower, upper := listBounds(query)
+	users := make([]*model.UserGet, 0, query.Limit)
+
+	for uint64(len(users)) < query.Limit {
+		var (
+			members []string
+			err     error
+		)
+		if query.SortAsc {
+			members, err = r.cl.ZRangeByLex(ctx, createdAtIndexKey, lower, upper, listChunkSize)
+		} else {
+			members, err = r.cl.ZRevRangeByLex(ctx, createdAtIndexKey, upper, lower, listChunkSize)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range members {
+			idStr := member[strings.LastIndexByte(member, ':')+1:]
+			id, err := strconv.ParseInt(idStr, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid created_at index member %q", member)
+			}
+
+			if roleIDs != nil {
+				if _, ok := roleIDs[strconv.FormatInt(id, 10)]; !ok {
+					continue
+				}
+			}
+
+			user, err := r.GetUser(ctx, id)
+			if errors.Is(err, model.ErrorUserNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if !matchUserFilter(user, filter) {
+				continue
+			}
+
+			users = append(users, user)
+			if uint64(len(users)) == query.Limit {
+				break
+			}
+		}
+
+		if len(members) < listChunkSize {
+			break
+		}
+
+		last := members[len(members)-1]
+		if query.SortAsc {
+			lower = "(" + last
+		} else {
+			upper = "(" + last
+		}
+	}
+
+	return users, nil
+}
+
+// listBounds возвращает границы ZRANGEBYLEX для фильтра по дате создания и курсора
+func listBounds(query *model.UserListQuery) (lower string, upper string) {
+	lower, upper = "-", "+"
+
+	if query.Filter.CreatedFrom != nil {
+		lower = "[" + createdAtIndexPrefix(*query.Filter.CreatedFrom)
+	}
+	if query.Filter.CreatedTo != nil {
+		upper = "(" + createdAtIndexPrefix(*query.Filter.CreatedTo)
+	}
+
+	if query.Cursor == nil {
+		return lower, upper
+	}
+
+	cursor := createdAtIndexMember(query.Cursor.CreatedAt, query.Cursor.ID)
+	if query.SortAsc {
+		if lower == "-" || cursor >= lower[1:] {
+			lower = "(" + cursor
+		}
+	} else {
+		if upper == "+" || cursor < upper[1:] {
+			upper = "(" + cursor
+		}
+	}
+
+	return lower, upper
+}
+
+func matchUserFilter(user *model.UserGet, filter model.UserListFilter) bool {
+	if filter.Email != "" && !strings.Contains(strings.ToLower(user.Email), strings.ToLower(filter.Email)) {
+		return false
+	}
+
+	if filter.Name != "" && !strings.Contains(strings.ToLower(user.Name), strings.ToLower(filter.Name)) {
+		return false
+	}
+
+	return true
+}
+
+// getUser читает пользователя из хеша, в том числе помеченного удаленным
+func (r *repo) getUser(ctx context.Context, id int64) (*modelRepo.User, error) {
+	values, err := r.cl.HGetAll(ctx, userKey(id))
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if len(values) == 0 {
+		return nil, model.ErrorUserNotFound
+	}
+
+	var user modelRepo.User
+	err = redigo.ScanStruct(values, &user)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return &user, nil
 }
 
-func (r *repo) DeleteUser(ctx context.Context, id int64) error {
-	idStr := strconv.FormatInt(id, 10)
-	return r.cl.Delete(ctx, idStr)
+// eval выполняет скрипт и переводит отрицательный код результата в доменную ошибку
+func (r *repo) eval(ctx context.Context, script string, keys []interface{}, args ...interface{}) (int64, error) {
+	reply, err := redigo.Int64(r.cl.Eval(ctx, script, len(keys), append(keys, args...)...))
+	if err != nil {
+		return 0, err
+	}
+
+	switch reply {
+	case scriptNotFound:
+		return 0, model.ErrorUserNotFound
+	case scriptVersionMismatch:
+		return 0, model.ErrorUserVersionMismatch
+	case scriptAlreadyExists:
+		return 0, model.ErrUserAlreadyExists
+	}
+
+	return reply, nil
+}
+
+func userKey(id int64) string {
+	return userKeyPrefix + strconv.FormatInt(id, 10)
+}
+
+// emailKey ключ индекса email без учета регистра
+func emailKey(email string) string {
+	return emailKeyPrefix + strings.ToLower(strings.TrimSpace(email))
+}
+
+func createdAtIndexPrefix(createdAt time.Time) string {
+	return fmt.Sprintf("%019d:", createdAt.UnixNano())
+}
+
+func createdAtIndexMember(createdAt time.Time, id int64) string {
+	return fmt.Sprintf("%s%019d", createdAtIndexPrefix(createdAt), id)
+}
+
+func roleIndexKey(role int32) string {
+	return roleIndexKeyPrefix + strconv.FormatInt(int64(role), 10)
 }
@@ -0,0 +1,158 @@
+package redis
+
+// Все изменения пользователя выполняются lua скриптами, чтобы хеш пользователя,
+// индекс email и вторичные индексы менялись атомарно.
+// Скрипты возвращают id пользователя или 0 при успехе и отрицательный код ошибки.
+const (
+	scriptNotFound        = -1
+	scriptVersionMismatch = -2
+	scriptAlreadyExists   = -3
+)
+
+// createUserScript выделяет id через INCR и сохраняет пользователя, если email свободен.
+// KEYS: email, счетчик id, индекс created_at, индекс роли.
+// ARGV: name, email, password, role, created_at, префикс элемента индекса created_at, префикс ключа пользователя
+const createUserScript = `
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return -3
+end
+
+local id = redis.call('INCR', KEYS[2])
+redis.call('HSET', ARGV[7] .. id,
+	'id', id,
+	'name', ARGV[1],
+	'email', ARGV[2],
+	'email_key', KEYS[1],
+	'password', ARGV[3],
+	'role', ARGV[4],
+	'created_at', ARGV[5],
+	'version', 1,
+	'deleted_at', 0)
+redis.call('SET', KEYS[1], id)
+redis.call('ZADD', KEYS[3], 0, ARGV[6] .. string.format('%019d', id))
+redis.call('SADD', KEYS[4], id)
+
+return id
+`
+
+// updateUserScript обновляет переданные поля, проверяя версию и уникальность нового email.
+// KEYS: пользователь.
+// ARGV: ожидаемая версия (0 - без проверки), updated_at,
+// флаг и значение name, флаг и значение email, ключ нового email, флаг и значение role, префикс индекса роли
+const updateUserScript = `
+local user = redis.call('HMGET', KEYS[1], 'id', 'email_key', 'role', 'version', 'deleted_at')
+if not user[1] or user[5] ~= '0' then
+	return -1
+end
+
+if ARGV[1] ~= '0' and ARGV[1] ~= user[4] then
+	return -2
+end
+
+local id = user[1]
+
+if ARGV[5] == '1' then
+	if ARGV[7] ~= user[2] then
+		local owner = redis.call('GET', ARGV[7])
+		if owner and owner ~= id then
+			return -3
+		end
+
+		redis.call('DEL', user[2])
+		redis.call('SET', ARGV[7], id)
+		redis.call('HSET', KEYS[1], 'email_key', ARGV[7])
+	end
+
+	redis.call('HSET', KEYS[1], 'email', ARGV[6])
+end
+
+if ARGV[3] == '1' then
+	redis.call('HSET', KEYS[1], 'name', ARGV[4])
+end
+
+if ARGV[8] == '1' and ARGV[9] ~= user[3] then
+	redis.call('SREM', ARGV[10] .. user[3], id)
+	redis.call('SADD', ARGV[10] .. ARGV[9], id)
+	redis.call('HSET', KEYS[1], 'role', ARGV[9])
+end
+
+redis.call('HSET', KEYS[1], 'updated_at', ARGV[2])
+redis.call('HINCRBY', KEYS[1], 'version', 1)
+
+return 0
+`
+
+// updatePasswordScript сохраняет новый хеш пароля неудаленного пользователя.
+// KEYS: пользователь. ARGV: хеш пароля, updated_at
+const updatePasswordScript = `
+if redis.call('HGET', KEYS[1], 'deleted_at') ~= '0' then
+	return -1
+end
+
+redis.call('HSET', KEYS[1], 'password', ARGV[1], 'updated_at', ARGV[2])
+
+return 0
+`
+
+// deleteUserScript помечает пользователя удаленным и освобождает его email.
+// KEYS: пользователь, индекс deleted_at. ARGV: deleted_at
+const deleteUserScript = `
+local user = redis.call('HMGET', KEYS[1], 'id', 'email_key', 'deleted_at')
+if not user[1] or user[3] ~= '0' then
+	return -1
+end
+
+redis.call('HSET', KEYS[1], 'deleted_at', ARGV[1])
+redis.call('ZADD', KEYS[2], ARGV[1], user[1])
+redis.call('DEL', user[2])
+
+return 0
+`
+
+// restoreUserScript снимает пометку об удалении, если email пользователя еще свободен.
+// KEYS: пользователь, индекс deleted_at
+const restoreUserScript = `
+local user = redis.call('HMGET', KEYS[1], 'id', 'email_key', 'deleted_at')
+if not user[1] or not user[3] or user[3] == '0' then
+	return -1
+end
+
+local owner = redis.call('GET', user[2])
+if owner and owner ~= user[1] then
+	return -3
+end
+
+redis.call('SET', user[2], user[1])
+redis.call('HSET', KEYS[1], 'deleted_at', 0)
+redis.call('ZREM', KEYS[2], user[1])
+
+return 0
+`
+
+// purgeUserScript физически удаляет пользователя, если он все еще удален раньше границы, и возвращает 1.
+// Для восстановленного или уже удаленного пользователя убирает только запись индекса deleted_at и возвращает 0.
+// Время хранится в наносекундах строкой одинаковой длины, поэтому сравнивается как строка.
+// KEYS: пользователь, индекс deleted_at, индекс created_at. ARGV: id, префикс индекса роли, граница deleted_at
+const purgeUserScript = `
+local user = redis.call('HMGET', KEYS[1], 'role', 'created_at', 'deleted_at')
+local deletedAt = user[3]
+
+local purged = 0
+
+if deletedAt and deletedAt ~= '0' then
+	if #deletedAt > #ARGV[3] or (#deletedAt == #ARGV[3] and deletedAt >= ARGV[3]) then
+		return 0
+	end
+
+	local createdAt = user[2]
+	redis.call('ZREM', KEYS[3],
+		string.rep('0', 19 - #createdAt) .. createdAt .. ':' .. string.rep('0', 19 - #ARGV[1]) .. ARGV[1])
+	redis.call('SREM', ARGV[2] .. user[1], ARGV[1])
+	redis.call('DEL', KEYS[1])
+	purged = 1
+end
+
+redis.call('ZREM', KEYS[2], ARGV[1])
+
+return purged
+`
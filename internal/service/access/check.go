@@ -0,0 +1,36 @@
+package access
+
+import (
+	"context"
+
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/tracing"
+	"github.com/ipv02/auth/internal/utils"
+)
+
+// Check проверяет, что владельцу access токена разрешен вызов эндпоинта
+func (s *service) Check(ctx context.Context, accessToken, endpointAddress string) error {
+	ctx, span := tracing.StartSpan(ctx, "AccessService.Check")
+	defer span.End()
+
+	claims, err := utils.VerifyToken(accessToken, s.jwtConfig.AccessTokenSecretKey(), model.AccessTokenType)
+	if err != nil {
+		return err
+	}
+
+	p, err := s.getPolicies(ctx)
+	if err != nil {
+		return err
+	}
+
+	roles, ok := p[endpointAddress]
+	if !ok {
+		return model.ErrorAccessDenied
+	}
+
+	if _, ok = roles[claims.Role]; !ok {
+		return model.ErrorAccessDenied
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package access
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RunPolicyReload периодически перечитывает правила доступа из хранилища.
+// При ошибке загрузки продолжают действовать ранее загруженные правила. Завершается без ошибки с отменой ctx
+func (s *service) RunPolicyReload(ctx context.Context) error {
+	ticker := time.NewTicker(s.accessConfig.PolicyReloadInterval())
+	defer ticker.Stop()
+
+	for {
+		if err := s.reloadPolicies(ctx); err != nil {
+			log.Printf("failed to reload access policies: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// getPolicies возвращает закешированные правила, загружая их при первом обращении
+func (s *service) getPolicies(ctx context.Context) (policies, error) {
+	s.mu.RLock()
+	p := s.policies
+	s.mu.RUnlock()
+
+	if p != nil {
+		return p, nil
+	}
+
+	if err := s.reloadPolicies(ctx); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.policies, nil
+}
+
+func (s *service) reloadPolicies(ctx context.Context) error {
+	list, err := s.accessRepository.GetAccessPolicies(ctx)
+	if err != nil {
+		return err
+	}
+
+	p := make(policies, len(list))
+	for _, policy := range list {
+		roles, ok := p[policy.EndpointAddress]
+		if !ok {
+			roles = make(map[int32]struct{})
+			p[policy.EndpointAddress] = roles
+		}
+
+		roles[policy.Role] = struct{}{}
+	}
+
+	s.mu.Lock()
+	s.policies = p
+	s.mu.Unlock()
+
+	return nil
+}
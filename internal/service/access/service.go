@@ -0,0 +1,36 @@
+package access
+
+import (
+	"sync"
+
+	"github.com/ipv02/auth/internal/config"
+	"github.com/ipv02/auth/internal/repository"
+	def "github.com/ipv02/auth/internal/service"
+)
+
+var _ def.AccessService = (*service)(nil)
+
+// policies роли, которым разрешен вызов, по адресу эндпоинта
+type policies map[string]map[int32]struct{}
+
+type service struct {
+	accessRepository repository.AccessRepository
+	jwtConfig        config.JWTConfig
+	accessConfig     config.AccessConfig
+
+	mu       sync.RWMutex
+	policies policies
+}
+
+// NewService конструктор сервиса проверки доступа
+func NewService(
+	accessRepository repository.AccessRepository,
+	jwtConfig config.JWTConfig,
+	accessConfig config.AccessConfig,
+) def.AccessService {
+	return &service{
+		accessRepository: accessRepository,
+		jwtConfig:        jwtConfig,
+		accessConfig:     accessConfig,
+	}
+}
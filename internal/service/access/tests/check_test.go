@@ -0,0 +1,154 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/repository"
+	repoMocks "github.com/ipv02/auth/internal/repository/mocks"
+	"github.com/ipv02/auth/internal/service/access"
+	"github.com/ipv02/auth/internal/utils"
+)
+
+type jwtConfig struct{}
+
+func (jwtConfig) AccessTokenSecretKey() []byte   { return []byte("access") }
+func (jwtConfig) RefreshTokenSecretKey() []byte  { return []byte("refresh") }
+func (jwtConfig) AccessTokenTTL() time.Duration  { return time.Minute }
+func (jwtConfig) RefreshTokenTTL() time.Duration { return time.Hour }
+
+type accessConfig struct{}
+
+func (accessConfig) PolicyReloadInterval() time.Duration { return time.Minute }
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+	type accessRepositoryMockFunc func(mc *minimock.Controller) repository.AccessRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		endpoint = "/user_v1.UserV1/DeleteUser"
+
+		repoErr = fmt.Errorf("repo error")
+
+		policies = []*model.AccessPolicy{
+			{EndpointAddress: endpoint, Role: 2},
+			{EndpointAddress: "/user_v1.UserV1/GetUser", Role: 1},
+		}
+	)
+
+	adminToken, err := utils.GenerateToken(model.UserInfo{ID: gofakeit.Int64(), Role: 2}, jwtConfig{}.AccessTokenSecretKey(), time.Minute)
+	require.NoError(t, err)
+
+	userToken, err := utils.GenerateToken(model.UserInfo{ID: gofakeit.Int64(), Role: 1}, jwtConfig{}.AccessTokenSecretKey(), time.Minute)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                 string
+		accessToken          string
+		endpoint             string
+		err                  error
+		accessRepositoryMock accessRepositoryMockFunc
+	}{
+		{
+			name:        "success case",
+			accessToken: adminToken,
+			endpoint:    endpoint,
+			err:         nil,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repoMocks.NewAccessRepositoryMock(mc)
+				mock.GetAccessPoliciesMock.Expect(minimock.AnyContext).Return(policies, nil)
+				return mock
+			},
+		},
+		{
+			name:        "role not allowed case",
+			accessToken: userToken,
+			endpoint:    endpoint,
+			err:         model.ErrorAccessDenied,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repoMocks.NewAccessRepositoryMock(mc)
+				mock.GetAccessPoliciesMock.Expect(minimock.AnyContext).Return(policies, nil)
+				return mock
+			},
+		},
+		{
+			name:        "unknown endpoint case",
+			accessToken: adminToken,
+			endpoint:    "/user_v1.UserV1/Unknown",
+			err:         model.ErrorAccessDenied,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repoMocks.NewAccessRepositoryMock(mc)
+				mock.GetAccessPoliciesMock.Expect(minimock.AnyContext).Return(policies, nil)
+				return mock
+			},
+		},
+		{
+			name:        "invalid token case",
+			accessToken: gofakeit.UUID(),
+			endpoint:    endpoint,
+			err:         model.ErrorInvalidToken,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				return repoMocks.NewAccessRepositoryMock(mc)
+			},
+		},
+		{
+			name:        "repo error case",
+			accessToken: adminToken,
+			endpoint:    endpoint,
+			err:         repoErr,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repoMocks.NewAccessRepositoryMock(mc)
+				mock.GetAccessPoliciesMock.Expect(minimock.AnyContext).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := access.NewService(tt.accessRepositoryMock(mc), jwtConfig{}, accessConfig{})
+
+			err := service.Check(ctx, tt.accessToken, tt.endpoint)
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestCheckUsesCachedPolicies(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+	)
+
+	token, err := utils.GenerateToken(model.UserInfo{ID: gofakeit.Int64(), Role: 1}, jwtConfig{}.AccessTokenSecretKey(), time.Minute)
+	require.NoError(t, err)
+
+	mock := repoMocks.NewAccessRepositoryMock(mc)
+	mock.GetAccessPoliciesMock.Expect(minimock.AnyContext).Return([]*model.AccessPolicy{{EndpointAddress: "/a", Role: 1}}, nil)
+
+	service := access.NewService(mock, jwtConfig{}, accessConfig{})
+
+	require.NoError(t, service.Check(ctx, token, "/a"))
+	require.NoError(t, service.Check(ctx, token, "/a"))
+	require.Equal(t, uint64(1), mock.GetAccessPoliciesAfterCounter())
+}
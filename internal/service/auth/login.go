@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"log"
+
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/metric"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/tracing"
+)
+
+// Login проверяет email и пароль пользователя и выписывает пару токенов.
+// Refresh токен открывает новое семейство токенов, неудачные попытки учитываются в метриках
+func (s *service) Login(ctx context.Context, email, password string) (*model.TokenPair, error) {
+	ctx, span := tracing.StartSpan(ctx, "AuthService.Login")
+	defer span.End()
+
+	pair, err := s.login(ctx, email, password)
+	if err != nil {
+		if errors.Is(err, model.ErrorInvalidCredentials) {
+			metric.IncLoginsFailed(metric.LoginFailedInvalidCredentials)
+		} else {
+			metric.IncLoginsFailed(metric.LoginFailedError)
+		}
+
+		return nil, err
+	}
+
+	return pair, nil
+}
+
+func (s *service) login(ctx context.Context, email, password string) (*model.TokenPair, error) {
+	user, err := s.userRepository.GetUserAuthByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, model.ErrorUserNotFound) {
+			return nil, model.ErrorInvalidCredentials
+		}
+
+		return nil, err
+	}
+
+	ok, err := s.passwordHasher.Verify(user.PasswordHash, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, model.ErrorInvalidCredentials
+	}
+
+	if s.passwordHasher.NeedsRehash(user.PasswordHash) {
+		s.rehashPassword(ctx, user.ID, password)
+	}
+
+	info := model.UserInfo{ID: user.ID, Role: user.Role}
+
+	refreshToken := s.newRefreshToken(user.ID, newTokenID())
+	err = s.refreshTokenRepository.CreateRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.signTokenPair(info, refreshToken)
+}
+
+// rehashPassword перехеширует пароль актуальным алгоритмом. Ошибка не мешает входу пользователя
+func (s *service) rehashPassword(ctx context.Context, id int64, password string) {
+	passwordHash, err := s.passwordHasher.Hash(password)
+	if err != nil {
+		log.Printf("failed to rehash password of user %d: %v", id, err)
+		return
+	}
+
+	err = s.userRepository.UpdateUserPassword(ctx, id, passwordHash)
+	if err != nil {
+		log.Printf("failed to update password hash of user %d: %v", id, err)
+	}
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"github.com/ipv02/auth/internal/client/db"
+	"github.com/ipv02/auth/internal/config"
+	"github.com/ipv02/auth/internal/repository"
+	def "github.com/ipv02/auth/internal/service"
+)
+
+var _ def.AuthService = (*service)(nil)
+
+type service struct {
+	userRepository         repository.UserRepository
+	refreshTokenRepository repository.RefreshTokenRepository
+	txManager              db.TxManager
+	passwordHasher         def.PasswordHasher
+	jwtConfig              config.JWTConfig
+}
+
+// NewService конструктор сервиса аутентификации
+func NewService(
+	userRepository repository.UserRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+	txManager db.TxManager,
+	passwordHasher def.PasswordHasher,
+	jwtConfig config.JWTConfig,
+) def.AuthService {
+	return &service{
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		txManager:              txManager,
+		passwordHasher:         passwordHasher,
+		jwtConfig:              jwtConfig,
+	}
+}
@@ -0,0 +1,12 @@
+package tests
+
+import (
+	"time"
+)
+
+type jwtConfig struct{}
+
+func (jwtConfig) AccessTokenSecretKey() []byte   { return []byte("access") }
+func (jwtConfig) RefreshTokenSecretKey() []byte  { return []byte("refresh") }
+func (jwtConfig) AccessTokenTTL() time.Duration  { return time.Minute }
+func (jwtConfig) RefreshTokenTTL() time.Duration { return time.Hour }
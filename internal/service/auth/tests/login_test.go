@@ -0,0 +1,249 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	dbMocks "github.com/ipv02/auth/internal/client/db/mocks"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/repository"
+	repoMocks "github.com/ipv02/auth/internal/repository/mocks"
+	"github.com/ipv02/auth/internal/service"
+	"github.com/ipv02/auth/internal/service/auth"
+	"github.com/ipv02/auth/internal/service/hasher"
+	serviceMocks "github.com/ipv02/auth/internal/service/mocks"
+	"github.com/ipv02/auth/internal/utils"
+)
+
+func TestLogin(t *testing.T) {
+	t.Parallel()
+	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type refreshTokenRepositoryMockFunc func(mc *minimock.Controller) repository.RefreshTokenRepository
+	type passwordHasherMockFunc func(mc *minimock.Controller) service.PasswordHasher
+
+	type args struct {
+		ctx      context.Context
+		email    string
+		password string
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id           = gofakeit.Int64()
+		email        = gofakeit.Email()
+		password     = gofakeit.Password(true, true, true, true, false, 10)
+		passwordHash = gofakeit.UUID()
+		newHash      = gofakeit.UUID()
+		role         = int32(1)
+
+		repoErr = fmt.Errorf("repo error")
+
+		userAuth = &model.UserAuth{
+			ID:           id,
+			Role:         role,
+			PasswordHash: passwordHash,
+		}
+	)
+
+	tests := []struct {
+		name                       string
+		args                       args
+		err                        error
+		userRepositoryMock         userRepositoryMockFunc
+		refreshTokenRepositoryMock refreshTokenRepositoryMockFunc
+		passwordHasherMock         passwordHasherMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx:      ctx,
+				email:    email,
+				password: password,
+			},
+			err: nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserAuthByEmailMock.Expect(minimock.AnyContext, email).Return(userAuth, nil)
+				return mock
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repoMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.CreateRefreshTokenMock.Return(nil)
+				return mock
+			},
+			passwordHasherMock: func(mc *minimock.Controller) service.PasswordHasher {
+				mock := serviceMocks.NewPasswordHasherMock(mc)
+				mock.VerifyMock.Expect(passwordHash, password).Return(true, nil)
+				mock.NeedsRehashMock.Expect(passwordHash).Return(false)
+				return mock
+			},
+		},
+		{
+			name: "success with rehash case",
+			args: args{
+				ctx:      ctx,
+				email:    email,
+				password: password,
+			},
+			err: nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserAuthByEmailMock.Expect(minimock.AnyContext, email).Return(userAuth, nil)
+				mock.UpdateUserPasswordMock.Expect(minimock.AnyContext, id, newHash).Return(nil)
+				return mock
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repoMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.CreateRefreshTokenMock.Return(nil)
+				return mock
+			},
+			passwordHasherMock: func(mc *minimock.Controller) service.PasswordHasher {
+				mock := serviceMocks.NewPasswordHasherMock(mc)
+				mock.VerifyMock.Expect(passwordHash, password).Return(true, nil)
+				mock.NeedsRehashMock.Expect(passwordHash).Return(true)
+				mock.HashMock.Expect(password).Return(newHash, nil)
+				return mock
+			},
+		},
+		{
+			name: "plaintext password case",
+			args: args{
+				ctx:      ctx,
+				email:    email,
+				password: password,
+			},
+			err: nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserAuthByEmailMock.Expect(minimock.AnyContext, email).Return(&model.UserAuth{
+					ID:           id,
+					Role:         role,
+					PasswordHash: password,
+				}, nil)
+				mock.UpdateUserPasswordMock.Set(func(_ context.Context, userID int64, hash string) error {
+					require.Equal(t, id, userID)
+					require.True(t, strings.HasPrefix(hash, "$argon2id$"))
+					return nil
+				})
+				return mock
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repoMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.CreateRefreshTokenMock.Return(nil)
+				return mock
+			},
+			passwordHasherMock: func(_ *minimock.Controller) service.PasswordHasher {
+				h, err := hasher.NewHasher(hasher.AlgorithmArgon2ID)
+				require.NoError(t, err)
+				return h
+			},
+		},
+		{
+			name: "user not found case",
+			args: args{
+				ctx:      ctx,
+				email:    email,
+				password: password,
+			},
+			err: model.ErrorInvalidCredentials,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserAuthByEmailMock.Expect(minimock.AnyContext, email).Return(nil, model.ErrorUserNotFound)
+				return mock
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				return repoMocks.NewRefreshTokenRepositoryMock(mc)
+			},
+			passwordHasherMock: func(mc *minimock.Controller) service.PasswordHasher {
+				return serviceMocks.NewPasswordHasherMock(mc)
+			},
+		},
+		{
+			name: "wrong password case",
+			args: args{
+				ctx:      ctx,
+				email:    email,
+				password: password,
+			},
+			err: model.ErrorInvalidCredentials,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserAuthByEmailMock.Expect(minimock.AnyContext, email).Return(userAuth, nil)
+				return mock
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				return repoMocks.NewRefreshTokenRepositoryMock(mc)
+			},
+			passwordHasherMock: func(mc *minimock.Controller) service.PasswordHasher {
+				mock := serviceMocks.NewPasswordHasherMock(mc)
+				mock.VerifyMock.Expect(passwordHash, password).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "repo error case",
+			args: args{
+				ctx:      ctx,
+				email:    email,
+				password: password,
+			},
+			err: repoErr,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserAuthByEmailMock.Expect(minimock.AnyContext, email).Return(nil, repoErr)
+				return mock
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				return repoMocks.NewRefreshTokenRepositoryMock(mc)
+			},
+			passwordHasherMock: func(mc *minimock.Controller) service.PasswordHasher {
+				return serviceMocks.NewPasswordHasherMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userRepoMock := tt.userRepositoryMock(mc)
+			refreshTokenRepoMock := tt.refreshTokenRepositoryMock(mc)
+			passwordHasherMock := tt.passwordHasherMock(mc)
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			service := auth.NewService(userRepoMock, refreshTokenRepoMock, txManagerMock, passwordHasherMock, jwtConfig{})
+
+			tokens, err := service.Login(tt.args.ctx, tt.args.email, tt.args.password)
+			require.Equal(t, tt.err, err)
+			if tt.err != nil {
+				require.Nil(t, tokens)
+				return
+			}
+
+			claims, err := utils.VerifyToken(tokens.AccessToken, jwtConfig{}.AccessTokenSecretKey(), model.AccessTokenType)
+			require.NoError(t, err)
+			require.Equal(t, id, claims.UserID)
+			require.Equal(t, role, claims.Role)
+
+			claims, err = utils.VerifyToken(tokens.RefreshToken, jwtConfig{}.RefreshTokenSecretKey(), model.RefreshTokenType)
+			require.NoError(t, err)
+			require.Equal(t, id, claims.UserID)
+			require.NotEmpty(t, claims.ID)
+			require.NotEmpty(t, claims.FamilyID)
+
+			_, err = utils.VerifyToken(tokens.RefreshToken, jwtConfig{}.AccessTokenSecretKey(), model.AccessTokenType)
+			require.ErrorIs(t, err, model.ErrorInvalidToken)
+
+			_, err = utils.VerifyToken(tokens.RefreshToken, jwtConfig{}.RefreshTokenSecretKey(), model.AccessTokenType)
+			require.ErrorIs(t, err, model.ErrorInvalidToken)
+		})
+	}
+}
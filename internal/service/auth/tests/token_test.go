@@ -0,0 +1,281 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/auth/internal/client/db"
+	dbMocks "github.com/ipv02/auth/internal/client/db/mocks"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/repository"
+	repoMocks "github.com/ipv02/auth/internal/repository/mocks"
+	"github.com/ipv02/auth/internal/service/auth"
+	serviceMocks "github.com/ipv02/auth/internal/service/mocks"
+	"github.com/ipv02/auth/internal/utils"
+)
+
+func TestGetRefreshToken(t *testing.T) {
+	t.Parallel()
+	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type refreshTokenRepositoryMockFunc func(mc *minimock.Controller) repository.RefreshTokenRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id       = gofakeit.Int64()
+		role     = int32(2)
+		tokenID  = gofakeit.UUID()
+		familyID = gofakeit.UUID()
+
+		repoErr = fmt.Errorf("repo error")
+
+		stored = &model.RefreshToken{
+			ID:        tokenID,
+			FamilyID:  familyID,
+			UserID:    id,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		used = &model.RefreshToken{
+			ID:        tokenID,
+			FamilyID:  familyID,
+			UserID:    id,
+			ExpiresAt: stored.ExpiresAt,
+			UsedAt:    sql.NullTime{Time: time.Now(), Valid: true},
+		}
+
+		revoked = &model.RefreshToken{
+			ID:        tokenID,
+			FamilyID:  familyID,
+			UserID:    id,
+			ExpiresAt: stored.ExpiresAt,
+			RevokedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		}
+
+		user = &model.UserGet{
+			ID:       id,
+			UserRole: role,
+		}
+	)
+
+	refreshToken, err := utils.GenerateRefreshToken(model.UserInfo{ID: id, Role: 1}, stored, jwtConfig{}.RefreshTokenSecretKey())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                       string
+		refreshToken               string
+		err                        error
+		userRepositoryMock         userRepositoryMockFunc
+		refreshTokenRepositoryMock refreshTokenRepositoryMockFunc
+	}{
+		{
+			name:         "success case",
+			refreshToken: refreshToken,
+			err:          nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(minimock.AnyContext, id).Return(user, nil)
+				return mock
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repoMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.GetRefreshTokenMock.Expect(minimock.AnyContext, tokenID).Return(stored, nil)
+				mock.MarkRefreshTokenUsedMock.Expect(minimock.AnyContext, tokenID).Return(nil)
+				mock.CreateRefreshTokenMock.Return(nil)
+				return mock
+			},
+		},
+		{
+			name:         "reused token revokes family case",
+			refreshToken: refreshToken,
+			err:          model.ErrorRefreshTokenReused,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repoMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.GetRefreshTokenMock.Expect(minimock.AnyContext, tokenID).Return(used, nil)
+				mock.RevokeRefreshTokenFamilyMock.Expect(minimock.AnyContext, familyID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:         "revoked token case",
+			refreshToken: refreshToken,
+			err:          model.ErrorInvalidToken,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repoMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.GetRefreshTokenMock.Expect(minimock.AnyContext, tokenID).Return(revoked, nil)
+				return mock
+			},
+		},
+		{
+			name:         "malformed token case",
+			refreshToken: gofakeit.UUID(),
+			err:          model.ErrorInvalidToken,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				return repoMocks.NewRefreshTokenRepositoryMock(mc)
+			},
+		},
+		{
+			name:         "repo error case",
+			refreshToken: refreshToken,
+			err:          repoErr,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repoMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.GetRefreshTokenMock.Expect(minimock.AnyContext, tokenID).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc).SerializableMock.Optional().Set(func(ctx context.Context, f db.Handler, _ ...db.TxOption) error {
+				return f(ctx)
+			})
+			service := auth.NewService(
+				tt.userRepositoryMock(mc),
+				tt.refreshTokenRepositoryMock(mc),
+				txManagerMock,
+				serviceMocks.NewPasswordHasherMock(mc),
+				jwtConfig{},
+			)
+
+			tokens, err := service.GetRefreshToken(ctx, tt.refreshToken)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				require.Nil(t, tokens)
+				return
+			}
+
+			require.NoError(t, err)
+
+			claims, err := utils.VerifyToken(tokens.RefreshToken, jwtConfig{}.RefreshTokenSecretKey(), model.RefreshTokenType)
+			require.NoError(t, err)
+			require.Equal(t, familyID, claims.FamilyID)
+			require.NotEqual(t, tokenID, claims.ID)
+			require.Equal(t, role, claims.Role)
+
+			accessClaims, err := utils.VerifyToken(tokens.AccessToken, jwtConfig{}.AccessTokenSecretKey(), model.AccessTokenType)
+			require.NoError(t, err)
+			require.Equal(t, id, accessClaims.UserID)
+			require.Equal(t, role, accessClaims.Role)
+		})
+	}
+}
+
+func TestGetAccessToken(t *testing.T) {
+	t.Parallel()
+	type refreshTokenRepositoryMockFunc func(mc *minimock.Controller) repository.RefreshTokenRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id       = gofakeit.Int64()
+		role     = int32(2)
+		tokenID  = gofakeit.UUID()
+		familyID = gofakeit.UUID()
+
+		stored = &model.RefreshToken{
+			ID:        tokenID,
+			FamilyID:  familyID,
+			UserID:    id,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		used = &model.RefreshToken{
+			ID:        tokenID,
+			FamilyID:  familyID,
+			UserID:    id,
+			ExpiresAt: stored.ExpiresAt,
+			UsedAt:    sql.NullTime{Time: time.Now(), Valid: true},
+		}
+	)
+
+	refreshToken, err := utils.GenerateRefreshToken(model.UserInfo{ID: id, Role: role}, stored, jwtConfig{}.RefreshTokenSecretKey())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                       string
+		err                        error
+		refreshTokenRepositoryMock refreshTokenRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repoMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.GetRefreshTokenMock.Expect(minimock.AnyContext, tokenID).Return(stored, nil)
+				return mock
+			},
+		},
+		{
+			name: "reused token revokes family case",
+			err:  model.ErrorRefreshTokenReused,
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repoMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.GetRefreshTokenMock.Expect(minimock.AnyContext, tokenID).Return(used, nil)
+				mock.RevokeRefreshTokenFamilyMock.Expect(minimock.AnyContext, familyID).Return(nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userRepoMock := repoMocks.NewUserRepositoryMock(mc)
+			if tt.err == nil {
+				userRepoMock.GetUserMock.Expect(minimock.AnyContext, id).Return(&model.UserGet{ID: id, UserRole: role}, nil)
+			}
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc).ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler, _ ...db.TxOption) error {
+				return f(ctx)
+			})
+			service := auth.NewService(
+				userRepoMock,
+				tt.refreshTokenRepositoryMock(mc),
+				txManagerMock,
+				serviceMocks.NewPasswordHasherMock(mc),
+				jwtConfig{},
+			)
+
+			accessToken, err := service.GetAccessToken(ctx, refreshToken)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			claims, err := utils.VerifyToken(accessToken, jwtConfig{}.AccessTokenSecretKey(), model.AccessTokenType)
+			require.NoError(t, err)
+			require.Equal(t, id, claims.UserID)
+			require.Equal(t, role, claims.Role)
+		})
+	}
+}
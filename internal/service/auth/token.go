@@ -0,0 +1,186 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/client/db"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/tracing"
+	"github.com/ipv02/auth/internal/utils"
+)
+
+// GetRefreshToken обменивает действующий refresh токен на новую пару токенов, refresh токен
+// остается в том же семействе. Предъявленный токен помечается использованным. Обмен выполняется в serializable транзакции, поэтому
+// из одновременных обменов одного токена один завершится, а повтор остальных обнаружит повторное использование
+func (s *service) GetRefreshToken(ctx context.Context, refreshToken string) (*model.TokenPair, error) {
+	ctx, span := tracing.StartSpan(ctx, "AuthService.GetRefreshToken")
+	defer span.End()
+
+	claims, err := s.verifyRefreshToken(refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		info     model.UserInfo
+		newToken *model.RefreshToken
+		reused   bool
+	)
+
+	err = s.txManager.Serializable(ctx, func(ctx context.Context) error {
+		var errTx error
+		info, reused, errTx = s.useRefreshToken(ctx, claims)
+		if errTx != nil || reused {
+			return errTx
+		}
+
+		errTx = s.refreshTokenRepository.MarkRefreshTokenUsed(ctx, claims.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		newToken = s.newRefreshToken(info.ID, claims.FamilyID)
+
+		return s.refreshTokenRepository.CreateRefreshToken(ctx, newToken)
+	}, db.RetrySafe())
+	if err != nil {
+		return nil, err
+	}
+
+	if reused {
+		return nil, model.ErrorRefreshTokenReused
+	}
+
+	return s.signTokenPair(info, newToken)
+}
+
+// GetAccessToken выписывает access токен по действующему refresh токену
+func (s *service) GetAccessToken(ctx context.Context, refreshToken string) (string, error) {
+	ctx, span := tracing.StartSpan(ctx, "AuthService.GetAccessToken")
+	defer span.End()
+
+	claims, err := s.verifyRefreshToken(refreshToken)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		info   model.UserInfo
+		reused bool
+	)
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		info, reused, errTx = s.useRefreshToken(ctx, claims)
+
+		return errTx
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if reused {
+		return "", model.ErrorRefreshTokenReused
+	}
+
+	return s.signAccessToken(info)
+}
+
+// useRefreshToken проверяет состояние refresh токена в хранилище и возвращает актуальные данные пользователя.
+// Если токен уже был использован, отзывается все семейство: вероятно, токен украден.
+// Отзыв семейства должен быть закоммичен, поэтому о повторном использовании сообщается флагом, а не ошибкой
+func (s *service) useRefreshToken(ctx context.Context, claims *model.UserClaims) (model.UserInfo, bool, error) {
+	stored, err := s.refreshTokenRepository.GetRefreshToken(ctx, claims.ID)
+	if err != nil {
+		return model.UserInfo{}, false, err
+	}
+
+	if stored.FamilyID != claims.FamilyID || stored.UserID != claims.UserID || stored.RevokedAt.Valid {
+		return model.UserInfo{}, false, model.ErrorInvalidToken
+	}
+
+	if stored.UsedAt.Valid {
+		err = s.refreshTokenRepository.RevokeRefreshTokenFamily(ctx, stored.FamilyID)
+		if err != nil {
+			return model.UserInfo{}, false, err
+		}
+
+		return model.UserInfo{}, true, nil
+	}
+
+	user, err := s.userRepository.GetUser(ctx, stored.UserID)
+	if err != nil {
+		if errors.Is(err, model.ErrorUserNotFound) {
+			return model.UserInfo{}, false, model.ErrorInvalidToken
+		}
+
+		return model.UserInfo{}, false, err
+	}
+
+	return model.UserInfo{ID: user.ID, Role: user.UserRole}, false, nil
+}
+
+func (s *service) verifyRefreshToken(refreshToken string) (*model.UserClaims, error) {
+	claims, err := utils.VerifyToken(refreshToken, s.jwtConfig.RefreshTokenSecretKey(), model.RefreshTokenType)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.ID == "" || claims.FamilyID == "" {
+		return nil, model.ErrorInvalidToken
+	}
+
+	return claims, nil
+}
+
+func (s *service) newRefreshToken(userID int64, familyID string) *model.RefreshToken {
+	return &model.RefreshToken{
+		ID:        newTokenID(),
+		FamilyID:  familyID,
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(s.jwtConfig.RefreshTokenTTL()),
+	}
+}
+
+func (s *service) signTokenPair(info model.UserInfo, refreshToken *model.RefreshToken) (*model.TokenPair, error) {
+	refreshTokenStr, err := s.signRefreshToken(info, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	accessTokenStr, err := s.signAccessToken(info)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.TokenPair{
+		AccessToken:  accessTokenStr,
+		RefreshToken: refreshTokenStr,
+	}, nil
+}
+
+func (s *service) signRefreshToken(info model.UserInfo, refreshToken *model.RefreshToken) (string, error) {
+	token, err := utils.GenerateRefreshToken(info, refreshToken, s.jwtConfig.RefreshTokenSecretKey())
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate refresh token")
+	}
+
+	return token, nil
+}
+
+func (s *service) signAccessToken(info model.UserInfo) (string, error) {
+	token, err := utils.GenerateToken(info, s.jwtConfig.AccessTokenSecretKey(), s.jwtConfig.AccessTokenTTL())
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate access token")
+	}
+
+	return token, nil
+}
+
+func newTokenID() string {
+	return uuid.NewString()
+}
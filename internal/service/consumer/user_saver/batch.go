@@ -0,0 +1,109 @@
+package user_saver
+
+import (
+	"context"
+	"log"
+
+	"github.com/IBM/sarama"
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/client/db"
+	"github.com/ipv02/auth/internal/client/kafka"
+	kafkaConsumer "github.com/ipv02/auth/internal/client/kafka/consumer"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/repository"
+	def "github.com/ipv02/auth/internal/service"
+)
+
+var _ def.ConsumerService = (*batchService)(nil)
+
+type batchService struct {
+	userService                def.UserService
+	consumer                   kafka.BatchConsumer
+	topics                     []string
+	txManager                  db.TxManager
+	processedMessageRepository repository.ProcessedMessageRepository
+}
+
+// NewBatchService создает сервис, который сохраняет пользователей из сообщений пакетами
+func NewBatchService(
+	userService def.UserService,
+	consumer kafka.BatchConsumer,
+	topics []string,
+	txManager db.TxManager,
+	processedMessageRepository repository.ProcessedMessageRepository,
+) *batchService {
+	return &batchService{
+		userService:                userService,
+		consumer:                   consumer,
+		topics:                     topics,
+		txManager:                  txManager,
+		processedMessageRepository: processedMessageRepository,
+	}
+}
+
+// RunConsumer запускает процесс потребления сообщений в фоновом режиме
+func (s *batchService) RunConsumer(ctx context.Context) error {
+	return runConsumer(ctx, func(ctx context.Context) error {
+		return s.consumer.Consume(ctx, s.topics, s.UserSaveBatchHandler)
+	})
+}
+
+// UserSaveBatchHandler создает пользователей из пакета сообщений одной транзакцией вместе с их ключами идемпотентности.
+// Уже обработанные и повторяющиеся в пакете сообщения пропускаются. Если часть сообщений не декодируется,
+// пакет не обрабатывается, а эти сообщения возвращаются в PermanentBatchError. Другая ошибка пакета приводит
+// к обработке его сообщений по одному, поэтому пользователь с существующим email пропускается только в пакете из одного сообщения
+func (s *batchService) UserSaveBatchHandler(ctx context.Context, msgs []*sarama.ConsumerMessage) error {
+	users := make(map[string]*model.UserCreate, len(msgs))
+	keys := make([]string, 0, len(msgs))
+	failed := make(map[*sarama.ConsumerMessage]error)
+	for _, msg := range msgs {
+		userCreate, err := decodeUserCreate(msg)
+		if err != nil {
+			failed[msg] = err
+			continue
+		}
+
+		key := idempotencyKey(msg)
+		if _, ok := users[key]; ok {
+			continue
+		}
+
+		users[key] = userCreate
+		keys = append(keys, key)
+	}
+
+	if len(failed) > 0 {
+		return &kafkaConsumer.PermanentBatchError{Errors: failed}
+	}
+
+	var ids []int64
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		marked, errTx := s.processedMessageRepository.MarkProcessedBatch(ctx, keys)
+		if errTx != nil {
+			return errTx
+		}
+		if len(marked) == 0 {
+			return nil
+		}
+
+		userCreates := make([]*model.UserCreate, 0, len(marked))
+		for _, key := range marked {
+			userCreates = append(userCreates, users[key])
+		}
+
+		ids, errTx = s.userService.CreateUsers(ctx, userCreates)
+		return errTx
+	})
+	if errors.Is(err, model.ErrUserAlreadyExists) && len(msgs) == 1 {
+		log.Printf("User from %s/%d at offset %d already exists, skipping message\n", msgs[0].Topic, msgs[0].Partition, msgs[0].Offset)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Printf("%d users created from batch of %d messages\n", len(ids), len(msgs))
+
+	return nil
+}
@@ -3,6 +3,7 @@ package user_saver
 import (
 	"context"
 
+	"github.com/ipv02/auth/internal/client/db"
 	"github.com/ipv02/auth/internal/client/kafka"
 	"github.com/ipv02/auth/internal/repository"
 	def "github.com/ipv02/auth/internal/service"
@@ -11,28 +12,43 @@ import (
 var _ def.ConsumerService = (*service)(nil)
 
 type service struct {
-	userRepository repository.UserRepository
-	consumer       kafka.Consumer
+	userService                def.UserService
+	consumer                   kafka.Consumer
+	topics                     []string
+	txManager                  db.TxManager
+	processedMessageRepository repository.ProcessedMessageRepository
 }
 
 // NewService создает и возвращает новый экземпляр сервиса
 func NewService(
-	userRepository repository.UserRepository,
+	userService def.UserService,
 	consumer kafka.Consumer,
+	topics []string,
+	txManager db.TxManager,
+	processedMessageRepository repository.ProcessedMessageRepository,
 ) *service {
 	return &service{
-		userRepository: userRepository,
-		consumer:       consumer,
+		userService:                userService,
+		consumer:                   consumer,
+		topics:                     topics,
+		txManager:                  txManager,
+		processedMessageRepository: processedMessageRepository,
 	}
 }
 
 // RunConsumer запускает процесс потребления сообщений в фоновом режиме
 func (s *service) RunConsumer(ctx context.Context) error {
+	return runConsumer(ctx, func(ctx context.Context) error {
+		return s.consumer.Consume(ctx, s.topics, s.UserSaveHandler)
+	})
+}
+
+func runConsumer(ctx context.Context, consume func(ctx context.Context) error) error {
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case err := <-s.run(ctx):
+		case err := <-run(ctx, consume):
 			if err != nil {
 				return err
 			}
@@ -40,13 +56,13 @@ func (s *service) RunConsumer(ctx context.Context) error {
 	}
 }
 
-func (s *service) run(ctx context.Context) <-chan error {
+func run(ctx context.Context, consume func(ctx context.Context) error) <-chan error {
 	errChan := make(chan error)
 
 	go func() {
 		defer close(errChan)
 
-		errChan <- s.consumer.Consume(ctx, "test-topic", s.UserSaveHandler)
+		errChan <- consume(ctx)
 	}()
 
 	return errChan
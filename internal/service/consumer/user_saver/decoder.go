@@ -0,0 +1,66 @@
+package user_saver
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ipv02/auth/internal/client/kafka/consumer"
+	"github.com/ipv02/auth/internal/converter"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/pkg/user_events_v1"
+)
+
+// Заголовок и поддерживаемые форматы сообщения
+const (
+	ContentTypeHeader   = "content-type"
+	ContentTypeProtobuf = "application/x-protobuf"
+	ContentTypeJSON     = "application/json"
+)
+
+// errUnsupportedContentType формат сообщения не поддерживается
+var errUnsupportedContentType = errors.New("unsupported content type")
+
+var jsonUnmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+// decodeUserCreate декодирует событие по заголовку content-type и проверяет его правилами gRPC-запроса.
+// Сообщение без заголовка считается JSON, как у отправителей до появления схемы.
+// Ошибки помечаются постоянными, так как повторная обработка сообщения приведет к той же ошибке
+func decodeUserCreate(msg *sarama.ConsumerMessage) (*model.UserCreate, error) {
+	event := &user_events_v1.UserCreateEvent{}
+
+	var err error
+	switch contentType := header(msg, ContentTypeHeader); contentType {
+	case ContentTypeProtobuf:
+		err = proto.Unmarshal(msg.Value, event)
+	case ContentTypeJSON, "":
+		err = jsonUnmarshaler.Unmarshal(msg.Value, event)
+	default:
+		return nil, consumer.Permanent(errors.Wrap(errUnsupportedContentType, contentType))
+	}
+	if err != nil {
+		return nil, consumer.Permanent(errors.Wrap(err, "failed to decode user create event"))
+	}
+
+	req := converter.ToCreateUserReqFromEvent(event)
+	if err = req.Validate(); err != nil {
+		return nil, consumer.Permanent(model.NewInvalidArgumentError(err.Error()))
+	}
+	if err = req.ValidateRequest(); err != nil {
+		return nil, consumer.Permanent(model.NewInvalidArgumentError(err.Error()))
+	}
+
+	return converter.ToUserCreateFromReq(req), nil
+}
+
+// header возвращает значение заголовка сообщения или пустую строку
+func header(msg *sarama.ConsumerMessage, key string) string {
+	for _, h := range msg.Headers {
+		if h != nil && string(h.Key) == key {
+			return string(h.Value)
+		}
+	}
+
+	return ""
+}
@@ -2,27 +2,70 @@ package user_saver
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"log"
 
 	"github.com/IBM/sarama"
+	"github.com/pkg/errors"
 
 	"github.com/ipv02/auth/internal/model"
 )
 
+// IdempotencyKeyHeader заголовок с ключом идемпотентности, который задает отправитель сообщения
+const IdempotencyKeyHeader = "idempotency-key"
+
+// UserSaveHandler создает пользователя из сообщения после проверки правилами gRPC-запроса. Ключ идемпотентности сохраняется в одной
+// транзакции с пользователем, поэтому повторно доставленное сообщение подтверждается без создания дубля.
+// С хранилищем redis пользователь создается вне транзакции ключа: если она не закоммитится, повторная доставка
+// не создаст дубль только благодаря уникальности email
 func (s *service) UserSaveHandler(ctx context.Context, msg *sarama.ConsumerMessage) error {
-	userCreate := &model.UserCreate{}
-	err := json.Unmarshal(msg.Value, userCreate)
+	userCreate, err := decodeUserCreate(msg)
 	if err != nil {
 		return err
 	}
 
-	id, err := s.userRepository.CreateUser(ctx, userCreate)
+	key := idempotencyKey(msg)
+
+	var (
+		id        int64
+		processed bool
+	)
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		first, errTx := s.processedMessageRepository.MarkProcessed(ctx, key)
+		if errTx != nil {
+			return errTx
+		}
+		if !first {
+			processed = true
+			return nil
+		}
+
+		id, errTx = s.userService.CreateUser(ctx, userCreate)
+		return errTx
+	})
+	if errors.Is(err, model.ErrUserAlreadyExists) {
+		log.Printf("User from %s/%d at offset %d already exists, skipping message\n", msg.Topic, msg.Partition, msg.Offset)
+		return nil
+	}
 	if err != nil {
 		return err
 	}
 
+	if processed {
+		log.Printf("Message %s was already processed, skipping\n", key)
+		return nil
+	}
+
 	log.Printf("User with id %d created\n", id)
 
 	return nil
 }
+
+// idempotencyKey возвращает ключ из заголовка сообщения, а без него - положение сообщения в топике
+func idempotencyKey(msg *sarama.ConsumerMessage) string {
+	if key := header(msg, IdempotencyKeyHeader); key != "" {
+		return key
+	}
+
+	return fmt.Sprintf("%s/%d/%d", msg.Topic, msg.Partition, msg.Offset)
+}
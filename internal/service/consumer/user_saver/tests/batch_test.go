@@ -0,0 +1,174 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/auth/internal/client/db"
+	dbMocks "github.com/ipv02/auth/internal/client/db/mocks"
+	"github.com/ipv02/auth/internal/client/kafka/consumer"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/repository"
+	repoMocks "github.com/ipv02/auth/internal/repository/mocks"
+	"github.com/ipv02/auth/internal/service"
+	"github.com/ipv02/auth/internal/service/consumer/user_saver"
+	serviceMocks "github.com/ipv02/auth/internal/service/mocks"
+)
+
+func TestUserSaveBatchHandler(t *testing.T) {
+	t.Parallel()
+	type userServiceMockFunc func(mc *minimock.Controller) service.UserService
+	type processedMessageRepositoryMockFunc func(mc *minimock.Controller) repository.ProcessedMessageRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		repoErr = fmt.Errorf("repo error")
+
+		password = gofakeit.Password(true, true, true, true, false, 10)
+		alice    = &model.UserCreate{Name: "alice", Email: gofakeit.Email(), Password: password, PasswordConfirm: password, Role: 1}
+		bob      = &model.UserCreate{Name: "bob", Email: gofakeit.Email(), Password: password, PasswordConfirm: password, Role: 2}
+	)
+
+	message := func(offset int64, userCreate *model.UserCreate) *sarama.ConsumerMessage {
+		value, err := json.Marshal(userCreate)
+		require.NoError(t, err)
+
+		return &sarama.ConsumerMessage{Topic: "users", Offset: offset, Value: value}
+	}
+
+	batch := []*sarama.ConsumerMessage{message(1, alice), message(2, bob)}
+	invalidBatch := []*sarama.ConsumerMessage{
+		message(1, alice),
+		message(2, &model.UserCreate{Name: "bob", Email: bob.Email, Password: password, PasswordConfirm: password + "x", Role: 2}),
+	}
+
+	tests := []struct {
+		name                           string
+		msgs                           []*sarama.ConsumerMessage
+		err                            error
+		userServiceMock                userServiceMockFunc
+		processedMessageRepositoryMock processedMessageRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			msgs: batch,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateUsersMock.Expect(ctx, []*model.UserCreate{alice, bob}).Return([]int64{1, 2}, nil)
+				return mock
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller) repository.ProcessedMessageRepository {
+				mock := repoMocks.NewProcessedMessageRepositoryMock(mc)
+				mock.MarkProcessedBatchMock.Expect(ctx, []string{"users/0/1", "users/0/2"}).Return([]string{"users/0/1", "users/0/2"}, nil)
+				return mock
+			},
+		},
+		{
+			name: "partially processed batch case",
+			msgs: batch,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateUsersMock.Expect(ctx, []*model.UserCreate{bob}).Return([]int64{2}, nil)
+				return mock
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller) repository.ProcessedMessageRepository {
+				mock := repoMocks.NewProcessedMessageRepositoryMock(mc)
+				mock.MarkProcessedBatchMock.Expect(ctx, []string{"users/0/1", "users/0/2"}).Return([]string{"users/0/2"}, nil)
+				return mock
+			},
+		},
+		{
+			name: "processed batch case",
+			msgs: batch,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				return serviceMocks.NewUserServiceMock(mc)
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller) repository.ProcessedMessageRepository {
+				mock := repoMocks.NewProcessedMessageRepositoryMock(mc)
+				mock.MarkProcessedBatchMock.Expect(ctx, []string{"users/0/1", "users/0/2"}).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "user already exists in batch case",
+			msgs: batch,
+			err:  model.ErrUserAlreadyExists,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateUsersMock.Expect(ctx, []*model.UserCreate{alice, bob}).Return(nil, model.ErrUserAlreadyExists)
+				return mock
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller) repository.ProcessedMessageRepository {
+				mock := repoMocks.NewProcessedMessageRepositoryMock(mc)
+				mock.MarkProcessedBatchMock.Expect(ctx, []string{"users/0/1", "users/0/2"}).Return([]string{"users/0/1", "users/0/2"}, nil)
+				return mock
+			},
+		},
+		{
+			name: "user already exists in single message case",
+			msgs: batch[:1],
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateUsersMock.Expect(ctx, []*model.UserCreate{alice}).Return(nil, model.ErrUserAlreadyExists)
+				return mock
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller) repository.ProcessedMessageRepository {
+				mock := repoMocks.NewProcessedMessageRepositoryMock(mc)
+				mock.MarkProcessedBatchMock.Expect(ctx, []string{"users/0/1"}).Return([]string{"users/0/1"}, nil)
+				return mock
+			},
+		},
+		{
+			name: "validation error case",
+			msgs: invalidBatch,
+			err: &consumer.PermanentBatchError{
+				Errors: map[*sarama.ConsumerMessage]error{
+					invalidBatch[1]: consumer.Permanent(model.NewInvalidArgumentError("passwords do not match")),
+				},
+			},
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				return serviceMocks.NewUserServiceMock(mc)
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller) repository.ProcessedMessageRepository {
+				return repoMocks.NewProcessedMessageRepositoryMock(mc)
+			},
+		},
+		{
+			name: "repo error case",
+			msgs: batch,
+			err:  repoErr,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				return serviceMocks.NewUserServiceMock(mc)
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller) repository.ProcessedMessageRepository {
+				mock := repoMocks.NewProcessedMessageRepositoryMock(mc)
+				mock.MarkProcessedBatchMock.Expect(ctx, []string{"users/0/1", "users/0/2"}).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc).ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler, _ ...db.TxOption) error {
+				return f(ctx)
+			})
+			s := user_saver.NewBatchService(tt.userServiceMock(mc), nil, nil, txManagerMock, tt.processedMessageRepositoryMock(mc))
+
+			err := s.UserSaveBatchHandler(ctx, tt.msgs)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
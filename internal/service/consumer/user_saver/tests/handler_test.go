@@ -0,0 +1,247 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ipv02/auth/internal/client/db"
+	dbMocks "github.com/ipv02/auth/internal/client/db/mocks"
+	"github.com/ipv02/auth/internal/client/kafka/consumer"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/repository"
+	repoMocks "github.com/ipv02/auth/internal/repository/mocks"
+	"github.com/ipv02/auth/internal/service"
+	"github.com/ipv02/auth/internal/service/consumer/user_saver"
+	serviceMocks "github.com/ipv02/auth/internal/service/mocks"
+	"github.com/ipv02/auth/pkg/user_events_v1"
+)
+
+func TestUserSaveHandler(t *testing.T) {
+	t.Parallel()
+	type userServiceMockFunc func(mc *minimock.Controller) service.UserService
+	type processedMessageRepositoryMockFunc func(mc *minimock.Controller) repository.ProcessedMessageRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id = gofakeit.Int64()
+
+		serviceErr = fmt.Errorf("service error")
+		repoErr    = fmt.Errorf("repo error")
+
+		password   = gofakeit.Password(true, true, true, true, false, 10)
+		userCreate = &model.UserCreate{
+			Name:            "alice",
+			Email:           gofakeit.Email(),
+			Password:        password,
+			PasswordConfirm: password,
+			Role:            1,
+		}
+	)
+
+	value, err := json.Marshal(userCreate)
+	require.NoError(t, err)
+
+	protoValue, err := proto.Marshal(&user_events_v1.UserCreateEvent{
+		Name:            userCreate.Name,
+		Email:           userCreate.Email,
+		Password:        password,
+		PasswordConfirm: password,
+		Role:            user_events_v1.UserRole_USER,
+	})
+	require.NoError(t, err)
+
+	invalidValue, err := json.Marshal(&model.UserCreate{
+		Name:            userCreate.Name,
+		Email:           userCreate.Email,
+		Password:        password,
+		PasswordConfirm: password + "x",
+		Role:            1,
+	})
+	require.NoError(t, err)
+
+	msg := &sarama.ConsumerMessage{Topic: "users", Partition: 1, Offset: 7, Value: value}
+	protoMsg := &sarama.ConsumerMessage{
+		Topic:     "users",
+		Partition: 1,
+		Offset:    7,
+		Value:     protoValue,
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte(user_saver.ContentTypeHeader), Value: []byte(user_saver.ContentTypeProtobuf)},
+		},
+	}
+	unsupportedMsg := &sarama.ConsumerMessage{
+		Topic:     "users",
+		Partition: 1,
+		Offset:    7,
+		Value:     value,
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte(user_saver.ContentTypeHeader), Value: []byte("text/plain")},
+		},
+	}
+	invalidMsg := &sarama.ConsumerMessage{Topic: "users", Partition: 1, Offset: 7, Value: invalidValue}
+	msgWithKey := &sarama.ConsumerMessage{
+		Topic:     "users",
+		Partition: 1,
+		Offset:    7,
+		Value:     value,
+		Headers:   []*sarama.RecordHeader{{Key: []byte(user_saver.IdempotencyKeyHeader), Value: []byte("request-1")}},
+	}
+
+	tests := []struct {
+		name                           string
+		msg                            *sarama.ConsumerMessage
+		err                            error
+		errText                        string
+		userServiceMock                userServiceMockFunc
+		processedMessageRepositoryMock processedMessageRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			msg:  msg,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateUserMock.Expect(ctx, userCreate).Return(id, nil)
+				return mock
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller) repository.ProcessedMessageRepository {
+				mock := repoMocks.NewProcessedMessageRepositoryMock(mc)
+				mock.MarkProcessedMock.Expect(ctx, "users/1/7").Return(true, nil)
+				return mock
+			},
+		},
+		{
+			name: "protobuf content type case",
+			msg:  protoMsg,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateUserMock.Expect(ctx, userCreate).Return(id, nil)
+				return mock
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller) repository.ProcessedMessageRepository {
+				mock := repoMocks.NewProcessedMessageRepositoryMock(mc)
+				mock.MarkProcessedMock.Expect(ctx, "users/1/7").Return(true, nil)
+				return mock
+			},
+		},
+		{
+			name:    "unsupported content type case",
+			msg:     unsupportedMsg,
+			errText: "text/plain: unsupported content type",
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				return serviceMocks.NewUserServiceMock(mc)
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller) repository.ProcessedMessageRepository {
+				return repoMocks.NewProcessedMessageRepositoryMock(mc)
+			},
+		},
+		{
+			name: "validation error case",
+			msg:  invalidMsg,
+			err:  consumer.Permanent(model.NewInvalidArgumentError("passwords do not match")),
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				return serviceMocks.NewUserServiceMock(mc)
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller) repository.ProcessedMessageRepository {
+				return repoMocks.NewProcessedMessageRepositoryMock(mc)
+			},
+		},
+		{
+			name: "header key case",
+			msg:  msgWithKey,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateUserMock.Expect(ctx, userCreate).Return(id, nil)
+				return mock
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller) repository.ProcessedMessageRepository {
+				mock := repoMocks.NewProcessedMessageRepositoryMock(mc)
+				mock.MarkProcessedMock.Expect(ctx, "request-1").Return(true, nil)
+				return mock
+			},
+		},
+		{
+			name: "duplicate message case",
+			msg:  msg,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				return serviceMocks.NewUserServiceMock(mc)
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller) repository.ProcessedMessageRepository {
+				mock := repoMocks.NewProcessedMessageRepositoryMock(mc)
+				mock.MarkProcessedMock.Expect(ctx, "users/1/7").Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "user already exists case",
+			msg:  msg,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateUserMock.Expect(ctx, userCreate).Return(0, model.ErrUserAlreadyExists)
+				return mock
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller) repository.ProcessedMessageRepository {
+				mock := repoMocks.NewProcessedMessageRepositoryMock(mc)
+				mock.MarkProcessedMock.Expect(ctx, "users/1/7").Return(true, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			msg:  msg,
+			err:  serviceErr,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateUserMock.Expect(ctx, userCreate).Return(0, serviceErr)
+				return mock
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller) repository.ProcessedMessageRepository {
+				mock := repoMocks.NewProcessedMessageRepositoryMock(mc)
+				mock.MarkProcessedMock.Expect(ctx, "users/1/7").Return(true, nil)
+				return mock
+			},
+		},
+		{
+			name: "repo error case",
+			msg:  msg,
+			err:  repoErr,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				return serviceMocks.NewUserServiceMock(mc)
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller) repository.ProcessedMessageRepository {
+				mock := repoMocks.NewProcessedMessageRepositoryMock(mc)
+				mock.MarkProcessedMock.Expect(ctx, "users/1/7").Return(false, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc).ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler, _ ...db.TxOption) error {
+				return f(ctx)
+			})
+			s := user_saver.NewService(tt.userServiceMock(mc), nil, nil, txManagerMock, tt.processedMessageRepositoryMock(mc))
+
+			err := s.UserSaveHandler(ctx, tt.msg)
+			if tt.errText != "" {
+				require.EqualError(t, err, tt.errText)
+				require.True(t, consumer.IsPermanent(err))
+				return
+			}
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package hasher
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+
+	def "github.com/ipv02/auth/internal/service"
+)
+
+var _ def.PasswordHasher = (*argon2Hasher)(nil)
+
+const argon2IDPrefix = "$argon2id$"
+
+// Argon2Params параметры алгоритма argon2id
+type Argon2Params struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultArgon2Params параметры argon2id по умолчанию (рекомендации OWASP)
+var DefaultArgon2Params = Argon2Params{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+type argon2Hasher struct {
+	params Argon2Params
+}
+
+// NewArgon2Hasher создает хешер паролей на основе argon2id
+func NewArgon2Hasher(params Argon2Params) *argon2Hasher {
+	return &argon2Hasher{params: params}
+}
+
+// Hash возвращает argon2id-хеш пароля в формате $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<hash>
+func (h *argon2Hasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", errors.Wrap(err, "failed to generate salt")
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+
+	return fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2IDPrefix,
+		argon2.Version,
+		h.params.Memory,
+		h.params.Iterations,
+		h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify сравнивает пароль с argon2id-хешем, используя параметры из самого хеша
+func (h *argon2Hasher) Verify(hash, password string) (bool, error) {
+	params, salt, key, err := decodeArgon2Hash(hash)
+	if err != nil {
+		return false, err
+	}
+
+	otherKey := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+
+	return subtle.ConstantTimeCompare(key, otherKey) == 1, nil
+}
+
+// NeedsRehash сообщает, что хеш был получен с параметрами, отличными от текущих
+func (h *argon2Hasher) NeedsRehash(hash string) bool {
+	params, _, _, err := decodeArgon2Hash(hash)
+	if err != nil {
+		return true
+	}
+
+	return params != h.params
+}
+
+func decodeArgon2Hash(hash string) (Argon2Params, []byte, []byte, error) {
+	var params Argon2Params
+
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return params, nil, nil, errors.New("invalid argon2id hash format")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return params, nil, nil, errors.Wrap(err, "failed to parse argon2id version")
+	}
+
+	if version != argon2.Version {
+		return params, nil, nil, errors.Errorf("unsupported argon2id version: %d", version)
+	}
+
+	_, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism)
+	if err != nil {
+		return params, nil, nil, errors.Wrap(err, "failed to parse argon2id params")
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, errors.Wrap(err, "failed to decode argon2id salt")
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return params, nil, nil, errors.Wrap(err, "failed to decode argon2id key")
+	}
+
+	// длины соли и ключа ограничены форматом хеша, переполнение невозможно
+	params.SaltLength = uint32(len(salt)) //nolint:gosec
+	params.KeyLength = uint32(len(key))   //nolint:gosec
+
+	return params, salt, key, nil
+}
+
+func isArgon2Hash(hash string) bool {
+	return strings.HasPrefix(hash, argon2IDPrefix)
+}
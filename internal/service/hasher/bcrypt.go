@@ -0,0 +1,68 @@
+package hasher
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+
+	def "github.com/ipv02/auth/internal/service"
+)
+
+var _ def.PasswordHasher = (*bcryptHasher)(nil)
+
+// DefaultBcryptCost стоимость хеширования bcrypt по умолчанию
+const DefaultBcryptCost = 12
+
+type bcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher создает хешер паролей на основе bcrypt
+func NewBcryptHasher(cost int) *bcryptHasher {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		cost = DefaultBcryptCost
+	}
+
+	return &bcryptHasher{cost: cost}
+}
+
+// Hash возвращает bcrypt-хеш пароля в формате $2a$<cost>$<salt+hash>
+func (h *bcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to hash password with bcrypt")
+	}
+
+	return string(hash), nil
+}
+
+// Verify сравнивает пароль с bcrypt-хешем
+func (h *bcryptHasher) Verify(hash, password string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+
+		return false, errors.Wrap(err, "failed to compare bcrypt hash")
+	}
+
+	return true, nil
+}
+
+// NeedsRehash сообщает, что хеш был получен с другой стоимостью
+func (h *bcryptHasher) NeedsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		return true
+	}
+
+	return cost != h.cost
+}
+
+func isBcryptHash(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") ||
+		strings.HasPrefix(hash, "$2b$") ||
+		strings.HasPrefix(hash, "$2y$")
+}
@@ -0,0 +1,87 @@
+package hasher
+
+import (
+	"crypto/subtle"
+
+	"github.com/pkg/errors"
+
+	def "github.com/ipv02/auth/internal/service"
+)
+
+// Алгоритмы хеширования паролей
+const (
+	AlgorithmBcrypt   = "bcrypt"
+	AlgorithmArgon2ID = "argon2id"
+)
+
+var _ def.PasswordHasher = (*hasher)(nil)
+
+// hasher хеширует новые пароли основным алгоритмом,
+// но умеет проверять хеши всех поддерживаемых алгоритмов.
+// Это позволяет сменить алгоритм и перехешировать пароли при следующем входе пользователя.
+type hasher struct {
+	algorithm string
+	preferred def.PasswordHasher
+	bcrypt    def.PasswordHasher
+	argon2    def.PasswordHasher
+}
+
+// NewHasher создает хешер паролей с указанным основным алгоритмом
+func NewHasher(algorithm string) (*hasher, error) {
+	h := &hasher{
+		algorithm: algorithm,
+		bcrypt:    NewBcryptHasher(DefaultBcryptCost),
+		argon2:    NewArgon2Hasher(DefaultArgon2Params),
+	}
+
+	switch algorithm {
+	case AlgorithmBcrypt:
+		h.preferred = h.bcrypt
+	case AlgorithmArgon2ID:
+		h.preferred = h.argon2
+	default:
+		return nil, errors.Errorf("unknown password hash algorithm: %s", algorithm)
+	}
+
+	return h, nil
+}
+
+// Hash хеширует пароль основным алгоритмом
+func (h *hasher) Hash(password string) (string, error) {
+	return h.preferred.Hash(password)
+}
+
+// Verify проверяет пароль, определяя алгоритм по префиксу хеша.
+// Значение без известного префикса - пароль, сохраненный до появления хеширования.
+// Он сравнивается как есть, а NeedsRehash для него возвращает true, поэтому пароль хешируется при входе
+func (h *hasher) Verify(hash, password string) (bool, error) {
+	switch {
+	case isArgon2Hash(hash):
+		return h.argon2.Verify(hash, password)
+	case isBcryptHash(hash):
+		return h.bcrypt.Verify(hash, password)
+	default:
+		return verifyPlaintext(hash, password), nil
+	}
+}
+
+// NeedsRehash сообщает, что хеш получен другим алгоритмом или с устаревшими параметрами
+func (h *hasher) NeedsRehash(hash string) bool {
+	switch h.algorithm {
+	case AlgorithmArgon2ID:
+		return !isArgon2Hash(hash) || h.argon2.NeedsRehash(hash)
+	case AlgorithmBcrypt:
+		return !isBcryptHash(hash) || h.bcrypt.NeedsRehash(hash)
+	default:
+		return true
+	}
+}
+
+// verifyPlaintext сравнивает пароль с незахешированным значением за постоянное время
+func verifyPlaintext(stored, password string) bool {
+	if len(stored) == 0 {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(stored), []byte(password)) == 1
+}
@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/auth/internal/service/hasher"
+)
+
+func TestHasher(t *testing.T) {
+	t.Parallel()
+
+	password := gofakeit.Password(true, true, true, true, false, 10)
+
+	tests := []struct {
+		name      string
+		algorithm string
+	}{
+		{
+			name:      "bcrypt",
+			algorithm: hasher.AlgorithmBcrypt,
+		},
+		{
+			name:      "argon2id",
+			algorithm: hasher.AlgorithmArgon2ID,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, err := hasher.NewHasher(tt.algorithm)
+			require.NoError(t, err)
+
+			hash, err := h.Hash(password)
+			require.NoError(t, err)
+			require.NotEqual(t, password, hash)
+
+			ok, err := h.Verify(hash, password)
+			require.NoError(t, err)
+			require.True(t, ok)
+
+			ok, err = h.Verify(hash, password+"x")
+			require.NoError(t, err)
+			require.False(t, ok)
+
+			require.False(t, h.NeedsRehash(hash))
+		})
+	}
+}
+
+func TestHasherUpgrade(t *testing.T) {
+	t.Parallel()
+
+	password := gofakeit.Password(true, true, true, true, false, 10)
+
+	bcryptHasher, err := hasher.NewHasher(hasher.AlgorithmBcrypt)
+	require.NoError(t, err)
+
+	argon2Hasher, err := hasher.NewHasher(hasher.AlgorithmArgon2ID)
+	require.NoError(t, err)
+
+	hash, err := bcryptHasher.Hash(password)
+	require.NoError(t, err)
+
+	ok, err := argon2Hasher.Verify(hash, password)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, argon2Hasher.NeedsRehash(hash))
+
+	weakHash, err := hasher.NewArgon2Hasher(hasher.Argon2Params{
+		Memory:      8 * 1024,
+		Iterations:  1,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	}).Hash(password)
+	require.NoError(t, err)
+
+	ok, err = argon2Hasher.Verify(weakHash, password)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, argon2Hasher.NeedsRehash(weakHash))
+}
+
+func TestHasherPlaintextPassword(t *testing.T) {
+	t.Parallel()
+
+	password := gofakeit.Password(true, true, true, true, false, 10)
+
+	h, err := hasher.NewHasher(hasher.AlgorithmArgon2ID)
+	require.NoError(t, err)
+
+	ok, err := h.Verify(password, password)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, h.NeedsRehash(password))
+
+	ok, err = h.Verify(password, password+"x")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = h.Verify("", "")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestHasherUnknownAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	_, err := hasher.NewHasher("md5")
+	require.Error(t, err)
+}
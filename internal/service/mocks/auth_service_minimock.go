@@ -0,0 +1,1161 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/ipv02/auth/internal/service.AuthService -o auth_service_minimock.go -n AuthServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/ipv02/auth/internal/model"
+)
+
+// AuthServiceMock implements mm_service.AuthService
+type AuthServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetAccessToken          func(ctx context.Context, refreshToken string) (s1 string, err error)
+	funcGetAccessTokenOrigin    string
+	inspectFuncGetAccessToken   func(ctx context.Context, refreshToken string)
+	afterGetAccessTokenCounter  uint64
+	beforeGetAccessTokenCounter uint64
+	GetAccessTokenMock          mAuthServiceMockGetAccessToken
+
+	funcGetRefreshToken          func(ctx context.Context, refreshToken string) (tp1 *model.TokenPair, err error)
+	funcGetRefreshTokenOrigin    string
+	inspectFuncGetRefreshToken   func(ctx context.Context, refreshToken string)
+	afterGetRefreshTokenCounter  uint64
+	beforeGetRefreshTokenCounter uint64
+	GetRefreshTokenMock          mAuthServiceMockGetRefreshToken
+
+	funcLogin          func(ctx context.Context, email string, password string) (tp1 *model.TokenPair, err error)
+	funcLoginOrigin    string
+	inspectFuncLogin   func(ctx context.Context, email string, password string)
+	afterLoginCounter  uint64
+	beforeLoginCounter uint64
+	LoginMock          mAuthServiceMockLogin
+}
+
+// NewAuthServiceMock returns a mock for mm_service.AuthService
+func NewAuthServiceMock(t minimock.Tester) *AuthServiceMock {
+	m := &AuthServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetAccessTokenMock = mAuthServiceMockGetAccessToken{mock: m}
+	m.GetAccessTokenMock.callArgs = []*AuthServiceMockGetAccessTokenParams{}
+
+	m.GetRefreshTokenMock = mAuthServiceMockGetRefreshToken{mock: m}
+	m.GetRefreshTokenMock.callArgs = []*AuthServiceMockGetRefreshTokenParams{}
+
+	m.LoginMock = mAuthServiceMockLogin{mock: m}
+	m.LoginMock.callArgs = []*AuthServiceMockLoginParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuthServiceMockGetAccessToken struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockGetAccessTokenExpectation
+	expectations       []*AuthServiceMockGetAccessTokenExpectation
+
+	callArgs []*AuthServiceMockGetAccessTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockGetAccessTokenExpectation specifies expectation struct of the AuthService.GetAccessToken
+type AuthServiceMockGetAccessTokenExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockGetAccessTokenParams
+	paramPtrs          *AuthServiceMockGetAccessTokenParamPtrs
+	expectationOrigins AuthServiceMockGetAccessTokenExpectationOrigins
+	results            *AuthServiceMockGetAccessTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockGetAccessTokenParams contains parameters of the AuthService.GetAccessToken
+type AuthServiceMockGetAccessTokenParams struct {
+	ctx          context.Context
+	refreshToken string
+}
+
+// AuthServiceMockGetAccessTokenParamPtrs contains pointers to parameters of the AuthService.GetAccessToken
+type AuthServiceMockGetAccessTokenParamPtrs struct {
+	ctx          *context.Context
+	refreshToken *string
+}
+
+// AuthServiceMockGetAccessTokenResults contains results of the AuthService.GetAccessToken
+type AuthServiceMockGetAccessTokenResults struct {
+	s1  string
+	err error
+}
+
+// AuthServiceMockGetAccessTokenOrigins contains origins of expectations of the AuthService.GetAccessToken
+type AuthServiceMockGetAccessTokenExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originRefreshToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Optional() *mAuthServiceMockGetAccessToken {
+	mmGetAccessToken.optional = true
+	return mmGetAccessToken
+}
+
+// Expect sets up expected params for AuthService.GetAccessToken
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Expect(ctx context.Context, refreshToken string) *mAuthServiceMockGetAccessToken {
+	if mmGetAccessToken.mock.funcGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+	}
+
+	if mmGetAccessToken.defaultExpectation == nil {
+		mmGetAccessToken.defaultExpectation = &AuthServiceMockGetAccessTokenExpectation{}
+	}
+
+	if mmGetAccessToken.defaultExpectation.paramPtrs != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by ExpectParams functions")
+	}
+
+	mmGetAccessToken.defaultExpectation.params = &AuthServiceMockGetAccessTokenParams{ctx, refreshToken}
+	mmGetAccessToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAccessToken.expectations {
+		if minimock.Equal(e.params, mmGetAccessToken.defaultExpectation.params) {
+			mmGetAccessToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAccessToken.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAccessToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.GetAccessToken
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockGetAccessToken {
+	if mmGetAccessToken.mock.funcGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+	}
+
+	if mmGetAccessToken.defaultExpectation == nil {
+		mmGetAccessToken.defaultExpectation = &AuthServiceMockGetAccessTokenExpectation{}
+	}
+
+	if mmGetAccessToken.defaultExpectation.params != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Expect")
+	}
+
+	if mmGetAccessToken.defaultExpectation.paramPtrs == nil {
+		mmGetAccessToken.defaultExpectation.paramPtrs = &AuthServiceMockGetAccessTokenParamPtrs{}
+	}
+	mmGetAccessToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAccessToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAccessToken
+}
+
+// ExpectRefreshTokenParam2 sets up expected param refreshToken for AuthService.GetAccessToken
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) ExpectRefreshTokenParam2(refreshToken string) *mAuthServiceMockGetAccessToken {
+	if mmGetAccessToken.mock.funcGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+	}
+
+	if mmGetAccessToken.defaultExpectation == nil {
+		mmGetAccessToken.defaultExpectation = &AuthServiceMockGetAccessTokenExpectation{}
+	}
+
+	if mmGetAccessToken.defaultExpectation.params != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Expect")
+	}
+
+	if mmGetAccessToken.defaultExpectation.paramPtrs == nil {
+		mmGetAccessToken.defaultExpectation.paramPtrs = &AuthServiceMockGetAccessTokenParamPtrs{}
+	}
+	mmGetAccessToken.defaultExpectation.paramPtrs.refreshToken = &refreshToken
+	mmGetAccessToken.defaultExpectation.expectationOrigins.originRefreshToken = minimock.CallerInfo(1)
+
+	return mmGetAccessToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.GetAccessToken
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Inspect(f func(ctx context.Context, refreshToken string)) *mAuthServiceMockGetAccessToken {
+	if mmGetAccessToken.mock.inspectFuncGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.GetAccessToken")
+	}
+
+	mmGetAccessToken.mock.inspectFuncGetAccessToken = f
+
+	return mmGetAccessToken
+}
+
+// Return sets up results that will be returned by AuthService.GetAccessToken
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Return(s1 string, err error) *AuthServiceMock {
+	if mmGetAccessToken.mock.funcGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+	}
+
+	if mmGetAccessToken.defaultExpectation == nil {
+		mmGetAccessToken.defaultExpectation = &AuthServiceMockGetAccessTokenExpectation{mock: mmGetAccessToken.mock}
+	}
+	mmGetAccessToken.defaultExpectation.results = &AuthServiceMockGetAccessTokenResults{s1, err}
+	mmGetAccessToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAccessToken.mock
+}
+
+// Set uses given function f to mock the AuthService.GetAccessToken method
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Set(f func(ctx context.Context, refreshToken string) (s1 string, err error)) *AuthServiceMock {
+	if mmGetAccessToken.defaultExpectation != nil {
+		mmGetAccessToken.mock.t.Fatalf("Default expectation is already set for the AuthService.GetAccessToken method")
+	}
+
+	if len(mmGetAccessToken.expectations) > 0 {
+		mmGetAccessToken.mock.t.Fatalf("Some expectations are already set for the AuthService.GetAccessToken method")
+	}
+
+	mmGetAccessToken.mock.funcGetAccessToken = f
+	mmGetAccessToken.mock.funcGetAccessTokenOrigin = minimock.CallerInfo(1)
+	return mmGetAccessToken.mock
+}
+
+// When sets expectation for the AuthService.GetAccessToken which will trigger the result defined by the following
+// Then helper
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) When(ctx context.Context, refreshToken string) *AuthServiceMockGetAccessTokenExpectation {
+	if mmGetAccessToken.mock.funcGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockGetAccessTokenExpectation{
+		mock:               mmGetAccessToken.mock,
+		params:             &AuthServiceMockGetAccessTokenParams{ctx, refreshToken},
+		expectationOrigins: AuthServiceMockGetAccessTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAccessToken.expectations = append(mmGetAccessToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.GetAccessToken return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockGetAccessTokenExpectation) Then(s1 string, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockGetAccessTokenResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.GetAccessToken should be invoked
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Times(n uint64) *mAuthServiceMockGetAccessToken {
+	if n == 0 {
+		mmGetAccessToken.mock.t.Fatalf("Times of AuthServiceMock.GetAccessToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAccessToken.expectedInvocations, n)
+	mmGetAccessToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAccessToken
+}
+
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) invocationsDone() bool {
+	if len(mmGetAccessToken.expectations) == 0 && mmGetAccessToken.defaultExpectation == nil && mmGetAccessToken.mock.funcGetAccessToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAccessToken.mock.afterGetAccessTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAccessToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAccessToken implements mm_service.AuthService
+func (mmGetAccessToken *AuthServiceMock) GetAccessToken(ctx context.Context, refreshToken string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGetAccessToken.beforeGetAccessTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAccessToken.afterGetAccessTokenCounter, 1)
+
+	mmGetAccessToken.t.Helper()
+
+	if mmGetAccessToken.inspectFuncGetAccessToken != nil {
+		mmGetAccessToken.inspectFuncGetAccessToken(ctx, refreshToken)
+	}
+
+	mm_params := AuthServiceMockGetAccessTokenParams{ctx, refreshToken}
+
+	// Record call args
+	mmGetAccessToken.GetAccessTokenMock.mutex.Lock()
+	mmGetAccessToken.GetAccessTokenMock.callArgs = append(mmGetAccessToken.GetAccessTokenMock.callArgs, &mm_params)
+	mmGetAccessToken.GetAccessTokenMock.mutex.Unlock()
+
+	for _, e := range mmGetAccessToken.GetAccessTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetAccessToken.GetAccessTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAccessToken.GetAccessTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAccessToken.GetAccessTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAccessToken.GetAccessTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockGetAccessTokenParams{ctx, refreshToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAccessToken.t.Errorf("AuthServiceMock.GetAccessToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAccessToken.GetAccessTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.refreshToken != nil && !minimock.Equal(*mm_want_ptrs.refreshToken, mm_got.refreshToken) {
+				mmGetAccessToken.t.Errorf("AuthServiceMock.GetAccessToken got unexpected parameter refreshToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAccessToken.GetAccessTokenMock.defaultExpectation.expectationOrigins.originRefreshToken, *mm_want_ptrs.refreshToken, mm_got.refreshToken, minimock.Diff(*mm_want_ptrs.refreshToken, mm_got.refreshToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAccessToken.t.Errorf("AuthServiceMock.GetAccessToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAccessToken.GetAccessTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAccessToken.GetAccessTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAccessToken.t.Fatal("No results are set for the AuthServiceMock.GetAccessToken")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetAccessToken.funcGetAccessToken != nil {
+		return mmGetAccessToken.funcGetAccessToken(ctx, refreshToken)
+	}
+	mmGetAccessToken.t.Fatalf("Unexpected call to AuthServiceMock.GetAccessToken. %v %v", ctx, refreshToken)
+	return
+}
+
+// GetAccessTokenAfterCounter returns a count of finished AuthServiceMock.GetAccessToken invocations
+func (mmGetAccessToken *AuthServiceMock) GetAccessTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAccessToken.afterGetAccessTokenCounter)
+}
+
+// GetAccessTokenBeforeCounter returns a count of AuthServiceMock.GetAccessToken invocations
+func (mmGetAccessToken *AuthServiceMock) GetAccessTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAccessToken.beforeGetAccessTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.GetAccessToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Calls() []*AuthServiceMockGetAccessTokenParams {
+	mmGetAccessToken.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockGetAccessTokenParams, len(mmGetAccessToken.callArgs))
+	copy(argCopy, mmGetAccessToken.callArgs)
+
+	mmGetAccessToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAccessTokenDone returns true if the count of the GetAccessToken invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockGetAccessTokenDone() bool {
+	if m.GetAccessTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAccessTokenMock.invocationsDone()
+}
+
+// MinimockGetAccessTokenInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockGetAccessTokenInspect() {
+	for _, e := range m.GetAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.GetAccessToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAccessTokenCounter := mm_atomic.LoadUint64(&m.afterGetAccessTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAccessTokenMock.defaultExpectation != nil && afterGetAccessTokenCounter < 1 {
+		if m.GetAccessTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.GetAccessToken at\n%s", m.GetAccessTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.GetAccessToken at\n%s with params: %#v", m.GetAccessTokenMock.defaultExpectation.expectationOrigins.origin, *m.GetAccessTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAccessToken != nil && afterGetAccessTokenCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.GetAccessToken at\n%s", m.funcGetAccessTokenOrigin)
+	}
+
+	if !m.GetAccessTokenMock.invocationsDone() && afterGetAccessTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.GetAccessToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAccessTokenMock.expectedInvocations), m.GetAccessTokenMock.expectedInvocationsOrigin, afterGetAccessTokenCounter)
+	}
+}
+
+type mAuthServiceMockGetRefreshToken struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockGetRefreshTokenExpectation
+	expectations       []*AuthServiceMockGetRefreshTokenExpectation
+
+	callArgs []*AuthServiceMockGetRefreshTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockGetRefreshTokenExpectation specifies expectation struct of the AuthService.GetRefreshToken
+type AuthServiceMockGetRefreshTokenExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockGetRefreshTokenParams
+	paramPtrs          *AuthServiceMockGetRefreshTokenParamPtrs
+	expectationOrigins AuthServiceMockGetRefreshTokenExpectationOrigins
+	results            *AuthServiceMockGetRefreshTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockGetRefreshTokenParams contains parameters of the AuthService.GetRefreshToken
+type AuthServiceMockGetRefreshTokenParams struct {
+	ctx          context.Context
+	refreshToken string
+}
+
+// AuthServiceMockGetRefreshTokenParamPtrs contains pointers to parameters of the AuthService.GetRefreshToken
+type AuthServiceMockGetRefreshTokenParamPtrs struct {
+	ctx          *context.Context
+	refreshToken *string
+}
+
+// AuthServiceMockGetRefreshTokenResults contains results of the AuthService.GetRefreshToken
+type AuthServiceMockGetRefreshTokenResults struct {
+	tp1 *model.TokenPair
+	err error
+}
+
+// AuthServiceMockGetRefreshTokenOrigins contains origins of expectations of the AuthService.GetRefreshToken
+type AuthServiceMockGetRefreshTokenExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originRefreshToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Optional() *mAuthServiceMockGetRefreshToken {
+	mmGetRefreshToken.optional = true
+	return mmGetRefreshToken
+}
+
+// Expect sets up expected params for AuthService.GetRefreshToken
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Expect(ctx context.Context, refreshToken string) *mAuthServiceMockGetRefreshToken {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &AuthServiceMockGetRefreshTokenExpectation{}
+	}
+
+	if mmGetRefreshToken.defaultExpectation.paramPtrs != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by ExpectParams functions")
+	}
+
+	mmGetRefreshToken.defaultExpectation.params = &AuthServiceMockGetRefreshTokenParams{ctx, refreshToken}
+	mmGetRefreshToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRefreshToken.expectations {
+		if minimock.Equal(e.params, mmGetRefreshToken.defaultExpectation.params) {
+			mmGetRefreshToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRefreshToken.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRefreshToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.GetRefreshToken
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockGetRefreshToken {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &AuthServiceMockGetRefreshTokenExpectation{}
+	}
+
+	if mmGetRefreshToken.defaultExpectation.params != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Expect")
+	}
+
+	if mmGetRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmGetRefreshToken.defaultExpectation.paramPtrs = &AuthServiceMockGetRefreshTokenParamPtrs{}
+	}
+	mmGetRefreshToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRefreshToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetRefreshToken
+}
+
+// ExpectRefreshTokenParam2 sets up expected param refreshToken for AuthService.GetRefreshToken
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) ExpectRefreshTokenParam2(refreshToken string) *mAuthServiceMockGetRefreshToken {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &AuthServiceMockGetRefreshTokenExpectation{}
+	}
+
+	if mmGetRefreshToken.defaultExpectation.params != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Expect")
+	}
+
+	if mmGetRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmGetRefreshToken.defaultExpectation.paramPtrs = &AuthServiceMockGetRefreshTokenParamPtrs{}
+	}
+	mmGetRefreshToken.defaultExpectation.paramPtrs.refreshToken = &refreshToken
+	mmGetRefreshToken.defaultExpectation.expectationOrigins.originRefreshToken = minimock.CallerInfo(1)
+
+	return mmGetRefreshToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.GetRefreshToken
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Inspect(f func(ctx context.Context, refreshToken string)) *mAuthServiceMockGetRefreshToken {
+	if mmGetRefreshToken.mock.inspectFuncGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.GetRefreshToken")
+	}
+
+	mmGetRefreshToken.mock.inspectFuncGetRefreshToken = f
+
+	return mmGetRefreshToken
+}
+
+// Return sets up results that will be returned by AuthService.GetRefreshToken
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Return(tp1 *model.TokenPair, err error) *AuthServiceMock {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &AuthServiceMockGetRefreshTokenExpectation{mock: mmGetRefreshToken.mock}
+	}
+	mmGetRefreshToken.defaultExpectation.results = &AuthServiceMockGetRefreshTokenResults{tp1, err}
+	mmGetRefreshToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRefreshToken.mock
+}
+
+// Set uses given function f to mock the AuthService.GetRefreshToken method
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Set(f func(ctx context.Context, refreshToken string) (tp1 *model.TokenPair, err error)) *AuthServiceMock {
+	if mmGetRefreshToken.defaultExpectation != nil {
+		mmGetRefreshToken.mock.t.Fatalf("Default expectation is already set for the AuthService.GetRefreshToken method")
+	}
+
+	if len(mmGetRefreshToken.expectations) > 0 {
+		mmGetRefreshToken.mock.t.Fatalf("Some expectations are already set for the AuthService.GetRefreshToken method")
+	}
+
+	mmGetRefreshToken.mock.funcGetRefreshToken = f
+	mmGetRefreshToken.mock.funcGetRefreshTokenOrigin = minimock.CallerInfo(1)
+	return mmGetRefreshToken.mock
+}
+
+// When sets expectation for the AuthService.GetRefreshToken which will trigger the result defined by the following
+// Then helper
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) When(ctx context.Context, refreshToken string) *AuthServiceMockGetRefreshTokenExpectation {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockGetRefreshTokenExpectation{
+		mock:               mmGetRefreshToken.mock,
+		params:             &AuthServiceMockGetRefreshTokenParams{ctx, refreshToken},
+		expectationOrigins: AuthServiceMockGetRefreshTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetRefreshToken.expectations = append(mmGetRefreshToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.GetRefreshToken return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockGetRefreshTokenExpectation) Then(tp1 *model.TokenPair, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockGetRefreshTokenResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.GetRefreshToken should be invoked
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Times(n uint64) *mAuthServiceMockGetRefreshToken {
+	if n == 0 {
+		mmGetRefreshToken.mock.t.Fatalf("Times of AuthServiceMock.GetRefreshToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRefreshToken.expectedInvocations, n)
+	mmGetRefreshToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRefreshToken
+}
+
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) invocationsDone() bool {
+	if len(mmGetRefreshToken.expectations) == 0 && mmGetRefreshToken.defaultExpectation == nil && mmGetRefreshToken.mock.funcGetRefreshToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRefreshToken.mock.afterGetRefreshTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRefreshToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRefreshToken implements mm_service.AuthService
+func (mmGetRefreshToken *AuthServiceMock) GetRefreshToken(ctx context.Context, refreshToken string) (tp1 *model.TokenPair, err error) {
+	mm_atomic.AddUint64(&mmGetRefreshToken.beforeGetRefreshTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRefreshToken.afterGetRefreshTokenCounter, 1)
+
+	mmGetRefreshToken.t.Helper()
+
+	if mmGetRefreshToken.inspectFuncGetRefreshToken != nil {
+		mmGetRefreshToken.inspectFuncGetRefreshToken(ctx, refreshToken)
+	}
+
+	mm_params := AuthServiceMockGetRefreshTokenParams{ctx, refreshToken}
+
+	// Record call args
+	mmGetRefreshToken.GetRefreshTokenMock.mutex.Lock()
+	mmGetRefreshToken.GetRefreshTokenMock.callArgs = append(mmGetRefreshToken.GetRefreshTokenMock.callArgs, &mm_params)
+	mmGetRefreshToken.GetRefreshTokenMock.mutex.Unlock()
+
+	for _, e := range mmGetRefreshToken.GetRefreshTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockGetRefreshTokenParams{ctx, refreshToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRefreshToken.t.Errorf("AuthServiceMock.GetRefreshToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.refreshToken != nil && !minimock.Equal(*mm_want_ptrs.refreshToken, mm_got.refreshToken) {
+				mmGetRefreshToken.t.Errorf("AuthServiceMock.GetRefreshToken got unexpected parameter refreshToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.expectationOrigins.originRefreshToken, *mm_want_ptrs.refreshToken, mm_got.refreshToken, minimock.Diff(*mm_want_ptrs.refreshToken, mm_got.refreshToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRefreshToken.t.Errorf("AuthServiceMock.GetRefreshToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRefreshToken.t.Fatal("No results are set for the AuthServiceMock.GetRefreshToken")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmGetRefreshToken.funcGetRefreshToken != nil {
+		return mmGetRefreshToken.funcGetRefreshToken(ctx, refreshToken)
+	}
+	mmGetRefreshToken.t.Fatalf("Unexpected call to AuthServiceMock.GetRefreshToken. %v %v", ctx, refreshToken)
+	return
+}
+
+// GetRefreshTokenAfterCounter returns a count of finished AuthServiceMock.GetRefreshToken invocations
+func (mmGetRefreshToken *AuthServiceMock) GetRefreshTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRefreshToken.afterGetRefreshTokenCounter)
+}
+
+// GetRefreshTokenBeforeCounter returns a count of AuthServiceMock.GetRefreshToken invocations
+func (mmGetRefreshToken *AuthServiceMock) GetRefreshTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRefreshToken.beforeGetRefreshTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.GetRefreshToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Calls() []*AuthServiceMockGetRefreshTokenParams {
+	mmGetRefreshToken.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockGetRefreshTokenParams, len(mmGetRefreshToken.callArgs))
+	copy(argCopy, mmGetRefreshToken.callArgs)
+
+	mmGetRefreshToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRefreshTokenDone returns true if the count of the GetRefreshToken invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockGetRefreshTokenDone() bool {
+	if m.GetRefreshTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRefreshTokenMock.invocationsDone()
+}
+
+// MinimockGetRefreshTokenInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockGetRefreshTokenInspect() {
+	for _, e := range m.GetRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.GetRefreshToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetRefreshTokenCounter := mm_atomic.LoadUint64(&m.afterGetRefreshTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRefreshTokenMock.defaultExpectation != nil && afterGetRefreshTokenCounter < 1 {
+		if m.GetRefreshTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.GetRefreshToken at\n%s", m.GetRefreshTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.GetRefreshToken at\n%s with params: %#v", m.GetRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *m.GetRefreshTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRefreshToken != nil && afterGetRefreshTokenCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.GetRefreshToken at\n%s", m.funcGetRefreshTokenOrigin)
+	}
+
+	if !m.GetRefreshTokenMock.invocationsDone() && afterGetRefreshTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.GetRefreshToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRefreshTokenMock.expectedInvocations), m.GetRefreshTokenMock.expectedInvocationsOrigin, afterGetRefreshTokenCounter)
+	}
+}
+
+type mAuthServiceMockLogin struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockLoginExpectation
+	expectations       []*AuthServiceMockLoginExpectation
+
+	callArgs []*AuthServiceMockLoginParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockLoginExpectation specifies expectation struct of the AuthService.Login
+type AuthServiceMockLoginExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockLoginParams
+	paramPtrs          *AuthServiceMockLoginParamPtrs
+	expectationOrigins AuthServiceMockLoginExpectationOrigins
+	results            *AuthServiceMockLoginResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockLoginParams contains parameters of the AuthService.Login
+type AuthServiceMockLoginParams struct {
+	ctx      context.Context
+	email    string
+	password string
+}
+
+// AuthServiceMockLoginParamPtrs contains pointers to parameters of the AuthService.Login
+type AuthServiceMockLoginParamPtrs struct {
+	ctx      *context.Context
+	email    *string
+	password *string
+}
+
+// AuthServiceMockLoginResults contains results of the AuthService.Login
+type AuthServiceMockLoginResults struct {
+	tp1 *model.TokenPair
+	err error
+}
+
+// AuthServiceMockLoginOrigins contains origins of expectations of the AuthService.Login
+type AuthServiceMockLoginExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originEmail    string
+	originPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogin *mAuthServiceMockLogin) Optional() *mAuthServiceMockLogin {
+	mmLogin.optional = true
+	return mmLogin
+}
+
+// Expect sets up expected params for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) Expect(ctx context.Context, email string, password string) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by ExpectParams functions")
+	}
+
+	mmLogin.defaultExpectation.params = &AuthServiceMockLoginParams{ctx, email, password}
+	mmLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLogin.expectations {
+		if minimock.Equal(e.params, mmLogin.defaultExpectation.params) {
+			mmLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogin.defaultExpectation.params)
+		}
+	}
+
+	return mmLogin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &AuthServiceMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// ExpectEmailParam2 sets up expected param email for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) ExpectEmailParam2(email string) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &AuthServiceMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.email = &email
+	mmLogin.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// ExpectPasswordParam3 sets up expected param password for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) ExpectPasswordParam3(password string) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &AuthServiceMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.password = &password
+	mmLogin.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) Inspect(f func(ctx context.Context, email string, password string)) *mAuthServiceMockLogin {
+	if mmLogin.mock.inspectFuncLogin != nil {
+		mmLogin.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.Login")
+	}
+
+	mmLogin.mock.inspectFuncLogin = f
+
+	return mmLogin
+}
+
+// Return sets up results that will be returned by AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) Return(tp1 *model.TokenPair, err error) *AuthServiceMock {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{mock: mmLogin.mock}
+	}
+	mmLogin.defaultExpectation.results = &AuthServiceMockLoginResults{tp1, err}
+	mmLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLogin.mock
+}
+
+// Set uses given function f to mock the AuthService.Login method
+func (mmLogin *mAuthServiceMockLogin) Set(f func(ctx context.Context, email string, password string) (tp1 *model.TokenPair, err error)) *AuthServiceMock {
+	if mmLogin.defaultExpectation != nil {
+		mmLogin.mock.t.Fatalf("Default expectation is already set for the AuthService.Login method")
+	}
+
+	if len(mmLogin.expectations) > 0 {
+		mmLogin.mock.t.Fatalf("Some expectations are already set for the AuthService.Login method")
+	}
+
+	mmLogin.mock.funcLogin = f
+	mmLogin.mock.funcLoginOrigin = minimock.CallerInfo(1)
+	return mmLogin.mock
+}
+
+// When sets expectation for the AuthService.Login which will trigger the result defined by the following
+// Then helper
+func (mmLogin *mAuthServiceMockLogin) When(ctx context.Context, email string, password string) *AuthServiceMockLoginExpectation {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockLoginExpectation{
+		mock:               mmLogin.mock,
+		params:             &AuthServiceMockLoginParams{ctx, email, password},
+		expectationOrigins: AuthServiceMockLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLogin.expectations = append(mmLogin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.Login return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockLoginExpectation) Then(tp1 *model.TokenPair, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockLoginResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.Login should be invoked
+func (mmLogin *mAuthServiceMockLogin) Times(n uint64) *mAuthServiceMockLogin {
+	if n == 0 {
+		mmLogin.mock.t.Fatalf("Times of AuthServiceMock.Login mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLogin.expectedInvocations, n)
+	mmLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLogin
+}
+
+func (mmLogin *mAuthServiceMockLogin) invocationsDone() bool {
+	if len(mmLogin.expectations) == 0 && mmLogin.defaultExpectation == nil && mmLogin.mock.funcLogin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLogin.mock.afterLoginCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Login implements mm_service.AuthService
+func (mmLogin *AuthServiceMock) Login(ctx context.Context, email string, password string) (tp1 *model.TokenPair, err error) {
+	mm_atomic.AddUint64(&mmLogin.beforeLoginCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogin.afterLoginCounter, 1)
+
+	mmLogin.t.Helper()
+
+	if mmLogin.inspectFuncLogin != nil {
+		mmLogin.inspectFuncLogin(ctx, email, password)
+	}
+
+	mm_params := AuthServiceMockLoginParams{ctx, email, password}
+
+	// Record call args
+	mmLogin.LoginMock.mutex.Lock()
+	mmLogin.LoginMock.callArgs = append(mmLogin.LoginMock.callArgs, &mm_params)
+	mmLogin.LoginMock.mutex.Unlock()
+
+	for _, e := range mmLogin.LoginMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmLogin.LoginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogin.LoginMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogin.LoginMock.defaultExpectation.params
+		mm_want_ptrs := mmLogin.LoginMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockLoginParams{ctx, email, password}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLogin.LoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLogin.LoginMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogin.t.Fatal("No results are set for the AuthServiceMock.Login")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmLogin.funcLogin != nil {
+		return mmLogin.funcLogin(ctx, email, password)
+	}
+	mmLogin.t.Fatalf("Unexpected call to AuthServiceMock.Login. %v %v %v", ctx, email, password)
+	return
+}
+
+// LoginAfterCounter returns a count of finished AuthServiceMock.Login invocations
+func (mmLogin *AuthServiceMock) LoginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.afterLoginCounter)
+}
+
+// LoginBeforeCounter returns a count of AuthServiceMock.Login invocations
+func (mmLogin *AuthServiceMock) LoginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.beforeLoginCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.Login.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLogin *mAuthServiceMockLogin) Calls() []*AuthServiceMockLoginParams {
+	mmLogin.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockLoginParams, len(mmLogin.callArgs))
+	copy(argCopy, mmLogin.callArgs)
+
+	mmLogin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLoginDone returns true if the count of the Login invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockLoginDone() bool {
+	if m.LoginMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LoginMock.invocationsDone()
+}
+
+// MinimockLoginInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockLoginInspect() {
+	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLoginCounter := mm_atomic.LoadUint64(&m.afterLoginCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LoginMock.defaultExpectation != nil && afterLoginCounter < 1 {
+		if m.LoginMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s", m.LoginMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s with params: %#v", m.LoginMock.defaultExpectation.expectationOrigins.origin, *m.LoginMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogin != nil && afterLoginCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s", m.funcLoginOrigin)
+	}
+
+	if !m.LoginMock.invocationsDone() && afterLoginCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.Login at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LoginMock.expectedInvocations), m.LoginMock.expectedInvocationsOrigin, afterLoginCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuthServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetAccessTokenInspect()
+
+			m.MinimockGetRefreshTokenInspect()
+
+			m.MinimockLoginInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuthServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuthServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetAccessTokenDone() &&
+		m.MinimockGetRefreshTokenDone() &&
+		m.MinimockLoginDone()
+}
@@ -0,0 +1,1065 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/ipv02/auth/internal/service.PasswordHasher -o password_hasher_minimock.go -n PasswordHasherMock -p mocks
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// PasswordHasherMock implements mm_service.PasswordHasher
+type PasswordHasherMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcHash          func(password string) (s1 string, err error)
+	funcHashOrigin    string
+	inspectFuncHash   func(password string)
+	afterHashCounter  uint64
+	beforeHashCounter uint64
+	HashMock          mPasswordHasherMockHash
+
+	funcNeedsRehash          func(hash string) (b1 bool)
+	funcNeedsRehashOrigin    string
+	inspectFuncNeedsRehash   func(hash string)
+	afterNeedsRehashCounter  uint64
+	beforeNeedsRehashCounter uint64
+	NeedsRehashMock          mPasswordHasherMockNeedsRehash
+
+	funcVerify          func(hash string, password string) (b1 bool, err error)
+	funcVerifyOrigin    string
+	inspectFuncVerify   func(hash string, password string)
+	afterVerifyCounter  uint64
+	beforeVerifyCounter uint64
+	VerifyMock          mPasswordHasherMockVerify
+}
+
+// NewPasswordHasherMock returns a mock for mm_service.PasswordHasher
+func NewPasswordHasherMock(t minimock.Tester) *PasswordHasherMock {
+	m := &PasswordHasherMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.HashMock = mPasswordHasherMockHash{mock: m}
+	m.HashMock.callArgs = []*PasswordHasherMockHashParams{}
+
+	m.NeedsRehashMock = mPasswordHasherMockNeedsRehash{mock: m}
+	m.NeedsRehashMock.callArgs = []*PasswordHasherMockNeedsRehashParams{}
+
+	m.VerifyMock = mPasswordHasherMockVerify{mock: m}
+	m.VerifyMock.callArgs = []*PasswordHasherMockVerifyParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPasswordHasherMockHash struct {
+	optional           bool
+	mock               *PasswordHasherMock
+	defaultExpectation *PasswordHasherMockHashExpectation
+	expectations       []*PasswordHasherMockHashExpectation
+
+	callArgs []*PasswordHasherMockHashParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PasswordHasherMockHashExpectation specifies expectation struct of the PasswordHasher.Hash
+type PasswordHasherMockHashExpectation struct {
+	mock               *PasswordHasherMock
+	params             *PasswordHasherMockHashParams
+	paramPtrs          *PasswordHasherMockHashParamPtrs
+	expectationOrigins PasswordHasherMockHashExpectationOrigins
+	results            *PasswordHasherMockHashResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PasswordHasherMockHashParams contains parameters of the PasswordHasher.Hash
+type PasswordHasherMockHashParams struct {
+	password string
+}
+
+// PasswordHasherMockHashParamPtrs contains pointers to parameters of the PasswordHasher.Hash
+type PasswordHasherMockHashParamPtrs struct {
+	password *string
+}
+
+// PasswordHasherMockHashResults contains results of the PasswordHasher.Hash
+type PasswordHasherMockHashResults struct {
+	s1  string
+	err error
+}
+
+// PasswordHasherMockHashOrigins contains origins of expectations of the PasswordHasher.Hash
+type PasswordHasherMockHashExpectationOrigins struct {
+	origin         string
+	originPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHash *mPasswordHasherMockHash) Optional() *mPasswordHasherMockHash {
+	mmHash.optional = true
+	return mmHash
+}
+
+// Expect sets up expected params for PasswordHasher.Hash
+func (mmHash *mPasswordHasherMockHash) Expect(password string) *mPasswordHasherMockHash {
+	if mmHash.mock.funcHash != nil {
+		mmHash.mock.t.Fatalf("PasswordHasherMock.Hash mock is already set by Set")
+	}
+
+	if mmHash.defaultExpectation == nil {
+		mmHash.defaultExpectation = &PasswordHasherMockHashExpectation{}
+	}
+
+	if mmHash.defaultExpectation.paramPtrs != nil {
+		mmHash.mock.t.Fatalf("PasswordHasherMock.Hash mock is already set by ExpectParams functions")
+	}
+
+	mmHash.defaultExpectation.params = &PasswordHasherMockHashParams{password}
+	mmHash.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmHash.expectations {
+		if minimock.Equal(e.params, mmHash.defaultExpectation.params) {
+			mmHash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHash.defaultExpectation.params)
+		}
+	}
+
+	return mmHash
+}
+
+// ExpectPasswordParam1 sets up expected param password for PasswordHasher.Hash
+func (mmHash *mPasswordHasherMockHash) ExpectPasswordParam1(password string) *mPasswordHasherMockHash {
+	if mmHash.mock.funcHash != nil {
+		mmHash.mock.t.Fatalf("PasswordHasherMock.Hash mock is already set by Set")
+	}
+
+	if mmHash.defaultExpectation == nil {
+		mmHash.defaultExpectation = &PasswordHasherMockHashExpectation{}
+	}
+
+	if mmHash.defaultExpectation.params != nil {
+		mmHash.mock.t.Fatalf("PasswordHasherMock.Hash mock is already set by Expect")
+	}
+
+	if mmHash.defaultExpectation.paramPtrs == nil {
+		mmHash.defaultExpectation.paramPtrs = &PasswordHasherMockHashParamPtrs{}
+	}
+	mmHash.defaultExpectation.paramPtrs.password = &password
+	mmHash.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmHash
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasswordHasher.Hash
+func (mmHash *mPasswordHasherMockHash) Inspect(f func(password string)) *mPasswordHasherMockHash {
+	if mmHash.mock.inspectFuncHash != nil {
+		mmHash.mock.t.Fatalf("Inspect function is already set for PasswordHasherMock.Hash")
+	}
+
+	mmHash.mock.inspectFuncHash = f
+
+	return mmHash
+}
+
+// Return sets up results that will be returned by PasswordHasher.Hash
+func (mmHash *mPasswordHasherMockHash) Return(s1 string, err error) *PasswordHasherMock {
+	if mmHash.mock.funcHash != nil {
+		mmHash.mock.t.Fatalf("PasswordHasherMock.Hash mock is already set by Set")
+	}
+
+	if mmHash.defaultExpectation == nil {
+		mmHash.defaultExpectation = &PasswordHasherMockHashExpectation{mock: mmHash.mock}
+	}
+	mmHash.defaultExpectation.results = &PasswordHasherMockHashResults{s1, err}
+	mmHash.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmHash.mock
+}
+
+// Set uses given function f to mock the PasswordHasher.Hash method
+func (mmHash *mPasswordHasherMockHash) Set(f func(password string) (s1 string, err error)) *PasswordHasherMock {
+	if mmHash.defaultExpectation != nil {
+		mmHash.mock.t.Fatalf("Default expectation is already set for the PasswordHasher.Hash method")
+	}
+
+	if len(mmHash.expectations) > 0 {
+		mmHash.mock.t.Fatalf("Some expectations are already set for the PasswordHasher.Hash method")
+	}
+
+	mmHash.mock.funcHash = f
+	mmHash.mock.funcHashOrigin = minimock.CallerInfo(1)
+	return mmHash.mock
+}
+
+// When sets expectation for the PasswordHasher.Hash which will trigger the result defined by the following
+// Then helper
+func (mmHash *mPasswordHasherMockHash) When(password string) *PasswordHasherMockHashExpectation {
+	if mmHash.mock.funcHash != nil {
+		mmHash.mock.t.Fatalf("PasswordHasherMock.Hash mock is already set by Set")
+	}
+
+	expectation := &PasswordHasherMockHashExpectation{
+		mock:               mmHash.mock,
+		params:             &PasswordHasherMockHashParams{password},
+		expectationOrigins: PasswordHasherMockHashExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmHash.expectations = append(mmHash.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasswordHasher.Hash return parameters for the expectation previously defined by the When method
+func (e *PasswordHasherMockHashExpectation) Then(s1 string, err error) *PasswordHasherMock {
+	e.results = &PasswordHasherMockHashResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times PasswordHasher.Hash should be invoked
+func (mmHash *mPasswordHasherMockHash) Times(n uint64) *mPasswordHasherMockHash {
+	if n == 0 {
+		mmHash.mock.t.Fatalf("Times of PasswordHasherMock.Hash mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHash.expectedInvocations, n)
+	mmHash.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmHash
+}
+
+func (mmHash *mPasswordHasherMockHash) invocationsDone() bool {
+	if len(mmHash.expectations) == 0 && mmHash.defaultExpectation == nil && mmHash.mock.funcHash == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHash.mock.afterHashCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHash.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Hash implements mm_service.PasswordHasher
+func (mmHash *PasswordHasherMock) Hash(password string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmHash.beforeHashCounter, 1)
+	defer mm_atomic.AddUint64(&mmHash.afterHashCounter, 1)
+
+	mmHash.t.Helper()
+
+	if mmHash.inspectFuncHash != nil {
+		mmHash.inspectFuncHash(password)
+	}
+
+	mm_params := PasswordHasherMockHashParams{password}
+
+	// Record call args
+	mmHash.HashMock.mutex.Lock()
+	mmHash.HashMock.callArgs = append(mmHash.HashMock.callArgs, &mm_params)
+	mmHash.HashMock.mutex.Unlock()
+
+	for _, e := range mmHash.HashMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmHash.HashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHash.HashMock.defaultExpectation.Counter, 1)
+		mm_want := mmHash.HashMock.defaultExpectation.params
+		mm_want_ptrs := mmHash.HashMock.defaultExpectation.paramPtrs
+
+		mm_got := PasswordHasherMockHashParams{password}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmHash.t.Errorf("PasswordHasherMock.Hash got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHash.HashMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHash.t.Errorf("PasswordHasherMock.Hash got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmHash.HashMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHash.HashMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHash.t.Fatal("No results are set for the PasswordHasherMock.Hash")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmHash.funcHash != nil {
+		return mmHash.funcHash(password)
+	}
+	mmHash.t.Fatalf("Unexpected call to PasswordHasherMock.Hash. %v", password)
+	return
+}
+
+// HashAfterCounter returns a count of finished PasswordHasherMock.Hash invocations
+func (mmHash *PasswordHasherMock) HashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHash.afterHashCounter)
+}
+
+// HashBeforeCounter returns a count of PasswordHasherMock.Hash invocations
+func (mmHash *PasswordHasherMock) HashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHash.beforeHashCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasswordHasherMock.Hash.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHash *mPasswordHasherMockHash) Calls() []*PasswordHasherMockHashParams {
+	mmHash.mutex.RLock()
+
+	argCopy := make([]*PasswordHasherMockHashParams, len(mmHash.callArgs))
+	copy(argCopy, mmHash.callArgs)
+
+	mmHash.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHashDone returns true if the count of the Hash invocations corresponds
+// the number of defined expectations
+func (m *PasswordHasherMock) MinimockHashDone() bool {
+	if m.HashMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HashMock.invocationsDone()
+}
+
+// MinimockHashInspect logs each unmet expectation
+func (m *PasswordHasherMock) MinimockHashInspect() {
+	for _, e := range m.HashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasswordHasherMock.Hash at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterHashCounter := mm_atomic.LoadUint64(&m.afterHashCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HashMock.defaultExpectation != nil && afterHashCounter < 1 {
+		if m.HashMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PasswordHasherMock.Hash at\n%s", m.HashMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PasswordHasherMock.Hash at\n%s with params: %#v", m.HashMock.defaultExpectation.expectationOrigins.origin, *m.HashMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHash != nil && afterHashCounter < 1 {
+		m.t.Errorf("Expected call to PasswordHasherMock.Hash at\n%s", m.funcHashOrigin)
+	}
+
+	if !m.HashMock.invocationsDone() && afterHashCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasswordHasherMock.Hash at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.HashMock.expectedInvocations), m.HashMock.expectedInvocationsOrigin, afterHashCounter)
+	}
+}
+
+type mPasswordHasherMockNeedsRehash struct {
+	optional           bool
+	mock               *PasswordHasherMock
+	defaultExpectation *PasswordHasherMockNeedsRehashExpectation
+	expectations       []*PasswordHasherMockNeedsRehashExpectation
+
+	callArgs []*PasswordHasherMockNeedsRehashParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PasswordHasherMockNeedsRehashExpectation specifies expectation struct of the PasswordHasher.NeedsRehash
+type PasswordHasherMockNeedsRehashExpectation struct {
+	mock               *PasswordHasherMock
+	params             *PasswordHasherMockNeedsRehashParams
+	paramPtrs          *PasswordHasherMockNeedsRehashParamPtrs
+	expectationOrigins PasswordHasherMockNeedsRehashExpectationOrigins
+	results            *PasswordHasherMockNeedsRehashResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PasswordHasherMockNeedsRehashParams contains parameters of the PasswordHasher.NeedsRehash
+type PasswordHasherMockNeedsRehashParams struct {
+	hash string
+}
+
+// PasswordHasherMockNeedsRehashParamPtrs contains pointers to parameters of the PasswordHasher.NeedsRehash
+type PasswordHasherMockNeedsRehashParamPtrs struct {
+	hash *string
+}
+
+// PasswordHasherMockNeedsRehashResults contains results of the PasswordHasher.NeedsRehash
+type PasswordHasherMockNeedsRehashResults struct {
+	b1 bool
+}
+
+// PasswordHasherMockNeedsRehashOrigins contains origins of expectations of the PasswordHasher.NeedsRehash
+type PasswordHasherMockNeedsRehashExpectationOrigins struct {
+	origin     string
+	originHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Optional() *mPasswordHasherMockNeedsRehash {
+	mmNeedsRehash.optional = true
+	return mmNeedsRehash
+}
+
+// Expect sets up expected params for PasswordHasher.NeedsRehash
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Expect(hash string) *mPasswordHasherMockNeedsRehash {
+	if mmNeedsRehash.mock.funcNeedsRehash != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by Set")
+	}
+
+	if mmNeedsRehash.defaultExpectation == nil {
+		mmNeedsRehash.defaultExpectation = &PasswordHasherMockNeedsRehashExpectation{}
+	}
+
+	if mmNeedsRehash.defaultExpectation.paramPtrs != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by ExpectParams functions")
+	}
+
+	mmNeedsRehash.defaultExpectation.params = &PasswordHasherMockNeedsRehashParams{hash}
+	mmNeedsRehash.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmNeedsRehash.expectations {
+		if minimock.Equal(e.params, mmNeedsRehash.defaultExpectation.params) {
+			mmNeedsRehash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmNeedsRehash.defaultExpectation.params)
+		}
+	}
+
+	return mmNeedsRehash
+}
+
+// ExpectHashParam1 sets up expected param hash for PasswordHasher.NeedsRehash
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) ExpectHashParam1(hash string) *mPasswordHasherMockNeedsRehash {
+	if mmNeedsRehash.mock.funcNeedsRehash != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by Set")
+	}
+
+	if mmNeedsRehash.defaultExpectation == nil {
+		mmNeedsRehash.defaultExpectation = &PasswordHasherMockNeedsRehashExpectation{}
+	}
+
+	if mmNeedsRehash.defaultExpectation.params != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by Expect")
+	}
+
+	if mmNeedsRehash.defaultExpectation.paramPtrs == nil {
+		mmNeedsRehash.defaultExpectation.paramPtrs = &PasswordHasherMockNeedsRehashParamPtrs{}
+	}
+	mmNeedsRehash.defaultExpectation.paramPtrs.hash = &hash
+	mmNeedsRehash.defaultExpectation.expectationOrigins.originHash = minimock.CallerInfo(1)
+
+	return mmNeedsRehash
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasswordHasher.NeedsRehash
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Inspect(f func(hash string)) *mPasswordHasherMockNeedsRehash {
+	if mmNeedsRehash.mock.inspectFuncNeedsRehash != nil {
+		mmNeedsRehash.mock.t.Fatalf("Inspect function is already set for PasswordHasherMock.NeedsRehash")
+	}
+
+	mmNeedsRehash.mock.inspectFuncNeedsRehash = f
+
+	return mmNeedsRehash
+}
+
+// Return sets up results that will be returned by PasswordHasher.NeedsRehash
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Return(b1 bool) *PasswordHasherMock {
+	if mmNeedsRehash.mock.funcNeedsRehash != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by Set")
+	}
+
+	if mmNeedsRehash.defaultExpectation == nil {
+		mmNeedsRehash.defaultExpectation = &PasswordHasherMockNeedsRehashExpectation{mock: mmNeedsRehash.mock}
+	}
+	mmNeedsRehash.defaultExpectation.results = &PasswordHasherMockNeedsRehashResults{b1}
+	mmNeedsRehash.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmNeedsRehash.mock
+}
+
+// Set uses given function f to mock the PasswordHasher.NeedsRehash method
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Set(f func(hash string) (b1 bool)) *PasswordHasherMock {
+	if mmNeedsRehash.defaultExpectation != nil {
+		mmNeedsRehash.mock.t.Fatalf("Default expectation is already set for the PasswordHasher.NeedsRehash method")
+	}
+
+	if len(mmNeedsRehash.expectations) > 0 {
+		mmNeedsRehash.mock.t.Fatalf("Some expectations are already set for the PasswordHasher.NeedsRehash method")
+	}
+
+	mmNeedsRehash.mock.funcNeedsRehash = f
+	mmNeedsRehash.mock.funcNeedsRehashOrigin = minimock.CallerInfo(1)
+	return mmNeedsRehash.mock
+}
+
+// When sets expectation for the PasswordHasher.NeedsRehash which will trigger the result defined by the following
+// Then helper
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) When(hash string) *PasswordHasherMockNeedsRehashExpectation {
+	if mmNeedsRehash.mock.funcNeedsRehash != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by Set")
+	}
+
+	expectation := &PasswordHasherMockNeedsRehashExpectation{
+		mock:               mmNeedsRehash.mock,
+		params:             &PasswordHasherMockNeedsRehashParams{hash},
+		expectationOrigins: PasswordHasherMockNeedsRehashExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmNeedsRehash.expectations = append(mmNeedsRehash.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasswordHasher.NeedsRehash return parameters for the expectation previously defined by the When method
+func (e *PasswordHasherMockNeedsRehashExpectation) Then(b1 bool) *PasswordHasherMock {
+	e.results = &PasswordHasherMockNeedsRehashResults{b1}
+	return e.mock
+}
+
+// Times sets number of times PasswordHasher.NeedsRehash should be invoked
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Times(n uint64) *mPasswordHasherMockNeedsRehash {
+	if n == 0 {
+		mmNeedsRehash.mock.t.Fatalf("Times of PasswordHasherMock.NeedsRehash mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmNeedsRehash.expectedInvocations, n)
+	mmNeedsRehash.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmNeedsRehash
+}
+
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) invocationsDone() bool {
+	if len(mmNeedsRehash.expectations) == 0 && mmNeedsRehash.defaultExpectation == nil && mmNeedsRehash.mock.funcNeedsRehash == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmNeedsRehash.mock.afterNeedsRehashCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmNeedsRehash.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// NeedsRehash implements mm_service.PasswordHasher
+func (mmNeedsRehash *PasswordHasherMock) NeedsRehash(hash string) (b1 bool) {
+	mm_atomic.AddUint64(&mmNeedsRehash.beforeNeedsRehashCounter, 1)
+	defer mm_atomic.AddUint64(&mmNeedsRehash.afterNeedsRehashCounter, 1)
+
+	mmNeedsRehash.t.Helper()
+
+	if mmNeedsRehash.inspectFuncNeedsRehash != nil {
+		mmNeedsRehash.inspectFuncNeedsRehash(hash)
+	}
+
+	mm_params := PasswordHasherMockNeedsRehashParams{hash}
+
+	// Record call args
+	mmNeedsRehash.NeedsRehashMock.mutex.Lock()
+	mmNeedsRehash.NeedsRehashMock.callArgs = append(mmNeedsRehash.NeedsRehashMock.callArgs, &mm_params)
+	mmNeedsRehash.NeedsRehashMock.mutex.Unlock()
+
+	for _, e := range mmNeedsRehash.NeedsRehashMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1
+		}
+	}
+
+	if mmNeedsRehash.NeedsRehashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmNeedsRehash.NeedsRehashMock.defaultExpectation.Counter, 1)
+		mm_want := mmNeedsRehash.NeedsRehashMock.defaultExpectation.params
+		mm_want_ptrs := mmNeedsRehash.NeedsRehashMock.defaultExpectation.paramPtrs
+
+		mm_got := PasswordHasherMockNeedsRehashParams{hash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.hash != nil && !minimock.Equal(*mm_want_ptrs.hash, mm_got.hash) {
+				mmNeedsRehash.t.Errorf("PasswordHasherMock.NeedsRehash got unexpected parameter hash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmNeedsRehash.NeedsRehashMock.defaultExpectation.expectationOrigins.originHash, *mm_want_ptrs.hash, mm_got.hash, minimock.Diff(*mm_want_ptrs.hash, mm_got.hash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmNeedsRehash.t.Errorf("PasswordHasherMock.NeedsRehash got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmNeedsRehash.NeedsRehashMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmNeedsRehash.NeedsRehashMock.defaultExpectation.results
+		if mm_results == nil {
+			mmNeedsRehash.t.Fatal("No results are set for the PasswordHasherMock.NeedsRehash")
+		}
+		return (*mm_results).b1
+	}
+	if mmNeedsRehash.funcNeedsRehash != nil {
+		return mmNeedsRehash.funcNeedsRehash(hash)
+	}
+	mmNeedsRehash.t.Fatalf("Unexpected call to PasswordHasherMock.NeedsRehash. %v", hash)
+	return
+}
+
+// NeedsRehashAfterCounter returns a count of finished PasswordHasherMock.NeedsRehash invocations
+func (mmNeedsRehash *PasswordHasherMock) NeedsRehashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNeedsRehash.afterNeedsRehashCounter)
+}
+
+// NeedsRehashBeforeCounter returns a count of PasswordHasherMock.NeedsRehash invocations
+func (mmNeedsRehash *PasswordHasherMock) NeedsRehashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNeedsRehash.beforeNeedsRehashCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasswordHasherMock.NeedsRehash.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Calls() []*PasswordHasherMockNeedsRehashParams {
+	mmNeedsRehash.mutex.RLock()
+
+	argCopy := make([]*PasswordHasherMockNeedsRehashParams, len(mmNeedsRehash.callArgs))
+	copy(argCopy, mmNeedsRehash.callArgs)
+
+	mmNeedsRehash.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockNeedsRehashDone returns true if the count of the NeedsRehash invocations corresponds
+// the number of defined expectations
+func (m *PasswordHasherMock) MinimockNeedsRehashDone() bool {
+	if m.NeedsRehashMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.NeedsRehashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.NeedsRehashMock.invocationsDone()
+}
+
+// MinimockNeedsRehashInspect logs each unmet expectation
+func (m *PasswordHasherMock) MinimockNeedsRehashInspect() {
+	for _, e := range m.NeedsRehashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasswordHasherMock.NeedsRehash at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterNeedsRehashCounter := mm_atomic.LoadUint64(&m.afterNeedsRehashCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.NeedsRehashMock.defaultExpectation != nil && afterNeedsRehashCounter < 1 {
+		if m.NeedsRehashMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PasswordHasherMock.NeedsRehash at\n%s", m.NeedsRehashMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PasswordHasherMock.NeedsRehash at\n%s with params: %#v", m.NeedsRehashMock.defaultExpectation.expectationOrigins.origin, *m.NeedsRehashMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcNeedsRehash != nil && afterNeedsRehashCounter < 1 {
+		m.t.Errorf("Expected call to PasswordHasherMock.NeedsRehash at\n%s", m.funcNeedsRehashOrigin)
+	}
+
+	if !m.NeedsRehashMock.invocationsDone() && afterNeedsRehashCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasswordHasherMock.NeedsRehash at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.NeedsRehashMock.expectedInvocations), m.NeedsRehashMock.expectedInvocationsOrigin, afterNeedsRehashCounter)
+	}
+}
+
+type mPasswordHasherMockVerify struct {
+	optional           bool
+	mock               *PasswordHasherMock
+	defaultExpectation *PasswordHasherMockVerifyExpectation
+	expectations       []*PasswordHasherMockVerifyExpectation
+
+	callArgs []*PasswordHasherMockVerifyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PasswordHasherMockVerifyExpectation specifies expectation struct of the PasswordHasher.Verify
+type PasswordHasherMockVerifyExpectation struct {
+	mock               *PasswordHasherMock
+	params             *PasswordHasherMockVerifyParams
+	paramPtrs          *PasswordHasherMockVerifyParamPtrs
+	expectationOrigins PasswordHasherMockVerifyExpectationOrigins
+	results            *PasswordHasherMockVerifyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PasswordHasherMockVerifyParams contains parameters of the PasswordHasher.Verify
+type PasswordHasherMockVerifyParams struct {
+	hash     string
+	password string
+}
+
+// PasswordHasherMockVerifyParamPtrs contains pointers to parameters of the PasswordHasher.Verify
+type PasswordHasherMockVerifyParamPtrs struct {
+	hash     *string
+	password *string
+}
+
+// PasswordHasherMockVerifyResults contains results of the PasswordHasher.Verify
+type PasswordHasherMockVerifyResults struct {
+	b1  bool
+	err error
+}
+
+// PasswordHasherMockVerifyOrigins contains origins of expectations of the PasswordHasher.Verify
+type PasswordHasherMockVerifyExpectationOrigins struct {
+	origin         string
+	originHash     string
+	originPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmVerify *mPasswordHasherMockVerify) Optional() *mPasswordHasherMockVerify {
+	mmVerify.optional = true
+	return mmVerify
+}
+
+// Expect sets up expected params for PasswordHasher.Verify
+func (mmVerify *mPasswordHasherMockVerify) Expect(hash string, password string) *mPasswordHasherMockVerify {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("PasswordHasherMock.Verify mock is already set by Set")
+	}
+
+	if mmVerify.defaultExpectation == nil {
+		mmVerify.defaultExpectation = &PasswordHasherMockVerifyExpectation{}
+	}
+
+	if mmVerify.defaultExpectation.paramPtrs != nil {
+		mmVerify.mock.t.Fatalf("PasswordHasherMock.Verify mock is already set by ExpectParams functions")
+	}
+
+	mmVerify.defaultExpectation.params = &PasswordHasherMockVerifyParams{hash, password}
+	mmVerify.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmVerify.expectations {
+		if minimock.Equal(e.params, mmVerify.defaultExpectation.params) {
+			mmVerify.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVerify.defaultExpectation.params)
+		}
+	}
+
+	return mmVerify
+}
+
+// ExpectHashParam1 sets up expected param hash for PasswordHasher.Verify
+func (mmVerify *mPasswordHasherMockVerify) ExpectHashParam1(hash string) *mPasswordHasherMockVerify {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("PasswordHasherMock.Verify mock is already set by Set")
+	}
+
+	if mmVerify.defaultExpectation == nil {
+		mmVerify.defaultExpectation = &PasswordHasherMockVerifyExpectation{}
+	}
+
+	if mmVerify.defaultExpectation.params != nil {
+		mmVerify.mock.t.Fatalf("PasswordHasherMock.Verify mock is already set by Expect")
+	}
+
+	if mmVerify.defaultExpectation.paramPtrs == nil {
+		mmVerify.defaultExpectation.paramPtrs = &PasswordHasherMockVerifyParamPtrs{}
+	}
+	mmVerify.defaultExpectation.paramPtrs.hash = &hash
+	mmVerify.defaultExpectation.expectationOrigins.originHash = minimock.CallerInfo(1)
+
+	return mmVerify
+}
+
+// ExpectPasswordParam2 sets up expected param password for PasswordHasher.Verify
+func (mmVerify *mPasswordHasherMockVerify) ExpectPasswordParam2(password string) *mPasswordHasherMockVerify {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("PasswordHasherMock.Verify mock is already set by Set")
+	}
+
+	if mmVerify.defaultExpectation == nil {
+		mmVerify.defaultExpectation = &PasswordHasherMockVerifyExpectation{}
+	}
+
+	if mmVerify.defaultExpectation.params != nil {
+		mmVerify.mock.t.Fatalf("PasswordHasherMock.Verify mock is already set by Expect")
+	}
+
+	if mmVerify.defaultExpectation.paramPtrs == nil {
+		mmVerify.defaultExpectation.paramPtrs = &PasswordHasherMockVerifyParamPtrs{}
+	}
+	mmVerify.defaultExpectation.paramPtrs.password = &password
+	mmVerify.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmVerify
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasswordHasher.Verify
+func (mmVerify *mPasswordHasherMockVerify) Inspect(f func(hash string, password string)) *mPasswordHasherMockVerify {
+	if mmVerify.mock.inspectFuncVerify != nil {
+		mmVerify.mock.t.Fatalf("Inspect function is already set for PasswordHasherMock.Verify")
+	}
+
+	mmVerify.mock.inspectFuncVerify = f
+
+	return mmVerify
+}
+
+// Return sets up results that will be returned by PasswordHasher.Verify
+func (mmVerify *mPasswordHasherMockVerify) Return(b1 bool, err error) *PasswordHasherMock {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("PasswordHasherMock.Verify mock is already set by Set")
+	}
+
+	if mmVerify.defaultExpectation == nil {
+		mmVerify.defaultExpectation = &PasswordHasherMockVerifyExpectation{mock: mmVerify.mock}
+	}
+	mmVerify.defaultExpectation.results = &PasswordHasherMockVerifyResults{b1, err}
+	mmVerify.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmVerify.mock
+}
+
+// Set uses given function f to mock the PasswordHasher.Verify method
+func (mmVerify *mPasswordHasherMockVerify) Set(f func(hash string, password string) (b1 bool, err error)) *PasswordHasherMock {
+	if mmVerify.defaultExpectation != nil {
+		mmVerify.mock.t.Fatalf("Default expectation is already set for the PasswordHasher.Verify method")
+	}
+
+	if len(mmVerify.expectations) > 0 {
+		mmVerify.mock.t.Fatalf("Some expectations are already set for the PasswordHasher.Verify method")
+	}
+
+	mmVerify.mock.funcVerify = f
+	mmVerify.mock.funcVerifyOrigin = minimock.CallerInfo(1)
+	return mmVerify.mock
+}
+
+// When sets expectation for the PasswordHasher.Verify which will trigger the result defined by the following
+// Then helper
+func (mmVerify *mPasswordHasherMockVerify) When(hash string, password string) *PasswordHasherMockVerifyExpectation {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("PasswordHasherMock.Verify mock is already set by Set")
+	}
+
+	expectation := &PasswordHasherMockVerifyExpectation{
+		mock:               mmVerify.mock,
+		params:             &PasswordHasherMockVerifyParams{hash, password},
+		expectationOrigins: PasswordHasherMockVerifyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmVerify.expectations = append(mmVerify.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasswordHasher.Verify return parameters for the expectation previously defined by the When method
+func (e *PasswordHasherMockVerifyExpectation) Then(b1 bool, err error) *PasswordHasherMock {
+	e.results = &PasswordHasherMockVerifyResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times PasswordHasher.Verify should be invoked
+func (mmVerify *mPasswordHasherMockVerify) Times(n uint64) *mPasswordHasherMockVerify {
+	if n == 0 {
+		mmVerify.mock.t.Fatalf("Times of PasswordHasherMock.Verify mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmVerify.expectedInvocations, n)
+	mmVerify.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmVerify
+}
+
+func (mmVerify *mPasswordHasherMockVerify) invocationsDone() bool {
+	if len(mmVerify.expectations) == 0 && mmVerify.defaultExpectation == nil && mmVerify.mock.funcVerify == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmVerify.mock.afterVerifyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVerify.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Verify implements mm_service.PasswordHasher
+func (mmVerify *PasswordHasherMock) Verify(hash string, password string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmVerify.beforeVerifyCounter, 1)
+	defer mm_atomic.AddUint64(&mmVerify.afterVerifyCounter, 1)
+
+	mmVerify.t.Helper()
+
+	if mmVerify.inspectFuncVerify != nil {
+		mmVerify.inspectFuncVerify(hash, password)
+	}
+
+	mm_params := PasswordHasherMockVerifyParams{hash, password}
+
+	// Record call args
+	mmVerify.VerifyMock.mutex.Lock()
+	mmVerify.VerifyMock.callArgs = append(mmVerify.VerifyMock.callArgs, &mm_params)
+	mmVerify.VerifyMock.mutex.Unlock()
+
+	for _, e := range mmVerify.VerifyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmVerify.VerifyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVerify.VerifyMock.defaultExpectation.Counter, 1)
+		mm_want := mmVerify.VerifyMock.defaultExpectation.params
+		mm_want_ptrs := mmVerify.VerifyMock.defaultExpectation.paramPtrs
+
+		mm_got := PasswordHasherMockVerifyParams{hash, password}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.hash != nil && !minimock.Equal(*mm_want_ptrs.hash, mm_got.hash) {
+				mmVerify.t.Errorf("PasswordHasherMock.Verify got unexpected parameter hash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerify.VerifyMock.defaultExpectation.expectationOrigins.originHash, *mm_want_ptrs.hash, mm_got.hash, minimock.Diff(*mm_want_ptrs.hash, mm_got.hash))
+			}
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmVerify.t.Errorf("PasswordHasherMock.Verify got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerify.VerifyMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVerify.t.Errorf("PasswordHasherMock.Verify got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmVerify.VerifyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmVerify.VerifyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmVerify.t.Fatal("No results are set for the PasswordHasherMock.Verify")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmVerify.funcVerify != nil {
+		return mmVerify.funcVerify(hash, password)
+	}
+	mmVerify.t.Fatalf("Unexpected call to PasswordHasherMock.Verify. %v %v", hash, password)
+	return
+}
+
+// VerifyAfterCounter returns a count of finished PasswordHasherMock.Verify invocations
+func (mmVerify *PasswordHasherMock) VerifyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerify.afterVerifyCounter)
+}
+
+// VerifyBeforeCounter returns a count of PasswordHasherMock.Verify invocations
+func (mmVerify *PasswordHasherMock) VerifyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerify.beforeVerifyCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasswordHasherMock.Verify.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmVerify *mPasswordHasherMockVerify) Calls() []*PasswordHasherMockVerifyParams {
+	mmVerify.mutex.RLock()
+
+	argCopy := make([]*PasswordHasherMockVerifyParams, len(mmVerify.callArgs))
+	copy(argCopy, mmVerify.callArgs)
+
+	mmVerify.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockVerifyDone returns true if the count of the Verify invocations corresponds
+// the number of defined expectations
+func (m *PasswordHasherMock) MinimockVerifyDone() bool {
+	if m.VerifyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.VerifyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.VerifyMock.invocationsDone()
+}
+
+// MinimockVerifyInspect logs each unmet expectation
+func (m *PasswordHasherMock) MinimockVerifyInspect() {
+	for _, e := range m.VerifyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasswordHasherMock.Verify at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterVerifyCounter := mm_atomic.LoadUint64(&m.afterVerifyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VerifyMock.defaultExpectation != nil && afterVerifyCounter < 1 {
+		if m.VerifyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PasswordHasherMock.Verify at\n%s", m.VerifyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PasswordHasherMock.Verify at\n%s with params: %#v", m.VerifyMock.defaultExpectation.expectationOrigins.origin, *m.VerifyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVerify != nil && afterVerifyCounter < 1 {
+		m.t.Errorf("Expected call to PasswordHasherMock.Verify at\n%s", m.funcVerifyOrigin)
+	}
+
+	if !m.VerifyMock.invocationsDone() && afterVerifyCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasswordHasherMock.Verify at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.VerifyMock.expectedInvocations), m.VerifyMock.expectedInvocationsOrigin, afterVerifyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PasswordHasherMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockHashInspect()
+
+			m.MinimockNeedsRehashInspect()
+
+			m.MinimockVerifyInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PasswordHasherMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PasswordHasherMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockHashDone() &&
+		m.MinimockNeedsRehashDone() &&
+		m.MinimockVerifyDone()
+}
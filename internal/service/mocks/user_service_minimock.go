@@ -26,6 +26,13 @@ type UserServiceMock struct {
 	beforeCreateUserCounter uint64
 	CreateUserMock          mUserServiceMockCreateUser
 
+	funcCreateUsers          func(ctx context.Context, users []*model.UserCreate) (ia1 []int64, err error)
+	funcCreateUsersOrigin    string
+	inspectFuncCreateUsers   func(ctx context.Context, users []*model.UserCreate)
+	afterCreateUsersCounter  uint64
+	beforeCreateUsersCounter uint64
+	CreateUsersMock          mUserServiceMockCreateUsers
+
 	funcDeleteUser          func(ctx context.Context, id int64) (err error)
 	funcDeleteUserOrigin    string
 	inspectFuncDeleteUser   func(ctx context.Context, id int64)
@@ -40,6 +47,20 @@ type UserServiceMock struct {
 	beforeGetUserCounter uint64
 	GetUserMock          mUserServiceMockGetUser
 
+	funcListUsers          func(ctx context.Context, query *model.UserListQuery) (up1 *model.UserList, err error)
+	funcListUsersOrigin    string
+	inspectFuncListUsers   func(ctx context.Context, query *model.UserListQuery)
+	afterListUsersCounter  uint64
+	beforeListUsersCounter uint64
+	ListUsersMock          mUserServiceMockListUsers
+
+	funcRestoreUser          func(ctx context.Context, id int64) (err error)
+	funcRestoreUserOrigin    string
+	inspectFuncRestoreUser   func(ctx context.Context, id int64)
+	afterRestoreUserCounter  uint64
+	beforeRestoreUserCounter uint64
+	RestoreUserMock          mUserServiceMockRestoreUser
+
 	funcUpdateUser          func(ctx context.Context, user *model.UserUpdate) (err error)
 	funcUpdateUserOrigin    string
 	inspectFuncUpdateUser   func(ctx context.Context, user *model.UserUpdate)
@@ -59,12 +80,21 @@ func NewUserServiceMock(t minimock.Tester) *UserServiceMock {
 	m.CreateUserMock = mUserServiceMockCreateUser{mock: m}
 	m.CreateUserMock.callArgs = []*UserServiceMockCreateUserParams{}
 
+	m.CreateUsersMock = mUserServiceMockCreateUsers{mock: m}
+	m.CreateUsersMock.callArgs = []*UserServiceMockCreateUsersParams{}
+
 	m.DeleteUserMock = mUserServiceMockDeleteUser{mock: m}
 	m.DeleteUserMock.callArgs = []*UserServiceMockDeleteUserParams{}
 
 	m.GetUserMock = mUserServiceMockGetUser{mock: m}
 	m.GetUserMock.callArgs = []*UserServiceMockGetUserParams{}
 
+	m.ListUsersMock = mUserServiceMockListUsers{mock: m}
+	m.ListUsersMock.callArgs = []*UserServiceMockListUsersParams{}
+
+	m.RestoreUserMock = mUserServiceMockRestoreUser{mock: m}
+	m.RestoreUserMock.callArgs = []*UserServiceMockRestoreUserParams{}
+
 	m.UpdateUserMock = mUserServiceMockUpdateUser{mock: m}
 	m.UpdateUserMock.callArgs = []*UserServiceMockUpdateUserParams{}
 
@@ -416,6 +446,349 @@ func (m *UserServiceMock) MinimockCreateUserInspect() {
 	}
 }
 
+type mUserServiceMockCreateUsers struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockCreateUsersExpectation
+	expectations       []*UserServiceMockCreateUsersExpectation
+
+	callArgs []*UserServiceMockCreateUsersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockCreateUsersExpectation specifies expectation struct of the UserService.CreateUsers
+type UserServiceMockCreateUsersExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockCreateUsersParams
+	paramPtrs          *UserServiceMockCreateUsersParamPtrs
+	expectationOrigins UserServiceMockCreateUsersExpectationOrigins
+	results            *UserServiceMockCreateUsersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockCreateUsersParams contains parameters of the UserService.CreateUsers
+type UserServiceMockCreateUsersParams struct {
+	ctx   context.Context
+	users []*model.UserCreate
+}
+
+// UserServiceMockCreateUsersParamPtrs contains pointers to parameters of the UserService.CreateUsers
+type UserServiceMockCreateUsersParamPtrs struct {
+	ctx   *context.Context
+	users *[]*model.UserCreate
+}
+
+// UserServiceMockCreateUsersResults contains results of the UserService.CreateUsers
+type UserServiceMockCreateUsersResults struct {
+	ia1 []int64
+	err error
+}
+
+// UserServiceMockCreateUsersOrigins contains origins of expectations of the UserService.CreateUsers
+type UserServiceMockCreateUsersExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originUsers string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateUsers *mUserServiceMockCreateUsers) Optional() *mUserServiceMockCreateUsers {
+	mmCreateUsers.optional = true
+	return mmCreateUsers
+}
+
+// Expect sets up expected params for UserService.CreateUsers
+func (mmCreateUsers *mUserServiceMockCreateUsers) Expect(ctx context.Context, users []*model.UserCreate) *mUserServiceMockCreateUsers {
+	if mmCreateUsers.mock.funcCreateUsers != nil {
+		mmCreateUsers.mock.t.Fatalf("UserServiceMock.CreateUsers mock is already set by Set")
+	}
+
+	if mmCreateUsers.defaultExpectation == nil {
+		mmCreateUsers.defaultExpectation = &UserServiceMockCreateUsersExpectation{}
+	}
+
+	if mmCreateUsers.defaultExpectation.paramPtrs != nil {
+		mmCreateUsers.mock.t.Fatalf("UserServiceMock.CreateUsers mock is already set by ExpectParams functions")
+	}
+
+	mmCreateUsers.defaultExpectation.params = &UserServiceMockCreateUsersParams{ctx, users}
+	mmCreateUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateUsers.expectations {
+		if minimock.Equal(e.params, mmCreateUsers.defaultExpectation.params) {
+			mmCreateUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateUsers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.CreateUsers
+func (mmCreateUsers *mUserServiceMockCreateUsers) ExpectCtxParam1(ctx context.Context) *mUserServiceMockCreateUsers {
+	if mmCreateUsers.mock.funcCreateUsers != nil {
+		mmCreateUsers.mock.t.Fatalf("UserServiceMock.CreateUsers mock is already set by Set")
+	}
+
+	if mmCreateUsers.defaultExpectation == nil {
+		mmCreateUsers.defaultExpectation = &UserServiceMockCreateUsersExpectation{}
+	}
+
+	if mmCreateUsers.defaultExpectation.params != nil {
+		mmCreateUsers.mock.t.Fatalf("UserServiceMock.CreateUsers mock is already set by Expect")
+	}
+
+	if mmCreateUsers.defaultExpectation.paramPtrs == nil {
+		mmCreateUsers.defaultExpectation.paramPtrs = &UserServiceMockCreateUsersParamPtrs{}
+	}
+	mmCreateUsers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateUsers
+}
+
+// ExpectUsersParam2 sets up expected param users for UserService.CreateUsers
+func (mmCreateUsers *mUserServiceMockCreateUsers) ExpectUsersParam2(users []*model.UserCreate) *mUserServiceMockCreateUsers {
+	if mmCreateUsers.mock.funcCreateUsers != nil {
+		mmCreateUsers.mock.t.Fatalf("UserServiceMock.CreateUsers mock is already set by Set")
+	}
+
+	if mmCreateUsers.defaultExpectation == nil {
+		mmCreateUsers.defaultExpectation = &UserServiceMockCreateUsersExpectation{}
+	}
+
+	if mmCreateUsers.defaultExpectation.params != nil {
+		mmCreateUsers.mock.t.Fatalf("UserServiceMock.CreateUsers mock is already set by Expect")
+	}
+
+	if mmCreateUsers.defaultExpectation.paramPtrs == nil {
+		mmCreateUsers.defaultExpectation.paramPtrs = &UserServiceMockCreateUsersParamPtrs{}
+	}
+	mmCreateUsers.defaultExpectation.paramPtrs.users = &users
+	mmCreateUsers.defaultExpectation.expectationOrigins.originUsers = minimock.CallerInfo(1)
+
+	return mmCreateUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.CreateUsers
+func (mmCreateUsers *mUserServiceMockCreateUsers) Inspect(f func(ctx context.Context, users []*model.UserCreate)) *mUserServiceMockCreateUsers {
+	if mmCreateUsers.mock.inspectFuncCreateUsers != nil {
+		mmCreateUsers.mock.t.Fatalf("Inspect function is already set for UserServiceMock.CreateUsers")
+	}
+
+	mmCreateUsers.mock.inspectFuncCreateUsers = f
+
+	return mmCreateUsers
+}
+
+// Return sets up results that will be returned by UserService.CreateUsers
+func (mmCreateUsers *mUserServiceMockCreateUsers) Return(ia1 []int64, err error) *UserServiceMock {
+	if mmCreateUsers.mock.funcCreateUsers != nil {
+		mmCreateUsers.mock.t.Fatalf("UserServiceMock.CreateUsers mock is already set by Set")
+	}
+
+	if mmCreateUsers.defaultExpectation == nil {
+		mmCreateUsers.defaultExpectation = &UserServiceMockCreateUsersExpectation{mock: mmCreateUsers.mock}
+	}
+	mmCreateUsers.defaultExpectation.results = &UserServiceMockCreateUsersResults{ia1, err}
+	mmCreateUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateUsers.mock
+}
+
+// Set uses given function f to mock the UserService.CreateUsers method
+func (mmCreateUsers *mUserServiceMockCreateUsers) Set(f func(ctx context.Context, users []*model.UserCreate) (ia1 []int64, err error)) *UserServiceMock {
+	if mmCreateUsers.defaultExpectation != nil {
+		mmCreateUsers.mock.t.Fatalf("Default expectation is already set for the UserService.CreateUsers method")
+	}
+
+	if len(mmCreateUsers.expectations) > 0 {
+		mmCreateUsers.mock.t.Fatalf("Some expectations are already set for the UserService.CreateUsers method")
+	}
+
+	mmCreateUsers.mock.funcCreateUsers = f
+	mmCreateUsers.mock.funcCreateUsersOrigin = minimock.CallerInfo(1)
+	return mmCreateUsers.mock
+}
+
+// When sets expectation for the UserService.CreateUsers which will trigger the result defined by the following
+// Then helper
+func (mmCreateUsers *mUserServiceMockCreateUsers) When(ctx context.Context, users []*model.UserCreate) *UserServiceMockCreateUsersExpectation {
+	if mmCreateUsers.mock.funcCreateUsers != nil {
+		mmCreateUsers.mock.t.Fatalf("UserServiceMock.CreateUsers mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockCreateUsersExpectation{
+		mock:               mmCreateUsers.mock,
+		params:             &UserServiceMockCreateUsersParams{ctx, users},
+		expectationOrigins: UserServiceMockCreateUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateUsers.expectations = append(mmCreateUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.CreateUsers return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockCreateUsersExpectation) Then(ia1 []int64, err error) *UserServiceMock {
+	e.results = &UserServiceMockCreateUsersResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.CreateUsers should be invoked
+func (mmCreateUsers *mUserServiceMockCreateUsers) Times(n uint64) *mUserServiceMockCreateUsers {
+	if n == 0 {
+		mmCreateUsers.mock.t.Fatalf("Times of UserServiceMock.CreateUsers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateUsers.expectedInvocations, n)
+	mmCreateUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateUsers
+}
+
+func (mmCreateUsers *mUserServiceMockCreateUsers) invocationsDone() bool {
+	if len(mmCreateUsers.expectations) == 0 && mmCreateUsers.defaultExpectation == nil && mmCreateUsers.mock.funcCreateUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateUsers.mock.afterCreateUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateUsers implements mm_service.UserService
+func (mmCreateUsers *UserServiceMock) CreateUsers(ctx context.Context, users []*model.UserCreate) (ia1 []int64, err error) {
+	mm_atomic.AddUint64(&mmCreateUsers.beforeCreateUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateUsers.afterCreateUsersCounter, 1)
+
+	mmCreateUsers.t.Helper()
+
+	if mmCreateUsers.inspectFuncCreateUsers != nil {
+		mmCreateUsers.inspectFuncCreateUsers(ctx, users)
+	}
+
+	mm_params := UserServiceMockCreateUsersParams{ctx, users}
+
+	// Record call args
+	mmCreateUsers.CreateUsersMock.mutex.Lock()
+	mmCreateUsers.CreateUsersMock.callArgs = append(mmCreateUsers.CreateUsersMock.callArgs, &mm_params)
+	mmCreateUsers.CreateUsersMock.mutex.Unlock()
+
+	for _, e := range mmCreateUsers.CreateUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmCreateUsers.CreateUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateUsers.CreateUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateUsers.CreateUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateUsers.CreateUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockCreateUsersParams{ctx, users}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateUsers.t.Errorf("UserServiceMock.CreateUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUsers.CreateUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.users != nil && !minimock.Equal(*mm_want_ptrs.users, mm_got.users) {
+				mmCreateUsers.t.Errorf("UserServiceMock.CreateUsers got unexpected parameter users, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUsers.CreateUsersMock.defaultExpectation.expectationOrigins.originUsers, *mm_want_ptrs.users, mm_got.users, minimock.Diff(*mm_want_ptrs.users, mm_got.users))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateUsers.t.Errorf("UserServiceMock.CreateUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateUsers.CreateUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateUsers.CreateUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateUsers.t.Fatal("No results are set for the UserServiceMock.CreateUsers")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmCreateUsers.funcCreateUsers != nil {
+		return mmCreateUsers.funcCreateUsers(ctx, users)
+	}
+	mmCreateUsers.t.Fatalf("Unexpected call to UserServiceMock.CreateUsers. %v %v", ctx, users)
+	return
+}
+
+// CreateUsersAfterCounter returns a count of finished UserServiceMock.CreateUsers invocations
+func (mmCreateUsers *UserServiceMock) CreateUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUsers.afterCreateUsersCounter)
+}
+
+// CreateUsersBeforeCounter returns a count of UserServiceMock.CreateUsers invocations
+func (mmCreateUsers *UserServiceMock) CreateUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUsers.beforeCreateUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.CreateUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateUsers *mUserServiceMockCreateUsers) Calls() []*UserServiceMockCreateUsersParams {
+	mmCreateUsers.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockCreateUsersParams, len(mmCreateUsers.callArgs))
+	copy(argCopy, mmCreateUsers.callArgs)
+
+	mmCreateUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateUsersDone returns true if the count of the CreateUsers invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockCreateUsersDone() bool {
+	if m.CreateUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateUsersMock.invocationsDone()
+}
+
+// MinimockCreateUsersInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockCreateUsersInspect() {
+	for _, e := range m.CreateUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.CreateUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateUsersCounter := mm_atomic.LoadUint64(&m.afterCreateUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateUsersMock.defaultExpectation != nil && afterCreateUsersCounter < 1 {
+		if m.CreateUsersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.CreateUsers at\n%s", m.CreateUsersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.CreateUsers at\n%s with params: %#v", m.CreateUsersMock.defaultExpectation.expectationOrigins.origin, *m.CreateUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateUsers != nil && afterCreateUsersCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.CreateUsers at\n%s", m.funcCreateUsersOrigin)
+	}
+
+	if !m.CreateUsersMock.invocationsDone() && afterCreateUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.CreateUsers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateUsersMock.expectedInvocations), m.CreateUsersMock.expectedInvocationsOrigin, afterCreateUsersCounter)
+	}
+}
+
 type mUserServiceMockDeleteUser struct {
 	optional           bool
 	mock               *UserServiceMock
@@ -1101,52 +1474,53 @@ func (m *UserServiceMock) MinimockGetUserInspect() {
 	}
 }
 
-type mUserServiceMockUpdateUser struct {
+type mUserServiceMockListUsers struct {
 	optional           bool
 	mock               *UserServiceMock
-	defaultExpectation *UserServiceMockUpdateUserExpectation
-	expectations       []*UserServiceMockUpdateUserExpectation
+	defaultExpectation *UserServiceMockListUsersExpectation
+	expectations       []*UserServiceMockListUsersExpectation
 
-	callArgs []*UserServiceMockUpdateUserParams
+	callArgs []*UserServiceMockListUsersParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserServiceMockUpdateUserExpectation specifies expectation struct of the UserService.UpdateUser
-type UserServiceMockUpdateUserExpectation struct {
+// UserServiceMockListUsersExpectation specifies expectation struct of the UserService.ListUsers
+type UserServiceMockListUsersExpectation struct {
 	mock               *UserServiceMock
-	params             *UserServiceMockUpdateUserParams
-	paramPtrs          *UserServiceMockUpdateUserParamPtrs
-	expectationOrigins UserServiceMockUpdateUserExpectationOrigins
-	results            *UserServiceMockUpdateUserResults
+	params             *UserServiceMockListUsersParams
+	paramPtrs          *UserServiceMockListUsersParamPtrs
+	expectationOrigins UserServiceMockListUsersExpectationOrigins
+	results            *UserServiceMockListUsersResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserServiceMockUpdateUserParams contains parameters of the UserService.UpdateUser
-type UserServiceMockUpdateUserParams struct {
-	ctx  context.Context
-	user *model.UserUpdate
+// UserServiceMockListUsersParams contains parameters of the UserService.ListUsers
+type UserServiceMockListUsersParams struct {
+	ctx   context.Context
+	query *model.UserListQuery
 }
 
-// UserServiceMockUpdateUserParamPtrs contains pointers to parameters of the UserService.UpdateUser
-type UserServiceMockUpdateUserParamPtrs struct {
-	ctx  *context.Context
-	user **model.UserUpdate
+// UserServiceMockListUsersParamPtrs contains pointers to parameters of the UserService.ListUsers
+type UserServiceMockListUsersParamPtrs struct {
+	ctx   *context.Context
+	query **model.UserListQuery
 }
 
-// UserServiceMockUpdateUserResults contains results of the UserService.UpdateUser
-type UserServiceMockUpdateUserResults struct {
+// UserServiceMockListUsersResults contains results of the UserService.ListUsers
+type UserServiceMockListUsersResults struct {
+	up1 *model.UserList
 	err error
 }
 
-// UserServiceMockUpdateUserOrigins contains origins of expectations of the UserService.UpdateUser
-type UserServiceMockUpdateUserExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originUser string
+// UserServiceMockListUsersOrigins contains origins of expectations of the UserService.ListUsers
+type UserServiceMockListUsersExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originQuery string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1154,108 +1528,792 @@ type UserServiceMockUpdateUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdateUser *mUserServiceMockUpdateUser) Optional() *mUserServiceMockUpdateUser {
-	mmUpdateUser.optional = true
-	return mmUpdateUser
+func (mmListUsers *mUserServiceMockListUsers) Optional() *mUserServiceMockListUsers {
+	mmListUsers.optional = true
+	return mmListUsers
 }
 
-// Expect sets up expected params for UserService.UpdateUser
-func (mmUpdateUser *mUserServiceMockUpdateUser) Expect(ctx context.Context, user *model.UserUpdate) *mUserServiceMockUpdateUser {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UserServiceMock.UpdateUser mock is already set by Set")
+// Expect sets up expected params for UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) Expect(ctx context.Context, query *model.UserListQuery) *mUserServiceMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &UserServiceMockUpdateUserExpectation{}
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{}
 	}
 
-	if mmUpdateUser.defaultExpectation.paramPtrs != nil {
-		mmUpdateUser.mock.t.Fatalf("UserServiceMock.UpdateUser mock is already set by ExpectParams functions")
+	if mmListUsers.defaultExpectation.paramPtrs != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by ExpectParams functions")
 	}
 
-	mmUpdateUser.defaultExpectation.params = &UserServiceMockUpdateUserParams{ctx, user}
-	mmUpdateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmUpdateUser.expectations {
-		if minimock.Equal(e.params, mmUpdateUser.defaultExpectation.params) {
-			mmUpdateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateUser.defaultExpectation.params)
+	mmListUsers.defaultExpectation.params = &UserServiceMockListUsersParams{ctx, query}
+	mmListUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListUsers.expectations {
+		if minimock.Equal(e.params, mmListUsers.defaultExpectation.params) {
+			mmListUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUsers.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdateUser
+	return mmListUsers
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserService.UpdateUser
-func (mmUpdateUser *mUserServiceMockUpdateUser) ExpectCtxParam1(ctx context.Context) *mUserServiceMockUpdateUser {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UserServiceMock.UpdateUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) ExpectCtxParam1(ctx context.Context) *mUserServiceMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &UserServiceMockUpdateUserExpectation{}
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{}
 	}
 
-	if mmUpdateUser.defaultExpectation.params != nil {
-		mmUpdateUser.mock.t.Fatalf("UserServiceMock.UpdateUser mock is already set by Expect")
+	if mmListUsers.defaultExpectation.params != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Expect")
 	}
 
-	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
-		mmUpdateUser.defaultExpectation.paramPtrs = &UserServiceMockUpdateUserParamPtrs{}
+	if mmListUsers.defaultExpectation.paramPtrs == nil {
+		mmListUsers.defaultExpectation.paramPtrs = &UserServiceMockListUsersParamPtrs{}
 	}
-	mmUpdateUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmUpdateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmListUsers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmUpdateUser
+	return mmListUsers
 }
 
-// ExpectUserParam2 sets up expected param user for UserService.UpdateUser
-func (mmUpdateUser *mUserServiceMockUpdateUser) ExpectUserParam2(user *model.UserUpdate) *mUserServiceMockUpdateUser {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UserServiceMock.UpdateUser mock is already set by Set")
+// ExpectQueryParam2 sets up expected param query for UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) ExpectQueryParam2(query *model.UserListQuery) *mUserServiceMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &UserServiceMockUpdateUserExpectation{}
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{}
 	}
 
-	if mmUpdateUser.defaultExpectation.params != nil {
-		mmUpdateUser.mock.t.Fatalf("UserServiceMock.UpdateUser mock is already set by Expect")
+	if mmListUsers.defaultExpectation.params != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Expect")
 	}
 
-	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
-		mmUpdateUser.defaultExpectation.paramPtrs = &UserServiceMockUpdateUserParamPtrs{}
+	if mmListUsers.defaultExpectation.paramPtrs == nil {
+		mmListUsers.defaultExpectation.paramPtrs = &UserServiceMockListUsersParamPtrs{}
 	}
-	mmUpdateUser.defaultExpectation.paramPtrs.user = &user
-	mmUpdateUser.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+	mmListUsers.defaultExpectation.paramPtrs.query = &query
+	mmListUsers.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
 
-	return mmUpdateUser
+	return mmListUsers
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserService.UpdateUser
-func (mmUpdateUser *mUserServiceMockUpdateUser) Inspect(f func(ctx context.Context, user *model.UserUpdate)) *mUserServiceMockUpdateUser {
-	if mmUpdateUser.mock.inspectFuncUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("Inspect function is already set for UserServiceMock.UpdateUser")
+// Inspect accepts an inspector function that has same arguments as the UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) Inspect(f func(ctx context.Context, query *model.UserListQuery)) *mUserServiceMockListUsers {
+	if mmListUsers.mock.inspectFuncListUsers != nil {
+		mmListUsers.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ListUsers")
 	}
 
-	mmUpdateUser.mock.inspectFuncUpdateUser = f
+	mmListUsers.mock.inspectFuncListUsers = f
 
-	return mmUpdateUser
+	return mmListUsers
 }
 
-// Return sets up results that will be returned by UserService.UpdateUser
-func (mmUpdateUser *mUserServiceMockUpdateUser) Return(err error) *UserServiceMock {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UserServiceMock.UpdateUser mock is already set by Set")
+// Return sets up results that will be returned by UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) Return(up1 *model.UserList, err error) *UserServiceMock {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &UserServiceMockUpdateUserExpectation{mock: mmUpdateUser.mock}
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{mock: mmListUsers.mock}
 	}
-	mmUpdateUser.defaultExpectation.results = &UserServiceMockUpdateUserResults{err}
-	mmUpdateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmUpdateUser.mock
+	mmListUsers.defaultExpectation.results = &UserServiceMockListUsersResults{up1, err}
+	mmListUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListUsers.mock
 }
 
-// Set uses given function f to mock the UserService.UpdateUser method
+// Set uses given function f to mock the UserService.ListUsers method
+func (mmListUsers *mUserServiceMockListUsers) Set(f func(ctx context.Context, query *model.UserListQuery) (up1 *model.UserList, err error)) *UserServiceMock {
+	if mmListUsers.defaultExpectation != nil {
+		mmListUsers.mock.t.Fatalf("Default expectation is already set for the UserService.ListUsers method")
+	}
+
+	if len(mmListUsers.expectations) > 0 {
+		mmListUsers.mock.t.Fatalf("Some expectations are already set for the UserService.ListUsers method")
+	}
+
+	mmListUsers.mock.funcListUsers = f
+	mmListUsers.mock.funcListUsersOrigin = minimock.CallerInfo(1)
+	return mmListUsers.mock
+}
+
+// When sets expectation for the UserService.ListUsers which will trigger the result defined by the following
+// Then helper
+func (mmListUsers *mUserServiceMockListUsers) When(ctx context.Context, query *model.UserListQuery) *UserServiceMockListUsersExpectation {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockListUsersExpectation{
+		mock:               mmListUsers.mock,
+		params:             &UserServiceMockListUsersParams{ctx, query},
+		expectationOrigins: UserServiceMockListUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListUsers.expectations = append(mmListUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.ListUsers return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockListUsersExpectation) Then(up1 *model.UserList, err error) *UserServiceMock {
+	e.results = &UserServiceMockListUsersResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.ListUsers should be invoked
+func (mmListUsers *mUserServiceMockListUsers) Times(n uint64) *mUserServiceMockListUsers {
+	if n == 0 {
+		mmListUsers.mock.t.Fatalf("Times of UserServiceMock.ListUsers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListUsers.expectedInvocations, n)
+	mmListUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListUsers
+}
+
+func (mmListUsers *mUserServiceMockListUsers) invocationsDone() bool {
+	if len(mmListUsers.expectations) == 0 && mmListUsers.defaultExpectation == nil && mmListUsers.mock.funcListUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListUsers.mock.afterListUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListUsers implements mm_service.UserService
+func (mmListUsers *UserServiceMock) ListUsers(ctx context.Context, query *model.UserListQuery) (up1 *model.UserList, err error) {
+	mm_atomic.AddUint64(&mmListUsers.beforeListUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListUsers.afterListUsersCounter, 1)
+
+	mmListUsers.t.Helper()
+
+	if mmListUsers.inspectFuncListUsers != nil {
+		mmListUsers.inspectFuncListUsers(ctx, query)
+	}
+
+	mm_params := UserServiceMockListUsersParams{ctx, query}
+
+	// Record call args
+	mmListUsers.ListUsersMock.mutex.Lock()
+	mmListUsers.ListUsersMock.callArgs = append(mmListUsers.ListUsersMock.callArgs, &mm_params)
+	mmListUsers.ListUsersMock.mutex.Unlock()
+
+	for _, e := range mmListUsers.ListUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmListUsers.ListUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListUsers.ListUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListUsers.ListUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmListUsers.ListUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockListUsersParams{ctx, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListUsers.t.Errorf("UserServiceMock.ListUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUsers.ListUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmListUsers.t.Errorf("UserServiceMock.ListUsers got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUsers.ListUsersMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListUsers.t.Errorf("UserServiceMock.ListUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListUsers.ListUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListUsers.ListUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListUsers.t.Fatal("No results are set for the UserServiceMock.ListUsers")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmListUsers.funcListUsers != nil {
+		return mmListUsers.funcListUsers(ctx, query)
+	}
+	mmListUsers.t.Fatalf("Unexpected call to UserServiceMock.ListUsers. %v %v", ctx, query)
+	return
+}
+
+// ListUsersAfterCounter returns a count of finished UserServiceMock.ListUsers invocations
+func (mmListUsers *UserServiceMock) ListUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUsers.afterListUsersCounter)
+}
+
+// ListUsersBeforeCounter returns a count of UserServiceMock.ListUsers invocations
+func (mmListUsers *UserServiceMock) ListUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUsers.beforeListUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.ListUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListUsers *mUserServiceMockListUsers) Calls() []*UserServiceMockListUsersParams {
+	mmListUsers.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockListUsersParams, len(mmListUsers.callArgs))
+	copy(argCopy, mmListUsers.callArgs)
+
+	mmListUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListUsersDone returns true if the count of the ListUsers invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockListUsersDone() bool {
+	if m.ListUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListUsersMock.invocationsDone()
+}
+
+// MinimockListUsersInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockListUsersInspect() {
+	for _, e := range m.ListUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.ListUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListUsersCounter := mm_atomic.LoadUint64(&m.afterListUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUsersMock.defaultExpectation != nil && afterListUsersCounter < 1 {
+		if m.ListUsersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.ListUsers at\n%s", m.ListUsersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.ListUsers at\n%s with params: %#v", m.ListUsersMock.defaultExpectation.expectationOrigins.origin, *m.ListUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUsers != nil && afterListUsersCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.ListUsers at\n%s", m.funcListUsersOrigin)
+	}
+
+	if !m.ListUsersMock.invocationsDone() && afterListUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.ListUsers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListUsersMock.expectedInvocations), m.ListUsersMock.expectedInvocationsOrigin, afterListUsersCounter)
+	}
+}
+
+type mUserServiceMockRestoreUser struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockRestoreUserExpectation
+	expectations       []*UserServiceMockRestoreUserExpectation
+
+	callArgs []*UserServiceMockRestoreUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockRestoreUserExpectation specifies expectation struct of the UserService.RestoreUser
+type UserServiceMockRestoreUserExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockRestoreUserParams
+	paramPtrs          *UserServiceMockRestoreUserParamPtrs
+	expectationOrigins UserServiceMockRestoreUserExpectationOrigins
+	results            *UserServiceMockRestoreUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockRestoreUserParams contains parameters of the UserService.RestoreUser
+type UserServiceMockRestoreUserParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// UserServiceMockRestoreUserParamPtrs contains pointers to parameters of the UserService.RestoreUser
+type UserServiceMockRestoreUserParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// UserServiceMockRestoreUserResults contains results of the UserService.RestoreUser
+type UserServiceMockRestoreUserResults struct {
+	err error
+}
+
+// UserServiceMockRestoreUserOrigins contains origins of expectations of the UserService.RestoreUser
+type UserServiceMockRestoreUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRestoreUser *mUserServiceMockRestoreUser) Optional() *mUserServiceMockRestoreUser {
+	mmRestoreUser.optional = true
+	return mmRestoreUser
+}
+
+// Expect sets up expected params for UserService.RestoreUser
+func (mmRestoreUser *mUserServiceMockRestoreUser) Expect(ctx context.Context, id int64) *mUserServiceMockRestoreUser {
+	if mmRestoreUser.mock.funcRestoreUser != nil {
+		mmRestoreUser.mock.t.Fatalf("UserServiceMock.RestoreUser mock is already set by Set")
+	}
+
+	if mmRestoreUser.defaultExpectation == nil {
+		mmRestoreUser.defaultExpectation = &UserServiceMockRestoreUserExpectation{}
+	}
+
+	if mmRestoreUser.defaultExpectation.paramPtrs != nil {
+		mmRestoreUser.mock.t.Fatalf("UserServiceMock.RestoreUser mock is already set by ExpectParams functions")
+	}
+
+	mmRestoreUser.defaultExpectation.params = &UserServiceMockRestoreUserParams{ctx, id}
+	mmRestoreUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRestoreUser.expectations {
+		if minimock.Equal(e.params, mmRestoreUser.defaultExpectation.params) {
+			mmRestoreUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestoreUser.defaultExpectation.params)
+		}
+	}
+
+	return mmRestoreUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.RestoreUser
+func (mmRestoreUser *mUserServiceMockRestoreUser) ExpectCtxParam1(ctx context.Context) *mUserServiceMockRestoreUser {
+	if mmRestoreUser.mock.funcRestoreUser != nil {
+		mmRestoreUser.mock.t.Fatalf("UserServiceMock.RestoreUser mock is already set by Set")
+	}
+
+	if mmRestoreUser.defaultExpectation == nil {
+		mmRestoreUser.defaultExpectation = &UserServiceMockRestoreUserExpectation{}
+	}
+
+	if mmRestoreUser.defaultExpectation.params != nil {
+		mmRestoreUser.mock.t.Fatalf("UserServiceMock.RestoreUser mock is already set by Expect")
+	}
+
+	if mmRestoreUser.defaultExpectation.paramPtrs == nil {
+		mmRestoreUser.defaultExpectation.paramPtrs = &UserServiceMockRestoreUserParamPtrs{}
+	}
+	mmRestoreUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRestoreUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRestoreUser
+}
+
+// ExpectIdParam2 sets up expected param id for UserService.RestoreUser
+func (mmRestoreUser *mUserServiceMockRestoreUser) ExpectIdParam2(id int64) *mUserServiceMockRestoreUser {
+	if mmRestoreUser.mock.funcRestoreUser != nil {
+		mmRestoreUser.mock.t.Fatalf("UserServiceMock.RestoreUser mock is already set by Set")
+	}
+
+	if mmRestoreUser.defaultExpectation == nil {
+		mmRestoreUser.defaultExpectation = &UserServiceMockRestoreUserExpectation{}
+	}
+
+	if mmRestoreUser.defaultExpectation.params != nil {
+		mmRestoreUser.mock.t.Fatalf("UserServiceMock.RestoreUser mock is already set by Expect")
+	}
+
+	if mmRestoreUser.defaultExpectation.paramPtrs == nil {
+		mmRestoreUser.defaultExpectation.paramPtrs = &UserServiceMockRestoreUserParamPtrs{}
+	}
+	mmRestoreUser.defaultExpectation.paramPtrs.id = &id
+	mmRestoreUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRestoreUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.RestoreUser
+func (mmRestoreUser *mUserServiceMockRestoreUser) Inspect(f func(ctx context.Context, id int64)) *mUserServiceMockRestoreUser {
+	if mmRestoreUser.mock.inspectFuncRestoreUser != nil {
+		mmRestoreUser.mock.t.Fatalf("Inspect function is already set for UserServiceMock.RestoreUser")
+	}
+
+	mmRestoreUser.mock.inspectFuncRestoreUser = f
+
+	return mmRestoreUser
+}
+
+// Return sets up results that will be returned by UserService.RestoreUser
+func (mmRestoreUser *mUserServiceMockRestoreUser) Return(err error) *UserServiceMock {
+	if mmRestoreUser.mock.funcRestoreUser != nil {
+		mmRestoreUser.mock.t.Fatalf("UserServiceMock.RestoreUser mock is already set by Set")
+	}
+
+	if mmRestoreUser.defaultExpectation == nil {
+		mmRestoreUser.defaultExpectation = &UserServiceMockRestoreUserExpectation{mock: mmRestoreUser.mock}
+	}
+	mmRestoreUser.defaultExpectation.results = &UserServiceMockRestoreUserResults{err}
+	mmRestoreUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRestoreUser.mock
+}
+
+// Set uses given function f to mock the UserService.RestoreUser method
+func (mmRestoreUser *mUserServiceMockRestoreUser) Set(f func(ctx context.Context, id int64) (err error)) *UserServiceMock {
+	if mmRestoreUser.defaultExpectation != nil {
+		mmRestoreUser.mock.t.Fatalf("Default expectation is already set for the UserService.RestoreUser method")
+	}
+
+	if len(mmRestoreUser.expectations) > 0 {
+		mmRestoreUser.mock.t.Fatalf("Some expectations are already set for the UserService.RestoreUser method")
+	}
+
+	mmRestoreUser.mock.funcRestoreUser = f
+	mmRestoreUser.mock.funcRestoreUserOrigin = minimock.CallerInfo(1)
+	return mmRestoreUser.mock
+}
+
+// When sets expectation for the UserService.RestoreUser which will trigger the result defined by the following
+// Then helper
+func (mmRestoreUser *mUserServiceMockRestoreUser) When(ctx context.Context, id int64) *UserServiceMockRestoreUserExpectation {
+	if mmRestoreUser.mock.funcRestoreUser != nil {
+		mmRestoreUser.mock.t.Fatalf("UserServiceMock.RestoreUser mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockRestoreUserExpectation{
+		mock:               mmRestoreUser.mock,
+		params:             &UserServiceMockRestoreUserParams{ctx, id},
+		expectationOrigins: UserServiceMockRestoreUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRestoreUser.expectations = append(mmRestoreUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.RestoreUser return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockRestoreUserExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockRestoreUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserService.RestoreUser should be invoked
+func (mmRestoreUser *mUserServiceMockRestoreUser) Times(n uint64) *mUserServiceMockRestoreUser {
+	if n == 0 {
+		mmRestoreUser.mock.t.Fatalf("Times of UserServiceMock.RestoreUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRestoreUser.expectedInvocations, n)
+	mmRestoreUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRestoreUser
+}
+
+func (mmRestoreUser *mUserServiceMockRestoreUser) invocationsDone() bool {
+	if len(mmRestoreUser.expectations) == 0 && mmRestoreUser.defaultExpectation == nil && mmRestoreUser.mock.funcRestoreUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRestoreUser.mock.afterRestoreUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRestoreUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RestoreUser implements mm_service.UserService
+func (mmRestoreUser *UserServiceMock) RestoreUser(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmRestoreUser.beforeRestoreUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestoreUser.afterRestoreUserCounter, 1)
+
+	mmRestoreUser.t.Helper()
+
+	if mmRestoreUser.inspectFuncRestoreUser != nil {
+		mmRestoreUser.inspectFuncRestoreUser(ctx, id)
+	}
+
+	mm_params := UserServiceMockRestoreUserParams{ctx, id}
+
+	// Record call args
+	mmRestoreUser.RestoreUserMock.mutex.Lock()
+	mmRestoreUser.RestoreUserMock.callArgs = append(mmRestoreUser.RestoreUserMock.callArgs, &mm_params)
+	mmRestoreUser.RestoreUserMock.mutex.Unlock()
+
+	for _, e := range mmRestoreUser.RestoreUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRestoreUser.RestoreUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestoreUser.RestoreUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestoreUser.RestoreUserMock.defaultExpectation.params
+		mm_want_ptrs := mmRestoreUser.RestoreUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockRestoreUserParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRestoreUser.t.Errorf("UserServiceMock.RestoreUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreUser.RestoreUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRestoreUser.t.Errorf("UserServiceMock.RestoreUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreUser.RestoreUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRestoreUser.t.Errorf("UserServiceMock.RestoreUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRestoreUser.RestoreUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRestoreUser.RestoreUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRestoreUser.t.Fatal("No results are set for the UserServiceMock.RestoreUser")
+		}
+		return (*mm_results).err
+	}
+	if mmRestoreUser.funcRestoreUser != nil {
+		return mmRestoreUser.funcRestoreUser(ctx, id)
+	}
+	mmRestoreUser.t.Fatalf("Unexpected call to UserServiceMock.RestoreUser. %v %v", ctx, id)
+	return
+}
+
+// RestoreUserAfterCounter returns a count of finished UserServiceMock.RestoreUser invocations
+func (mmRestoreUser *UserServiceMock) RestoreUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreUser.afterRestoreUserCounter)
+}
+
+// RestoreUserBeforeCounter returns a count of UserServiceMock.RestoreUser invocations
+func (mmRestoreUser *UserServiceMock) RestoreUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreUser.beforeRestoreUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.RestoreUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRestoreUser *mUserServiceMockRestoreUser) Calls() []*UserServiceMockRestoreUserParams {
+	mmRestoreUser.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockRestoreUserParams, len(mmRestoreUser.callArgs))
+	copy(argCopy, mmRestoreUser.callArgs)
+
+	mmRestoreUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRestoreUserDone returns true if the count of the RestoreUser invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockRestoreUserDone() bool {
+	if m.RestoreUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RestoreUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RestoreUserMock.invocationsDone()
+}
+
+// MinimockRestoreUserInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockRestoreUserInspect() {
+	for _, e := range m.RestoreUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.RestoreUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRestoreUserCounter := mm_atomic.LoadUint64(&m.afterRestoreUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreUserMock.defaultExpectation != nil && afterRestoreUserCounter < 1 {
+		if m.RestoreUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.RestoreUser at\n%s", m.RestoreUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.RestoreUser at\n%s with params: %#v", m.RestoreUserMock.defaultExpectation.expectationOrigins.origin, *m.RestoreUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestoreUser != nil && afterRestoreUserCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.RestoreUser at\n%s", m.funcRestoreUserOrigin)
+	}
+
+	if !m.RestoreUserMock.invocationsDone() && afterRestoreUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.RestoreUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RestoreUserMock.expectedInvocations), m.RestoreUserMock.expectedInvocationsOrigin, afterRestoreUserCounter)
+	}
+}
+
+type mUserServiceMockUpdateUser struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockUpdateUserExpectation
+	expectations       []*UserServiceMockUpdateUserExpectation
+
+	callArgs []*UserServiceMockUpdateUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockUpdateUserExpectation specifies expectation struct of the UserService.UpdateUser
+type UserServiceMockUpdateUserExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockUpdateUserParams
+	paramPtrs          *UserServiceMockUpdateUserParamPtrs
+	expectationOrigins UserServiceMockUpdateUserExpectationOrigins
+	results            *UserServiceMockUpdateUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockUpdateUserParams contains parameters of the UserService.UpdateUser
+type UserServiceMockUpdateUserParams struct {
+	ctx  context.Context
+	user *model.UserUpdate
+}
+
+// UserServiceMockUpdateUserParamPtrs contains pointers to parameters of the UserService.UpdateUser
+type UserServiceMockUpdateUserParamPtrs struct {
+	ctx  *context.Context
+	user **model.UserUpdate
+}
+
+// UserServiceMockUpdateUserResults contains results of the UserService.UpdateUser
+type UserServiceMockUpdateUserResults struct {
+	err error
+}
+
+// UserServiceMockUpdateUserOrigins contains origins of expectations of the UserService.UpdateUser
+type UserServiceMockUpdateUserExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originUser string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateUser *mUserServiceMockUpdateUser) Optional() *mUserServiceMockUpdateUser {
+	mmUpdateUser.optional = true
+	return mmUpdateUser
+}
+
+// Expect sets up expected params for UserService.UpdateUser
+func (mmUpdateUser *mUserServiceMockUpdateUser) Expect(ctx context.Context, user *model.UserUpdate) *mUserServiceMockUpdateUser {
+	if mmUpdateUser.mock.funcUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("UserServiceMock.UpdateUser mock is already set by Set")
+	}
+
+	if mmUpdateUser.defaultExpectation == nil {
+		mmUpdateUser.defaultExpectation = &UserServiceMockUpdateUserExpectation{}
+	}
+
+	if mmUpdateUser.defaultExpectation.paramPtrs != nil {
+		mmUpdateUser.mock.t.Fatalf("UserServiceMock.UpdateUser mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateUser.defaultExpectation.params = &UserServiceMockUpdateUserParams{ctx, user}
+	mmUpdateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateUser.expectations {
+		if minimock.Equal(e.params, mmUpdateUser.defaultExpectation.params) {
+			mmUpdateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateUser.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.UpdateUser
+func (mmUpdateUser *mUserServiceMockUpdateUser) ExpectCtxParam1(ctx context.Context) *mUserServiceMockUpdateUser {
+	if mmUpdateUser.mock.funcUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("UserServiceMock.UpdateUser mock is already set by Set")
+	}
+
+	if mmUpdateUser.defaultExpectation == nil {
+		mmUpdateUser.defaultExpectation = &UserServiceMockUpdateUserExpectation{}
+	}
+
+	if mmUpdateUser.defaultExpectation.params != nil {
+		mmUpdateUser.mock.t.Fatalf("UserServiceMock.UpdateUser mock is already set by Expect")
+	}
+
+	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
+		mmUpdateUser.defaultExpectation.paramPtrs = &UserServiceMockUpdateUserParamPtrs{}
+	}
+	mmUpdateUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateUser
+}
+
+// ExpectUserParam2 sets up expected param user for UserService.UpdateUser
+func (mmUpdateUser *mUserServiceMockUpdateUser) ExpectUserParam2(user *model.UserUpdate) *mUserServiceMockUpdateUser {
+	if mmUpdateUser.mock.funcUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("UserServiceMock.UpdateUser mock is already set by Set")
+	}
+
+	if mmUpdateUser.defaultExpectation == nil {
+		mmUpdateUser.defaultExpectation = &UserServiceMockUpdateUserExpectation{}
+	}
+
+	if mmUpdateUser.defaultExpectation.params != nil {
+		mmUpdateUser.mock.t.Fatalf("UserServiceMock.UpdateUser mock is already set by Expect")
+	}
+
+	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
+		mmUpdateUser.defaultExpectation.paramPtrs = &UserServiceMockUpdateUserParamPtrs{}
+	}
+	mmUpdateUser.defaultExpectation.paramPtrs.user = &user
+	mmUpdateUser.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+
+	return mmUpdateUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.UpdateUser
+func (mmUpdateUser *mUserServiceMockUpdateUser) Inspect(f func(ctx context.Context, user *model.UserUpdate)) *mUserServiceMockUpdateUser {
+	if mmUpdateUser.mock.inspectFuncUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("Inspect function is already set for UserServiceMock.UpdateUser")
+	}
+
+	mmUpdateUser.mock.inspectFuncUpdateUser = f
+
+	return mmUpdateUser
+}
+
+// Return sets up results that will be returned by UserService.UpdateUser
+func (mmUpdateUser *mUserServiceMockUpdateUser) Return(err error) *UserServiceMock {
+	if mmUpdateUser.mock.funcUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("UserServiceMock.UpdateUser mock is already set by Set")
+	}
+
+	if mmUpdateUser.defaultExpectation == nil {
+		mmUpdateUser.defaultExpectation = &UserServiceMockUpdateUserExpectation{mock: mmUpdateUser.mock}
+	}
+	mmUpdateUser.defaultExpectation.results = &UserServiceMockUpdateUserResults{err}
+	mmUpdateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateUser.mock
+}
+
+// Set uses given function f to mock the UserService.UpdateUser method
 func (mmUpdateUser *mUserServiceMockUpdateUser) Set(f func(ctx context.Context, user *model.UserUpdate) (err error)) *UserServiceMock {
 	if mmUpdateUser.defaultExpectation != nil {
 		mmUpdateUser.mock.t.Fatalf("Default expectation is already set for the UserService.UpdateUser method")
@@ -1449,10 +2507,16 @@ func (m *UserServiceMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockCreateUserInspect()
 
+			m.MinimockCreateUsersInspect()
+
 			m.MinimockDeleteUserInspect()
 
 			m.MinimockGetUserInspect()
 
+			m.MinimockListUsersInspect()
+
+			m.MinimockRestoreUserInspect()
+
 			m.MinimockUpdateUserInspect()
 		}
 	})
@@ -1478,7 +2542,10 @@ func (m *UserServiceMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockCreateUserDone() &&
+		m.MinimockCreateUsersDone() &&
 		m.MinimockDeleteUserDone() &&
 		m.MinimockGetUserDone() &&
+		m.MinimockListUsersDone() &&
+		m.MinimockRestoreUserDone() &&
 		m.MinimockUpdateUserDone()
 }
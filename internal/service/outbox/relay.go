@@ -0,0 +1,97 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/ipv02/auth/internal/client/kafka/producer"
+	"github.com/ipv02/auth/internal/model"
+)
+
+const (
+	eventIDHeader   = "event-id"
+	eventTypeHeader = "event-type"
+)
+
+// RunRelay периодически публикует события из outbox в kafka.
+// Полная пачка означает, что событий могло остаться больше, и следующая отправляется без ожидания.
+// Завершается без ошибки с отменой ctx
+func (s *service) RunRelay(ctx context.Context) error {
+	ticker := time.NewTicker(s.outboxConfig.PollInterval())
+	defer ticker.Stop()
+
+	for {
+		sent, err := s.relay(ctx)
+		if err != nil {
+			log.Printf("failed to relay outbox events: %v", err)
+		}
+
+		if err == nil && uint64(sent) == s.outboxConfig.BatchSize() {
+			if ctx.Err() != nil {
+				return nil
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// relay отправляет пачку событий и удаляет отправленные в той же транзакции, в которой они заблокированы.
+// Если транзакция не зафиксируется после отправки, события будут отправлены повторно,
+// поэтому доставка гарантируется не менее одного раза
+func (s *service) relay(ctx context.Context) (int, error) {
+	var (
+		sent    []int64
+		sendErr error
+	)
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		sent = sent[:0]
+
+		events, errTx := s.outboxRepository.GetPendingEvents(ctx, s.outboxConfig.BatchSize())
+		if errTx != nil {
+			return errTx
+		}
+
+		// при ошибке отправки удаляются только уже отправленные события,
+		// остальные сохраняют порядок и будут отправлены при следующем проходе
+		for _, event := range events {
+			sendErr = s.producer.SendMessage(ctx, s.toMessage(event))
+			if sendErr != nil {
+				break
+			}
+
+			sent = append(sent, event.ID)
+		}
+
+		if len(sent) == 0 {
+			return nil
+		}
+
+		return s.outboxRepository.DeleteEvents(ctx, sent)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(sent), sendErr
+}
+
+func (s *service) toMessage(event *model.OutboxEvent) *producer.Message {
+	return &producer.Message{
+		Topic: s.outboxConfig.Topic(),
+		Key:   []byte(strconv.FormatInt(event.AggregateID, 10)),
+		Value: event.Payload,
+		Headers: []producer.Header{
+			{Key: eventIDHeader, Value: []byte(strconv.FormatInt(event.ID, 10))},
+			{Key: eventTypeHeader, Value: []byte(event.EventType)},
+		},
+		Timestamp: event.CreatedAt,
+	}
+}
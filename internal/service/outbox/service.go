@@ -0,0 +1,33 @@
+package outbox
+
+import (
+	"github.com/ipv02/auth/internal/client/db"
+	"github.com/ipv02/auth/internal/client/kafka"
+	"github.com/ipv02/auth/internal/config"
+	"github.com/ipv02/auth/internal/repository"
+	def "github.com/ipv02/auth/internal/service"
+)
+
+var _ def.OutboxRelayService = (*service)(nil)
+
+type service struct {
+	outboxRepository repository.OutboxRepository
+	txManager        db.TxManager
+	producer         kafka.Producer
+	outboxConfig     config.OutboxConfig
+}
+
+// NewService конструктор сервиса публикации событий из outbox
+func NewService(
+	outboxRepository repository.OutboxRepository,
+	txManager db.TxManager,
+	producer kafka.Producer,
+	outboxConfig config.OutboxConfig,
+) def.OutboxRelayService {
+	return &service{
+		outboxRepository: outboxRepository,
+		txManager:        txManager,
+		producer:         producer,
+		outboxConfig:     outboxConfig,
+	}
+}
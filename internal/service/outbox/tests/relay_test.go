@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/auth/internal/client/db"
+	dbMocks "github.com/ipv02/auth/internal/client/db/mocks"
+	kafkaProducer "github.com/ipv02/auth/internal/client/kafka/producer"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/repository"
+	repoMocks "github.com/ipv02/auth/internal/repository/mocks"
+	"github.com/ipv02/auth/internal/service/outbox"
+)
+
+type outboxConfig struct{}
+
+func (outboxConfig) Enabled() bool               { return true }
+func (outboxConfig) Topic() string               { return "user-events" }
+func (outboxConfig) PollInterval() time.Duration { return time.Hour }
+func (outboxConfig) BatchSize() uint64           { return 3 }
+
+// producer запоминает отправленные сообщения и возвращает ошибку на сообщении failOn
+type producer struct {
+	mu     sync.Mutex
+	sent   []*kafkaProducer.Message
+	failOn int
+	cancel context.CancelFunc
+}
+
+func (p *producer) SendMessage(_ context.Context, msg *kafkaProducer.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.failOn != 0 && len(p.sent)+1 == p.failOn {
+		p.cancel()
+		return fmt.Errorf("producer error")
+	}
+
+	p.sent = append(p.sent, msg)
+	return nil
+}
+
+func (p *producer) Close() error {
+	return nil
+}
+
+func TestRunRelay(t *testing.T) {
+	t.Parallel()
+	// cancel останавливает RunRelay после обработки событий
+	type outboxRepositoryMockFunc func(mc *minimock.Controller, cancel context.CancelFunc) repository.OutboxRepository
+
+	var (
+		createdAt = time.Now()
+		repoErr   = fmt.Errorf("repo error")
+
+		events = []*model.OutboxEvent{
+			{ID: 1, AggregateID: 10, EventType: model.UserCreatedEventType, Payload: []byte(`{"id":10}`), CreatedAt: createdAt},
+			{ID: 2, AggregateID: 10, EventType: model.UserUpdatedEventType, Payload: []byte(`{"id":10}`), CreatedAt: createdAt},
+			{ID: 3, AggregateID: 11, EventType: model.UserDeletedEventType, Payload: []byte(`{"id":11}`), CreatedAt: createdAt},
+		}
+	)
+
+	tests := []struct {
+		name                 string
+		failOn               int
+		sent                 int
+		outboxRepositoryMock outboxRepositoryMockFunc
+	}{
+		{
+			name: "sends full batch and polls again without waiting",
+			sent: 3,
+			outboxRepositoryMock: func(mc *minimock.Controller, cancel context.CancelFunc) repository.OutboxRepository {
+				calls := 0
+
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.GetPendingEventsMock.Set(func(_ context.Context, limit uint64) ([]*model.OutboxEvent, error) {
+					require.Equal(t, uint64(3), limit)
+
+					calls++
+					if calls == 2 {
+						cancel()
+						return nil, nil
+					}
+
+					return events, nil
+				})
+				mock.DeleteEventsMock.Set(func(_ context.Context, ids []int64) error {
+					require.Equal(t, []int64{1, 2, 3}, ids)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name:   "deletes only sent events on producer error",
+			failOn: 2,
+			sent:   1,
+			outboxRepositoryMock: func(mc *minimock.Controller, _ context.CancelFunc) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.GetPendingEventsMock.Return(events, nil)
+				mock.DeleteEventsMock.Set(func(_ context.Context, ids []int64) error {
+					require.Equal(t, []int64{1}, ids)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "repo error",
+			outboxRepositoryMock: func(mc *minimock.Controller, cancel context.CancelFunc) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.GetPendingEventsMock.Set(func(_ context.Context, _ uint64) ([]*model.OutboxEvent, error) {
+					cancel()
+					return nil, repoErr
+				})
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			mc := minimock.NewController(t)
+			p := &producer{failOn: tt.failOn, cancel: cancel}
+			txManagerMock := dbMocks.NewTxManagerMock(mc).ReadCommittedMock.Set(func(ctx context.Context, f db.Handler, _ ...db.TxOption) error {
+				return f(ctx)
+			})
+			service := outbox.NewService(tt.outboxRepositoryMock(mc, cancel), txManagerMock, p, outboxConfig{})
+
+			err := service.RunRelay(ctx)
+			require.NoError(t, err)
+
+			require.Len(t, p.sent, tt.sent)
+			for i, msg := range p.sent {
+				event := events[i]
+
+				require.Equal(t, "user-events", msg.Topic)
+				require.Equal(t, []byte(fmt.Sprint(event.AggregateID)), msg.Key)
+				require.Equal(t, event.Payload, msg.Value)
+				require.Equal(t, []kafkaProducer.Header{
+					{Key: "event-id", Value: []byte(fmt.Sprint(event.ID))},
+					{Key: "event-type", Value: []byte(event.EventType)},
+				}, msg.Headers)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package purger
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ipv02/auth/internal/client/db"
+)
+
+// purgeBatchFunc удаляет не более limit записей и возвращает их количество
+type purgeBatchFunc func(ctx context.Context, limit uint64) (int64, error)
+
+// RunPurger периодически физически удаляет пользователей, помеченных удаленными дольше срока хранения,
+// и ключи идемпотентности сообщений, обработанных дольше срока хранения. Завершается без ошибки с отменой ctx
+func (s *service) RunPurger(ctx context.Context) error {
+	ticker := time.NewTicker(s.purgerConfig.Interval())
+	defer ticker.Stop()
+
+	for {
+		purged, err := s.purgeUsers(ctx)
+		if err != nil {
+			log.Printf("failed to purge deleted users: %v", err)
+		}
+		if purged > 0 {
+			log.Printf("purged %d deleted users", purged)
+		}
+
+		purged, err = s.purgeProcessedMessages(ctx)
+		if err != nil {
+			log.Printf("failed to purge processed messages: %v", err)
+		}
+		if purged > 0 {
+			log.Printf("purged %d processed messages", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *service) purgeUsers(ctx context.Context) (int64, error) {
+	deletedBefore := time.Now().Add(-s.purgerConfig.Retention())
+
+	return s.purge(ctx, func(ctx context.Context, limit uint64) (int64, error) {
+		return s.userRepository.PurgeDeletedUsers(ctx, deletedBefore, limit)
+	})
+}
+
+func (s *service) purgeProcessedMessages(ctx context.Context) (int64, error) {
+	processedBefore := time.Now().Add(-s.purgerConfig.ProcessedMessageRetention())
+
+	return s.purge(ctx, func(ctx context.Context, limit uint64) (int64, error) {
+		return s.processedMessageRepository.PurgeProcessedMessages(ctx, processedBefore, limit)
+	})
+}
+
+// purge удаляет записи пачками, каждая пачка удаляется в отдельной транзакции,
+// чтобы не держать блокировки на все время очистки
+func (s *service) purge(ctx context.Context, purgeBatch purgeBatchFunc) (int64, error) {
+	batchSize := s.purgerConfig.BatchSize()
+
+	var total int64
+	for {
+		var purged int64
+		err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+			var errTx error
+			purged, errTx = purgeBatch(ctx, batchSize)
+			return errTx
+		}, db.RetrySafe())
+		if err != nil {
+			return total, err
+		}
+
+		total += purged
+		if uint64(purged) < batchSize || ctx.Err() != nil {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package purger
+
+import (
+	"github.com/ipv02/auth/internal/client/db"
+	"github.com/ipv02/auth/internal/config"
+	"github.com/ipv02/auth/internal/repository"
+	def "github.com/ipv02/auth/internal/service"
+)
+
+var _ def.PurgerService = (*service)(nil)
+
+type service struct {
+	userRepository             repository.UserRepository
+	processedMessageRepository repository.ProcessedMessageRepository
+	txManager                  db.TxManager
+	purgerConfig               config.UserPurgerConfig
+}
+
+// NewService конструктор сервиса очистки удаленных пользователей и ключей идемпотентности обработанных сообщений
+func NewService(
+	userRepository repository.UserRepository,
+	processedMessageRepository repository.ProcessedMessageRepository,
+	txManager db.TxManager,
+	purgerConfig config.UserPurgerConfig,
+) def.PurgerService {
+	return &service{
+		userRepository:             userRepository,
+		processedMessageRepository: processedMessageRepository,
+		txManager:                  txManager,
+		purgerConfig:               purgerConfig,
+	}
+}
@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/auth/internal/client/db"
+	dbMocks "github.com/ipv02/auth/internal/client/db/mocks"
+	"github.com/ipv02/auth/internal/repository"
+	repoMocks "github.com/ipv02/auth/internal/repository/mocks"
+	"github.com/ipv02/auth/internal/service/purger"
+)
+
+type purgerConfig struct{}
+
+func (purgerConfig) Retention() time.Duration                 { return time.Hour }
+func (purgerConfig) Interval() time.Duration                  { return time.Hour }
+func (purgerConfig) BatchSize() uint64                        { return 2 }
+func (purgerConfig) ProcessedMessageRetention() time.Duration { return 24 * time.Hour }
+
+func TestRunPurger(t *testing.T) {
+	t.Parallel()
+	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+	// cancel останавливает RunPurger после первого прохода очистки
+	type processedMessageRepositoryMockFunc func(mc *minimock.Controller, cancel context.CancelFunc) repository.ProcessedMessageRepository
+
+	repoErr := fmt.Errorf("repo error")
+
+	tests := []struct {
+		name                           string
+		txCalls                        uint64
+		userRepositoryMock             userRepositoryMockFunc
+		processedMessageRepositoryMock processedMessageRepositoryMockFunc
+	}{
+		{
+			name:    "purges in batches until batch is not full",
+			txCalls: 4,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				purged := []int64{2, 2, 1}
+				calls := 0
+
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.PurgeDeletedUsersMock.Set(func(_ context.Context, deletedBefore time.Time, limit uint64) (int64, error) {
+					require.Equal(t, uint64(2), limit)
+					require.WithinDuration(t, time.Now().Add(-time.Hour), deletedBefore, time.Minute)
+
+					calls++
+					return purged[calls-1], nil
+				})
+				return mock
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller, cancel context.CancelFunc) repository.ProcessedMessageRepository {
+				mock := repoMocks.NewProcessedMessageRepositoryMock(mc)
+				mock.PurgeProcessedMessagesMock.Set(func(_ context.Context, processedBefore time.Time, limit uint64) (int64, error) {
+					require.Equal(t, uint64(2), limit)
+					require.WithinDuration(t, time.Now().Add(-24*time.Hour), processedBefore, time.Minute)
+
+					cancel()
+					return 1, nil
+				})
+				return mock
+			},
+		},
+		{
+			name:    "continues after repo error",
+			txCalls: 2,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.PurgeDeletedUsersMock.Return(0, repoErr)
+				return mock
+			},
+			processedMessageRepositoryMock: func(mc *minimock.Controller, cancel context.CancelFunc) repository.ProcessedMessageRepository {
+				mock := repoMocks.NewProcessedMessageRepositoryMock(mc)
+				mock.PurgeProcessedMessagesMock.Set(func(_ context.Context, _ time.Time, _ uint64) (int64, error) {
+					cancel()
+					return 0, repoErr
+				})
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			txManagerMock := dbMocks.NewTxManagerMock(mc).ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler, _ ...db.TxOption) error {
+				return f(ctx)
+			})
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			service := purger.NewService(
+				tt.userRepositoryMock(mc),
+				tt.processedMessageRepositoryMock(mc, cancel),
+				txManagerMock,
+				purgerConfig{},
+			)
+
+			err := service.RunPurger(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tt.txCalls, txManagerMock.ReadCommittedAfterCounter())
+		})
+	}
+}
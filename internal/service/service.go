@@ -9,12 +9,46 @@ import (
 // UserService интерфейс описывающий сервисный слой
 type UserService interface {
 	CreateUser(ctx context.Context, user *model.UserCreate) (int64, error)
+	CreateUsers(ctx context.Context, users []*model.UserCreate) ([]int64, error)
 	GetUser(ctx context.Context, id int64) (*model.UserGet, error)
 	UpdateUser(ctx context.Context, user *model.UserUpdate) error
 	DeleteUser(ctx context.Context, id int64) error
+	ListUsers(ctx context.Context, query *model.UserListQuery) (*model.UserList, error)
+	RestoreUser(ctx context.Context, id int64) error
+}
+
+// AuthService интерфейс описывающий сервисный слой аутентификации
+type AuthService interface {
+	Login(ctx context.Context, email, password string) (*model.TokenPair, error)
+	GetRefreshToken(ctx context.Context, refreshToken string) (*model.TokenPair, error)
+	GetAccessToken(ctx context.Context, refreshToken string) (string, error)
+}
+
+// AccessService интерфейс описывающий сервисный слой проверки доступа
+type AccessService interface {
+	Check(ctx context.Context, accessToken, endpointAddress string) error
+	RunPolicyReload(ctx context.Context) error
+}
+
+// PurgerService интерфейс описывающий фоновую очистку удаленных пользователей и обработанных сообщений
+type PurgerService interface {
+	RunPurger(ctx context.Context) error
+}
+
+// OutboxRelayService интерфейс описывающий фоновую публикацию событий из outbox
+type OutboxRelayService interface {
+	RunRelay(ctx context.Context) error
 }
 
 // ConsumerService интерфейс описывающий consumer
 type ConsumerService interface {
 	RunConsumer(ctx context.Context) error
 }
+
+// PasswordHasher интерфейс для хеширования и проверки паролей.
+// Хеш хранит в себе алгоритм и параметры, с которыми он был получен.
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	Verify(hash, password string) (bool, error)
+	NeedsRehash(hash string) bool
+}
@@ -3,15 +3,101 @@ package user
 import (
 	"context"
 
+	"github.com/ipv02/auth/internal/metric"
 	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/tracing"
 )
 
 // CreateUser - запрос сервисного слоя создания нового пользователя
 func (s *service) CreateUser(ctx context.Context, user *model.UserCreate) (int64, error) {
-	id, err := s.userRepository.CreateUser(ctx, user)
+	ctx, span := tracing.StartSpan(ctx, "UserService.CreateUser")
+	defer span.End()
+
+	passwordHash, err := s.passwordHasher.Hash(user.Password)
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		id, errTx = s.userRepository.CreateUser(ctx, &model.UserCreate{
+			Name:     user.Name,
+			Email:    user.Email,
+			Password: passwordHash,
+			Role:     user.Role,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.addEvent(ctx, model.UserCreatedEventType, id, model.UserCreatedEvent{
+			ID:    id,
+			Name:  user.Name,
+			Email: user.Email,
+			Role:  user.Role,
+		})
+	})
 	if err != nil {
 		return 0, err
 	}
 
+	metric.AddUsersCreated(1)
+
 	return id, nil
 }
+
+// CreateUsers - запрос сервисного слоя создания пакета пользователей одной транзакцией.
+// Пароли хешируются до начала транзакции, чтобы не держать ее открытой
+func (s *service) CreateUsers(ctx context.Context, users []*model.UserCreate) ([]int64, error) {
+	ctx, span := tracing.StartSpan(ctx, "UserService.CreateUsers")
+	defer span.End()
+
+	hashed := make([]*model.UserCreate, 0, len(users))
+	for _, user := range users {
+		passwordHash, err := s.passwordHasher.Hash(user.Password)
+		if err != nil {
+			return nil, err
+		}
+
+		hashed = append(hashed, &model.UserCreate{
+			Name:     user.Name,
+			Email:    user.Email,
+			Password: passwordHash,
+			Role:     user.Role,
+		})
+	}
+
+	var ids []int64
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		ids, errTx = s.userRepository.CreateUsers(ctx, hashed)
+		if errTx != nil {
+			return errTx
+		}
+
+		events := make([]*model.OutboxEvent, 0, len(ids))
+		for i, id := range ids {
+			event, errEvent := newEvent(model.UserCreatedEventType, id, model.UserCreatedEvent{
+				ID:    id,
+				Name:  users[i].Name,
+				Email: users[i].Email,
+				Role:  users[i].Role,
+			})
+			if errEvent != nil {
+				return errEvent
+			}
+
+			events = append(events, event)
+		}
+
+		return s.addEvents(ctx, events)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	metric.AddUsersCreated(len(ids))
+
+	return ids, nil
+}
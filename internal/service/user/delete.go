@@ -1,10 +1,23 @@
 package user
 
-import "context"
+import (
+	"context"
+
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/tracing"
+)
 
 // DeleteUser запрос сервесного слоя на удаление пользователя
 func (s *service) DeleteUser(ctx context.Context, id int64) error {
-	err := s.userRepository.DeleteUser(ctx, id)
+	ctx, span := tracing.StartSpan(ctx, "UserService.DeleteUser")
+	defer span.End()
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		err := s.userRepository.DeleteUser(ctx, id)
+		if err != nil {
+			return err
+		}
 
-	return err
+		return s.addEvent(ctx, model.UserDeletedEventType, id, model.UserDeletedEvent{ID: id})
+	})
 }
@@ -4,10 +4,14 @@ import (
 	"context"
 
 	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/tracing"
 )
 
 // GetUser запрос сервесного слоя на получения информации о пользователе
 func (s *service) GetUser(ctx context.Context, id int64) (*model.UserGet, error) {
+	ctx, span := tracing.StartSpan(ctx, "UserService.GetUser")
+	defer span.End()
+
 	user, err := s.userRepository.GetUser(ctx, id)
 	if err != nil {
 		return nil, err
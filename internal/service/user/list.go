@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/tracing"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+// ListUsers запрос сервисного слоя на получение страницы пользователей.
+// Из репо слоя запрашивается на одну запись больше, чтобы определить наличие следующей страницы
+func (s *service) ListUsers(ctx context.Context, query *model.UserListQuery) (*model.UserList, error) {
+	ctx, span := tracing.StartSpan(ctx, "UserService.ListUsers")
+	defer span.End()
+
+	pageSize := query.Limit
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	repoQuery := *query
+	repoQuery.Limit = pageSize + 1
+
+	users, err := s.userRepository.ListUsers(ctx, &repoQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &model.UserList{Users: users}
+	if uint64(len(users)) > pageSize {
+		list.Users = users[:pageSize]
+
+		last := list.Users[pageSize-1]
+		list.NextCursor = &model.UserCursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		}
+	}
+
+	return list, nil
+}
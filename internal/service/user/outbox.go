@@ -0,0 +1,48 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/model"
+)
+
+// addEvent сохраняет событие пользователя в outbox, вызывается в транзакции изменения пользователя.
+// Если outbox выключен, событие не сохраняется
+func (s *service) addEvent(ctx context.Context, eventType string, userID int64, payload interface{}) error {
+	if s.outboxRepository == nil {
+		return nil
+	}
+
+	event, err := newEvent(eventType, userID, payload)
+	if err != nil {
+		return err
+	}
+
+	return s.outboxRepository.AddEvent(ctx, event)
+}
+
+// addEvents сохраняет события пользователей в outbox одним запросом.
+// Если outbox выключен, события не сохраняются
+func (s *service) addEvents(ctx context.Context, events []*model.OutboxEvent) error {
+	if s.outboxRepository == nil {
+		return nil
+	}
+
+	return s.outboxRepository.AddEvents(ctx, events)
+}
+
+func newEvent(eventType string, userID int64, payload interface{}) (*model.OutboxEvent, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal event")
+	}
+
+	return &model.OutboxEvent{
+		AggregateID: userID,
+		EventType:   eventType,
+		Payload:     data,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package user
+
+import (
+	"context"
+
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/tracing"
+)
+
+// RestoreUser запрос сервисного слоя на восстановление удаленного пользователя
+func (s *service) RestoreUser(ctx context.Context, id int64) error {
+	ctx, span := tracing.StartSpan(ctx, "UserService.RestoreUser")
+	defer span.End()
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		err := s.userRepository.RestoreUser(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return s.addEvent(ctx, model.UserRestoredEventType, id, model.UserRestoredEvent{ID: id})
+	})
+}
@@ -7,15 +7,25 @@ import (
 )
 
 type service struct {
-	userRepository repository.UserRepository
-	txManager      db.TxManager
+	userRepository   repository.UserRepository
+	outboxRepository repository.OutboxRepository
+	txManager        db.TxManager
+	passwordHasher   userService.PasswordHasher
 }
 
-// NewService конструктор для создания связи между сервисным слоем и репо слоем
-func NewService(userRepository repository.UserRepository, txManger db.TxManager) userService.UserService {
+// NewService конструктор для создания связи между сервисным слоем и репо слоем.
+// outboxRepository равен nil, если outbox выключен
+func NewService(
+	userRepository repository.UserRepository,
+	outboxRepository repository.OutboxRepository,
+	txManger db.TxManager,
+	passwordHasher userService.PasswordHasher,
+) userService.UserService {
 	return &service{
-		userRepository: userRepository,
-		txManager:      txManger,
+		userRepository:   userRepository,
+		outboxRepository: outboxRepository,
+		txManager:        txManger,
+		passwordHasher:   passwordHasher,
 	}
 }
 
@@ -27,6 +37,12 @@ func NewMockService(deps ...interface{}) userService.UserService {
 		switch s := v.(type) {
 		case repository.UserRepository:
 			service.userRepository = s
+		case repository.OutboxRepository:
+			service.outboxRepository = s
+		case db.TxManager:
+			service.txManager = s
+		case userService.PasswordHasher:
+			service.passwordHasher = s
 		}
 	}
 
@@ -2,6 +2,7 @@ package tests
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -9,15 +10,21 @@ import (
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ipv02/auth/internal/client/db"
+	dbMocks "github.com/ipv02/auth/internal/client/db/mocks"
 	"github.com/ipv02/auth/internal/model"
 	"github.com/ipv02/auth/internal/repository"
 	repoMocks "github.com/ipv02/auth/internal/repository/mocks"
+	"github.com/ipv02/auth/internal/service"
+	serviceMocks "github.com/ipv02/auth/internal/service/mocks"
 	"github.com/ipv02/auth/internal/service/user"
 )
 
 func TestCreate(t *testing.T) {
 	t.Parallel()
 	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+	type passwordHasherMockFunc func(mc *minimock.Controller) service.PasswordHasher
 
 	type args struct {
 		ctx context.Context
@@ -33,9 +40,12 @@ func TestCreate(t *testing.T) {
 		email           = gofakeit.Email()
 		password        = gofakeit.Password(true, true, true, true, false, 10)
 		passwordConfirm = password
+		passwordHash    = gofakeit.UUID()
 		role            = gofakeit.Int32()
 
-		repoErr = fmt.Errorf("repo error")
+		repoErr   = fmt.Errorf("repo error")
+		outboxErr = fmt.Errorf("outbox error")
+		hasherErr = fmt.Errorf("hasher error")
 
 		req = &model.UserCreate{
 			Name:            name,
@@ -44,14 +54,37 @@ func TestCreate(t *testing.T) {
 			PasswordConfirm: passwordConfirm,
 			Role:            role,
 		}
+
+		repoReq = &model.UserCreate{
+			Name:     name,
+			Email:    email,
+			Password: passwordHash,
+			Role:     role,
+		}
 	)
 
+	payload, err := json.Marshal(model.UserCreatedEvent{
+		ID:    id,
+		Name:  name,
+		Email: email,
+		Role:  role,
+	})
+	require.NoError(t, err)
+
+	event := &model.OutboxEvent{
+		AggregateID: id,
+		EventType:   model.UserCreatedEventType,
+		Payload:     payload,
+	}
+
 	tests := []struct {
-		name               string
-		args               args
-		want               int64
-		err                error
-		userRepositoryMock userRepositoryMockFunc
+		name                 string
+		args                 args
+		want                 int64
+		err                  error
+		userRepositoryMock   userRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
+		passwordHasherMock   passwordHasherMockFunc
 	}{
 		{
 			name: "success case",
@@ -63,7 +96,39 @@ func TestCreate(t *testing.T) {
 			err:  nil,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repoMocks.NewUserRepositoryMock(mc)
-				mock.CreateUserMock.Expect(ctx, req).Return(id, nil)
+				mock.CreateUserMock.Expect(minimock.AnyContext, repoReq).Return(id, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(minimock.AnyContext, event).Return(nil)
+				return mock
+			},
+			passwordHasherMock: func(mc *minimock.Controller) service.PasswordHasher {
+				mock := serviceMocks.NewPasswordHasherMock(mc)
+				mock.HashMock.Expect(password).Return(passwordHash, nil)
+				return mock
+			},
+		},
+		{
+			name: "outbox disabled case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: id,
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.CreateUserMock.Expect(minimock.AnyContext, repoReq).Return(id, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(_ *minimock.Controller) repository.OutboxRepository {
+				return nil
+			},
+			passwordHasherMock: func(mc *minimock.Controller) service.PasswordHasher {
+				mock := serviceMocks.NewPasswordHasherMock(mc)
+				mock.HashMock.Expect(password).Return(passwordHash, nil)
 				return mock
 			},
 		},
@@ -77,7 +142,59 @@ func TestCreate(t *testing.T) {
 			err:  repoErr,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repoMocks.NewUserRepositoryMock(mc)
-				mock.CreateUserMock.Expect(ctx, req).Return(0, repoErr)
+				mock.CreateUserMock.Expect(minimock.AnyContext, repoReq).Return(0, repoErr)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+			passwordHasherMock: func(mc *minimock.Controller) service.PasswordHasher {
+				mock := serviceMocks.NewPasswordHasherMock(mc)
+				mock.HashMock.Expect(password).Return(passwordHash, nil)
+				return mock
+			},
+		},
+		{
+			name: "outbox error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: 0,
+			err:  outboxErr,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.CreateUserMock.Expect(minimock.AnyContext, repoReq).Return(id, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(minimock.AnyContext, event).Return(outboxErr)
+				return mock
+			},
+			passwordHasherMock: func(mc *minimock.Controller) service.PasswordHasher {
+				mock := serviceMocks.NewPasswordHasherMock(mc)
+				mock.HashMock.Expect(password).Return(passwordHash, nil)
+				return mock
+			},
+		},
+		{
+			name: "hasher error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: 0,
+			err:  hasherErr,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+			passwordHasherMock: func(mc *minimock.Controller) service.PasswordHasher {
+				mock := serviceMocks.NewPasswordHasherMock(mc)
+				mock.HashMock.Expect(password).Return("", hasherErr)
 				return mock
 			},
 		},
@@ -89,7 +206,12 @@ func TestCreate(t *testing.T) {
 			t.Parallel()
 
 			userRepoMock := tt.userRepositoryMock(mc)
-			service := user.NewMockService(userRepoMock)
+			outboxRepoMock := tt.outboxRepositoryMock(mc)
+			passwordHasherMock := tt.passwordHasherMock(mc)
+			txManagerMock := dbMocks.NewTxManagerMock(mc).ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler, _ ...db.TxOption) error {
+				return f(ctx)
+			})
+			service := user.NewMockService(userRepoMock, outboxRepoMock, txManagerMock, passwordHasherMock)
 
 			newID, err := service.CreateUser(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
@@ -0,0 +1,193 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/auth/internal/client/db"
+	dbMocks "github.com/ipv02/auth/internal/client/db/mocks"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/repository"
+	repoMocks "github.com/ipv02/auth/internal/repository/mocks"
+	"github.com/ipv02/auth/internal/service"
+	serviceMocks "github.com/ipv02/auth/internal/service/mocks"
+	"github.com/ipv02/auth/internal/service/user"
+)
+
+func TestCreateUsers(t *testing.T) {
+	t.Parallel()
+	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+	type passwordHasherMockFunc func(mc *minimock.Controller) service.PasswordHasher
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		ids          = []int64{gofakeit.Int64(), gofakeit.Int64()}
+		password     = gofakeit.Password(true, true, true, true, false, 10)
+		passwordHash = gofakeit.UUID()
+
+		repoErr   = fmt.Errorf("repo error")
+		outboxErr = fmt.Errorf("outbox error")
+		hasherErr = fmt.Errorf("hasher error")
+
+		req     = make([]*model.UserCreate, 0, len(ids))
+		repoReq = make([]*model.UserCreate, 0, len(ids))
+		events  = make([]*model.OutboxEvent, 0, len(ids))
+	)
+
+	for _, id := range ids {
+		name, email, role := gofakeit.Name(), gofakeit.Email(), gofakeit.Int32()
+
+		req = append(req, &model.UserCreate{
+			Name:            name,
+			Email:           email,
+			Password:        password,
+			PasswordConfirm: password,
+			Role:            role,
+		})
+		repoReq = append(repoReq, &model.UserCreate{
+			Name:     name,
+			Email:    email,
+			Password: passwordHash,
+			Role:     role,
+		})
+
+		payload, err := json.Marshal(model.UserCreatedEvent{
+			ID:    id,
+			Name:  name,
+			Email: email,
+			Role:  role,
+		})
+		require.NoError(t, err)
+
+		events = append(events, &model.OutboxEvent{
+			AggregateID: id,
+			EventType:   model.UserCreatedEventType,
+			Payload:     payload,
+		})
+	}
+
+	tests := []struct {
+		name                 string
+		want                 []int64
+		err                  error
+		userRepositoryMock   userRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
+		passwordHasherMock   passwordHasherMockFunc
+	}{
+		{
+			name: "success case",
+			want: ids,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.CreateUsersMock.Expect(minimock.AnyContext, repoReq).Return(ids, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventsMock.Expect(minimock.AnyContext, events).Return(nil)
+				return mock
+			},
+			passwordHasherMock: func(mc *minimock.Controller) service.PasswordHasher {
+				mock := serviceMocks.NewPasswordHasherMock(mc)
+				mock.HashMock.Expect(password).Return(passwordHash, nil)
+				return mock
+			},
+		},
+		{
+			name: "outbox disabled case",
+			want: ids,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.CreateUsersMock.Expect(minimock.AnyContext, repoReq).Return(ids, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return nil
+			},
+			passwordHasherMock: func(mc *minimock.Controller) service.PasswordHasher {
+				mock := serviceMocks.NewPasswordHasherMock(mc)
+				mock.HashMock.Expect(password).Return(passwordHash, nil)
+				return mock
+			},
+		},
+		{
+			name: "repo error case",
+			err:  repoErr,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.CreateUsersMock.Expect(minimock.AnyContext, repoReq).Return(nil, repoErr)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+			passwordHasherMock: func(mc *minimock.Controller) service.PasswordHasher {
+				mock := serviceMocks.NewPasswordHasherMock(mc)
+				mock.HashMock.Expect(password).Return(passwordHash, nil)
+				return mock
+			},
+		},
+		{
+			name: "outbox error case",
+			err:  outboxErr,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.CreateUsersMock.Expect(minimock.AnyContext, repoReq).Return(ids, nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventsMock.Expect(minimock.AnyContext, events).Return(outboxErr)
+				return mock
+			},
+			passwordHasherMock: func(mc *minimock.Controller) service.PasswordHasher {
+				mock := serviceMocks.NewPasswordHasherMock(mc)
+				mock.HashMock.Expect(password).Return(passwordHash, nil)
+				return mock
+			},
+		},
+		{
+			name: "hasher error case",
+			err:  hasherErr,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+			passwordHasherMock: func(mc *minimock.Controller) service.PasswordHasher {
+				mock := serviceMocks.NewPasswordHasherMock(mc)
+				mock.HashMock.Expect(password).Return("", hasherErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userRepoMock := tt.userRepositoryMock(mc)
+			outboxRepoMock := tt.outboxRepositoryMock(mc)
+			passwordHasherMock := tt.passwordHasherMock(mc)
+			txManagerMock := dbMocks.NewTxManagerMock(mc).ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler, _ ...db.TxOption) error {
+				return f(ctx)
+			})
+			service := user.NewMockService(userRepoMock, outboxRepoMock, txManagerMock, passwordHasherMock)
+
+			newIDs, err := service.CreateUsers(ctx, req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, newIDs)
+		})
+	}
+}
@@ -2,11 +2,15 @@ package tests
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	"github.com/brianvoe/gofakeit"
 	"github.com/gojuno/minimock/v3"
+	"github.com/ipv02/auth/internal/client/db"
+	dbMocks "github.com/ipv02/auth/internal/client/db/mocks"
+	"github.com/ipv02/auth/internal/model"
 	"github.com/ipv02/auth/internal/repository"
 	repoMocks "github.com/ipv02/auth/internal/repository/mocks"
 	"github.com/ipv02/auth/internal/service/user"
@@ -16,6 +20,7 @@ import (
 func TestDelete(t *testing.T) {
 	t.Parallel()
 	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
 
 	type args struct {
 		ctx context.Context
@@ -28,15 +33,26 @@ func TestDelete(t *testing.T) {
 
 		id = gofakeit.Int64()
 
-		repoErr = fmt.Errorf("repo error")
+		repoErr   = fmt.Errorf("repo error")
+		outboxErr = fmt.Errorf("outbox error")
 	)
 
+	payload, err := json.Marshal(model.UserDeletedEvent{ID: id})
+	require.NoError(t, err)
+
+	event := &model.OutboxEvent{
+		AggregateID: id,
+		EventType:   model.UserDeletedEventType,
+		Payload:     payload,
+	}
+
 	tests := []struct {
-		name               string
-		args               args
-		want               error
-		err                error
-		userRepositoryMock userRepositoryMockFunc
+		name                 string
+		args                 args
+		want                 error
+		err                  error
+		userRepositoryMock   userRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
 	}{
 		{
 			name: "success case",
@@ -48,7 +64,12 @@ func TestDelete(t *testing.T) {
 			err:  nil,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repoMocks.NewUserRepositoryMock(mc)
-				mock.DeleteUserMock.Expect(ctx, id).Return(nil)
+				mock.DeleteUserMock.Expect(minimock.AnyContext, id).Return(nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(minimock.AnyContext, event).Return(nil)
 				return mock
 			},
 		},
@@ -62,7 +83,29 @@ func TestDelete(t *testing.T) {
 			err:  repoErr,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repoMocks.NewUserRepositoryMock(mc)
-				mock.DeleteUserMock.Expect(ctx, id).Return(repoErr)
+				mock.DeleteUserMock.Expect(minimock.AnyContext, id).Return(repoErr)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "outbox error case",
+			args: args{
+				ctx: ctx,
+				req: id,
+			},
+			want: nil,
+			err:  outboxErr,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.DeleteUserMock.Expect(minimock.AnyContext, id).Return(nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(minimock.AnyContext, event).Return(outboxErr)
 				return mock
 			},
 		},
@@ -74,7 +117,11 @@ func TestDelete(t *testing.T) {
 			t.Parallel()
 
 			userRepoMock := tt.userRepositoryMock(mc)
-			service := user.NewMockService(userRepoMock)
+			outboxRepoMock := tt.outboxRepositoryMock(mc)
+			txManagerMock := dbMocks.NewTxManagerMock(mc).ReadCommittedMock.Set(func(ctx context.Context, f db.Handler, _ ...db.TxOption) error {
+				return f(ctx)
+			})
+			service := user.NewMockService(userRepoMock, outboxRepoMock, txManagerMock)
 
 			err := service.DeleteUser(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
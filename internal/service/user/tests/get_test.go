@@ -68,7 +68,7 @@ func TestGet(t *testing.T) {
 			err:  nil,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repoMocks.NewUserRepositoryMock(mc)
-				mock.GetUserMock.Expect(ctx, id).Return(res, nil)
+				mock.GetUserMock.Expect(minimock.AnyContext, id).Return(res, nil)
 				return mock
 			},
 		},
@@ -82,7 +82,7 @@ func TestGet(t *testing.T) {
 			err:  repoErr,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repoMocks.NewUserRepositoryMock(mc)
-				mock.GetUserMock.Expect(ctx, id).Return(nil, repoErr)
+				mock.GetUserMock.Expect(minimock.AnyContext, id).Return(nil, repoErr)
 				return mock
 			},
 		},
@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/repository"
+	repoMocks "github.com/ipv02/auth/internal/repository/mocks"
+	"github.com/ipv02/auth/internal/service/user"
+)
+
+func TestList(t *testing.T) {
+	t.Parallel()
+	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+
+	type args struct {
+		ctx   context.Context
+		query *model.UserListQuery
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		filter = model.UserListFilter{
+			Role:  1,
+			Email: gofakeit.Email(),
+		}
+
+		users = []*model.UserGet{
+			{ID: 3, Name: gofakeit.Name(), CreatedAt: gofakeit.Date()},
+			{ID: 2, Name: gofakeit.Name(), CreatedAt: gofakeit.Date()},
+			{ID: 1, Name: gofakeit.Name(), CreatedAt: gofakeit.Date()},
+		}
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	tests := []struct {
+		name               string
+		args               args
+		want               *model.UserList
+		err                error
+		userRepositoryMock userRepositoryMockFunc
+	}{
+		{
+			name: "success case with next page",
+			args: args{
+				ctx:   ctx,
+				query: &model.UserListQuery{Limit: 2, Filter: filter},
+			},
+			want: &model.UserList{
+				Users: users[:2],
+				NextCursor: &model.UserCursor{
+					CreatedAt: users[1].CreatedAt,
+					ID:        users[1].ID,
+				},
+			},
+			err: nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.ListUsersMock.Expect(minimock.AnyContext, &model.UserListQuery{Limit: 3, Filter: filter}).Return(users, nil)
+				return mock
+			},
+		},
+		{
+			name: "success case last page with default page size",
+			args: args{
+				ctx:   ctx,
+				query: &model.UserListQuery{SortAsc: true},
+			},
+			want: &model.UserList{
+				Users: users,
+			},
+			err: nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.ListUsersMock.Expect(minimock.AnyContext, &model.UserListQuery{Limit: 51, SortAsc: true}).Return(users, nil)
+				return mock
+			},
+		},
+		{
+			name: "repo error case",
+			args: args{
+				ctx:   ctx,
+				query: &model.UserListQuery{Limit: 1000},
+			},
+			want: nil,
+			err:  repoErr,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.ListUsersMock.Expect(minimock.AnyContext, &model.UserListQuery{Limit: 101}).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userRepoMock := tt.userRepositoryMock(mc)
+			service := user.NewMockService(userRepoMock)
+
+			res, err := service.ListUsers(tt.args.ctx, tt.args.query)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
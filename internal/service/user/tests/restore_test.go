@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/ipv02/auth/internal/client/db"
+	dbMocks "github.com/ipv02/auth/internal/client/db/mocks"
+	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/repository"
+	repoMocks "github.com/ipv02/auth/internal/repository/mocks"
+	"github.com/ipv02/auth/internal/service/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestore(t *testing.T) {
+	t.Parallel()
+	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+
+	type args struct {
+		ctx context.Context
+		req int64
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id = gofakeit.Int64()
+
+		repoErr   = fmt.Errorf("repo error")
+		outboxErr = fmt.Errorf("outbox error")
+	)
+
+	payload, err := json.Marshal(model.UserRestoredEvent{ID: id})
+	require.NoError(t, err)
+
+	event := &model.OutboxEvent{
+		AggregateID: id,
+		EventType:   model.UserRestoredEventType,
+		Payload:     payload,
+	}
+
+	tests := []struct {
+		name                 string
+		args                 args
+		want                 error
+		err                  error
+		userRepositoryMock   userRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: id,
+			},
+			want: nil,
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.RestoreUserMock.Expect(minimock.AnyContext, id).Return(nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(minimock.AnyContext, event).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "repo error case",
+			args: args{
+				ctx: ctx,
+				req: id,
+			},
+			want: nil,
+			err:  repoErr,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.RestoreUserMock.Expect(minimock.AnyContext, id).Return(repoErr)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "outbox error case",
+			args: args{
+				ctx: ctx,
+				req: id,
+			},
+			want: nil,
+			err:  outboxErr,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.RestoreUserMock.Expect(minimock.AnyContext, id).Return(nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(minimock.AnyContext, event).Return(outboxErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userRepoMock := tt.userRepositoryMock(mc)
+			outboxRepoMock := tt.outboxRepositoryMock(mc)
+			txManagerMock := dbMocks.NewTxManagerMock(mc).ReadCommittedMock.Set(func(ctx context.Context, f db.Handler, _ ...db.TxOption) error {
+				return f(ctx)
+			})
+			service := user.NewMockService(userRepoMock, outboxRepoMock, txManagerMock)
+
+			err := service.RestoreUser(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, nil)
+		})
+	}
+}
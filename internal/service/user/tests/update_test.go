@@ -2,6 +2,7 @@ package tests
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -9,6 +10,8 @@ import (
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ipv02/auth/internal/client/db"
+	dbMocks "github.com/ipv02/auth/internal/client/db/mocks"
 	"github.com/ipv02/auth/internal/model"
 	"github.com/ipv02/auth/internal/repository"
 	repoMocks "github.com/ipv02/auth/internal/repository/mocks"
@@ -18,6 +21,7 @@ import (
 func TestUpdate(t *testing.T) {
 	t.Parallel()
 	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
 
 	type args struct {
 		ctx context.Context
@@ -33,22 +37,38 @@ func TestUpdate(t *testing.T) {
 		email = gofakeit.Email()
 		role  = gofakeit.Int32()
 
-		repoErr = fmt.Errorf("repo error")
+		repoErr   = fmt.Errorf("repo error")
+		outboxErr = fmt.Errorf("outbox error")
 
 		req = &model.UserUpdate{
 			ID:    id,
 			Name:  &name,
 			Email: &email,
-			Role:  role,
+			Role:  &role,
 		}
 	)
 
+	payload, err := json.Marshal(model.UserUpdatedEvent{
+		ID:    id,
+		Name:  &name,
+		Email: &email,
+		Role:  &role,
+	})
+	require.NoError(t, err)
+
+	event := &model.OutboxEvent{
+		AggregateID: id,
+		EventType:   model.UserUpdatedEventType,
+		Payload:     payload,
+	}
+
 	tests := []struct {
-		name               string
-		args               args
-		want               error
-		err                error
-		userRepositoryMock userRepositoryMockFunc
+		name                 string
+		args                 args
+		want                 error
+		err                  error
+		userRepositoryMock   userRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
 	}{
 		{
 			name: "success case",
@@ -60,10 +80,30 @@ func TestUpdate(t *testing.T) {
 			err:  nil,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repoMocks.NewUserRepositoryMock(mc)
-				mock.UpdateUserMock.Expect(ctx, req).Return(nil)
+				mock.UpdateUserMock.Expect(minimock.AnyContext, req).Return(nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(minimock.AnyContext, event).Return(nil)
 				return mock
 			},
 		},
+		{
+			name: "empty update case",
+			args: args{
+				ctx: ctx,
+				req: &model.UserUpdate{ID: id},
+			},
+			want: nil,
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
 		{
 			name: "repo error case",
 			args: args{
@@ -74,7 +114,29 @@ func TestUpdate(t *testing.T) {
 			err:  repoErr,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repoMocks.NewUserRepositoryMock(mc)
-				mock.UpdateUserMock.Expect(ctx, req).Return(repoErr)
+				mock.UpdateUserMock.Expect(minimock.AnyContext, req).Return(repoErr)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repoMocks.NewOutboxRepositoryMock(mc)
+			},
+		},
+		{
+			name: "outbox error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  outboxErr,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.UpdateUserMock.Expect(minimock.AnyContext, req).Return(nil)
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repoMocks.NewOutboxRepositoryMock(mc)
+				mock.AddEventMock.Expect(minimock.AnyContext, event).Return(outboxErr)
 				return mock
 			},
 		},
@@ -86,7 +148,11 @@ func TestUpdate(t *testing.T) {
 			t.Parallel()
 
 			userRepoMock := tt.userRepositoryMock(mc)
-			service := user.NewMockService(userRepoMock)
+			outboxRepoMock := tt.outboxRepositoryMock(mc)
+			txManagerMock := dbMocks.NewTxManagerMock(mc).ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler, _ ...db.TxOption) error {
+				return f(ctx)
+			})
+			service := user.NewMockService(userRepoMock, outboxRepoMock, txManagerMock)
 
 			err := service.UpdateUser(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
@@ -4,11 +4,30 @@ import (
 	"context"
 
 	"github.com/ipv02/auth/internal/model"
+	"github.com/ipv02/auth/internal/tracing"
 )
 
-// UpdateUser запрос сервесного слоя на обновление данных о пользователе
+// UpdateUser запрос сервесного слоя на обновление данных о пользователе.
+// Запрос без обновляемых полей ничего не меняет и не увеличивает версию записи
 func (s *service) UpdateUser(ctx context.Context, user *model.UserUpdate) error {
-	err := s.userRepository.UpdateUser(ctx, user)
+	ctx, span := tracing.StartSpan(ctx, "UserService.UpdateUser")
+	defer span.End()
 
-	return err
+	if user.Name == nil && user.Email == nil && user.Role == nil {
+		return nil
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		err := s.userRepository.UpdateUser(ctx, user)
+		if err != nil {
+			return err
+		}
+
+		return s.addEvent(ctx, model.UserUpdatedEventType, user.ID, model.UserUpdatedEvent{
+			ID:    user.ID,
+			Name:  user.Name,
+			Email: user.Email,
+			Role:  user.Role,
+		})
+	})
 }
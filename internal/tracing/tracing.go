@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ipv02/auth/internal/config"
+)
+
+const tracerName = "github.com/ipv02/auth/internal/service"
+
+// Экспортеры трейсов
+const (
+	exporterOTLP   = "otlp"
+	exporterStdout = "stdout"
+)
+
+// NewTracerProvider создает провайдер трейсов с экспортером из конфигурации.
+// Решение о записи трейса, пришедшего во входящем запросе или сообщении, сохраняется,
+// новые трейсы записываются с долей cfg.SampleRatio()
+func NewTracerProvider(ctx context.Context, cfg config.TracingConfig) (*sdktrace.TracerProvider, error) {
+	exporter, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(cfg.ServiceName())),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create tracing resource")
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio()))),
+	), nil
+}
+
+func newExporter(ctx context.Context, cfg config.TracingConfig) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter() {
+	case exporterOTLP:
+		exporter, err := otlptracegrpc.New(ctx,
+			otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint()),
+			otlptracegrpc.WithInsecure(),
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create otlp exporter")
+		}
+
+		return exporter, nil
+	case exporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create stdout exporter")
+		}
+
+		return exporter, nil
+	default:
+		return nil, errors.Errorf("unknown tracing exporter %q", cfg.Exporter())
+	}
+}
+
+// NewPropagator возвращает формат передачи контекста трейса в заголовках gRPC, HTTP и kafka
+func NewPropagator() propagation.TextMapPropagator {
+	return propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+}
+
+// StartSpan начинает span сервисного слоя с именем name
+func StartSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name)
+}
@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/ipv02/auth/internal/model"
+)
+
+type callerKey struct{}
+
+// ContextWithCaller кладет в контекст данные аутентифицированного пользователя
+func ContextWithCaller(ctx context.Context, caller *model.UserClaims) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFromContext достает из контекста данные аутентифицированного пользователя
+func CallerFromContext(ctx context.Context) (*model.UserClaims, bool) {
+	caller, ok := ctx.Value(callerKey{}).(*model.UserClaims)
+	return caller, ok && caller != nil
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/ipv02/auth/internal/model"
+)
+
+// EncodeUserCursor кодирует курсор пагинации в непрозрачную строку.
+// Для nil курсора возвращает пустую строку
+func EncodeUserCursor(cursor *model.UserCursor) (string, error) {
+	if cursor == nil {
+		return "", nil
+	}
+
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeUserCursor декодирует курсор пагинации. Для пустой строки возвращает nil
+func DecodeUserCursor(token string) (*model.UserCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, model.ErrorInvalidPageToken
+	}
+
+	var cursor model.UserCursor
+	if err = json.Unmarshal(data, &cursor); err != nil || cursor.ID == 0 {
+		return nil, model.ErrorInvalidPageToken
+	}
+
+	return &cursor, nil
+}
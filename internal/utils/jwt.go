@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/auth/internal/model"
+)
+
+// GenerateToken выписывает access токен, подписанный алгоритмом HS256
+func GenerateToken(info model.UserInfo, secretKey []byte, duration time.Duration) (string, error) {
+	return signToken(newClaims(info, model.AccessTokenType, time.Now().Add(duration)), secretKey)
+}
+
+// GenerateRefreshToken выписывает refresh токен с идентификатором токена и его семейства
+func GenerateRefreshToken(info model.UserInfo, refreshToken *model.RefreshToken, secretKey []byte) (string, error) {
+	claims := newClaims(info, model.RefreshTokenType, refreshToken.ExpiresAt)
+	claims.ID = refreshToken.ID
+	claims.FamilyID = refreshToken.FamilyID
+
+	return signToken(claims, secretKey)
+}
+
+// VerifyToken проверяет подпись, срок действия и тип JWT токена и возвращает его claims
+func VerifyToken(tokenStr string, secretKey []byte, tokenType string) (*model.UserClaims, error) {
+	token, err := jwt.ParseWithClaims(
+		tokenStr,
+		&model.UserClaims{},
+		func(_ *jwt.Token) (interface{}, error) {
+			return secretKey, nil
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, errors.Wrap(model.ErrorInvalidToken, err.Error())
+	}
+
+	claims, ok := token.Claims.(*model.UserClaims)
+	if !ok || !token.Valid || claims.TokenType != tokenType {
+		return nil, model.ErrorInvalidToken
+	}
+
+	return claims, nil
+}
+
+func newClaims(info model.UserInfo, tokenType string, expiresAt time.Time) model.UserClaims {
+	return model.UserClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   strconv.FormatInt(info.ID, 10),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		TokenType: tokenType,
+		UserID:    info.ID,
+		Role:      info.Role,
+	}
+}
+
+func signToken(claims model.UserClaims, secretKey []byte) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	return token.SignedString(secretKey)
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ipv02/auth/internal/model"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+
+	// IfMatchHeader ключ метаданных с ожидаемой версией записи
+	IfMatchHeader = "if-match"
+	// ETagHeader ключ метаданных ответа с текущей версией записи
+	ETagHeader = "etag"
+
+	weakETagPrefix = "W/"
+)
+
+// BearerTokenFromContext достает bearer токен из входящих gRPC метаданных
+func BearerTokenFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", model.ErrorMissingToken
+	}
+
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return "", model.ErrorMissingToken
+	}
+
+	if !strings.HasPrefix(values[0], bearerPrefix) {
+		return "", model.ErrorMissingToken
+	}
+
+	token := strings.TrimSpace(strings.TrimPrefix(values[0], bearerPrefix))
+	if token == "" {
+		return "", model.ErrorMissingToken
+	}
+
+	return token, nil
+}
+
+// VersionFromIfMatch достает ожидаемую версию записи из заголовка If-Match.
+// Если заголовок не передан, возвращает 0
+func VersionFromIfMatch(ctx context.Context) (int64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+
+	values := md.Get(IfMatchHeader)
+	if len(values) == 0 {
+		return 0, nil
+	}
+
+	etag := strings.TrimPrefix(strings.TrimSpace(values[0]), weakETagPrefix)
+	version, err := strconv.ParseInt(strings.Trim(etag, `"`), 10, 64)
+	if err != nil || version <= 0 {
+		return 0, model.ErrorInvalidIfMatch
+	}
+
+	return version, nil
+}
+
+// ETagFromVersion формирует значение ETag по версии записи
+func ETagFromVersion(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
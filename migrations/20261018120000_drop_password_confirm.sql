@@ -0,0 +1,5 @@
+-- +goose Up
+alter table auth drop column password_confirm;
+
+-- +goose Down
+alter table auth add column password_confirm text not null default '';
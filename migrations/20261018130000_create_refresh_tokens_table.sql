@@ -0,0 +1,17 @@
+-- +goose Up
+-- Пользователи могут храниться в redis, поэтому user_id не ссылается на auth.
+-- Токены удаленных пользователей отклоняются при обмене
+create table refresh_tokens (
+    id uuid primary key,
+    family_id uuid not null,
+    user_id int not null,
+    expires_at timestamp not null,
+    used_at timestamp,
+    revoked_at timestamp,
+    created_at timestamp not null default now()
+);
+
+create index refresh_tokens_family_id_idx on refresh_tokens (family_id);
+
+-- +goose Down
+drop table refresh_tokens;
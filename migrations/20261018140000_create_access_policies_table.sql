@@ -0,0 +1,13 @@
+-- +goose Up
+-- Доступ к UserV1 проверяет AuthInterceptor, access_policies описывает только эндпоинты других сервисов.
+-- роли: 1 - USER, 2 - ADMIN
+create table access_policies (
+    id serial primary key,
+    endpoint_address text not null,
+    role int not null,
+    created_at timestamp not null default now(),
+    unique (endpoint_address, role)
+);
+
+-- +goose Down
+drop table access_policies;
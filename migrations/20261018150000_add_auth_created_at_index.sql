@@ -0,0 +1,5 @@
+-- +goose Up
+create index auth_created_at_id_idx on auth (created_at, id);
+
+-- +goose Down
+drop index auth_created_at_id_idx;
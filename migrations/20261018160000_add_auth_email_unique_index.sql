@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+do $$
+declare
+    conflicts text;
+begin
+    select string_agg(email || ': ' || ids, '; ')
+    into conflicts
+    from (
+        select email, string_agg(id::text, ', ' order by id) as ids
+        from (select id, lower(email) as email from auth) a
+        group by email
+        having count(*) > 1
+    ) d;
+
+    if conflicts is not null then
+        raise exception 'auth contains case-insensitive duplicate emails, resolve them before migrating: %', conflicts;
+    end if;
+end
+$$;
+-- +goose StatementEnd
+
+create unique index auth_email_lower_uniq_idx on auth (lower(email));
+
+-- +goose Down
+drop index auth_email_lower_uniq_idx;
@@ -0,0 +1,18 @@
+-- +goose Up
+alter table auth add column deleted_at timestamp;
+
+-- email уникален только среди неудаленных пользователей
+drop index auth_email_lower_uniq_idx;
+create unique index auth_email_lower_uniq_idx on auth (lower(email)) where deleted_at is null;
+
+create index auth_deleted_at_idx on auth (deleted_at) where deleted_at is not null;
+
+-- +goose Down
+drop index auth_deleted_at_idx;
+
+-- данные удаленных пользователей сохраняются: если их email совпадают с другими, откат остановится на создании индекса,
+-- и дубли нужно разрешить вручную
+drop index auth_email_lower_uniq_idx;
+create unique index auth_email_lower_uniq_idx on auth (lower(email));
+
+alter table auth drop column deleted_at;
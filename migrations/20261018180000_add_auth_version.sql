@@ -0,0 +1,5 @@
+-- +goose Up
+alter table auth add column version bigint not null default 1;
+
+-- +goose Down
+alter table auth drop column version;
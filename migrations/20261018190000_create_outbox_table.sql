@@ -0,0 +1,11 @@
+-- +goose Up
+create table outbox (
+    id bigserial primary key,
+    aggregate_id bigint not null,
+    event_type text not null,
+    payload jsonb not null,
+    created_at timestamp not null default now()
+);
+
+-- +goose Down
+drop table outbox;
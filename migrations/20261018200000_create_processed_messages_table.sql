@@ -0,0 +1,8 @@
+-- +goose Up
+create table processed_messages (
+    idempotency_key text primary key,
+    processed_at timestamp not null default now()
+);
+
+-- +goose Down
+drop table processed_messages;
@@ -0,0 +1,5 @@
+-- +goose Up
+create index processed_messages_processed_at_idx on processed_messages (processed_at);
+
+-- +goose Down
+drop index processed_messages_processed_at_idx;
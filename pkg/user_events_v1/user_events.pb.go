@@ -0,0 +1,245 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v5.28.2
+// source: user_events.proto
+
+package user_events_v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UserRole значения совпадают с user_v1.UserRole
+type UserRole int32
+
+const (
+	UserRole_UNKNOWN UserRole = 0
+	UserRole_USER    UserRole = 1
+	UserRole_ADMIN   UserRole = 2
+)
+
+// Enum value maps for UserRole.
+var (
+	UserRole_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "USER",
+		2: "ADMIN",
+	}
+	UserRole_value = map[string]int32{
+		"UNKNOWN": 0,
+		"USER":    1,
+		"ADMIN":   2,
+	}
+)
+
+func (x UserRole) Enum() *UserRole {
+	p := new(UserRole)
+	*p = x
+	return p
+}
+
+func (x UserRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_events_proto_enumTypes[0].Descriptor()
+}
+
+func (UserRole) Type() protoreflect.EnumType {
+	return &file_user_events_proto_enumTypes[0]
+}
+
+func (x UserRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserRole.Descriptor instead.
+func (UserRole) EnumDescriptor() ([]byte, []int) {
+	return file_user_events_proto_rawDescGZIP(), []int{0}
+}
+
+// UserCreateEvent событие создания пользователя из топика kafka.
+// Правила валидации совпадают с user_v1.CreateUserRequest и проверяются при обработке сообщения
+type UserCreateEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email           string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Password        string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	PasswordConfirm string   `protobuf:"bytes,4,opt,name=password_confirm,json=passwordConfirm,proto3" json:"password_confirm,omitempty"`
+	Role            UserRole `protobuf:"varint,5,opt,name=role,proto3,enum=user_events_v1.UserRole" json:"role,omitempty"`
+}
+
+func (x *UserCreateEvent) Reset() {
+	*x = UserCreateEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserCreateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCreateEvent) ProtoMessage() {}
+
+func (x *UserCreateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCreateEvent.ProtoReflect.Descriptor instead.
+func (*UserCreateEvent) Descriptor() ([]byte, []int) {
+	return file_user_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserCreateEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserCreateEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserCreateEvent) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *UserCreateEvent) GetPasswordConfirm() string {
+	if x != nil {
+		return x.PasswordConfirm
+	}
+	return ""
+}
+
+func (x *UserCreateEvent) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_UNKNOWN
+}
+
+var File_user_events_proto protoreflect.FileDescriptor
+
+var file_user_events_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x5f, 0x76, 0x31, 0x22, 0xb0, 0x01, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x29, 0x0a,
+	0x10, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x2c, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x2a, 0x2c, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x4d,
+	0x49, 0x4e, 0x10, 0x02, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x69, 0x70, 0x76, 0x30, 0x32, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x76, 0x31,
+	0x3b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_user_events_proto_rawDescOnce sync.Once
+	file_user_events_proto_rawDescData = file_user_events_proto_rawDesc
+)
+
+func file_user_events_proto_rawDescGZIP() []byte {
+	file_user_events_proto_rawDescOnce.Do(func() {
+		file_user_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_user_events_proto_rawDescData)
+	})
+	return file_user_events_proto_rawDescData
+}
+
+var file_user_events_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_user_events_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_user_events_proto_goTypes = []interface{}{
+	(UserRole)(0),           // 0: user_events_v1.UserRole
+	(*UserCreateEvent)(nil), // 1: user_events_v1.UserCreateEvent
+}
+var file_user_events_proto_depIdxs = []int32{
+	0, // 0: user_events_v1.UserCreateEvent.role:type_name -> user_events_v1.UserRole
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_user_events_proto_init() }
+func file_user_events_proto_init() {
+	if File_user_events_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_user_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserCreateEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_user_events_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_user_events_proto_goTypes,
+		DependencyIndexes: file_user_events_proto_depIdxs,
+		EnumInfos:         file_user_events_proto_enumTypes,
+		MessageInfos:      file_user_events_proto_msgTypes,
+	}.Build()
+	File_user_events_proto = out.File
+	file_user_events_proto_rawDesc = nil
+	file_user_events_proto_goTypes = nil
+	file_user_events_proto_depIdxs = nil
+}
@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user_events.proto
+
+package user_events_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on UserCreateEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserCreateEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserCreateEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserCreateEventMultiError, or nil if none found.
+func (m *UserCreateEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserCreateEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Email
+
+	// no validation rules for Password
+
+	// no validation rules for PasswordConfirm
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return UserCreateEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserCreateEventMultiError is an error wrapping multiple validation errors
+// returned by UserCreateEvent.ValidateAll() if the designated constraints
+// aren't met.
+type UserCreateEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserCreateEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserCreateEventMultiError) AllErrors() []error { return m }
+
+// UserCreateEventValidationError is the validation error returned by
+// UserCreateEvent.Validate if the designated constraints aren't met.
+type UserCreateEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserCreateEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserCreateEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserCreateEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserCreateEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserCreateEventValidationError) ErrorName() string { return "UserCreateEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserCreateEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserCreateEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserCreateEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserCreateEventValidationError{}
@@ -1,6 +1,8 @@
 package user_v1
 
 import (
+	"strings"
+
 	"github.com/pkg/errors"
 )
 
@@ -18,15 +20,46 @@ func (req *CreateUserRequest) ValidateRequest() error {
 	return nil
 }
 
-// ValidateRequest валидация UpdateUserRequest
+// Пути update_mask для UpdateUserRequest
+const (
+	UpdateUserPathName  = "name"
+	UpdateUserPathEmail = "email"
+	UpdateUserPathRole  = "role"
+)
+
+// ValidateRequest валидация UpdateUserRequest, проверяются только поля из update_mask
 func (req *UpdateUserRequest) ValidateRequest() error {
-	if req.Role == UserRole_UNKNOWN {
-		return errors.New("role is unknown")
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		switch path {
+		case UpdateUserPathName:
+			if strings.TrimSpace(req.GetName().GetValue()) == "" {
+				return errors.New("name is required")
+			}
+		case UpdateUserPathEmail:
+			if strings.TrimSpace(req.GetEmail().GetValue()) == "" {
+				return errors.New("email is required")
+			}
+		case UpdateUserPathRole:
+			if req.GetRole() == UserRole_UNKNOWN {
+				return errors.New("role is unknown")
+			}
+		}
 	}
 
 	return nil
 }
 
+// UpdatesPath проверяет, что update_mask содержит путь
+func (req *UpdateUserRequest) UpdatesPath(path string) bool {
+	for _, p := range req.GetUpdateMask().GetPaths() {
+		if p == path {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ValidateRequest валидация DeleteUserRequest
 func (req *DeleteUserRequest) ValidateRequest() error {
 	if req.Id == 0 {